@@ -23,6 +23,9 @@ type ShardStateAware interface {
 	Created(bhmetapb.Shard)
 	// Splited the shard was splited on the current store
 	Splited(bhmetapb.Shard)
+	// Merged the source shard was merged into the target shard on the current store,
+	// the arg is the target shard after merged.
+	Merged(bhmetapb.Shard)
 	// Destory the shard was destoryed on the current store
 	Destory(bhmetapb.Shard)
 	// BecomeLeader the shard was become leader on the current store
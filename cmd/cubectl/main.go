@@ -0,0 +1,224 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// cubectl is the command-line tool to operate the matrixcube cluster, it finds the
+// prophet leader from the etcd and talks to it by the prophet client.
+//
+// Usage:
+//
+//	cubectl [-etcd addrs] [-timeout duration] [-ca file -cert file -key file] <command> [subcommand] [flags] [args]
+//
+// Commands:
+//
+//	store list
+//	store up <id>
+//	store offline [-force] <id>
+//	store tombstone [-force] <id>
+//	shard list [-group id]
+//	hot [read|write]
+//	scheduler list
+//	scheduler add <type> [args...]
+//	scheduler remove <name>
+//	rule dump [-group name] [-file path]
+//	rule load -file path
+//	unsafe-recovery start <failed store id>...
+//	unsafe-recovery report
+//	quota list
+//	quota set [-tenant name] [-group id] [-read-qps n] [-write-qps n] [-read-bytes n] [-write-bytes n]
+//	quota remove [-tenant name] [-group id]
+//
+// The store state, scheduler and placement rule changes are rejected by prophet unless enable-http-admin is set.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	"github.com/matrixorigin/matrixcube/components/prophet/election"
+	"github.com/matrixorigin/matrixcube/components/prophet/member"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
+	"go.etcd.io/etcd/clientv3"
+)
+
+var (
+	etcdAddrs = flag.String("etcd", "http://127.0.0.1:2379", "Comma separated etcd addresses of the prophet cluster")
+	timeout   = flag.Duration("timeout", time.Second*10, "Timeout of the request to prophet")
+	caFile    = flag.String("ca", "", "CA certificate file to verify the prophet and the etcd if the tls is enabled")
+	certFile  = flag.String("cert", "", "Client certificate file, the tls is enabled if the cert and the key are set")
+	keyFile   = flag.String("key", "", "Private key file of the client certificate")
+)
+
+type command func(client prophet.Client, args []string) error
+
+var commands = map[string]map[string]command{
+	"store": {
+		"list":      storeList,
+		"up":        storeUp,
+		"offline":   storeOffline,
+		"tombstone": storeTombstone,
+	},
+	"shard": {
+		"list": shardList,
+	},
+	"scheduler": {
+		"list":   schedulerList,
+		"add":    schedulerAdd,
+		"remove": schedulerRemove,
+	},
+	"rule": {
+		"dump": ruleDump,
+		"load": ruleLoad,
+	},
+	"unsafe-recovery": {
+		"start":  unsafeRecoveryStart,
+		"report": unsafeRecoveryReport,
+	},
+	"quota": {
+		"list":   quotaList,
+		"set":    quotaSet,
+		"remove": quotaRemove,
+	},
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	cmd, args, err := parseCommand(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		usage()
+		os.Exit(2)
+	}
+
+	client, err := createClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect to prophet failed: %+v\n", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	if err := cmd(client, args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func parseCommand(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, fmt.Errorf("missing command")
+	}
+
+	if args[0] == "hot" {
+		return hot, args[1:], nil
+	}
+
+	subCommands, ok := commands[args[0]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %s", args[0])
+	}
+	if len(args) == 1 {
+		return nil, nil, fmt.Errorf("missing subcommand of %s", args[0])
+	}
+	cmd, ok := subCommands[args[1]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown subcommand %s %s", args[0], args[1])
+	}
+	return cmd, args[2:], nil
+}
+
+func createClient() (prophet.Client, error) {
+	tls, err := tlsutil.NewLoader(tlsutil.Config{
+		CAFile:   *caFile,
+		CertFile: *certFile,
+		KeyFile:  *keyFile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(*etcdAddrs, ","),
+		DialTimeout: *timeout,
+		TLS:         tls.ClientConfig(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	elector, err := election.NewElector(etcdClient)
+	if err != nil {
+		return nil, err
+	}
+
+	leader, err := member.CurrentLeader(elector)
+	if err != nil {
+		return nil, err
+	}
+	if leader == nil {
+		return nil, fmt.Errorf("prophet leader not found")
+	}
+
+	return prophet.NewClient(raftstore.NewProphetAdapter(),
+		prophet.WithRPCTimeout(*timeout),
+		prophet.WithTLS(tls),
+		prophet.WithLeaderGetter(func() *metapb.Member {
+			if value, err := member.CurrentLeader(elector); err == nil && value != nil {
+				leader = value
+			}
+			return leader
+		})), nil
+}
+
+// newFlagSet returns the flag set of the subcommand, the usage will print the giving
+// arguments description.
+func newFlagSet(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: cubectl %s [flags] %s\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: cubectl [flags] <command> [subcommand] [flags] [args]
+
+Commands:
+  store list                       list all the stores
+  store up <id>                    set the store state to up
+  store offline [-force] <id>      set the store state to offline
+  store tombstone [-force] <id>    set the store state to tombstone
+  shard list [-group id]           list all the shards
+  hot [read|write]                 show the hot shards
+  scheduler list                   list all the schedulers
+  scheduler add <type> [args...]   add a scheduler
+  scheduler remove <name>          remove the scheduler
+  rule dump [-group name] [-file]  dump the placement rules as json
+  rule load -file path             load the placement rules from the json file
+  unsafe-recovery start <ids...>   recover the shards which lost the majority on the failed stores
+  unsafe-recovery report           show the unsafe recovery progress and the possibly lost writes
+  quota list                       list the request quotas of the tenants and the shard groups
+  quota set [flags]                set the request quota of the tenant or the shard group
+  quota remove [flags]             remove the request quota of the tenant or the shard group
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
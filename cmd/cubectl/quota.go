@@ -0,0 +1,91 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+)
+
+func quotaList(client prophet.Client, args []string) error {
+	quotas, err := raftstore.GetRequestQuotas(client)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TENANT\tGROUP\tREAD-QPS\tWRITE-QPS\tREAD-BYTES\tWRITE-BYTES")
+	for _, q := range quotas {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n",
+			q.Tenant, q.Group, q.ReadQPS, q.WriteQPS, q.ReadBytes, q.WriteBytes)
+	}
+	return w.Flush()
+}
+
+// quotaSet sets the request quota of the tenant, or the shard group if the tenant is
+// not set. The limit of 0 means unlimited.
+func quotaSet(client prophet.Client, args []string) error {
+	fs := newFlagSet("quota set", "")
+	tenant, group := quotaTargetFlags(fs)
+	readQPS := fs.Uint64("read-qps", 0, "Max read requests per second, 0 means unlimited")
+	writeQPS := fs.Uint64("write-qps", 0, "Max write requests per second, 0 means unlimited")
+	readBytes := fs.Uint64("read-bytes", 0, "Max read bytes per second, 0 means unlimited")
+	writeBytes := fs.Uint64("write-bytes", 0, "Max write bytes per second, 0 means unlimited")
+	fs.Parse(args)
+
+	quota := bhmetapb.RequestQuota{
+		Tenant:     *tenant,
+		Group:      *group,
+		ReadQPS:    *readQPS,
+		WriteQPS:   *writeQPS,
+		ReadBytes:  *readBytes,
+		WriteBytes: *writeBytes,
+	}
+	if err := raftstore.SetRequestQuota(client, quota); err != nil {
+		return err
+	}
+	fmt.Printf("request quota of %s is set\n", quotaTarget(*tenant, *group))
+	return nil
+}
+
+func quotaRemove(client prophet.Client, args []string) error {
+	fs := newFlagSet("quota remove", "")
+	tenant, group := quotaTargetFlags(fs)
+	fs.Parse(args)
+
+	if err := raftstore.RemoveRequestQuota(client, *tenant, *group); err != nil {
+		return err
+	}
+	fmt.Printf("request quota of %s is removed\n", quotaTarget(*tenant, *group))
+	return nil
+}
+
+func quotaTargetFlags(fs *flag.FlagSet) (*string, *uint64) {
+	tenant := fs.String("tenant", "", "Tenant of the quota, the shard group is used if the tenant is empty")
+	group := fs.Uint64("group", 0, "Shard group of the quota")
+	return tenant, group
+}
+
+func quotaTarget(tenant string, group uint64) string {
+	if tenant != "" {
+		return fmt.Sprintf("tenant %s", tenant)
+	}
+	return fmt.Sprintf("group %d", group)
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+)
+
+func unsafeRecoveryStart(client prophet.Client, args []string) error {
+	fs := newFlagSet("unsafe-recovery start", "<failed store id>...")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("missing failed store id")
+	}
+
+	var failed []uint64
+	for _, arg := range fs.Args() {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid store id %s", arg)
+		}
+		failed = append(failed, id)
+	}
+
+	if err := raftstore.StartUnsafeRecovery(client, failed); err != nil {
+		return err
+	}
+	fmt.Printf("unsafe recovery started on the failed stores %v\n", failed)
+	return nil
+}
+
+// unsafeRecoveryReport prints the progress of the unsafe recovery. The writes to a
+// recovered shard which were committed after the recovered index may be lost.
+func unsafeRecoveryReport(client prophet.Client, args []string) error {
+	progress, err := raftstore.GetUnsafeRecoveryProgress(client)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SHARD\tGROUP\tSTART\tEND\tSTATE\tLOST-STORES\tRECOVERED-STORE\tRECOVERED-INDEX\tERROR")
+	for _, us := range progress.Shards {
+		var lost []uint64
+		for _, p := range us.LostPeers {
+			lost = append(lost, p.ContainerID)
+		}
+		fmt.Fprintf(w, "%d\t%d\t%x\t%x\t%s\t%v\t%d\t%d\t%s\n",
+			us.Shard.ID, us.Shard.Group, us.Shard.Start, us.Shard.End, us.State.String(), lost,
+			us.ChosenContainerID, recoveredIndex(us), us.Error)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nfailed stores %v, completed: %t\n", progress.FailedContainers, progress.Completed)
+	fmt.Println("the writes committed after the recovered index of the shard may be lost")
+	return nil
+}
+
+func recoveredIndex(us bhmetapb.UnsafeRecoveryShard) uint64 {
+	for _, report := range us.Reports {
+		if report.ContainerID == us.ChosenContainerID {
+			return report.AppliedIndex
+		}
+	}
+	return 0
+}
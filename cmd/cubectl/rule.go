@@ -0,0 +1,78 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+)
+
+func ruleDump(client prophet.Client, args []string) error {
+	fs := newFlagSet("rule dump", "")
+	group := fs.String("group", "", "Only dump the rules of the group")
+	file := fs.String("file", "", "Write the rules to the file instead of stdout")
+	fs.Parse(args)
+
+	rules, err := client.GetPlacementRules(*group)
+	if err != nil {
+		return err
+	}
+	if rules == nil {
+		rules = []rpcpb.PlacementRule{}
+	}
+
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if *file == "" {
+		_, err = fmt.Fprintln(os.Stdout, string(data))
+		return err
+	}
+	return ioutil.WriteFile(*file, data, 0644)
+}
+
+func ruleLoad(client prophet.Client, args []string) error {
+	fs := newFlagSet("rule load", "")
+	file := fs.String("file", "", "The json file of the rules, which is dumped by rule dump")
+	fs.Parse(args)
+	if *file == "" {
+		fs.Usage()
+		return fmt.Errorf("missing rules file")
+	}
+
+	data, err := ioutil.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+
+	var rules []rpcpb.PlacementRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if err := client.PutPlacementRule(rule); err != nil {
+			return fmt.Errorf("put rule %s/%s failed: %+v", rule.GroupID, rule.ID, err)
+		}
+	}
+	fmt.Printf("%d rules loaded\n", len(rules))
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+)
+
+func schedulerList(client prophet.Client, args []string) error {
+	schedulers, err := client.GetSchedulers()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPAUSED\tDISABLED")
+	for _, s := range schedulers {
+		fmt.Fprintf(w, "%s\t%t\t%t\n", s.Name, s.Paused, s.Disabled)
+	}
+	return w.Flush()
+}
+
+func schedulerAdd(client prophet.Client, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing scheduler type")
+	}
+
+	name, err := client.AddScheduler(args[0], args[1:]...)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("scheduler %s added\n", name)
+	return nil
+}
+
+func schedulerRemove(client prophet.Client, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("missing scheduler name")
+	}
+
+	if err := client.RemoveScheduler(args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("scheduler %s removed\n", args[0])
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+)
+
+func shardList(client prophet.Client, args []string) error {
+	fs := newFlagSet("shard list", "")
+	group := fs.Int64("group", -1, "Only list the shards of the group")
+	fs.Parse(args)
+
+	resources, leaders, stats, err := client.GetResources()
+	if err != nil {
+		return err
+	}
+
+	idx := make([]int, 0, len(resources))
+	for i, res := range resources {
+		if *group < 0 || res.Group() == uint64(*group) {
+			idx = append(idx, i)
+		}
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		return resources[idx[i]].ID() < resources[idx[j]].ID()
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tGROUP\tSTART\tEND\tLEADER\tPEERS\tSIZE\tKEYS")
+	for _, i := range idx {
+		res := resources[i]
+		start, end := res.Range()
+		var peers []uint64
+		for _, p := range res.Peers() {
+			peers = append(peers, p.ContainerID)
+		}
+		fmt.Fprintf(w, "%d\t%d\t%x\t%x\t%d\t%v\t%d\t%d\n",
+			res.ID(), res.Group(), start, end, leaders[i], peers,
+			stats[i].ApproximateSize, stats[i].ApproximateKeys)
+	}
+	return w.Flush()
+}
+
+func hot(client prophet.Client, args []string) error {
+	rsp, err := client.GetHotResources()
+	if err != nil {
+		return err
+	}
+
+	kind := "write"
+	if len(args) > 0 {
+		kind = args[0]
+	}
+
+	values := rsp.Writes
+	switch kind {
+	case "write":
+	case "read":
+		values = rsp.Reads
+	default:
+		return fmt.Errorf("invalid hot kind %s, must be read or write", kind)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].ByteRate > values[j].ByteRate
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SHARD\tSTORE\tLEADER\tHOT-DEGREE\tBYTE-RATE\tKEY-RATE")
+	for _, v := range values {
+		fmt.Fprintf(w, "%d\t%d\t%t\t%d\t%.2f\t%.2f\n",
+			v.ResourceID, v.ContainerID, v.IsLeader, v.HotDegree, v.ByteRate, v.KeyRate)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+)
+
+func storeList(client prophet.Client, args []string) error {
+	containers, stats, err := client.GetContainers()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tADDR\tSHARD-ADDR\tSTATE\tCAPACITY\tAVAILABLE\tSHARDS\tLABELS")
+	for i, c := range containers {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%d\t%d\t%v\n",
+			c.ID(), c.Addr(), c.ShardAddr(), c.State().String(),
+			stats[i].Capacity, stats[i].Available, stats[i].ResourceCount, c.Labels())
+	}
+	return w.Flush()
+}
+
+func storeUp(client prophet.Client, args []string) error {
+	return updateStoreState(client, "store up", metapb.ContainerState_UP, args, false)
+}
+
+func storeOffline(client prophet.Client, args []string) error {
+	return updateStoreState(client, "store offline", metapb.ContainerState_Offline, args, true)
+}
+
+func storeTombstone(client prophet.Client, args []string) error {
+	return updateStoreState(client, "store tombstone", metapb.ContainerState_Tombstone, args, true)
+}
+
+func updateStoreState(client prophet.Client, name string, state metapb.ContainerState, args []string, withForce bool) error {
+	fs := newFlagSet(name, "<id>")
+	var force *bool
+	if withForce {
+		force = fs.Bool("force", false, "Force to update the store state")
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("missing store id")
+	}
+
+	id, err := strconv.ParseUint(fs.Arg(0), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid store id %s", fs.Arg(0))
+	}
+
+	if err := client.UpdateContainerState(id, state, force != nil && *force); err != nil {
+		return err
+	}
+	fmt.Printf("store %d is %s\n", id, state.String())
+	return nil
+}
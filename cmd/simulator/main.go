@@ -0,0 +1,115 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// simulator runs the prophet schedulers offline against the scenarios, the stores, the
+// shards, the load and the failures are described by the scenario files, see the files
+// in the scenarios dir. The report of every scenario is printed, and the exit code is 1
+// if any expectation of the scenarios is not met, so the scenarios can run in the CI.
+//
+// Usage:
+//
+//	simulator [-json file] [-v] <scenario file>...
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/fagongzi/log"
+	"github.com/matrixorigin/matrixcube/components/prophet/simulator"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+var (
+	jsonFile = flag.String("json", "", "Write the reports of the scenarios as json to the file")
+	verbose  = flag.Bool("v", false, "Print the info logs of the prophet, only the errors are printed by default")
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "missing scenario file")
+		usage()
+		os.Exit(2)
+	}
+
+	log.SetHighlighting(false)
+	log.SetLevelByString("error")
+	if *verbose {
+		log.SetLevelByString("info")
+	}
+	util.SetLogger(log.NewLoggerWithPrefix("prophet"))
+
+	passed := true
+	var reports []*simulator.Report
+	for _, file := range flag.Args() {
+		report, err := run(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		report.Print(os.Stdout)
+		fmt.Println()
+		passed = passed && report.Passed()
+		reports = append(reports, report)
+	}
+
+	if *jsonFile != "" {
+		data, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := ioutil.WriteFile(*jsonFile, data, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if !passed {
+		os.Exit(1)
+	}
+}
+
+func run(file string) (*simulator.Report, error) {
+	scenario, err := simulator.LoadScenario(file)
+	if err != nil {
+		return nil, err
+	}
+	if scenario.Name == "" {
+		scenario.Name = file
+	}
+
+	sim, err := simulator.NewSimulator(scenario)
+	if err != nil {
+		return nil, err
+	}
+	report, err := sim.Run()
+	if err != nil {
+		return nil, fmt.Errorf("simulate %s failed: %w", file, err)
+	}
+	return report, nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: simulator [flags] <scenario file>...
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
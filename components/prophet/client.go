@@ -44,6 +44,9 @@ var (
 type Client interface {
 	Close() error
 	AllocID() (uint64, error)
+	// GetTimestamp allocates count continuous timestamps from the timestamp oracle of the prophet,
+	// returns the last one, the timestamps in [ts-count+1, ts] are allocated.
+	GetTimestamp(count uint32) (uint64, error)
 	PutContainer(container metadata.Container) error
 	GetContainer(containerID uint64) (metadata.Container, error)
 	ResourceHeartbeat(meta metadata.Resource, hb rpcpb.ResourceHeartbeatReq) error
@@ -82,6 +85,25 @@ type Client interface {
 	RemoveJob(metapb.Job) error
 	// ExecuteJob execute on job and returns the execute result
 	ExecuteJob(metapb.Job, []byte) ([]byte, error)
+
+	// GetContainers returns all the containers and their stats
+	GetContainers() ([]metadata.Container, []metapb.ContainerStats, error)
+	// GetResources returns all the resources, the leader containers and the stats of the resources
+	GetResources() ([]metadata.Resource, []uint64, []metapb.ResourceStats, error)
+	// GetHotResources returns the hot write and read resources
+	GetHotResources() (rpcpb.GetHotResourcesRsp, error)
+	// UpdateContainerState update the container state, UP, Offline or Tombstone. If force is true, the
+	// offline container will be marked physically destroyed, and the container which still has resources
+	// can be marked as tombstone.
+	UpdateContainerState(containerID uint64, state metapb.ContainerState, force bool) error
+	// AddScheduler add a scheduler with the type and args, returns the scheduler name
+	AddScheduler(typ string, args ...string) (string, error)
+	// RemoveScheduler remove the scheduler
+	RemoveScheduler(name string) error
+	// GetSchedulers returns all the schedulers
+	GetSchedulers() ([]rpcpb.SchedulerInfo, error)
+	// GetPlacementRules returns the placement rules of the group, all rules returned if group is empty
+	GetPlacementRules(group string) ([]rpcpb.PlacementRule, error)
 }
 
 type asyncClient struct {
@@ -108,7 +130,6 @@ func NewClient(adapter metadata.Adapter, opts ...Option) Client {
 	c := &asyncClient{
 		opts:                  &options{},
 		adapter:               adapter,
-		leaderConn:            createConn(),
 		resetReadC:            make(chan struct{}),
 		resetLeaderConnC:      make(chan struct{}),
 		writeC:                make(chan *ctx, 128),
@@ -119,6 +140,7 @@ func NewClient(adapter metadata.Adapter, opts ...Option) Client {
 		opt(c.opts)
 	}
 	c.opts.adjust()
+	c.leaderConn = createConn(c.opts.tls)
 
 	c.ctx, c.cancel = context.WithCancel(context.Background())
 	c.start()
@@ -149,6 +171,23 @@ func (c *asyncClient) AllocID() (uint64, error) {
 	return resp.AllocID.ID, nil
 }
 
+func (c *asyncClient) GetTimestamp(count uint32) (uint64, error) {
+	if !c.running() {
+		return 0, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetTimestampReq
+	req.GetTimestamp.Count = count
+
+	resp, err := c.syncDo(req)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.GetTimestamp.Timestamp, nil
+}
+
 func (c *asyncClient) ResourceHeartbeat(meta metadata.Resource, hb rpcpb.ResourceHeartbeatReq) error {
 	if !c.running() {
 		return ErrClosed
@@ -420,6 +459,7 @@ func (c *asyncClient) PutPlacementRule(rule rpcpb.PlacementRule) error {
 
 	req := &rpcpb.Request{}
 	req.Type = rpcpb.TypePutPlacementRuleReq
+	req.ContainerID = c.containerID
 	req.PutPlacementRule.Rule = rule
 
 	_, err := c.syncDo(req)
@@ -499,6 +539,151 @@ func (c *asyncClient) ExecuteJob(job metapb.Job, data []byte) ([]byte, error) {
 	return rsp.ExecuteJob.Data, nil
 }
 
+func (c *asyncClient) GetContainers() ([]metadata.Container, []metapb.ContainerStats, error) {
+	if !c.running() {
+		return nil, nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetContainersReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	containers := make([]metadata.Container, 0, len(rsp.GetContainers.Containers))
+	for _, data := range rsp.GetContainers.Containers {
+		meta := c.adapter.NewContainer()
+		if err := meta.Unmarshal(data); err != nil {
+			return nil, nil, err
+		}
+		containers = append(containers, meta)
+	}
+
+	return containers, rsp.GetContainers.Stats, nil
+}
+
+func (c *asyncClient) GetResources() ([]metadata.Resource, []uint64, []metapb.ResourceStats, error) {
+	if !c.running() {
+		return nil, nil, nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetResourcesReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resources := make([]metadata.Resource, 0, len(rsp.GetResources.Resources))
+	for _, data := range rsp.GetResources.Resources {
+		meta := c.adapter.NewResource()
+		if err := meta.Unmarshal(data); err != nil {
+			return nil, nil, nil, err
+		}
+		resources = append(resources, meta)
+	}
+
+	return resources, rsp.GetResources.Leaders, rsp.GetResources.Stats, nil
+}
+
+func (c *asyncClient) GetHotResources() (rpcpb.GetHotResourcesRsp, error) {
+	if !c.running() {
+		return rpcpb.GetHotResourcesRsp{}, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetHotResourcesReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return rpcpb.GetHotResourcesRsp{}, err
+	}
+
+	return rsp.GetHotResources, nil
+}
+
+func (c *asyncClient) UpdateContainerState(containerID uint64, state metapb.ContainerState, force bool) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeUpdateContainerStateReq
+	req.UpdateContainerState.ContainerID = containerID
+	req.UpdateContainerState.State = state
+	req.UpdateContainerState.Force = force
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) AddScheduler(typ string, args ...string) (string, error) {
+	if !c.running() {
+		return "", ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeAddSchedulerReq
+	req.AddScheduler.Type = typ
+	req.AddScheduler.Args = args
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return "", err
+	}
+
+	return rsp.AddScheduler.Name, nil
+}
+
+func (c *asyncClient) RemoveScheduler(name string) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeRemoveSchedulerReq
+	req.RemoveScheduler.Name = name
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) GetSchedulers() ([]rpcpb.SchedulerInfo, error) {
+	if !c.running() {
+		return nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetSchedulersReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.GetSchedulers.Schedulers, nil
+}
+
+func (c *asyncClient) GetPlacementRules(group string) ([]rpcpb.PlacementRule, error) {
+	if !c.running() {
+		return nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetPlacementRulesReq
+	req.GetPlacementRules.Group = group
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.GetPlacementRules.Rules, nil
+}
+
 func (c *asyncClient) doClose() {
 	c.cancel()
 	close(c.resourceHeartbeatRspC)
@@ -15,6 +15,7 @@ package prophet
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedulers"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -124,16 +126,16 @@ func TestPutPlacementRule(t *testing.T) {
 	defer p.Stop()
 
 	c := p.GetClient()
+	assert.NoError(t, c.PutContainer(newTestContainerMeta(1)))
+	_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(1, 1))
+	assert.NoError(t, err)
+
 	assert.NoError(t, c.PutPlacementRule(rpcpb.PlacementRule{
 		GroupID: "group01",
 		ID:      "rule01",
 		Count:   3,
 	}))
 
-	assert.NoError(t, c.PutContainer(newTestContainerMeta(1)))
-	_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(1, 1))
-	assert.NoError(t, err)
-
 	peer := metapb.Peer{ID: 1, ContainerID: 1}
 	assert.NoError(t, c.ResourceHeartbeat(newTestResourceMeta(2, peer), rpcpb.ResourceHeartbeatReq{
 		ContainerID: 1,
@@ -259,3 +261,139 @@ func newTestContainerHeartbeat(containerID uint64, resourceCount int, resourceSi
 		Stats: stats,
 	}
 }
+
+func TestClusterOperations(t *testing.T) {
+	p := newTestSingleProphet(t, func(c *config.Config) {
+		c.EnableHTTPAdmin = true
+	})
+	defer p.Stop()
+
+	c := p.GetClient()
+	assert.NoError(t, c.PutContainer(newTestContainerMeta(1)))
+	_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(1, 1))
+	assert.NoError(t, err)
+	peer := metapb.Peer{ID: 1, ContainerID: 1}
+	assert.NoError(t, c.ResourceHeartbeat(newTestResourceMeta(2, peer), rpcpb.ResourceHeartbeatReq{
+		ContainerID: 1,
+		Leader:      &peer}))
+
+	containers, stats, err := c.GetContainers()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(containers))
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, uint64(1), containers[0].ID())
+
+	for i := 0; i < 10; i++ {
+		resources, leaders, _, err := c.GetResources()
+		assert.NoError(t, err)
+		if len(resources) == 1 {
+			assert.Equal(t, uint64(2), resources[0].ID())
+			assert.Equal(t, uint64(1), leaders[0])
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	_, err = c.GetHotResources()
+	assert.NoError(t, err)
+
+	name, err := c.AddScheduler(schedulers.EvictLeaderType, "1")
+	assert.NoError(t, err)
+	infos, err := c.GetSchedulers()
+	assert.NoError(t, err)
+	assert.True(t, hasTestSchedulerInfo(infos, name))
+	assert.NoError(t, c.RemoveScheduler(name))
+	infos, err = c.GetSchedulers()
+	assert.NoError(t, err)
+	assert.False(t, hasTestSchedulerInfo(infos, name))
+	_, err = c.AddScheduler("not-exists")
+	assert.Error(t, err)
+
+	assert.NoError(t, c.PutPlacementRule(rpcpb.PlacementRule{GroupID: "group01", ID: "rule01", Count: 3}))
+	rules, err := c.GetPlacementRules("group01")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rules))
+	rules, err = c.GetPlacementRules("")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rules))
+
+	assert.Error(t, c.UpdateContainerState(1, metapb.ContainerState_Tombstone, false))
+	assert.NoError(t, c.UpdateContainerState(1, metapb.ContainerState_Offline, false))
+	containers, _, err = c.GetContainers()
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.ContainerState_Offline, containers[0].State())
+	assert.NoError(t, c.UpdateContainerState(1, metapb.ContainerState_UP, false))
+	containers, _, err = c.GetContainers()
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.ContainerState_UP, containers[0].State())
+}
+
+func TestAdminRPCDisabled(t *testing.T) {
+	p := newTestSingleProphet(t, nil)
+	defer p.Stop()
+
+	c := p.GetClient()
+	// only the registered stores can put the rules
+	assert.Error(t, c.PutPlacementRule(rpcpb.PlacementRule{GroupID: "group01", ID: "rule01", Count: 3}))
+
+	assert.NoError(t, c.PutContainer(newTestContainerMeta(1)))
+	_, err := c.AddScheduler(schedulers.EvictLeaderType, "1")
+	assert.Error(t, err)
+	assert.Error(t, c.RemoveScheduler(schedulers.EvictLeaderType))
+	assert.Error(t, c.UpdateContainerState(1, metapb.ContainerState_Offline, false))
+	containers, _, err := c.GetContainers()
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.ContainerState_UP, containers[0].State())
+
+	// the stores restore the rules when bootstrapping
+	assert.NoError(t, c.PutPlacementRule(rpcpb.PlacementRule{GroupID: "group01", ID: "rule01", Count: 3}))
+}
+
+func hasTestSchedulerInfo(infos []rpcpb.SchedulerInfo, name string) bool {
+	for _, info := range infos {
+		if info.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGetTimestamp(t *testing.T) {
+	p := newTestSingleProphet(t, nil)
+	defer p.Stop()
+
+	c := p.GetClient()
+	ts, err := c.GetTimestamp(10)
+	assert.NoError(t, err)
+	ts2, err := c.GetTimestamp(1)
+	assert.NoError(t, err)
+	assert.True(t, ts2 > ts)
+
+	o := NewTimestampOracle(c, 0)
+	defer o.Close()
+
+	n := 100
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	values := make(map[uint64]struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ts, err := o.Get()
+			assert.NoError(t, err)
+			lock.Lock()
+			values[ts] = struct{}{}
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, n, len(values))
+	for ts := range values {
+		assert.True(t, ts > ts2)
+	}
+
+	o.Close()
+	_, err = o.Get()
+	assert.Equal(t, ErrClosed, err)
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prophet
+
+import (
+	"sync"
+)
+
+const (
+	defaultMaxTimestampBatch = 10000
+)
+
+// TimestampOracle is a client side helper to get the timestamps from the prophet cheaply. The
+// concurrent requests are merged into one GetTimestamp rpc.
+//
+// The timestamps are never cached for later requests, a cached timestamp maybe smaller than
+// the timestamps which are already returned to other clients, that breaks the order between
+// the transactions.
+type TimestampOracle struct {
+	client   Client
+	maxBatch int
+	requestC chan *tsRequest
+	stopOnce sync.Once
+	stopC    chan struct{}
+}
+
+type tsRequest struct {
+	ts   uint64
+	err  error
+	done chan struct{}
+}
+
+// NewTimestampOracle returns a TimestampOracle, at most maxBatch requests are merged into one
+// rpc, use the default value 10000 if maxBatch is 0.
+func NewTimestampOracle(client Client, maxBatch int) *TimestampOracle {
+	if maxBatch <= 0 {
+		maxBatch = defaultMaxTimestampBatch
+	}
+
+	o := &TimestampOracle{
+		client:   client,
+		maxBatch: maxBatch,
+		requestC: make(chan *tsRequest, maxBatch),
+		stopC:    make(chan struct{}),
+	}
+	go o.run()
+	return o
+}
+
+// Get returns a new timestamp
+func (o *TimestampOracle) Get() (uint64, error) {
+	req := &tsRequest{done: make(chan struct{})}
+	select {
+	case <-o.stopC:
+		return 0, ErrClosed
+	case o.requestC <- req:
+	}
+
+	select {
+	case <-o.stopC:
+		return 0, ErrClosed
+	case <-req.done:
+		return req.ts, req.err
+	}
+}
+
+// Close close the TimestampOracle
+func (o *TimestampOracle) Close() {
+	o.stopOnce.Do(func() {
+		close(o.stopC)
+	})
+}
+
+func (o *TimestampOracle) run() {
+	requests := make([]*tsRequest, 0, o.maxBatch)
+	for {
+		select {
+		case <-o.stopC:
+			return
+		case req := <-o.requestC:
+			requests = append(requests[:0], req)
+		}
+
+	batch:
+		for len(requests) < o.maxBatch {
+			select {
+			case req := <-o.requestC:
+				requests = append(requests, req)
+			default:
+				break batch
+			}
+		}
+
+		ts, err := o.client.GetTimestamp(uint32(len(requests)))
+		for i, req := range requests {
+			if err != nil {
+				req.err = err
+			} else {
+				req.ts = ts - uint64(len(requests)-1-i)
+			}
+			close(req.done)
+		}
+	}
+}
@@ -58,6 +58,9 @@ const (
 
 var (
 	errResourceRemoved = errors.New("resource removed")
+
+	// ErrInvalidScheduler the scheduler cannot be created by the type and args
+	ErrInvalidScheduler = errors.New("invalid scheduler")
 )
 
 // Server is the interface for cluster.
@@ -101,6 +104,7 @@ type RaftCluster struct {
 	hotStat         *statistics.HotStat
 
 	coordinator      *coordinator
+	decommissions    map[uint64]*decommission
 	suspectResources *cache.TTLUint64 // suspectResources are resources that may need fix
 	suspectKeyRanges *cache.TTLString // suspect key-range resources that may need fix
 
@@ -146,6 +150,7 @@ func (c *RaftCluster) InitCluster(opt *config.PersistOptions, storage storage.St
 	c.labelLevelStats = statistics.NewLabelStatistics()
 	c.hotStat = statistics.NewHotStat()
 	c.prepareChecker = newPrepareChecker()
+	c.decommissions = make(map[uint64]*decommission)
 	c.suspectResources = cache.NewIDTTL(c.ctx, time.Minute, 3*time.Minute)
 	c.suspectKeyRanges = cache.NewStringTTL(c.ctx, time.Minute, 3*time.Minute)
 
@@ -235,17 +240,25 @@ func (c *RaftCluster) runBackgroundJobs(interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
+	lastHistoryGC := time.Now()
 	for {
 		select {
 		case <-c.quit:
 			util.GetLogger().Infof("metrics are reset")
 			c.resetMetrics()
+			// the operators finished since the last round
+			c.persistOperatorHistories()
 			util.GetLogger().Infof("background jobs has been stopped")
 			return
 		case <-ticker.C:
 			c.checkContainers()
 			c.collectMetrics()
 			c.coordinator.opController.PruneHistory()
+			c.persistOperatorHistories()
+			if time.Since(lastHistoryGC) >= operatorHistoryGCInterval {
+				c.gcOperatorHistories()
+				lastHistoryGC = time.Now()
+			}
 			c.doNotifyCreateResources()
 		case <-c.createResourceC:
 			c.doNotifyCreateResources()
@@ -415,6 +428,11 @@ func (c *RaftCluster) HandleContainerHeartbeat(stats *metapb.ContainerStats) err
 			newContainer.GetCapacity(),
 			newContainer.GetAvailable())
 	}
+	if ids := newContainer.GetInconsistentResources(); len(ids) > 0 {
+		util.GetLogger().Errorf("container %d has inconsistent resources %+v",
+			newContainer.Meta.ID(),
+			ids)
+	}
 	if newContainer.NeedPersist() && c.storage != nil {
 		if err := c.storage.PutContainer(newContainer.Meta); err != nil {
 			util.GetLogger().Errorf("persist container %d failed with %+v",
@@ -938,6 +956,17 @@ func (c *RaftCluster) RemoveContainer(containerID uint64, physicallyDestroyed bo
 	return err
 }
 
+// BuryContainer marks a container as tombstone in cluster. If force is false, the container
+// must has no resources.
+// State transition: Offline -> Tombstone.
+func (c *RaftCluster) BuryContainer(containerID uint64, force bool) error {
+	if count := c.core.GetContainerResourceCount(containerID); count > 0 && !force {
+		return fmt.Errorf("container %d still has %d resources", containerID, count)
+	}
+
+	return c.buryContainer(containerID)
+}
+
 // buryContainer marks a store as tombstone in cluster.
 // The store should be empty before calling this func
 // State transition: Offline -> Tombstone.
@@ -1013,7 +1042,13 @@ func (c *RaftCluster) UpContainer(containerID uint64) error {
 	util.GetLogger().Warningf("container %d/%s has been up",
 		containerID,
 		newStore.Meta.Addr())
-	return c.putContainerLocked(newStore)
+	if err := c.putContainerLocked(newStore); err != nil {
+		return err
+	}
+
+	// the decommission of the container is canceled
+	delete(c.decommissions, containerID)
+	return nil
 }
 
 // SetContainerWeight sets up a container's leader/resource balance weight.
@@ -1076,6 +1111,8 @@ func (c *RaftCluster) checkContainers() {
 					offlineContainer.ID(),
 					offlineContainer.Addr(),
 					err)
+			} else {
+				c.finishDecommission(offlineContainer.ID())
 			}
 		} else {
 			offlineContainers = append(offlineContainers, offlineContainer)
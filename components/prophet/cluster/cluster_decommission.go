@@ -0,0 +1,329 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/filter"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+const (
+	decommissionScope = "decommission"
+	mb                = 1 << 20
+)
+
+// DecommissionProgress the pre-check result and the progress of decommissioning a
+// container. The container is moved to Offline when the decommission starts, the
+// checkers replace its peers on the other containers, and it's buried as Tombstone
+// once it's empty.
+type DecommissionProgress struct {
+	ContainerID uint64
+	// State the state of the container, Up means the decommission is not started, and
+	// the progress is the pre-check result.
+	State string
+	// StartAt the time the decommission started or the prophet leader found the
+	// container offline, zero if not started.
+	StartAt time.Time
+	// FinishAt the time the container was buried, zero if not finished.
+	FinishAt time.Time
+	// Resources the count of the peers left on the container
+	Resources int
+	// Leaders the count of the leaders left on the container
+	Leaders int
+	// Size the approximate size in MiB of the peers left on the container
+	Size int64
+	// MovedSize the approximate size in MiB moved off the container since the start
+	MovedSize int64
+	// ETA the estimated time to move the left peers, zero if unknown or finished. It's
+	// estimated by the snapshot receive throughput of the target containers.
+	ETA time.Duration
+	// Blockers the reasons the peers cannot be moved off the container
+	Blockers []string
+}
+
+// decommission the progress record of a decommissioning container, the records are kept
+// in memory only, a new prophet leader restarts them when the offline containers are
+// queried. The record is removed when the container is set up.
+type decommission struct {
+	startAt   time.Time
+	startSize int64
+	finishAt  time.Time
+}
+
+// GetDecommissionProgress returns the progress of decommissioning the container, or the
+// pre-check result if the container is up.
+func (c *RaftCluster) GetDecommissionProgress(containerID uint64) (*DecommissionProgress, error) {
+	container := c.GetContainer(containerID)
+	if container == nil {
+		return nil, fmt.Errorf("container %d not found", containerID)
+	}
+
+	switch {
+	case container.IsUp():
+		return c.getDecommissionProgress(container, nil), nil
+	case container.IsTombstone():
+		c.RLock()
+		defer c.RUnlock()
+		var d *decommission
+		if v, ok := c.decommissions[containerID]; ok {
+			value := *v
+			d = &value
+		}
+		return c.getDecommissionProgress(container, d), nil
+	}
+	return c.getDecommissionProgress(container, c.getOrCreateDecommission(container)), nil
+}
+
+// DecommissionContainer checks whether the peers on the container can be moved to the
+// other containers by the capacity and the placement rules, then sets the container
+// offline to start the decommission. The decommission is rejected if any blocker is
+// found, unless force is true.
+func (c *RaftCluster) DecommissionContainer(containerID uint64, force bool) (*DecommissionProgress, error) {
+	container := c.GetContainer(containerID)
+	if container == nil {
+		return nil, fmt.Errorf("container %d not found", containerID)
+	}
+	if container.IsTombstone() {
+		return nil, fmt.Errorf("container %d is tombstone", containerID)
+	}
+	if container.IsUp() {
+		if blockers := c.checkDecommission(container); len(blockers) > 0 && !force {
+			return nil, fmt.Errorf("container %d cannot be decommissioned: %s",
+				containerID,
+				strings.Join(blockers, "; "))
+		}
+		if err := c.RemoveContainer(containerID, false); err != nil {
+			return nil, err
+		}
+		util.GetLogger().Warningf("container %d decommission started, force %+v",
+			containerID,
+			force)
+		container = c.GetContainer(containerID)
+	}
+	return c.getDecommissionProgress(container, c.getOrCreateDecommission(container)), nil
+}
+
+// CancelDecommission sets the decommissioning container up, and cancels the operators
+// moving the peers off the container. The peers already moved are not moved back, the
+// balance schedulers will do it. The decommission record is removed by UpContainer.
+func (c *RaftCluster) CancelDecommission(containerID uint64) error {
+	container := c.GetContainer(containerID)
+	if container == nil {
+		return fmt.Errorf("container %d not found", containerID)
+	}
+	if !container.IsOffline() {
+		return fmt.Errorf("container %d is not decommissioning", containerID)
+	}
+	if err := c.UpContainer(containerID); err != nil {
+		return err
+	}
+
+	oc := c.coordinator.opController
+	for _, op := range oc.GetOperators() {
+		if removePeerFrom(op, containerID) {
+			oc.RemoveOperator(op, "decommission canceled")
+		}
+	}
+	util.GetLogger().Warningf("container %d decommission canceled", containerID)
+	return nil
+}
+
+// getOrCreateDecommission returns a copy of the decommission record of the container, the
+// record is created if the container was set offline by the container state api or
+// before the prophet leader changed.
+func (c *RaftCluster) getOrCreateDecommission(container *core.CachedContainer) *decommission {
+	c.Lock()
+	defer c.Unlock()
+
+	id := container.Meta.ID()
+	d, ok := c.decommissions[id]
+	if !ok {
+		d = &decommission{
+			startAt:   time.Now(),
+			startSize: c.core.GetContainerResourceSize(id),
+		}
+		c.decommissions[id] = d
+	}
+	value := *d
+	return &value
+}
+
+// finishDecommission records the finish time of the buried container
+func (c *RaftCluster) finishDecommission(containerID uint64) {
+	c.Lock()
+	defer c.Unlock()
+	if d, ok := c.decommissions[containerID]; ok && d.finishAt.IsZero() {
+		d.finishAt = time.Now()
+	}
+}
+
+// getDecommissionProgress returns the progress by the record, or the pre-check result if
+// the record is nil.
+func (c *RaftCluster) getDecommissionProgress(container *core.CachedContainer, d *decommission) *DecommissionProgress {
+	id := container.Meta.ID()
+	p := &DecommissionProgress{
+		ContainerID: id,
+		State:       container.GetState().String(),
+		Resources:   c.core.GetContainerResourceCount(id),
+		Leaders:     c.core.GetContainerLeaderCount(id),
+		Size:        c.core.GetContainerResourceSize(id),
+	}
+	if d != nil {
+		p.StartAt = d.startAt
+		p.FinishAt = d.finishAt
+		if d.startSize > p.Size {
+			p.MovedSize = d.startSize - p.Size
+		}
+	}
+	if container.IsTombstone() || p.Resources == 0 {
+		return p
+	}
+
+	p.Blockers = c.checkDecommission(container)
+	if d != nil {
+		if leaderless := c.countLeaderlessResources(id); leaderless > 0 {
+			p.Blockers = append(p.Blockers,
+				fmt.Sprintf("%d resources on the container have no leader to move the peers", leaderless))
+		}
+		p.ETA = c.estimateDecommission(container, p)
+	}
+	return p
+}
+
+// checkDecommission returns the blockers which prevent the peers on the container from
+// being moved to the other containers: the free space of the other up containers, and
+// the placement rules or the max replicas which cannot be satisfied without the container.
+func (c *RaftCluster) checkDecommission(container *core.CachedContainer) []string {
+	id := container.Meta.ID()
+	targets := c.getDecommissionTargets(id)
+
+	var blockers []string
+	if len(targets) == 0 {
+		return append(blockers, "no up container to accept the peers")
+	}
+
+	// the free space of a container is the space before it turns to low space
+	var free int64
+	lowSpaceRatio := c.opt.GetLowSpaceRatio()
+	for _, target := range targets {
+		if v := float64(target.GetAvailable()) - float64(target.GetCapacity())*(1-lowSpaceRatio); v > 0 {
+			free += int64(v / mb)
+		}
+	}
+	if size := c.core.GetContainerResourceSize(id); size > free {
+		blockers = append(blockers,
+			fmt.Sprintf("the other up containers have %d MiB free space, but %d MiB to move", free, size))
+	}
+
+	// the peers are grouped by the rules, a blocker is reported per rule
+	unplaceable := make(map[string][]uint64)
+	rulesEnabled := c.opt.IsPlacementRulesEnabled()
+	for _, res := range c.core.GetContainerResources(id) {
+		peer, _ := res.GetContainerPeer(id)
+		filters := []filter.Filter{filter.NewExcludedFilter(decommissionScope, nil, res.GetContainerIDs())}
+		rule := "max-replicas"
+		if rulesEnabled {
+			fit := c.ruleManager.FitResource(c, res)
+			if rf := fit.GetRuleFit(peer.ID); rf != nil {
+				rule = fmt.Sprintf("rule %s/%s", rf.Rule.GroupID, rf.Rule.ID)
+				filters = append(filters, filter.NewLabelConstaintFilter(decommissionScope, rf.Rule.LabelConstraints))
+			}
+		}
+
+		placeable := false
+		for _, target := range targets {
+			if filter.Target(c.opt, target, filters) {
+				placeable = true
+				break
+			}
+		}
+		if !placeable {
+			unplaceable[rule] = append(unplaceable[rule], res.Meta.ID())
+		}
+	}
+
+	rules := make([]string, 0, len(unplaceable))
+	for rule := range unplaceable {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		ids := unplaceable[rule]
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		blockers = append(blockers,
+			fmt.Sprintf("%s cannot be satisfied without the container for %d resources, e.g. resource %d",
+				rule, len(ids), ids[0]))
+	}
+	return blockers
+}
+
+// getDecommissionTargets returns the up containers which can accept the peers, the
+// temporary states such as busy and the container limits are not the blockers.
+func (c *RaftCluster) getDecommissionTargets(containerID uint64) []*core.CachedContainer {
+	filters := []filter.Filter{
+		&filter.ContainerStateFilter{ActionScope: decommissionScope, MoveResource: true, AllowTemporaryStates: true},
+		filter.NewStorageThresholdFilter(decommissionScope),
+	}
+
+	var targets []*core.CachedContainer
+	for _, container := range c.core.GetContainers() {
+		if container.Meta.ID() != containerID && container.IsUp() &&
+			filter.Target(c.opt, container, filters) {
+			targets = append(targets, container)
+		}
+	}
+	return targets
+}
+
+func (c *RaftCluster) countLeaderlessResources(containerID uint64) int {
+	n := 0
+	for _, res := range c.core.GetContainerResources(containerID) {
+		if res.GetLeader() == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// estimateDecommission estimates the time to move the left peers by the snapshot receive
+// throughput of the target containers reported in the container heartbeats, the moved
+// peers are caught up by the snapshots. It's unknown if the targets received nothing
+// recently.
+func (c *RaftCluster) estimateDecommission(container *core.CachedContainer, p *DecommissionProgress) time.Duration {
+	var bytesPerSec float64
+	for _, target := range c.getDecommissionTargets(container.Meta.ID()) {
+		bytesPerSec += target.GetSnapReceiveRate()
+	}
+	if bytesPerSec == 0 {
+		return 0
+	}
+	return time.Duration(float64(p.Size) * (1 << 20) / bytesPerSec * float64(time.Second))
+}
+
+// removePeerFrom returns true if the operator removes the peer on the container
+func removePeerFrom(op *operator.Operator, containerID uint64) bool {
+	for i := 0; i < op.Len(); i++ {
+		if step, ok := op.Step(i).(operator.RemovePeer); ok && step.FromContainer == containerID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"math"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+const (
+	// operatorHistoryBatch the max count of the operator histories put or removed in
+	// one etcd txn, etcd limits the ops of a txn to 128 by default, and every history
+	// is written with the index keys of the resource and the containers.
+	operatorHistoryBatch = 16
+	// maxOperatorHistoryGCCount the max count of the operator histories removed in one
+	// gc round.
+	maxOperatorHistoryGCCount = 1000
+	// operatorHistoryGCInterval the interval to remove the expired or exceeded operator
+	// histories.
+	operatorHistoryGCInterval = 10 * time.Minute
+)
+
+var (
+	minHistoryTime = time.Unix(0, 0)
+	maxHistoryTime = time.Unix(0, math.MaxInt64)
+)
+
+// GetOperatorHistories returns at most limit persisted operator histories matched the
+// filter, in the order of the finish time.
+func (c *RaftCluster) GetOperatorHistories(filter schedule.OperatorHistoryFilter, limit int) ([]metapb.OperatorHistory, error) {
+	// make the operators finished just now visible
+	c.persistOperatorHistories()
+
+	start, end := minHistoryTime, maxHistoryTime
+	if !filter.Start.IsZero() {
+		start = filter.Start
+	}
+	if !filter.End.IsZero() {
+		end = filter.End
+	}
+
+	var histories []metapb.OperatorHistory
+	do := func(h metapb.OperatorHistory) bool {
+		if filter.Match(h) {
+			histories = append(histories, h)
+		}
+		return limit <= 0 || len(histories) < limit
+	}
+
+	// only the histories of the resource or the container are scanned by the index
+	var err error
+	switch {
+	case filter.ResourceID > 0:
+		err = c.storage.LoadResourceOperatorHistories(filter.ResourceID, start, end, batch, do)
+	case filter.ContainerID > 0:
+		err = c.storage.LoadContainerOperatorHistories(filter.ContainerID, start, end, batch, do)
+	default:
+		err = c.storage.LoadOperatorHistories(start, end, batch, do)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return histories, nil
+}
+
+// persistOperatorHistories persists the histories of the operators finished since the
+// last call, the histories are dropped if failed, the scheduling must not be blocked by
+// the audit log.
+func (c *RaftCluster) persistOperatorHistories() {
+	histories := c.coordinator.opController.TakeFinishedHistories()
+	for len(histories) > 0 {
+		n := len(histories)
+		if n > operatorHistoryBatch {
+			n = operatorHistoryBatch
+		}
+		if err := c.storage.PutOperatorHistories(histories[:n]...); err != nil {
+			util.GetLogger().Errorf("persist %d operator histories failed with %+v",
+				len(histories),
+				err)
+			return
+		}
+		histories = histories[n:]
+	}
+}
+
+// gcOperatorHistories removes the persisted operator histories which are older than the
+// retention, or exceed the max count.
+func (c *RaftCluster) gcOperatorHistories() {
+	opts := c.GetOpts()
+	expired, err := c.loadOldestOperatorHistories(time.Now().Add(-opts.GetOperatorHistoryRetention()),
+		maxOperatorHistoryGCCount)
+	if err != nil {
+		util.GetLogger().Errorf("load expired operator histories failed with %+v",
+			err)
+		return
+	}
+	if !c.removeOperatorHistories(expired) {
+		return
+	}
+
+	count, err := c.storage.CountOperatorHistories()
+	if err != nil {
+		util.GetLogger().Errorf("count operator histories failed with %+v",
+			err)
+		return
+	}
+	maxCount := opts.GetMaxOperatorHistoryCount()
+	if count <= maxCount {
+		return
+	}
+
+	n := count - maxCount
+	if n > maxOperatorHistoryGCCount {
+		n = maxOperatorHistoryGCCount
+	}
+	exceeded, err := c.loadOldestOperatorHistories(maxHistoryTime, int(n))
+	if err != nil {
+		util.GetLogger().Errorf("load exceeded operator histories failed with %+v",
+			err)
+		return
+	}
+	c.removeOperatorHistories(exceeded)
+}
+
+func (c *RaftCluster) loadOldestOperatorHistories(end time.Time, limit int) ([]metapb.OperatorHistory, error) {
+	var histories []metapb.OperatorHistory
+	err := c.storage.LoadOperatorHistories(minHistoryTime, end, int64(limit), func(h metapb.OperatorHistory) bool {
+		histories = append(histories, h)
+		return len(histories) < limit
+	})
+	return histories, err
+}
+
+func (c *RaftCluster) removeOperatorHistories(histories []metapb.OperatorHistory) bool {
+	for len(histories) > 0 {
+		n := len(histories)
+		if n > operatorHistoryBatch {
+			n = operatorHistoryBatch
+		}
+		if err := c.storage.RemoveOperatorHistories(histories[:n]...); err != nil {
+			util.GetLogger().Errorf("remove %d operator histories failed with %+v",
+				len(histories),
+				err)
+			return false
+		}
+		histories = histories[n:]
+	}
+	return true
+}
@@ -21,11 +21,14 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/core"
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/opt"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/placement"
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
@@ -747,6 +750,184 @@ type testCluster struct {
 	*RaftCluster
 }
 
+func TestOperatorHistories(t *testing.T) {
+	tc, co, cleanup := prepare(t, func(cfg *config.ScheduleConfig) {
+		cfg.MaxOperatorHistoryCount = 2
+	}, nil, nil)
+	defer cleanup()
+	tc.coordinator = co
+	oc := co.opController
+
+	assert.Nil(t, tc.addLeaderResource(1, 1))
+	assert.Nil(t, tc.addLeaderResource(2, 2))
+	for id := uint64(1); id <= 2; id++ {
+		op := newTestOperator(id, tc.GetResource(id).Meta.Epoch(), operator.OpLeader,
+			operator.TransferLeader{FromContainer: id, ToContainer: 3})
+		assert.True(t, oc.AddOperator(op))
+		assert.True(t, oc.RemoveOperator(op, ""))
+	}
+
+	histories, err := tc.GetOperatorHistories(schedule.OperatorHistoryFilter{}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(histories))
+	histories, err = tc.GetOperatorHistories(schedule.OperatorHistoryFilter{ContainerID: 2}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(histories))
+	assert.Equal(t, uint64(2), histories[0].ResourceID)
+	histories, err = tc.GetOperatorHistories(schedule.OperatorHistoryFilter{ContainerID: 3}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(histories))
+	assert.Equal(t, uint64(1), histories[0].ResourceID)
+
+	// the expired history is removed
+	expired := metapb.OperatorHistory{ResourceID: 3,
+		FinishAt: time.Now().Add(-tc.GetOpts().GetOperatorHistoryRetention() - time.Minute).UnixNano()}
+	assert.NoError(t, tc.storage.PutOperatorHistories(expired))
+	tc.gcOperatorHistories()
+	histories, err = tc.GetOperatorHistories(schedule.OperatorHistoryFilter{}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(histories))
+
+	// the oldest history is removed if exceed the max count
+	op := newTestOperator(1, tc.GetResource(1).Meta.Epoch(), operator.OpLeader)
+	assert.True(t, oc.AddOperator(op))
+	assert.True(t, oc.RemoveOperator(op, ""))
+	tc.persistOperatorHistories()
+	tc.gcOperatorHistories()
+	histories, err = tc.GetOperatorHistories(schedule.OperatorHistoryFilter{}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(histories))
+	assert.Equal(t, uint64(2), histories[0].ResourceID)
+	assert.Equal(t, uint64(1), histories[1].ResourceID)
+
+	// the histories finished after the last round are persisted when stopping
+	tc.quit = make(chan struct{})
+	tc.wg.Add(1)
+	go tc.runBackgroundJobs(time.Hour)
+	op = newTestOperator(2, tc.GetResource(2).Meta.Epoch(), operator.OpLeader)
+	assert.True(t, oc.AddOperator(op))
+	assert.True(t, oc.RemoveOperator(op, ""))
+	close(tc.quit)
+	tc.wg.Wait()
+	n, err := tc.storage.CountOperatorHistories()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), n)
+}
+
+func TestDecommissionContainer(t *testing.T) {
+	tc, co, cleanup := prepare(t, nil, nil, nil)
+	defer cleanup()
+	tc.coordinator = co
+
+	for id := uint64(1); id <= 3; id++ {
+		assert.NoError(t, tc.addResourceContainer(id, 0))
+	}
+	assert.NoError(t, tc.addLeaderResource(1, 1, 2, 3))
+	assert.NoError(t, tc.addLeaderResource(2, 2, 1, 3))
+
+	// the default rule needs 3 replicas
+	p, err := tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.ContainerState_UP.String(), p.State)
+	assert.Equal(t, 1, len(p.Blockers))
+	assert.True(t, strings.Contains(p.Blockers[0], "rule prophet/default"), p.Blockers[0])
+	_, err = tc.DecommissionContainer(1, false)
+	assert.Error(t, err)
+	assert.True(t, tc.GetContainer(1).IsUp())
+
+	assert.NoError(t, tc.addResourceContainer(4, 0))
+	p, err = tc.DecommissionContainer(1, false)
+	assert.NoError(t, err)
+	assert.True(t, tc.GetContainer(1).IsOffline())
+	assert.Equal(t, 2, p.Resources)
+	assert.Equal(t, 1, p.Leaders)
+	assert.Equal(t, int64(20), p.Size)
+	assert.Empty(t, p.Blockers)
+	assert.False(t, p.StartAt.IsZero())
+	// no snapshot is received by the target containers yet
+	assert.Equal(t, time.Duration(0), p.ETA)
+
+	// 20 MiB by the snapshot receive throughput of 3 containers, 1 MiB per second
+	for id := uint64(2); id <= 4; id++ {
+		stats := *tc.GetContainer(id).GetContainerStats()
+		stats.ContainerID = id
+		stats.Interval = &metapb.TimeInterval{Start: 100, End: 110}
+		stats.ReceivedSnapBytes = 10 * (1 << 20)
+		assert.NoError(t, tc.HandleContainerHeartbeat(&stats))
+	}
+	p, err = tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.InDelta(t, float64(time.Second*20/3), float64(p.ETA), float64(time.Millisecond))
+
+	// cancel the operator moving the peer off the container
+	op := newTestOperator(2, tc.GetResource(2).Meta.Epoch(), operator.OpResource,
+		operator.AddPeer{ToContainer: 4, PeerID: 100}, operator.RemovePeer{FromContainer: 1})
+	assert.True(t, co.opController.AddOperator(op))
+	assert.NoError(t, tc.CancelDecommission(1))
+	assert.True(t, tc.GetContainer(1).IsUp())
+	assert.Nil(t, co.opController.GetOperator(2))
+	assert.Error(t, tc.CancelDecommission(1))
+	assert.Empty(t, tc.decommissions)
+
+	// the record is kept while querying the progress, and removed by the container state api
+	_, err = tc.DecommissionContainer(1, false)
+	assert.NoError(t, err)
+	p, err = tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tc.decommissions))
+	assert.NoError(t, tc.UpContainer(1))
+	assert.Empty(t, tc.decommissions)
+	p, err = tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.True(t, p.StartAt.IsZero())
+
+	// the empty container is buried
+	_, err = tc.DecommissionContainer(1, false)
+	assert.NoError(t, err)
+	for _, id := range []uint64{1, 2} {
+		res := tc.GetResource(id)
+		peer, _ := res.GetContainerPeer(1)
+		tc.core.PutResource(res.Clone(core.WithRemoveContainerPeer(1), core.WithAddPeer(metapb.Peer{ID: peer.ID + 100, ContainerID: 4})))
+	}
+	p, err = tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, p.Resources)
+	assert.Equal(t, int64(20), p.MovedSize)
+	assert.True(t, p.FinishAt.IsZero())
+	tc.checkContainers()
+	p, err = tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.ContainerState_Tombstone.String(), p.State)
+	assert.False(t, p.FinishAt.IsZero())
+	_, err = tc.DecommissionContainer(1, false)
+	assert.Error(t, err)
+}
+
+func TestDecommissionContainerCapacity(t *testing.T) {
+	tc, co, cleanup := prepare(t, nil, nil, nil)
+	defer cleanup()
+	tc.coordinator = co
+
+	for id := uint64(1); id <= 4; id++ {
+		assert.NoError(t, tc.addResourceContainer(id, 0))
+	}
+	assert.NoError(t, tc.addLeaderResource(1, 1, 2, 3))
+	// the other containers have 80GiB free space before low space
+	tc.core.PutResource(tc.GetResource(1).Clone(core.SetApproximateSize(300 * 1024)))
+
+	p, err := tc.GetDecommissionProgress(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(p.Blockers))
+	assert.True(t, strings.Contains(p.Blockers[0], "free space"), p.Blockers[0])
+	_, err = tc.DecommissionContainer(1, false)
+	assert.Error(t, err)
+
+	p, err = tc.DecommissionContainer(1, true)
+	assert.NoError(t, err)
+	assert.True(t, tc.GetContainer(1).IsOffline())
+	assert.Equal(t, 1, len(p.Blockers))
+}
+
 func newTestScheduleConfig() (*config.ScheduleConfig, *config.PersistOptions, error) {
 	cfg := config.NewConfig()
 	cfg.Schedule.TolerantSizeRatio = 5
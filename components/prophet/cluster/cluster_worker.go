@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/core"
 	"github.com/matrixorigin/matrixcube/components/prophet/event"
@@ -26,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/placement"
+	"github.com/matrixorigin/matrixcube/components/prophet/statistics"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
 )
 
@@ -428,3 +430,162 @@ func (c *RaftCluster) HandleAppliedRules(request *rpcpb.Request) (*rpcpb.GetAppl
 		Rules: placement.RPCRules(rules),
 	}, nil
 }
+
+// HandleGetContainers handle get all containers
+func (c *RaftCluster) HandleGetContainers(request *rpcpb.Request) (*rpcpb.GetContainersRsp, error) {
+	containers := c.GetContainers()
+	rsp := &rpcpb.GetContainersRsp{}
+	for _, container := range containers {
+		data, err := container.Meta.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		rsp.Containers = append(rsp.Containers, data)
+		rsp.Stats = append(rsp.Stats, *container.GetContainerStats())
+	}
+	return rsp, nil
+}
+
+// HandleGetResources handle get all resources
+func (c *RaftCluster) HandleGetResources(request *rpcpb.Request) (*rpcpb.GetResourcesRsp, error) {
+	resources := c.GetResources()
+	rsp := &rpcpb.GetResourcesRsp{}
+	for _, res := range resources {
+		data, err := res.Meta.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		rsp.Resources = append(rsp.Resources, data)
+		rsp.Leaders = append(rsp.Leaders, res.GetLeader().GetContainerID())
+		rsp.Stats = append(rsp.Stats, *res.GetStat())
+	}
+	return rsp, nil
+}
+
+// HandleGetHotResources handle get hot resources
+func (c *RaftCluster) HandleGetHotResources(request *rpcpb.Request) (*rpcpb.GetHotResourcesRsp, error) {
+	return &rpcpb.GetHotResourcesRsp{
+		Writes: toRPCHotResources(c.GetHotWriteResources()),
+		Reads:  toRPCHotResources(c.GetHotReadResources()),
+	}, nil
+}
+
+func toRPCHotResources(infos *statistics.ContainerHotPeersInfos) []rpcpb.HotResource {
+	if infos == nil {
+		return nil
+	}
+
+	var values []rpcpb.HotResource
+	add := func(stats statistics.ContainerHotPeersStat, isLeader bool) {
+		for _, stat := range stats {
+			for _, peer := range stat.Stats {
+				values = append(values, rpcpb.HotResource{
+					ResourceID:  peer.ResourceID,
+					ContainerID: peer.ContainerID,
+					IsLeader:    isLeader,
+					HotDegree:   uint64(peer.HotDegree),
+					ByteRate:    peer.ByteRate,
+					KeyRate:     peer.KeyRate,
+				})
+			}
+		}
+	}
+	add(infos.AsLeader, true)
+	add(infos.AsPeer, false)
+	return values
+}
+
+// HandleUpdateContainerState handle update container state
+func (c *RaftCluster) HandleUpdateContainerState(request *rpcpb.Request) (*rpcpb.UpdateContainerStateRsp, error) {
+	req := request.UpdateContainerState
+	var err error
+	switch req.State {
+	case metapb.ContainerState_UP:
+		err = c.UpContainer(req.ContainerID)
+	case metapb.ContainerState_Offline:
+		err = c.RemoveContainer(req.ContainerID, req.Force)
+	case metapb.ContainerState_Tombstone:
+		err = c.BuryContainer(req.ContainerID, req.Force)
+	default:
+		err = fmt.Errorf("invalid container state %s", req.State.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.UpdateContainerStateRsp{}, nil
+}
+
+// HandleAddScheduler handle add scheduler
+func (c *RaftCluster) HandleAddScheduler(request *rpcpb.Request) (*rpcpb.AddSchedulerRsp, error) {
+	name, err := c.AddSchedulerWithArgs(request.AddScheduler.Type, request.AddScheduler.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.AddSchedulerRsp{Name: name}, nil
+}
+
+// AddSchedulerWithArgs creates the scheduler by the type and args, adds it to the
+// cluster and persists the schedule config. ErrInvalidScheduler is returned if the
+// scheduler cannot be created.
+func (c *RaftCluster) AddSchedulerWithArgs(typ string, args []string) (string, error) {
+	s, err := schedule.CreateScheduler(typ, c.GetOperatorController(), c.storage,
+		schedule.ConfigSliceDecoder(typ, args))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidScheduler, err)
+	}
+
+	if err := c.AddScheduler(s, args...); err != nil {
+		return "", err
+	}
+
+	if err := c.opt.Persist(c.storage); err != nil {
+		return "", err
+	}
+
+	util.GetLogger().Infof("scheduler %s added, args %+v",
+		s.GetName(),
+		args)
+	return s.GetName(), nil
+}
+
+// HandleRemoveScheduler handle remove scheduler
+func (c *RaftCluster) HandleRemoveScheduler(request *rpcpb.Request) (*rpcpb.RemoveSchedulerRsp, error) {
+	if err := c.RemoveScheduler(request.RemoveScheduler.Name); err != nil {
+		return nil, err
+	}
+
+	util.GetLogger().Infof("scheduler %s removed",
+		request.RemoveScheduler.Name)
+	return &rpcpb.RemoveSchedulerRsp{}, nil
+}
+
+// HandleGetSchedulers handle get schedulers
+func (c *RaftCluster) HandleGetSchedulers(request *rpcpb.Request) (*rpcpb.GetSchedulersRsp, error) {
+	rsp := &rpcpb.GetSchedulersRsp{}
+	for _, name := range c.GetSchedulers() {
+		info := rpcpb.SchedulerInfo{Name: name}
+		info.Paused, _ = c.IsSchedulerPaused(name)
+		info.Disabled, _ = c.IsSchedulerDisabled(name)
+		rsp.Schedulers = append(rsp.Schedulers, info)
+	}
+	sort.Slice(rsp.Schedulers, func(i, j int) bool { return rsp.Schedulers[i].Name < rsp.Schedulers[j].Name })
+	return rsp, nil
+}
+
+// HandleGetPlacementRules handle get placement rules
+func (c *RaftCluster) HandleGetPlacementRules(request *rpcpb.Request) (*rpcpb.GetPlacementRulesRsp, error) {
+	var rules []*placement.Rule
+	if request.GetPlacementRules.Group == "" {
+		rules = c.GetRuleManager().GetAllRules()
+	} else {
+		rules = c.GetRuleManager().GetRulesByGroup(request.GetPlacementRules.Group)
+	}
+
+	return &rpcpb.GetPlacementRulesRsp{
+		Rules: placement.RPCRules(rules),
+	}, nil
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/hbstream"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
@@ -63,6 +64,9 @@ type coordinator struct {
 	opController      *schedule.OperatorController
 	hbStreams         *hbstream.HeartbeatStreams
 	pluginInterface   *schedule.PluginInterface
+	// simulated the schedulers and the patrol are driven by the Simulation instead
+	// of the background goroutines.
+	simulated bool
 }
 
 // newCoordinator creates a new coordinator.
@@ -129,20 +133,8 @@ func (c *coordinator) patrolResources() {
 					continue
 				}
 
-				ops := c.checkers.CheckResource(res)
-
 				key = res.GetEndKey()
-				if len(ops) == 0 {
-					continue
-				}
-
-				if !c.opController.ExceedContainerLimit(ops...) {
-					c.opController.AddWaitingOperator(ops...)
-					c.checkers.RemoveWaitingResource(res.Meta.ID())
-					c.cluster.RemoveSuspectResource(res.Meta.ID())
-				} else {
-					c.checkers.AddWaitingResource(res)
-				}
+				c.checkResource(res)
 			}
 			// Updates the label level isolation statistics.
 			c.cluster.updateResourcesLabelLevelStats(resources)
@@ -154,6 +146,23 @@ func (c *coordinator) patrolResources() {
 	}
 }
 
+// checkResource checks the resource which has no pending operator, the operators are
+// added if the container limits allow, otherwise the resource waits for the next check.
+func (c *coordinator) checkResource(res *core.CachedResource) {
+	ops := c.checkers.CheckResource(res)
+	if len(ops) == 0 {
+		return
+	}
+
+	if !c.opController.ExceedContainerLimit(ops...) {
+		c.opController.AddWaitingOperator(ops...)
+		c.checkers.RemoveWaitingResource(res.Meta.ID())
+		c.cluster.RemoveSuspectResource(res.Meta.ID())
+	} else {
+		c.checkers.AddWaitingResource(res)
+	}
+}
+
 func (c *coordinator) checkSuspectResources() {
 	for _, id := range c.cluster.GetSuspectResources() {
 		res := c.cluster.GetResource(id)
@@ -270,6 +279,19 @@ func (c *coordinator) run() {
 		}
 	}
 	util.GetLogger().Info("coordinator starts to run schedulers")
+	if !c.loadSchedulers() {
+		return
+	}
+
+	c.wg.Add(2)
+	// Starts to patrol resources.
+	go c.patrolResources()
+	go c.drivePushOperator()
+}
+
+// loadSchedulers creates the schedulers from the storage and the schedule config, returns
+// false if the coordinator is stopped.
+func (c *coordinator) loadSchedulers() bool {
 	var (
 		scheduleNames []string
 		configs       []string
@@ -280,7 +302,7 @@ func (c *coordinator) run() {
 		select {
 		case <-c.ctx.Done():
 			util.GetLogger().Info("coordinator stops running")
-			return
+			return false
 		default:
 		}
 		if err == nil {
@@ -380,11 +402,7 @@ func (c *coordinator) run() {
 		util.GetLogger().Errorf("cannot persist schedule config, error %+v",
 			err)
 	}
-
-	c.wg.Add(2)
-	// Starts to patrol resources.
-	go c.patrolResources()
-	go c.drivePushOperator()
+	return true
 }
 
 func (c *coordinator) stop() {
@@ -506,6 +524,8 @@ func (c *coordinator) collectHotSpotMetrics() {
 		// TODO: add to tidb-ansible after merging pending influence into operator influence.
 		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "write_pending_influence_byte_rate").Set(infl.ByteRate)
 		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "write_pending_influence_key_rate").Set(infl.KeyRate)
+		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "write_pending_influence_query_rate").Set(infl.QueryRate)
+		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "write_pending_influence_cpu_rate").Set(infl.CPURate)
 		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "write_pending_influence_count").Set(infl.Count)
 	}
 
@@ -530,6 +550,8 @@ func (c *coordinator) collectHotSpotMetrics() {
 		infl := pendings[containerID]
 		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "read_pending_influence_byte_rate").Set(infl.ByteRate)
 		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "read_pending_influence_key_rate").Set(infl.KeyRate)
+		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "read_pending_influence_query_rate").Set(infl.QueryRate)
+		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "read_pending_influence_cpu_rate").Set(infl.CPURate)
 		hotSpotStatusGauge.WithLabelValues(containerAddress, containerLabel, "read_pending_influence_count").Set(infl.Count)
 	}
 }
@@ -555,8 +577,10 @@ func (c *coordinator) addScheduler(scheduler schedule.Scheduler, args ...string)
 		return err
 	}
 
-	c.wg.Add(1)
-	go c.runScheduler(s)
+	if !c.simulated {
+		c.wg.Add(1)
+		go c.runScheduler(s)
+	}
 	c.schedulers[s.GetName()] = s
 	c.cluster.opt.AddSchedulerCfg(s.GetType(), args)
 	return nil
@@ -704,16 +728,7 @@ func (c *coordinator) runScheduler(s *scheduleController) {
 		select {
 		case <-timer.C:
 			timer.Reset(s.GetInterval())
-			if !s.AllowSchedule() {
-				continue
-			}
-			if op := s.Schedule(); op != nil {
-				added := c.opController.AddWaitingOperator(op...)
-				util.GetLogger().Debugf("scheduler %s add %d operators, total %d",
-					s.GetName(),
-					added,
-					len(op))
-			}
+			c.schedule(s)
 
 		case <-s.Ctx().Done():
 			util.GetLogger().Infof("scheduler %s has been stopped",
@@ -723,6 +738,20 @@ func (c *coordinator) runScheduler(s *scheduleController) {
 	}
 }
 
+// schedule runs the scheduler once if it's allowed, and adds the created operators.
+func (c *coordinator) schedule(s *scheduleController) {
+	if !s.AllowSchedule() {
+		return
+	}
+	if op := s.Schedule(); op != nil {
+		added := c.opController.AddWaitingOperator(op...)
+		util.GetLogger().Debugf("scheduler %s add %d operators, total %d",
+			s.GetName(),
+			added,
+			len(op))
+	}
+}
+
 // scheduleController is used to manage a scheduler to schedule.
 type scheduleController struct {
 	schedule.Scheduler
@@ -0,0 +1,209 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/hbstream"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/placement"
+	"github.com/matrixorigin/matrixcube/components/prophet/statistics"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+)
+
+// Simulation runs a RaftCluster with the in-memory storage, the checkers and the
+// schedulers of the coordinator are driven by the virtual time of the caller instead
+// of the background goroutines, so a scenario of hours takes seconds and the result
+// is reproducible. It's used by the offline scheduling simulator.
+//
+// The operators are not pushed to the containers, the caller gets them from the
+// operator controller, applies the steps to its topology and reports the result by
+// the heartbeats.
+type Simulation struct {
+	cluster   *RaftCluster
+	hbStreams *hbstream.HeartbeatStreams
+	cancel    context.CancelFunc
+	clock     *simulationClock
+
+	nextPatrol   time.Time
+	nextSchedule map[string]time.Time
+}
+
+// NewSimulation creates a Simulation with the options, the virtual time starts at now.
+func NewSimulation(opt *config.PersistOptions, now time.Time) (*Simulation, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := storage.NewTestStorage()
+	c := &RaftCluster{ctx: ctx, adapter: metadata.NewTestAdapter()}
+	c.InitCluster(opt, s, core.NewBasicCluster(metadata.TestResourceFactory))
+	c.ruleManager = placement.NewRuleManager(s, c)
+	if opt.IsPlacementRulesEnabled() {
+		if err := c.ruleManager.Initialize(opt.GetMaxReplicas(), opt.GetLocationLabels()); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	clock := &simulationClock{}
+	clock.set(now)
+	// no stream is bound, the messages to the containers are dropped
+	hbStreams := hbstream.NewHeartbeatStreams(ctx, 0, c)
+	c.coordinator = newCoordinator(ctx, c, hbStreams)
+	c.coordinator.simulated = true
+	c.coordinator.opController.SetContainerLimitClock(clock)
+	c.resourceStats = statistics.NewResourceStatistics(opt, c.ruleManager)
+	c.limiter = NewContainerLimiter(opt)
+	return &Simulation{
+		cluster:      c,
+		hbStreams:    hbStreams,
+		cancel:       cancel,
+		clock:        clock,
+		nextPatrol:   now,
+		nextSchedule: make(map[string]time.Time),
+	}, nil
+}
+
+// GetRaftCluster returns the simulated RaftCluster
+func (s *Simulation) GetRaftCluster() *RaftCluster {
+	return s.cluster
+}
+
+// Now returns the virtual time
+func (s *Simulation) Now() time.Time {
+	return s.clock.Now()
+}
+
+// PutContainer adds or updates the container
+func (s *Simulation) PutContainer(container metadata.Container) error {
+	defer s.drainChangedEvents()
+	return s.cluster.PutContainer(container)
+}
+
+// HandleContainerHeartbeat handles the heartbeat of the container
+func (s *Simulation) HandleContainerHeartbeat(stats *metapb.ContainerStats) error {
+	defer s.drainChangedEvents()
+	return s.cluster.HandleContainerHeartbeat(stats)
+}
+
+// HandleResourceHeartbeat handles the heartbeat of the resource leader, and dispatches the
+// operator of the resource.
+func (s *Simulation) HandleResourceHeartbeat(res *core.CachedResource) error {
+	defer s.drainChangedEvents()
+	return s.cluster.HandleResourceHeartbeat(res)
+}
+
+// SetContainerLastHeartbeat makes the container look like no heartbeat was received for
+// the duration, the container state is judged by the real clock since the last heartbeat.
+func (s *Simulation) SetContainerLastHeartbeat(containerID uint64, since time.Duration) {
+	s.cluster.Lock()
+	defer s.cluster.Unlock()
+
+	if container := s.cluster.core.GetContainer(containerID); container != nil {
+		s.cluster.core.PutContainer(container.Clone(core.SetLastHeartbeatTS(time.Now().Add(-since))))
+	}
+}
+
+// Start creates the schedulers from the schedule config
+func (s *Simulation) Start() {
+	s.cluster.coordinator.loadSchedulers()
+}
+
+// Tick advances the virtual time to now, patrols the resources if the patrol interval is
+// elapsed, and runs the schedulers whose interval is elapsed in the order of the names.
+func (s *Simulation) Tick(now time.Time) {
+	s.clock.set(now)
+	co := s.cluster.coordinator
+
+	if !now.Before(s.nextPatrol) {
+		s.nextPatrol = now.Add(s.cluster.GetOpts().GetPatrolResourceInterval())
+		co.checkSuspectResources()
+		co.checkSuspectKeyRanges()
+		co.checkWaitingResources()
+		for _, group := range s.cluster.GetReplicationConfig().Groups {
+			resources := s.cluster.ScanResources(group, nil, nil, -1)
+			for _, res := range resources {
+				if co.opController.GetOperator(res.Meta.ID()) == nil {
+					co.checkResource(res)
+				}
+			}
+			s.cluster.updateResourcesLabelLevelStats(resources)
+		}
+	}
+
+	co.RLock()
+	schedulers := make([]*scheduleController, 0, len(co.schedulers))
+	for _, sc := range co.schedulers {
+		schedulers = append(schedulers, sc)
+	}
+	co.RUnlock()
+	sort.Slice(schedulers, func(i, j int) bool {
+		return schedulers[i].GetName() < schedulers[j].GetName()
+	})
+	for _, sc := range schedulers {
+		next, ok := s.nextSchedule[sc.GetName()]
+		if !ok {
+			next = now.Add(sc.GetInterval())
+		}
+		if now.Before(next) {
+			s.nextSchedule[sc.GetName()] = next
+			continue
+		}
+		co.schedule(sc)
+		s.nextSchedule[sc.GetName()] = now.Add(sc.GetInterval())
+	}
+
+	s.cluster.checkContainers()
+	s.drainChangedEvents()
+}
+
+// Stop stops the simulation
+func (s *Simulation) Stop() {
+	s.cluster.coordinator.stop()
+	s.hbStreams.Close()
+	s.cancel()
+}
+
+// drainChangedEvents drops the changed events, no one watches the simulated cluster.
+func (s *Simulation) drainChangedEvents() {
+	for {
+		select {
+		case <-s.cluster.changedEvents:
+		default:
+			return
+		}
+	}
+}
+
+// simulationClock is the virtual clock of the container limits
+type simulationClock struct {
+	now int64
+}
+
+func (c *simulationClock) set(now time.Time) {
+	atomic.StoreInt64(&c.now, now.UnixNano())
+}
+
+// Now returns the virtual time
+func (c *simulationClock) Now() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&c.now))
+}
+
+// Sleep returns immediately, the container limits never block.
+func (c *simulationClock) Sleep(d time.Duration) {}
@@ -27,8 +27,10 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/vfs"
 	"go.etcd.io/etcd/embed"
+	"go.etcd.io/etcd/pkg/transport"
 )
 
 // Config the prophet configuration
@@ -37,6 +39,18 @@ type Config struct {
 	DataDir    string            `toml:"data-dir"`
 	RPCAddr    string            `toml:"rpc-addr"`
 	RPCTimeout typeutil.Duration `toml:"rpc-timeout"`
+	// HTTPAddr the address of the http admin api, the api is disabled if empty,
+	// and only the leader serves the api requests.
+	HTTPAddr string `toml:"http-addr"`
+	// EnableHTTPAdmin allow the http api to change the schedulers, operators, rules
+	// and containers, only the read apis are served if disabled. The http api has no
+	// authentication itself, so enable it only if the http address is protected, e.g.
+	// by the tls with client-cert-auth. The admin rpcs used by cubectl to change the
+	// schedulers and the container states are gated by it too.
+	EnableHTTPAdmin bool `toml:"enable-http-admin"`
+	// TLS the tls config of the rpc, the http api and the embed etcd, all the
+	// traffics are plaintext if the tls is not enabled.
+	TLS tlsutil.Config `toml:"tls"`
 
 	// etcd configuration
 	StorageNode  bool            `toml:"storage-node"`
@@ -48,6 +62,9 @@ type Config struct {
 	// and other servers can campaign the leader again.
 	// Etcd only supports seconds TTL, so here is second too.
 	LeaderLease int64 `toml:"lease" json:"lease"`
+	// TSOSaveInterval the interval to save the upper bound of the timestamp window in the etcd,
+	// the new leader allocates the timestamps after the saved upper bound.
+	TSOSaveInterval typeutil.Duration `toml:"tso-save-interval"`
 
 	Schedule      ScheduleConfig      `toml:"schedule" json:"schedule"`
 	Replication   ReplicationConfig   `toml:"replication" json:"replication"`
@@ -113,6 +130,18 @@ func (c *Config) GenEmbedEtcdConfig() (*embed.Config, error) {
 	cfg.AutoCompactionMode = c.EmbedEtcd.AutoCompactionMode
 	cfg.AutoCompactionRetention = c.EmbedEtcd.AutoCompactionRetention
 	cfg.QuotaBackendBytes = int64(c.EmbedEtcd.QuotaBackendBytes)
+	if c.TLS.Enabled() {
+		// etcd loads the certificates in every handshake, so the rotated certificates
+		// are used without restart.
+		info := transport.TLSInfo{
+			CertFile:       c.TLS.CertFile,
+			KeyFile:        c.TLS.KeyFile,
+			TrustedCAFile:  c.TLS.CAFile,
+			ClientCertAuth: c.TLS.ClientCertAuth,
+		}
+		cfg.ClientTLSInfo = info
+		cfg.PeerTLSInfo = info
+	}
 
 	var err error
 	cfg.LPUrls, err = util.ParseUrls(c.EmbedEtcd.PeerUrls)
@@ -188,6 +217,9 @@ type ScheduleConfig struct {
 	// EnableCrossTableMerge is the option to enable cross table merge. This means two resources can be merged with different table IDs.
 	// This option only works when key type is "table".
 	EnableCrossTableMerge bool `toml:"enable-cross-table-merge" json:"enable-cross-table-merge,string"`
+	// EnableLoadSplit is the option to enable splitting the hot resources. The resource is split
+	// by the keys sampled from its requests.
+	EnableLoadSplit bool `toml:"enable-load-split" json:"enable-load-split,string"`
 	// PatrolResourceInterval is the interval for scanning resource during patrol.
 	PatrolResourceInterval typeutil.Duration `toml:"patrol-resource-interval" json:"patrol-resource-interval"`
 	// MaxContainerDownTime is the max duration after which
@@ -229,6 +261,11 @@ type ScheduleConfig struct {
 	ResourceScoreFormulaVersion string `toml:"resource-score-formula-version" json:"resource-score-formula-version"`
 	// SchedulerMaxWaitingOperator is the max coexist operators for each scheduler.
 	SchedulerMaxWaitingOperator uint64 `toml:"scheduler-max-waiting-operator" json:"scheduler-max-waiting-operator"`
+	// OperatorHistoryRetention is the duration to keep the persisted histories of the finished operators.
+	OperatorHistoryRetention typeutil.Duration `toml:"operator-history-retention" json:"operator-history-retention"`
+	// MaxOperatorHistoryCount is the max count of the persisted histories of the finished operators,
+	// the oldest histories are removed if exceeded.
+	MaxOperatorHistoryCount uint64 `toml:"max-operator-history-count" json:"max-operator-history-count"`
 
 	// EnableRemoveDownReplica is the option to enable replica checker to remove down replica.
 	EnableRemoveDownReplica bool `toml:"enable-remove-down-replica" json:"enable-remove-down-replica,string"`
@@ -330,6 +367,10 @@ func (c *ScheduleConfig) adjust(meta *configMetaData, reloading bool) error {
 	adjustDuration(&c.SplitMergeInterval, defaultSplitMergeInterval)
 	adjustDuration(&c.PatrolResourceInterval, defaultPatrolResourceInterval)
 	adjustDuration(&c.MaxContainerDownTime, defaultMaxContainerDownTime)
+	adjustDuration(&c.OperatorHistoryRetention, defaultOperatorHistoryRetention)
+	if !meta.IsDefined("max-operator-history-count") {
+		adjustUint64(&c.MaxOperatorHistoryCount, defaultMaxOperatorHistoryCount)
+	}
 	if !meta.IsDefined("leader-schedule-limit") {
 		adjustUint64(&c.LeaderScheduleLimit, defaultLeaderScheduleLimit)
 	}
@@ -36,6 +36,8 @@ const (
 	defaultSplitMergeInterval          = 1 * time.Hour
 	defaultPatrolResourceInterval      = 100 * time.Millisecond
 	defaultMaxContainerDownTime        = 30 * time.Minute
+	defaultOperatorHistoryRetention    = 7 * 24 * time.Hour
+	defaultMaxOperatorHistoryCount     = 100000
 	defaultLeaderScheduleLimit         = 4
 	defaultResourceScheduleLimit       = 2048
 	defaultReplicaScheduleLimit        = 64
@@ -63,6 +65,7 @@ var (
 
 const (
 	defaultLeaderLease             = int64(3)
+	defaultTSOSaveInterval         = time.Second * 3
 	defaultNextRetryDelay          = time.Second
 	defaultCompactionMode          = "periodic"
 	defaultAutoCompactionRetention = "1h"
@@ -148,6 +151,7 @@ func (c *Config) Adjust(meta *toml.MetaData, reloading bool) error {
 	}
 
 	adjustInt64(&c.LeaderLease, defaultLeaderLease)
+	adjustDuration(&c.TSOSaveInterval, defaultTSOSaveInterval)
 
 	if err := c.Schedule.adjust(configMetaData.Child("schedule"), reloading); err != nil {
 		return err
@@ -184,5 +188,9 @@ func (c *Config) Validate() error {
 	// 	return errors.New("log directory shouldn't be the subdirectory of data directory")
 	// }
 
+	if err := c.TLS.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
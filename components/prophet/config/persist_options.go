@@ -265,6 +265,18 @@ func (o *PersistOptions) IsCrossTableMergeEnabled() bool {
 	return o.GetScheduleConfig().EnableCrossTableMerge
 }
 
+// IsLoadSplitEnabled returns if the hot resources can be split by load.
+func (o *PersistOptions) IsLoadSplitEnabled() bool {
+	return o.GetScheduleConfig().EnableLoadSplit
+}
+
+// SetEnableLoadSplit sets whether to enable load split. It's only used to test.
+func (o *PersistOptions) SetEnableLoadSplit(enable bool) {
+	v := o.GetScheduleConfig().Clone()
+	v.EnableLoadSplit = enable
+	o.SetScheduleConfig(v)
+}
+
 // GetPatrolResourceInterval returns the interval of patrolling resource.
 func (o *PersistOptions) GetPatrolResourceInterval() time.Duration {
 	return o.GetScheduleConfig().PatrolResourceInterval.Duration
@@ -395,6 +407,16 @@ func (o *PersistOptions) GetSchedulerMaxWaitingOperator() uint64 {
 	return o.getTTLUintOr(schedulerMaxWaitingOperatorKey, o.GetScheduleConfig().SchedulerMaxWaitingOperator)
 }
 
+// GetOperatorHistoryRetention returns the duration to keep the persisted operator histories.
+func (o *PersistOptions) GetOperatorHistoryRetention() time.Duration {
+	return o.GetScheduleConfig().OperatorHistoryRetention.Duration
+}
+
+// GetMaxOperatorHistoryCount returns the max count of the persisted operator histories.
+func (o *PersistOptions) GetMaxOperatorHistoryCount() uint64 {
+	return o.GetScheduleConfig().MaxOperatorHistoryCount
+}
+
 // GetLeaderSchedulePolicy is to get leader schedule policy.
 func (o *PersistOptions) GetLeaderSchedulePolicy() core.SchedulePolicy {
 	return core.StringToSchedulePolicy(o.GetScheduleConfig().LeaderSchedulePolicy)
@@ -172,12 +172,16 @@ func (bc *BasicCluster) GetResourceContainers(res *CachedResource) []*CachedCont
 	return containers
 }
 
-// GetFollowerContainers returns all Containers that contains the resource's follower peer.
+// GetFollowerContainers returns all Containers that contains the resource's follower peer,
+// the witness peers are skipped because they can't be the leader.
 func (bc *BasicCluster) GetFollowerContainers(res *CachedResource) []*CachedContainer {
 	bc.RLock()
 	defer bc.RUnlock()
 	var containers []*CachedContainer
-	for id := range res.GetFollowers() {
+	for id, p := range res.GetFollowers() {
+		if metadata.IsWitness(p) {
+			continue
+		}
 		if container := bc.Containers.GetContainer(id); container != nil {
 			containers = append(containers, container)
 		}
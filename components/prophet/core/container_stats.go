@@ -34,6 +34,9 @@ type containerStats struct {
 	// `HMA` is used to make it smooth.
 	maxAvailableDeviation    *movingaverage.MaxFilter
 	avgMaxAvailableDeviation *movingaverage.HMA
+	// avgSnapReceiveRate is the snapshot bytes received per second, it's used to
+	// estimate the time to move the peers to the container.
+	avgSnapReceiveRate *movingaverage.HMA
 }
 
 func newContainerStats() *containerStats {
@@ -42,6 +45,7 @@ func newContainerStats() *containerStats {
 		avgAvailable:             movingaverage.NewHMA(240),       // take 40 minutes sample under 10s heartbeat rate
 		maxAvailableDeviation:    movingaverage.NewMaxFilter(120), // take 20 minutes sample under 10s heartbeat rate
 		avgMaxAvailableDeviation: movingaverage.NewHMA(60),        // take 10 minutes sample under 10s heartbeat rate
+		avgSnapReceiveRate:       movingaverage.NewHMA(30),        // take 5 minutes sample under 10s heartbeat rate
 	}
 }
 
@@ -58,6 +62,11 @@ func (ss *containerStats) updateRawStats(rawStats *metapb.ContainerStats) {
 	deviation := math.Abs(float64(rawStats.GetAvailable()) - ss.avgAvailable.Get())
 	ss.maxAvailableDeviation.Add(deviation)
 	ss.avgMaxAvailableDeviation.Add(ss.maxAvailableDeviation.Get())
+
+	if interval := rawStats.GetInterval(); interval != nil && interval.GetEnd() > interval.GetStart() {
+		ss.avgSnapReceiveRate.Add(float64(rawStats.GetReceivedSnapBytes()) /
+			float64(interval.GetEnd()-interval.GetStart()))
+	}
 }
 
 // GetContainerStats returns the statistics information of the container.
@@ -116,6 +125,16 @@ func (ss *containerStats) GetKeysRead() uint64 {
 	return ss.rawStats.GetReadKeys()
 }
 
+// GetSnapReceiveRate returns the snapshot bytes received by the container per second.
+func (ss *containerStats) GetSnapReceiveRate() float64 {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	if ss.avgSnapReceiveRate == nil {
+		return 0
+	}
+	return ss.avgSnapReceiveRate.Get()
+}
+
 // IsBusy returns if the container is busy.
 func (ss *containerStats) IsBusy() bool {
 	ss.mu.RLock()
@@ -144,6 +163,21 @@ func (ss *containerStats) GetApplyingSnapCount() uint64 {
 	return ss.rawStats.GetApplyingSnapCount()
 }
 
+// GetInconsistentResources returns the resources whose replica in the container is inconsistent
+// with the leader.
+func (ss *containerStats) GetInconsistentResources() []uint64 {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return ss.rawStats.GetInconsistentResources()
+}
+
+// GetSlowScore returns the slow score of the container, from 1 (normal) to 100 (slow).
+func (ss *containerStats) GetSlowScore() uint64 {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return ss.rawStats.GetSlowScore()
+}
+
 // GetAvgAvailable returns available size after the spike changes has been smoothed.
 func (ss *containerStats) GetAvgAvailable() uint64 {
 	ss.mu.RLock()
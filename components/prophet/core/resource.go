@@ -114,6 +114,14 @@ func ResourceFromHeartbeat(heartbeat rpcpb.ResourceHeartbeatReq, meta metadata.R
 		res.stats.ReadKeys = 0
 		res.stats.ReadBytes = 0
 	}
+	if res.stats.WrittenQuery >= ImpossibleFlowSize || res.stats.WriteCPUTime >= ImpossibleFlowSize {
+		res.stats.WrittenQuery = 0
+		res.stats.WriteCPUTime = 0
+	}
+	if res.stats.ReadQuery >= ImpossibleFlowSize || res.stats.ReadCPUTime >= ImpossibleFlowSize {
+		res.stats.ReadQuery = 0
+		res.stats.ReadCPUTime = 0
+	}
 
 	sort.Sort(peerStatsSlice(res.downPeers))
 	sort.Sort(peerSlice(res.pendingPeers))
@@ -370,6 +378,26 @@ func (r *CachedResource) GetKeysRead() uint64 {
 	return r.stats.ReadKeys
 }
 
+// GetQueryWritten returns the write requests of the resource.
+func (r *CachedResource) GetQueryWritten() uint64 {
+	return r.stats.WrittenQuery
+}
+
+// GetQueryRead returns the read requests of the resource.
+func (r *CachedResource) GetQueryRead() uint64 {
+	return r.stats.ReadQuery
+}
+
+// GetWriteCPUTime returns the cpu time in microseconds of the write requests of the resource.
+func (r *CachedResource) GetWriteCPUTime() uint64 {
+	return r.stats.WriteCPUTime
+}
+
+// GetReadCPUTime returns the cpu time in microseconds of the read requests of the resource.
+func (r *CachedResource) GetReadCPUTime() uint64 {
+	return r.stats.ReadCPUTime
+}
+
 // GetLeader returns the leader of the resource.
 func (r *CachedResource) GetLeader() *metapb.Peer {
 	return r.leader
@@ -750,13 +778,15 @@ func (s peerStatsSlice) Less(i, j int) bool {
 	return s[i].GetPeer().ID < s[j].GetPeer().ID
 }
 
-// SortedPeersStatsEqual judges whether two sorted `peerStatsSlice` are equal
+// SortedPeersStatsEqual judges whether two sorted `peerStatsSlice` are equal, the down
+// seconds are compared too, otherwise the down peers never age in the cache.
 func SortedPeersStatsEqual(peersA, peersB []metapb.PeerStats) bool {
 	if len(peersA) != len(peersB) {
 		return false
 	}
 	for i, peerStats := range peersA {
-		if peerStats.GetPeer().ID != peersB[i].GetPeer().ID {
+		if peerStats.GetPeer().ID != peersB[i].GetPeer().ID ||
+			peerStats.GetDownSeconds() != peersB[i].GetDownSeconds() {
 			return false
 		}
 	}
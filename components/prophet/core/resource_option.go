@@ -177,6 +177,34 @@ func SetReadKeys(v uint64) ResourceCreateOption {
 	}
 }
 
+// SetWrittenQuery sets the write requests for the resource.
+func SetWrittenQuery(v uint64) ResourceCreateOption {
+	return func(res *CachedResource) {
+		res.stats.WrittenQuery = v
+	}
+}
+
+// SetReadQuery sets the read requests for the resource.
+func SetReadQuery(v uint64) ResourceCreateOption {
+	return func(res *CachedResource) {
+		res.stats.ReadQuery = v
+	}
+}
+
+// SetWriteCPUTime sets the cpu time in microseconds of the write requests for the resource.
+func SetWriteCPUTime(v uint64) ResourceCreateOption {
+	return func(res *CachedResource) {
+		res.stats.WriteCPUTime = v
+	}
+}
+
+// SetReadCPUTime sets the cpu time in microseconds of the read requests for the resource.
+func SetReadCPUTime(v uint64) ResourceCreateOption {
+	return func(res *CachedResource) {
+		res.stats.ReadCPUTime = v
+	}
+}
+
 // SetApproximateSize sets the approximate size for the resource.
 func SetApproximateSize(v int64) ResourceCreateOption {
 	return func(res *CachedResource) {
@@ -121,6 +121,10 @@ func TestSortedEqual(t *testing.T) {
 		assert.Equal(t, tc.isEqual, SortedPeersStatsEqual(resA.GetDownPeers(), resB.GetDownPeers()))
 		assert.Equal(t, tc.isEqual, SortedPeersEqual(resA.GetPendingPeers(), resB.GetPendingPeers()))
 	}
+
+	resA := res.Clone(WithDownPeers([]metapb.PeerStats{{Peer: meta.ResPeers[1], DownSeconds: 10}}))
+	resB := res.Clone(WithDownPeers([]metapb.PeerStats{{Peer: meta.ResPeers[1], DownSeconds: 20}}))
+	assert.False(t, SortedPeersStatsEqual(resA.GetDownPeers(), resB.GetDownPeers()))
 }
 
 func TestResourceMap(t *testing.T) {
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/option"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/vfs"
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/embed"
@@ -76,7 +77,7 @@ var listMemberRetryTimes = 20
 //      What join does: return "" (as etcd will read data directory and find
 //                      that the Prophet itself has been removed, so an empty string
 //                      is fine.)
-func PrepareJoinCluster(cfg *config.Config) {
+func PrepareJoinCluster(cfg *config.Config, tls *tlsutil.Loader) {
 	// - A Prophet tries to join itself.
 	if cfg.EmbedEtcd.Join == "" {
 		return
@@ -117,6 +118,7 @@ func PrepareJoinCluster(cfg *config.Config) {
 	client, err := clientv3.New(clientv3.Config{
 		Endpoints:   strings.Split(cfg.EmbedEtcd.Join, ","),
 		DialTimeout: option.DefaultDialTimeout,
+		TLS:         tls.ClientConfig(),
 	})
 	if err != nil {
 		util.GetLogger().Fatalf("create etcd client failed with %+v",
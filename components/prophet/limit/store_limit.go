@@ -66,6 +66,15 @@ func (t Type) String() string {
 	return ""
 }
 
+// Clock provides the time to refill the container limit, the limit uses the real clock
+// by default, the simulator refills it by the virtual time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep sleeps for at least the given duration.
+	Sleep(d time.Duration)
+}
+
 // ContainerLimit limits the operators of a container
 type ContainerLimit struct {
 	bucket            *ratelimit.Bucket
@@ -75,6 +84,12 @@ type ContainerLimit struct {
 
 // NewContainerLimit returns a ContainerLimit object
 func NewContainerLimit(ratePerSec float64, resourceInfluence int64) *ContainerLimit {
+	return NewContainerLimitWithClock(ratePerSec, resourceInfluence, nil)
+}
+
+// NewContainerLimitWithClock returns a ContainerLimit object refilled by the clock, the
+// real clock is used if the clock is nil.
+func NewContainerLimitWithClock(ratePerSec float64, resourceInfluence int64, clock Clock) *ContainerLimit {
 	capacity := resourceInfluence
 	rate := ratePerSec
 	// unlimited
@@ -87,7 +102,7 @@ func NewContainerLimit(ratePerSec float64, resourceInfluence int64) *ContainerLi
 		ratePerSec *= float64(resourceInfluence)
 	}
 	return &ContainerLimit{
-		bucket:            ratelimit.NewBucketWithRate(ratePerSec, capacity),
+		bucket:            ratelimit.NewBucketWithRateAndClock(ratePerSec, capacity, clock),
 		resourceInfluence: resourceInfluence,
 		ratePerSec:        rate,
 	}
@@ -25,10 +25,33 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/election"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/embed"
 )
 
+const (
+	leaderPurpose = "prophet-leader"
+)
+
+// CurrentLeader returns the current prophet leader stored in etcd, nil if no leader. It's
+// used by the tools which are not the prophet member to find the leader.
+func CurrentLeader(elector election.Elector) (*metapb.Member, error) {
+	value, _, err := elector.CreateLeadship(leaderPurpose, "", "", false, nil, nil).CurrentLeader()
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	leader := &metapb.Member{}
+	if err := leader.Unmarshal([]byte(value)); err != nil {
+		return nil, err
+	}
+	return leader, nil
+}
+
 // Member is used for the election related logic.
 type Member struct {
 	candidate   bool
@@ -40,12 +63,13 @@ type Member struct {
 	member      *metapb.Member // current prophet's info.
 	memberValue string
 	id          uint64 //etcd server id
+	tls         *tlsutil.Loader
 
 	becomeLeaderFunc, becomeFollowerFunc func() error
 }
 
 // NewMember create a new Member.
-func NewMember(client *clientv3.Client, etcd *embed.Etcd, elector election.Elector, candidate bool, becomeLeaderFunc, becomeFollowerFunc func() error) *Member {
+func NewMember(client *clientv3.Client, etcd *embed.Etcd, elector election.Elector, candidate bool, tls *tlsutil.Loader, becomeLeaderFunc, becomeFollowerFunc func() error) *Member {
 	id := uint64(0)
 	if etcd != nil {
 		id = uint64(etcd.Server.ID())
@@ -58,6 +82,7 @@ func NewMember(client *clientv3.Client, etcd *embed.Etcd, elector election.Elect
 		becomeLeaderFunc:   becomeLeaderFunc,
 		becomeFollowerFunc: becomeFollowerFunc,
 		etcd:               etcd,
+		tls:                tls,
 		id:                 id,
 	}
 }
@@ -150,7 +175,7 @@ func (m *Member) MemberInfo(name, addr string) {
 	}
 	m.member = leader
 	m.memberValue = string(data)
-	m.leadership = m.elector.CreateLeadship("prophet-leader", name, m.memberValue, m.candidate, m.enableLeader, m.disableLeader)
+	m.leadership = m.elector.CreateLeadship(leaderPurpose, name, m.memberValue, m.candidate, m.enableLeader, m.disableLeader)
 }
 
 // IsLeader returns whether the server is prophet leader or not by checking its leadership's lease and leader info.
@@ -187,8 +212,13 @@ func (m *Member) getLeaderClient(addr string) goetty.IOSession {
 
 func (m *Member) createLeaderClient(leader string) (goetty.IOSession, error) {
 	encoder, decoder := codec.NewClientCodec(10 * buf.MB)
-	conn := goetty.NewIOSession(goetty.WithCodec(encoder, decoder),
-		goetty.WithEnableAsyncWrite(16))
+	var conn goetty.IOSession
+	if m.tls != nil {
+		conn = tlsutil.NewIOSession(m.tls, tlsutil.SessionOptions{Encoder: encoder, Decoder: decoder})
+	} else {
+		conn = goetty.NewIOSession(goetty.WithCodec(encoder, decoder),
+			goetty.WithEnableAsyncWrite(16))
+	}
 	_, err := conn.Connect(leader, time.Second*3)
 	if err != nil {
 		return nil, err
@@ -122,6 +122,12 @@ func IsLearner(peer metapb.Peer) bool {
 	return peer.Role == metapb.PeerRole_Learner
 }
 
+// IsWitness judges whether the Peer is a witness, the witness votes but stores no data,
+// and never becomes the leader.
+func IsWitness(peer metapb.Peer) bool {
+	return peer.Witness
+}
+
 // IsVoterOrIncomingVoter judges whether peer role will become Voter.
 // The peer is not nil and the role is equal to IncomingVoter or Voter.
 func IsVoterOrIncomingVoter(peer metapb.Peer) bool {
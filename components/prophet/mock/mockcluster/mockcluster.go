@@ -362,6 +362,32 @@ func (mc *Cluster) AddLeaderResourceWithReadInfo(
 	return items
 }
 
+// AddLeaderResourceWithReadQueryInfo adds resource with specified leader, followers and read requests info.
+func (mc *Cluster) AddLeaderResourceWithReadQueryInfo(
+	resID uint64, leaderID uint64,
+	readQuery, readCPUTime uint64,
+	reportInterval uint64,
+	followerIds []uint64, filledNums ...int) []*statistics.HotPeerStat {
+	r := mc.newMockCachedResource(resID, leaderID, followerIds...)
+	r = r.Clone(core.SetReadQuery(readQuery))
+	r = r.Clone(core.SetReadCPUTime(readCPUTime))
+	r = r.Clone(core.SetReportInterval(reportInterval))
+	filledNum := mc.HotCache.GetFilledPeriod(statistics.ReadFlow)
+	if len(filledNums) > 0 {
+		filledNum = filledNums[0]
+	}
+
+	var items []*statistics.HotPeerStat
+	for i := 0; i < filledNum; i++ {
+		items = mc.HotCache.CheckRead(r)
+		for _, item := range items {
+			mc.HotCache.Update(item)
+		}
+	}
+	mc.PutResource(r)
+	return items
+}
+
 // AddLeaderResourceWithWriteInfo adds resource with specified leader, followers and write info.
 func (mc *Cluster) AddLeaderResourceWithWriteInfo(
 	resID uint64, leaderID uint64,
@@ -474,6 +500,15 @@ func (mc *Cluster) UpdateStorageRatio(containerID uint64, usedRatio, availableRa
 	mc.PutContainer(newContainer)
 }
 
+// UpdateContainerSlowScore updates container slow score.
+func (mc *Cluster) UpdateContainerSlowScore(containerID uint64, score uint64) {
+	container := mc.GetContainer(containerID)
+	newStats := proto.Clone(container.GetContainerStats()).(*metapb.ContainerStats)
+	newStats.SlowScore = score
+	newContainer := container.Clone(core.SetContainerStats(newStats))
+	mc.PutContainer(newContainer)
+}
+
 // UpdateStorageWrittenStats updates container written bytes.
 func (mc *Cluster) UpdateStorageWrittenStats(containerID, bytesWritten, keysWritten uint64) {
 	container := mc.GetContainer(containerID)
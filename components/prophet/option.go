@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/codec"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 )
 
 // Option client option
@@ -29,6 +30,7 @@ type Option func(*options)
 type options struct {
 	leaderGetter func() *metapb.Member
 	rpcTimeout   time.Duration
+	tls          *tlsutil.Loader
 }
 
 func (opts *options) adjust() {
@@ -51,10 +53,20 @@ func WithRPCTimeout(value time.Duration) Option {
 	}
 }
 
-func createConn() goetty.IOSession {
+// WithTLS set the tls loader to connect to the prophet by tls
+func WithTLS(value *tlsutil.Loader) Option {
+	return func(opts *options) {
+		opts.tls = value
+	}
+}
+
+func createConn(tls *tlsutil.Loader) goetty.IOSession {
 	encoder, decoder := codec.NewClientCodec(10 * buf.MB)
+	if tls != nil {
+		return tlsutil.NewIOSession(tls, tlsutil.SessionOptions{Encoder: encoder, Decoder: decoder})
+	}
+
 	return goetty.NewIOSession(goetty.WithCodec(encoder, decoder),
 		goetty.WithLogger(util.GetLogger()),
 		goetty.WithEnableAsyncWrite(16))
-
 }
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -21,7 +22,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Action the action while a new node join the cluster
 type Action int32
@@ -213,18 +214,22 @@ const (
 	CheckPolicy_SCAN        CheckPolicy = 0
 	CheckPolicy_APPROXIMATE CheckPolicy = 1
 	CheckPolicy_USEKEY      CheckPolicy = 2
+	// LOAD split the shard by the sampled keys of the requests
+	CheckPolicy_LOAD CheckPolicy = 3
 )
 
 var CheckPolicy_name = map[int32]string{
 	0: "SCAN",
 	1: "APPROXIMATE",
 	2: "USEKEY",
+	3: "LOAD",
 }
 
 var CheckPolicy_value = map[string]int32{
 	"SCAN":        0,
 	"APPROXIMATE": 1,
 	"USEKEY":      2,
+	"LOAD":        3,
 }
 
 func (x CheckPolicy) String() string {
@@ -278,6 +283,12 @@ const (
 	JobType_RemoveResource JobType = 0
 	// CreateResourcePool create resource pool
 	JobType_CreateResourcePool JobType = 1
+	// Backup backup all the shards of the cluster
+	JobType_Backup JobType = 2
+	// UnsafeRecovery recover the shards which lost the raft majority
+	JobType_UnsafeRecovery JobType = 3
+	// RequestQuota the request quotas of the tenants and the shard groups
+	JobType_RequestQuota JobType = 4
 	// CustomStartAt custom job
 	JobType_CustomStartAt JobType = 100
 )
@@ -285,12 +296,18 @@ const (
 var JobType_name = map[int32]string{
 	0:   "RemoveResource",
 	1:   "CreateResourcePool",
+	2:   "Backup",
+	3:   "UnsafeRecovery",
+	4:   "RequestQuota",
 	100: "CustomStartAt",
 }
 
 var JobType_value = map[string]int32{
 	"RemoveResource":     0,
 	"CreateResourcePool": 1,
+	"Backup":             2,
+	"UnsafeRecovery":     3,
+	"RequestQuota":       4,
 	"CustomStartAt":      100,
 }
 
@@ -359,7 +376,7 @@ func (m *ResourceEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_ResourceEpoch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -394,9 +411,12 @@ func (m *ResourceEpoch) GetVersion() uint64 {
 
 // Peer is a replica of the resource, we called peer
 type Peer struct {
-	ID                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ContainerID          uint64   `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Role                 PeerRole `protobuf:"varint,3,opt,name=role,proto3,enum=metapb.PeerRole" json:"role,omitempty"`
+	ID          uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContainerID uint64   `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Role        PeerRole `protobuf:"varint,3,opt,name=role,proto3,enum=metapb.PeerRole" json:"role,omitempty"`
+	// Witness the witness peer votes and replicates the raft logs, but never applies
+	// the write requests and never becomes the leader.
+	Witness              bool     `protobuf:"varint,4,opt,name=witness,proto3" json:"witness,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -416,7 +436,7 @@ func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -456,6 +476,13 @@ func (m *Peer) GetRole() PeerRole {
 	return PeerRole_Voter
 }
 
+func (m *Peer) GetWitness() bool {
+	if m != nil {
+		return m.Witness
+	}
+	return false
+}
+
 // PeerStats peer stats
 type PeerStats struct {
 	Peer                 Peer     `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
@@ -479,7 +506,7 @@ func (m *PeerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_PeerStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -535,7 +562,7 @@ func (m *Pair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Pair.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -585,10 +612,18 @@ type ResourceStats struct {
 	// approximate count of keys in the resource
 	ApproximateKeys uint64 `protobuf:"varint,7,opt,name=approximateKeys,proto3" json:"approximateKeys,omitempty"`
 	// Actually reported time interval
-	Interval             *TimeInterval `protobuf:"bytes,8,opt,name=interval,proto3" json:"interval,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Interval *TimeInterval `protobuf:"bytes,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	// write request batches during this period
+	WrittenQuery uint64 `protobuf:"varint,9,opt,name=writtenQuery,proto3" json:"writtenQuery,omitempty"`
+	// read request batches during this period
+	ReadQuery uint64 `protobuf:"varint,10,opt,name=readQuery,proto3" json:"readQuery,omitempty"`
+	// microseconds of the cpu time spent on applying the write requests during this period
+	WriteCPUTime uint64 `protobuf:"varint,11,opt,name=writeCPUTime,proto3" json:"writeCPUTime,omitempty"`
+	// microseconds of the cpu time spent on executing the read requests during this period
+	ReadCPUTime          uint64   `protobuf:"varint,12,opt,name=readCPUTime,proto3" json:"readCPUTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ResourceStats) Reset()         { *m = ResourceStats{} }
@@ -605,7 +640,7 @@ func (m *ResourceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_ResourceStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -680,6 +715,34 @@ func (m *ResourceStats) GetInterval() *TimeInterval {
 	return nil
 }
 
+func (m *ResourceStats) GetWrittenQuery() uint64 {
+	if m != nil {
+		return m.WrittenQuery
+	}
+	return 0
+}
+
+func (m *ResourceStats) GetReadQuery() uint64 {
+	if m != nil {
+		return m.ReadQuery
+	}
+	return 0
+}
+
+func (m *ResourceStats) GetWriteCPUTime() uint64 {
+	if m != nil {
+		return m.WriteCPUTime
+	}
+	return 0
+}
+
+func (m *ResourceStats) GetReadCPUTime() uint64 {
+	if m != nil {
+		return m.ReadCPUTime
+	}
+	return 0
+}
+
 // ContainerStats container stats
 type ContainerStats struct {
 	// Container id
@@ -719,10 +782,19 @@ type ContainerStats struct {
 	// Threads' write disk I/O rates in the container
 	WriteIORates []RecordPair `protobuf:"bytes,18,rep,name=writeIORates,proto3" json:"writeIORates"`
 	// Operations' latencies in the container
-	OpLatencies          []RecordPair `protobuf:"bytes,19,rep,name=opLatencies,proto3" json:"opLatencies"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	OpLatencies []RecordPair `protobuf:"bytes,19,rep,name=opLatencies,proto3" json:"opLatencies"`
+	// Resources whose replica in the container is inconsistent with the leader
+	InconsistentResources []uint64 `protobuf:"varint,20,rep,packed,name=inconsistentResources,proto3" json:"inconsistentResources,omitempty"`
+	// Slow score of the container measured by the latency of the raft log append and
+	// apply, from 1 (normal) to 100 (slow)
+	SlowScore uint64 `protobuf:"varint,21,opt,name=slowScore,proto3" json:"slowScore,omitempty"`
+	// Snapshot bytes sent by the container during this period
+	SentSnapBytes uint64 `protobuf:"varint,22,opt,name=sentSnapBytes,proto3" json:"sentSnapBytes,omitempty"`
+	// Snapshot bytes received by the container during this period
+	ReceivedSnapBytes    uint64   `protobuf:"varint,23,opt,name=receivedSnapBytes,proto3" json:"receivedSnapBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ContainerStats) Reset()         { *m = ContainerStats{} }
@@ -739,7 +811,7 @@ func (m *ContainerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_ContainerStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -891,6 +963,34 @@ func (m *ContainerStats) GetOpLatencies() []RecordPair {
 	return nil
 }
 
+func (m *ContainerStats) GetInconsistentResources() []uint64 {
+	if m != nil {
+		return m.InconsistentResources
+	}
+	return nil
+}
+
+func (m *ContainerStats) GetSlowScore() uint64 {
+	if m != nil {
+		return m.SlowScore
+	}
+	return 0
+}
+
+func (m *ContainerStats) GetSentSnapBytes() uint64 {
+	if m != nil {
+		return m.SentSnapBytes
+	}
+	return 0
+}
+
+func (m *ContainerStats) GetReceivedSnapBytes() uint64 {
+	if m != nil {
+		return m.ReceivedSnapBytes
+	}
+	return 0
+}
+
 // RecordPair record pair
 type RecordPair struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -914,7 +1014,7 @@ func (m *RecordPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_RecordPair.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -971,7 +1071,7 @@ func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Member.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1034,7 +1134,7 @@ func (m *Cluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Cluster.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1092,7 +1192,7 @@ func (m *TimeInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_TimeInterval.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1149,7 +1249,7 @@ func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Job.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1212,7 +1312,7 @@ func (m *RemoveResourceJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_RemoveResourceJob.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1267,7 +1367,7 @@ func (m *ResourcePoolJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_ResourcePoolJob.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1293,6 +1393,111 @@ func (m *ResourcePoolJob) GetPools() []ResourcePool {
 	return nil
 }
 
+// BackupJob backup job
+type BackupJob struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// barrier the timestamp from the timestamp oracle when the backup is created
+	Barrier              uint64   `protobuf:"varint,2,opt,name=barrier,proto3" json:"barrier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupJob) Reset()         { *m = BackupJob{} }
+func (m *BackupJob) String() string { return proto.CompactTextString(m) }
+func (*BackupJob) ProtoMessage()    {}
+func (*BackupJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{13}
+}
+func (m *BackupJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupJob.Merge(m, src)
+}
+func (m *BackupJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupJob proto.InternalMessageInfo
+
+func (m *BackupJob) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BackupJob) GetBarrier() uint64 {
+	if m != nil {
+		return m.Barrier
+	}
+	return 0
+}
+
+// UnsafeRecoveryJob unsafe recovery job
+type UnsafeRecoveryJob struct {
+	FailedContainers     []uint64 `protobuf:"varint,1,rep,packed,name=failedContainers,proto3" json:"failedContainers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsafeRecoveryJob) Reset()         { *m = UnsafeRecoveryJob{} }
+func (m *UnsafeRecoveryJob) String() string { return proto.CompactTextString(m) }
+func (*UnsafeRecoveryJob) ProtoMessage()    {}
+func (*UnsafeRecoveryJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{14}
+}
+func (m *UnsafeRecoveryJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsafeRecoveryJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsafeRecoveryJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnsafeRecoveryJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsafeRecoveryJob.Merge(m, src)
+}
+func (m *UnsafeRecoveryJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsafeRecoveryJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsafeRecoveryJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsafeRecoveryJob proto.InternalMessageInfo
+
+func (m *UnsafeRecoveryJob) GetFailedContainers() []uint64 {
+	if m != nil {
+		return m.FailedContainers
+	}
+	return nil
+}
+
 // ResourcePool resource pool
 type ResourcePool struct {
 	Group                uint64   `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
@@ -1307,7 +1512,7 @@ func (m *ResourcePool) Reset()         { *m = ResourcePool{} }
 func (m *ResourcePool) String() string { return proto.CompactTextString(m) }
 func (*ResourcePool) ProtoMessage()    {}
 func (*ResourcePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{13}
+	return fileDescriptor_77b4d575d5a68dda, []int{15}
 }
 func (m *ResourcePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,7 +1522,7 @@ func (m *ResourcePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_ResourcePool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1357,6 +1562,121 @@ func (m *ResourcePool) GetRangePrefix() []byte {
 	return nil
 }
 
+// OperatorHistory the history of a finished operator
+type OperatorHistory struct {
+	ResourceID uint64 `protobuf:"varint,1,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
+	// desc the scheduler or checker which created the operator
+	Desc  string   `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	Kind  string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Steps []string `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	// status the end status of the operator, Success, Timeout, Canceled, Replaced or Expired
+	Status     string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Reason     string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Containers []uint64 `protobuf:"varint,7,rep,packed,name=containers,proto3" json:"containers,omitempty"`
+	// createAt and finishAt are unix timestamps in nanoseconds
+	CreateAt             int64    `protobuf:"varint,8,opt,name=createAt,proto3" json:"createAt,omitempty"`
+	FinishAt             int64    `protobuf:"varint,9,opt,name=finishAt,proto3" json:"finishAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperatorHistory) Reset()         { *m = OperatorHistory{} }
+func (m *OperatorHistory) String() string { return proto.CompactTextString(m) }
+func (*OperatorHistory) ProtoMessage()    {}
+func (*OperatorHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{16}
+}
+func (m *OperatorHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorHistory.Merge(m, src)
+}
+func (m *OperatorHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorHistory proto.InternalMessageInfo
+
+func (m *OperatorHistory) GetResourceID() uint64 {
+	if m != nil {
+		return m.ResourceID
+	}
+	return 0
+}
+
+func (m *OperatorHistory) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *OperatorHistory) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *OperatorHistory) GetSteps() []string {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *OperatorHistory) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OperatorHistory) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OperatorHistory) GetContainers() []uint64 {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+func (m *OperatorHistory) GetCreateAt() int64 {
+	if m != nil {
+		return m.CreateAt
+	}
+	return 0
+}
+
+func (m *OperatorHistory) GetFinishAt() int64 {
+	if m != nil {
+		return m.FinishAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("metapb.Action", Action_name, Action_value)
 	proto.RegisterEnum("metapb.ResourceKind", ResourceKind_name, ResourceKind_value)
@@ -1381,98 +1701,121 @@ func init() {
 	proto.RegisterType((*Job)(nil), "metapb.Job")
 	proto.RegisterType((*RemoveResourceJob)(nil), "metapb.RemoveResourceJob")
 	proto.RegisterType((*ResourcePoolJob)(nil), "metapb.ResourcePoolJob")
+	proto.RegisterType((*BackupJob)(nil), "metapb.BackupJob")
+	proto.RegisterType((*UnsafeRecoveryJob)(nil), "metapb.UnsafeRecoveryJob")
 	proto.RegisterType((*ResourcePool)(nil), "metapb.ResourcePool")
+	proto.RegisterType((*OperatorHistory)(nil), "metapb.OperatorHistory")
 }
 
 func init() { proto.RegisterFile("metapb.proto", fileDescriptor_77b4d575d5a68dda) }
 
 var fileDescriptor_77b4d575d5a68dda = []byte{
-	// 1261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x36, 0x29, 0x5a, 0x96, 0x46, 0xb2, 0x4c, 0xef, 0x09, 0x02, 0x21, 0x08, 0x1c, 0x83, 0x27,
-	0x08, 0x0c, 0xe1, 0x1c, 0x27, 0x70, 0x82, 0x5c, 0x1c, 0x9c, 0x5e, 0xc8, 0xb4, 0xd0, 0x28, 0x71,
-	0x64, 0x81, 0xb2, 0x92, 0xf6, 0xae, 0x2b, 0x72, 0x2c, 0x13, 0xa1, 0x76, 0x89, 0xe5, 0xd2, 0x89,
-	0xfa, 0x10, 0x7d, 0x9b, 0xbe, 0x43, 0x2e, 0xf3, 0x04, 0x41, 0xeb, 0x27, 0x29, 0x76, 0x49, 0x4a,
-	0x94, 0x94, 0xc6, 0xbd, 0xe3, 0xcc, 0x7c, 0xf3, 0xb3, 0xdf, 0xce, 0xcc, 0x12, 0x9a, 0x33, 0x94,
-	0x34, 0x9e, 0x1c, 0xc7, 0x82, 0x4b, 0x4e, 0xaa, 0x99, 0xf4, 0xe0, 0xbf, 0xd3, 0x50, 0x5e, 0xa7,
-	0x93, 0x63, 0x9f, 0xcf, 0x9e, 0x4e, 0xf9, 0x94, 0x3f, 0xd5, 0xe6, 0x49, 0x7a, 0xa5, 0x25, 0x2d,
-	0xe8, 0xaf, 0xcc, 0xcd, 0x71, 0x61, 0xd7, 0xc3, 0x84, 0xa7, 0xc2, 0xc7, 0x5e, 0xcc, 0xfd, 0x6b,
-	0xd2, 0x86, 0x1d, 0x9f, 0xb3, 0xab, 0x77, 0x28, 0xda, 0xc6, 0xa1, 0x71, 0x64, 0x79, 0x85, 0xa8,
-	0x2c, 0x37, 0x28, 0x92, 0x90, 0xb3, 0xb6, 0x99, 0x59, 0x72, 0xd1, 0xb9, 0x02, 0x6b, 0x88, 0x28,
-	0xc8, 0x7d, 0x30, 0xc3, 0x20, 0x73, 0x3b, 0xad, 0xde, 0x7e, 0x7d, 0x64, 0xf6, 0xcf, 0x3c, 0x33,
-	0x0c, 0xc8, 0x21, 0x34, 0x7c, 0xce, 0x24, 0x0d, 0x19, 0x8a, 0xfe, 0x59, 0xee, 0x5d, 0x56, 0x91,
-	0xc7, 0x60, 0x09, 0x1e, 0x61, 0xbb, 0x72, 0x68, 0x1c, 0xb5, 0x4e, 0xec, 0xe3, 0xfc, 0x68, 0x2a,
-	0xaa, 0xc7, 0x23, 0xf4, 0xb4, 0xd5, 0x19, 0x43, 0x5d, 0x69, 0x46, 0x92, 0xca, 0x84, 0x3c, 0x01,
-	0x2b, 0xc6, 0xbc, 0xca, 0xc6, 0x49, 0xb3, 0xec, 0x72, 0x6a, 0x7d, 0xfe, 0xfa, 0x68, 0xcb, 0xd3,
-	0x76, 0x95, 0x3c, 0xe0, 0x1f, 0xd9, 0x08, 0x7d, 0xce, 0x82, 0xa4, 0x48, 0x5e, 0x52, 0x39, 0xc7,
-	0x60, 0x0d, 0x69, 0x28, 0x88, 0x0d, 0x95, 0x0f, 0x38, 0xd7, 0x01, 0xeb, 0x9e, 0xfa, 0x24, 0xf7,
-	0x60, 0xfb, 0x86, 0x46, 0x29, 0x6a, 0xaf, 0xba, 0x97, 0x09, 0xce, 0xef, 0xe6, 0x92, 0xb4, 0xac,
-	0x96, 0x03, 0x00, 0x91, 0x2b, 0xfa, 0x67, 0x39, 0x6f, 0x25, 0x0d, 0x71, 0xa0, 0xf9, 0x51, 0x84,
-	0x52, 0x22, 0x3b, 0x9d, 0x4b, 0x2c, 0x8a, 0x58, 0xd1, 0xa9, 0x3a, 0x73, 0xf9, 0x0d, 0xce, 0x13,
-	0xcd, 0x84, 0xe5, 0x95, 0x55, 0xe4, 0x21, 0xd4, 0x05, 0xd2, 0x20, 0x0b, 0x61, 0x69, 0xfb, 0x52,
-	0x41, 0x1e, 0x40, 0x4d, 0x09, 0xda, 0x79, 0x5b, 0x1b, 0x17, 0x32, 0x39, 0x82, 0x3d, 0x1a, 0xc7,
-	0x82, 0x7f, 0x0a, 0x67, 0x54, 0xe2, 0x28, 0xfc, 0x15, 0xdb, 0x55, 0x0d, 0x59, 0x57, 0xaf, 0x21,
-	0x75, 0xb0, 0x9d, 0x0d, 0xa4, 0x8e, 0xf9, 0x0c, 0x6a, 0x21, 0x93, 0x28, 0x6e, 0x68, 0xd4, 0xae,
-	0xe9, 0x3b, 0xb8, 0x57, 0xdc, 0xc1, 0x65, 0x38, 0xc3, 0x7e, 0x6e, 0xf3, 0x16, 0x28, 0xe7, 0xb7,
-	0x2a, 0xb4, 0xdc, 0xe2, 0xd2, 0x33, 0xe2, 0xd6, 0x3a, 0xc3, 0xd8, 0xec, 0x8c, 0x87, 0x50, 0x4f,
-	0x24, 0x15, 0x52, 0xc5, 0xcc, 0x79, 0x5b, 0x2a, 0x56, 0x8a, 0xa8, 0xfc, 0x93, 0x22, 0x14, 0x4d,
-	0x3e, 0x8d, 0xa9, 0x1f, 0xca, 0x79, 0xce, 0xe1, 0x42, 0x56, 0xb9, 0xe8, 0x0d, 0x0d, 0x23, 0x3a,
-	0x89, 0x30, 0xe7, 0x70, 0xa9, 0x50, 0x9e, 0x69, 0x82, 0x41, 0x89, 0xbd, 0x85, 0x4c, 0xee, 0x43,
-	0x35, 0x4c, 0x4e, 0xd3, 0x64, 0xae, 0xd9, 0xaa, 0x79, 0xb9, 0x44, 0x1e, 0xc3, 0x6e, 0xd1, 0x06,
-	0x2e, 0x4f, 0x99, 0xd4, 0x4c, 0x59, 0xde, 0xaa, 0x92, 0x74, 0xc0, 0x4e, 0x90, 0x05, 0x21, 0x9b,
-	0x8e, 0x18, 0x8d, 0x33, 0x60, 0x5d, 0x03, 0x37, 0xf4, 0xe4, 0x18, 0x88, 0x40, 0x1f, 0xc3, 0x9b,
-	0x15, 0x34, 0x68, 0xf4, 0x37, 0x2c, 0xe4, 0x3f, 0xb0, 0x4f, 0xe3, 0x38, 0x9a, 0xaf, 0xc0, 0x1b,
-	0x1a, 0xbe, 0x69, 0xd8, 0x68, 0xd4, 0xe6, 0x37, 0x1a, 0x75, 0xa5, 0x0d, 0x77, 0xd7, 0xdb, 0x70,
-	0xad, 0x8d, 0x5b, 0x9b, 0x6d, 0x5c, 0x6e, 0xd4, 0xbd, 0xb5, 0x46, 0x7d, 0x09, 0x75, 0x3f, 0x4e,
-	0xc7, 0x09, 0x9d, 0x62, 0xd2, 0xb6, 0x0f, 0x2b, 0x47, 0x8d, 0x13, 0x52, 0x5c, 0xa8, 0x87, 0x3e,
-	0x17, 0x81, 0x9a, 0xd4, 0x7c, 0xbe, 0x97, 0x50, 0xf2, 0x3f, 0x68, 0xa8, 0x18, 0xfd, 0x0b, 0x8f,
-	0xaa, 0xaa, 0xf6, 0xef, 0xf0, 0x2c, 0x83, 0xc9, 0xff, 0xb3, 0x33, 0x63, 0xe1, 0x4c, 0xee, 0x70,
-	0x5e, 0x41, 0xab, 0xcc, 0x3c, 0x3e, 0xa7, 0x12, 0x99, 0x1f, 0x62, 0xd2, 0xfe, 0xd7, 0x5d, 0x99,
-	0x4b, 0x60, 0xe7, 0x05, 0xc0, 0x12, 0x70, 0xd7, 0xfa, 0xb1, 0x8a, 0xf5, 0xf3, 0x0a, 0xaa, 0x6f,
-	0x71, 0x36, 0xf9, 0xce, 0xbe, 0x25, 0x60, 0x31, 0x3a, 0x2b, 0xb6, 0x96, 0xfe, 0x56, 0x3a, 0x1a,
-	0x04, 0x42, 0x4f, 0x49, 0xdd, 0xd3, 0xdf, 0x4e, 0x0f, 0x76, 0xdc, 0x28, 0x4d, 0xe4, 0x77, 0x42,
-	0x39, 0xd0, 0x9c, 0xd1, 0x4f, 0x6a, 0xa9, 0x66, 0x9d, 0xa3, 0x42, 0xee, 0x7a, 0x2b, 0x3a, 0xe7,
-	0x25, 0x34, 0xcb, 0xc3, 0xa6, 0xca, 0xd6, 0x13, 0x9a, 0x8f, 0x73, 0x26, 0xa8, 0xe3, 0x21, 0x0b,
-	0xf2, 0xa3, 0xa8, 0x4f, 0x27, 0x82, 0xca, 0x6b, 0x3e, 0x21, 0xff, 0x06, 0x4b, 0xce, 0x63, 0xd4,
-	0xe8, 0xd6, 0xc9, 0x5e, 0x41, 0xdd, 0x6b, 0x3e, 0xb9, 0x9c, 0xc7, 0xe8, 0x69, 0x63, 0xfe, 0x2c,
-	0x49, 0xcc, 0x4b, 0x68, 0x7a, 0x85, 0x48, 0x9e, 0xe8, 0x6c, 0x72, 0xe3, 0xed, 0x78, 0xcd, 0x27,
-	0x6a, 0xc7, 0xa0, 0x97, 0x99, 0x1d, 0x84, 0x7d, 0x0f, 0x67, 0xfc, 0x06, 0x8b, 0xd5, 0xad, 0x72,
-	0x3f, 0xd9, 0x5c, 0xdc, 0x8b, 0xe3, 0x97, 0x17, 0xf8, 0x11, 0x6c, 0xab, 0xc7, 0x44, 0x6d, 0xee,
-	0xca, 0xdf, 0xbc, 0x36, 0x19, 0xc0, 0x71, 0x61, 0xaf, 0x48, 0x30, 0xe4, 0x3c, 0x52, 0x49, 0x9e,
-	0xc1, 0x76, 0xcc, 0x79, 0x94, 0xb4, 0x0d, 0xed, 0x7c, 0x6f, 0xd9, 0x1c, 0x4b, 0xdc, 0x22, 0x88,
-	0x02, 0x3a, 0x13, 0x68, 0x96, 0x8d, 0x8a, 0xd1, 0xa9, 0xe0, 0x69, 0x5c, 0x30, 0xaa, 0x85, 0x95,
-	0x55, 0x66, 0xae, 0xad, 0xb2, 0x43, 0x68, 0x08, 0xca, 0xa6, 0x38, 0x14, 0x78, 0x15, 0x7e, 0xd2,
-	0xdc, 0x34, 0xbd, 0xb2, 0xaa, 0x73, 0x08, 0xd5, 0xae, 0x2f, 0x43, 0xce, 0x48, 0x0d, 0xac, 0x01,
-	0x67, 0x68, 0x6f, 0x91, 0x26, 0xd4, 0x46, 0x3e, 0x8d, 0xf0, 0x22, 0x95, 0xb6, 0xd1, 0x79, 0xba,
-	0xac, 0xe2, 0x4d, 0xc8, 0x02, 0xd2, 0x02, 0x38, 0x47, 0x1a, 0xa0, 0x50, 0x92, 0xbd, 0x45, 0xf6,
-	0xa0, 0xe1, 0x61, 0x1c, 0x85, 0x3e, 0xd5, 0x0a, 0xa3, 0xf3, 0x62, 0x6d, 0xbf, 0x23, 0xa9, 0x82,
-	0x39, 0x1e, 0xda, 0x5b, 0xa4, 0x01, 0x3b, 0x17, 0x57, 0x57, 0x51, 0xc8, 0xd0, 0x36, 0xc8, 0x2e,
-	0xd4, 0x2f, 0xf9, 0x6c, 0x92, 0x48, 0x95, 0xd4, 0xec, 0xfc, 0xb0, 0xfa, 0x9a, 0xa2, 0x02, 0x7b,
-	0x29, 0x63, 0x21, 0x9b, 0xda, 0x5b, 0x84, 0x40, 0xeb, 0x3d, 0x0d, 0xa5, 0x0c, 0xd9, 0xd4, 0x15,
-	0x48, 0xa5, 0x0a, 0xa0, 0x00, 0xfa, 0x2a, 0x03, 0xdb, 0xec, 0xfc, 0x02, 0x2d, 0xf7, 0x5a, 0x9f,
-	0x0b, 0x51, 0xa8, 0x8e, 0x51, 0xe6, 0x6e, 0x10, 0x0c, 0x78, 0xa0, 0x8e, 0xd4, 0x52, 0x33, 0xa6,
-	0xb0, 0x5a, 0x36, 0x94, 0x3c, 0x8e, 0x03, 0x2a, 0x33, 0xd9, 0x54, 0xf1, 0xbb, 0x41, 0x70, 0x8e,
-	0x54, 0x30, 0x14, 0x5a, 0x57, 0x51, 0x05, 0x6a, 0x1a, 0x54, 0x44, 0xdb, 0xea, 0xbc, 0x82, 0x5a,
-	0xf1, 0x23, 0x42, 0xea, 0xb0, 0xfd, 0x8e, 0x4b, 0x14, 0xd9, 0x99, 0x72, 0x37, 0xdb, 0x20, 0xfb,
-	0xb0, 0xdb, 0x67, 0x3e, 0x9f, 0x85, 0x6c, 0x9a, 0xd9, 0x4d, 0xa5, 0x3a, 0xc3, 0x19, 0x97, 0x0b,
-	0x55, 0xa5, 0xf3, 0x02, 0x1a, 0xee, 0x35, 0xfa, 0x1f, 0x86, 0x3c, 0x0a, 0xfd, 0xb9, 0x22, 0x7e,
-	0xe4, 0x76, 0x07, 0x19, 0x95, 0xdd, 0xe1, 0xd0, 0xbb, 0xf8, 0xa9, 0xff, 0xb6, 0x7b, 0xd9, 0xb3,
-	0x0d, 0x02, 0x50, 0x1d, 0x8f, 0x7a, 0x6f, 0x7a, 0x3f, 0xdb, 0x66, 0x67, 0x08, 0xad, 0x8b, 0x18,
-	0x05, 0x95, 0x5c, 0xb3, 0x9a, 0x26, 0x2a, 0xf5, 0x68, 0xec, 0xba, 0xbd, 0xd1, 0x28, 0xab, 0xe3,
-	0xb2, 0xff, 0xb6, 0x77, 0x31, 0xbe, 0xcc, 0xfc, 0xdc, 0xee, 0xc0, 0xed, 0x9d, 0xdb, 0xa6, 0xa6,
-	0xa9, 0x37, 0x3c, 0xef, 0xba, 0x3d, 0xbb, 0xa2, 0x85, 0xf1, 0x60, 0xd0, 0x1f, 0xfc, 0xa8, 0x4f,
-	0xb4, 0x93, 0x8f, 0x97, 0x3a, 0xff, 0xea, 0x58, 0xd8, 0x5b, 0xe4, 0x3e, 0x90, 0x8c, 0xeb, 0x72,
-	0x13, 0x66, 0x87, 0x74, 0xd3, 0x44, 0xf2, 0xd9, 0x48, 0x4d, 0x74, 0x57, 0xda, 0x41, 0xe7, 0x39,
-	0xd4, 0x8a, 0x41, 0x53, 0x29, 0x32, 0xb7, 0x20, 0xab, 0xea, 0x3d, 0x17, 0x1f, 0xd4, 0x25, 0xea,
-	0x1b, 0x77, 0xf9, 0x2c, 0x8e, 0x50, 0xd9, 0xcc, 0x53, 0xfb, 0xcb, 0x9f, 0x07, 0xc6, 0xe7, 0xdb,
-	0x03, 0xe3, 0xcb, 0xed, 0x81, 0xf1, 0xc7, 0xed, 0x81, 0x31, 0xa9, 0xea, 0xbf, 0xd1, 0xe7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4e, 0x64, 0xd6, 0xd4, 0x0a, 0x00, 0x00,
+	// 1572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x5f, 0x6f, 0xe3, 0xc6,
+	0x11, 0x37, 0x25, 0x5a, 0x96, 0xc6, 0xb2, 0x4c, 0x6f, 0x2f, 0x57, 0xe1, 0x10, 0x38, 0x06, 0x1b,
+	0x1c, 0x0c, 0xa1, 0xf5, 0x05, 0x97, 0x43, 0x80, 0x16, 0xfd, 0x03, 0x99, 0x16, 0x7a, 0xba, 0xf3,
+	0xd9, 0x0a, 0x65, 0x25, 0xed, 0x5b, 0x57, 0xe4, 0x58, 0x5e, 0x98, 0xda, 0x65, 0x97, 0x4b, 0xdf,
+	0x29, 0x4f, 0xfd, 0x54, 0x7d, 0xeb, 0x7b, 0x1e, 0xf3, 0x09, 0x82, 0xd6, 0x5f, 0xa2, 0xaf, 0xc5,
+	0xee, 0x92, 0x12, 0x65, 0x5d, 0xe3, 0xbc, 0xed, 0xfc, 0x66, 0x76, 0x76, 0xfe, 0x0f, 0x09, 0xed,
+	0x39, 0x2a, 0x9a, 0x4e, 0x4f, 0x52, 0x29, 0x94, 0x20, 0x0d, 0x4b, 0x3d, 0xfb, 0xcd, 0x8c, 0xa9,
+	0x9b, 0x7c, 0x7a, 0x12, 0x89, 0xf9, 0x8b, 0x99, 0x98, 0x89, 0x17, 0x86, 0x3d, 0xcd, 0xaf, 0x0d,
+	0x65, 0x08, 0x73, 0xb2, 0xd7, 0xfc, 0x00, 0xf6, 0x42, 0xcc, 0x44, 0x2e, 0x23, 0x1c, 0xa4, 0x22,
+	0xba, 0x21, 0x5d, 0xd8, 0x89, 0x04, 0xbf, 0xfe, 0x06, 0x65, 0xd7, 0x39, 0x72, 0x8e, 0xdd, 0xb0,
+	0x24, 0x35, 0xe7, 0x0e, 0x65, 0xc6, 0x04, 0xef, 0xd6, 0x2c, 0xa7, 0x20, 0xfd, 0x7f, 0x38, 0xe0,
+	0x8e, 0x10, 0x25, 0x79, 0x0a, 0x35, 0x16, 0xdb, 0x7b, 0xa7, 0x8d, 0xfb, 0x1f, 0x3f, 0xab, 0x0d,
+	0xcf, 0xc2, 0x1a, 0x8b, 0xc9, 0x11, 0xec, 0x46, 0x82, 0x2b, 0xca, 0x38, 0xca, 0xe1, 0x59, 0x71,
+	0xbd, 0x0a, 0x91, 0xcf, 0xc1, 0x95, 0x22, 0xc1, 0x6e, 0xfd, 0xc8, 0x39, 0xee, 0xbc, 0xf4, 0x4e,
+	0x0a, 0xdf, 0xb4, 0xd6, 0x50, 0x24, 0x18, 0x1a, 0xae, 0x36, 0xe1, 0x3d, 0x53, 0x1c, 0xb3, 0xac,
+	0xeb, 0x1e, 0x39, 0xc7, 0xcd, 0xb0, 0x24, 0xfd, 0x09, 0xb4, 0xb4, 0xec, 0x58, 0x51, 0x95, 0x91,
+	0xe7, 0xe0, 0xa6, 0x58, 0x38, 0xb0, 0xfb, 0xb2, 0x5d, 0x55, 0x76, 0xea, 0x7e, 0xff, 0xe3, 0x67,
+	0x5b, 0xa1, 0xe1, 0x6b, 0xb3, 0x62, 0xf1, 0x9e, 0x8f, 0x31, 0x12, 0x3c, 0xce, 0x4a, 0xb3, 0x2a,
+	0x90, 0x7f, 0x02, 0xee, 0x88, 0x32, 0x49, 0x3c, 0xa8, 0xdf, 0xe2, 0xc2, 0x28, 0x6c, 0x85, 0xfa,
+	0x48, 0x9e, 0xc0, 0xf6, 0x1d, 0x4d, 0x72, 0x34, 0xb7, 0x5a, 0xa1, 0x25, 0xfc, 0x7f, 0xd5, 0x57,
+	0xf1, 0xb4, 0xb6, 0x1c, 0x02, 0xc8, 0x02, 0x18, 0x9e, 0x15, 0x21, 0xad, 0x20, 0xc4, 0x87, 0xf6,
+	0x7b, 0xc9, 0x94, 0x42, 0x7e, 0xba, 0x50, 0x58, 0x1a, 0xb1, 0x86, 0x69, 0x3b, 0x0b, 0xfa, 0x2d,
+	0x2e, 0x32, 0x13, 0x23, 0x37, 0xac, 0x42, 0xe4, 0x53, 0x68, 0x49, 0xa4, 0xb1, 0x55, 0xe1, 0x1a,
+	0xfe, 0x0a, 0x20, 0xcf, 0xa0, 0xa9, 0x09, 0x73, 0x79, 0xdb, 0x30, 0x97, 0x34, 0x39, 0x86, 0x7d,
+	0x9a, 0xa6, 0x52, 0x7c, 0x60, 0x73, 0xaa, 0x70, 0xcc, 0xbe, 0xc3, 0x6e, 0xc3, 0x88, 0x3c, 0x84,
+	0x1f, 0x48, 0x1a, 0x65, 0x3b, 0x1b, 0x92, 0x46, 0xe7, 0x17, 0xd0, 0x64, 0x5c, 0xa1, 0xbc, 0xa3,
+	0x49, 0xb7, 0x69, 0x72, 0xf0, 0xa4, 0xcc, 0xc1, 0x15, 0x9b, 0xe3, 0xb0, 0xe0, 0x85, 0x4b, 0xa9,
+	0x4a, 0x14, 0xbe, 0xce, 0x51, 0x2e, 0xba, 0xad, 0xb5, 0x28, 0x18, 0xac, 0xf4, 0xd1, 0x0a, 0xc0,
+	0xca, 0x47, 0xcb, 0x2d, 0x34, 0x60, 0x30, 0x9a, 0xe8, 0x37, 0xba, 0xbb, 0x2b, 0x0d, 0x25, 0xa6,
+	0xe3, 0xa8, 0x2f, 0x94, 0x22, 0x6d, 0x1b, 0xc7, 0x0a, 0xe4, 0xff, 0x73, 0x07, 0x3a, 0x41, 0x59,
+	0x96, 0x36, 0x81, 0x0f, 0x6a, 0xd7, 0xd9, 0xac, 0xdd, 0x4f, 0xa1, 0x95, 0x29, 0x2a, 0x95, 0x51,
+	0x6a, 0xf3, 0xb7, 0x02, 0xd6, 0x82, 0x51, 0xff, 0x59, 0xc1, 0x78, 0x06, 0xcd, 0x88, 0xa6, 0x34,
+	0x62, 0x6a, 0x51, 0xe4, 0x72, 0x49, 0xeb, 0xb7, 0xe8, 0x1d, 0x65, 0x09, 0x9d, 0x26, 0x58, 0xe4,
+	0x72, 0x05, 0xe8, 0x9b, 0x79, 0x86, 0x71, 0x25, 0x8b, 0x4b, 0x9a, 0x3c, 0x85, 0x06, 0xcb, 0x4e,
+	0xf3, 0x6c, 0x61, 0xb2, 0xd6, 0x0c, 0x0b, 0x8a, 0x7c, 0x0e, 0x7b, 0x65, 0x39, 0x06, 0x22, 0xe7,
+	0xca, 0x64, 0xcc, 0x0d, 0xd7, 0x41, 0xd2, 0x03, 0x2f, 0x43, 0x1e, 0x33, 0x3e, 0x1b, 0x73, 0x9a,
+	0x5a, 0x41, 0x9b, 0xa4, 0x0d, 0x9c, 0x9c, 0x00, 0x91, 0x18, 0x21, 0xbb, 0x5b, 0x93, 0xb6, 0x19,
+	0xfb, 0x08, 0x87, 0xfc, 0x1a, 0x0e, 0x68, 0x9a, 0x26, 0x8b, 0x35, 0x71, 0x9b, 0xbf, 0x4d, 0xc6,
+	0x46, 0xc3, 0xb4, 0x3f, 0xd2, 0x30, 0x6b, 0xed, 0xb0, 0xf7, 0xb0, 0x1d, 0x1e, 0xb4, 0x53, 0x67,
+	0xb3, 0x9d, 0xaa, 0x0d, 0xb3, 0xff, 0xa0, 0x61, 0xbe, 0x82, 0x56, 0x94, 0xe6, 0x93, 0x8c, 0xce,
+	0x30, 0xeb, 0x7a, 0x47, 0xf5, 0xe3, 0xdd, 0x97, 0xa4, 0x4c, 0x68, 0x88, 0x91, 0x90, 0xb1, 0x9e,
+	0x18, 0xc5, 0x9c, 0x59, 0x89, 0x92, 0xdf, 0xd9, 0xe2, 0x1b, 0x5e, 0x86, 0x54, 0x5b, 0x75, 0xf0,
+	0xc8, 0xcd, 0xaa, 0x30, 0xf9, 0x7d, 0x51, 0xdc, 0xe5, 0x65, 0xf2, 0xc8, 0xe5, 0x35, 0x69, 0xfd,
+	0xb2, 0x48, 0xcf, 0xa9, 0x42, 0x1e, 0x31, 0xcc, 0xba, 0xbf, 0x78, 0xec, 0xe5, 0x8a, 0x30, 0x79,
+	0x05, 0x9f, 0x30, 0x1e, 0x09, 0x9e, 0xb1, 0x4c, 0x21, 0x57, 0xe5, 0x6c, 0xcb, 0xba, 0x4f, 0x8e,
+	0xea, 0xc7, 0x6e, 0xf8, 0x71, 0xa6, 0xe9, 0x88, 0x44, 0xbc, 0x1f, 0x47, 0x42, 0x62, 0xf7, 0x93,
+	0xa2, 0x23, 0x4a, 0x40, 0x57, 0x5c, 0x86, 0x5c, 0xe9, 0x94, 0xda, 0x0c, 0x3d, 0xb5, 0x15, 0xb7,
+	0x06, 0xea, 0xaa, 0xb0, 0xb5, 0x82, 0xf1, 0x4a, 0xf2, 0x97, 0xb6, 0x2a, 0x36, 0x18, 0xfe, 0x2b,
+	0x80, 0x95, 0x23, 0x8f, 0x8d, 0x6b, 0xb7, 0x1c, 0xd7, 0xaf, 0xa1, 0xf1, 0x0e, 0xe7, 0xd3, 0x9f,
+	0xd8, 0x5c, 0x04, 0x5c, 0x4e, 0xe7, 0xe5, 0x94, 0x37, 0x67, 0x8d, 0xd1, 0x38, 0x96, 0xa6, 0x9b,
+	0x5b, 0xa1, 0x39, 0xfb, 0x03, 0xd8, 0x09, 0x92, 0x3c, 0x53, 0x3f, 0xa1, 0xca, 0x87, 0xf6, 0x9c,
+	0x7e, 0xd0, 0x4b, 0xc8, 0x56, 0xb8, 0x56, 0xb9, 0x17, 0xae, 0x61, 0xfe, 0x57, 0xd0, 0xae, 0x0e,
+	0x05, 0x6d, 0xb6, 0x99, 0x24, 0xc5, 0xd8, 0xb1, 0x84, 0x76, 0x0f, 0x79, 0x5c, 0xb8, 0xa2, 0x8f,
+	0x7e, 0x02, 0xf5, 0x37, 0x62, 0x4a, 0x7e, 0x05, 0xae, 0x5a, 0xa4, 0x68, 0xa4, 0x3b, 0x2f, 0xf7,
+	0xcb, 0x14, 0xbf, 0x11, 0xd3, 0xab, 0x45, 0x8a, 0xa1, 0x61, 0x16, 0x1b, 0x5e, 0x27, 0xcc, 0x68,
+	0x68, 0x87, 0x25, 0x49, 0x9e, 0x9b, 0xd7, 0xd4, 0xc6, 0x16, 0x7e, 0x23, 0xa6, 0x7a, 0x16, 0x62,
+	0x68, 0xd9, 0x3e, 0xc2, 0x41, 0x88, 0x73, 0x71, 0x87, 0x65, 0xc6, 0xf5, 0xdb, 0xcf, 0x37, 0x17,
+	0xdd, 0xd2, 0xfd, 0x0a, 0x87, 0x1c, 0xc3, 0xb6, 0x5e, 0xbe, 0x7a, 0xd3, 0xd5, 0xff, 0xcf, 0x76,
+	0xb6, 0x02, 0x7e, 0x00, 0xfb, 0xe5, 0x03, 0x23, 0x21, 0x12, 0xfd, 0xc8, 0x17, 0xb0, 0x9d, 0x0a,
+	0x91, 0x64, 0x5d, 0xe7, 0xa8, 0x5e, 0x9d, 0xa4, 0x55, 0xb9, 0xa5, 0x12, 0x2d, 0xe8, 0xff, 0x16,
+	0x5a, 0xa7, 0x34, 0xba, 0xcd, 0x53, 0x7d, 0x9d, 0x80, 0x9b, 0x52, 0x75, 0x53, 0x14, 0x86, 0x39,
+	0xeb, 0x70, 0x4c, 0xa9, 0x94, 0x0c, 0x65, 0xf9, 0x59, 0x53, 0x90, 0xfe, 0x9f, 0xe0, 0x60, 0xc2,
+	0x33, 0x7a, 0x8d, 0xba, 0xb2, 0xee, 0x50, 0x2e, 0xb4, 0x8a, 0x1e, 0x78, 0xd7, 0x94, 0x25, 0x18,
+	0x2f, 0xd7, 0x84, 0x35, 0xc6, 0x0d, 0x37, 0x70, 0x7f, 0x0a, 0xed, 0xaa, 0x61, 0x3a, 0x9b, 0x33,
+	0x29, 0xf2, 0xb4, 0xcc, 0xa6, 0x21, 0xd6, 0xc6, 0x7d, 0xed, 0xc1, 0xb8, 0xd7, 0x1b, 0x8b, 0xf2,
+	0x19, 0x8e, 0x24, 0x5e, 0xb3, 0x0f, 0x26, 0x2f, 0xed, 0xb0, 0x0a, 0xf9, 0xff, 0x75, 0x60, 0xff,
+	0x32, 0x45, 0x49, 0x95, 0x90, 0xaf, 0x59, 0xa6, 0x84, 0x5c, 0x3c, 0xfa, 0xcd, 0x41, 0xc0, 0x8d,
+	0x31, 0x8b, 0xca, 0xa2, 0xd6, 0x67, 0x8d, 0xdd, 0x32, 0x1e, 0x97, 0x45, 0xad, 0xcf, 0xb6, 0xfa,
+	0x30, 0xd5, 0x5f, 0x14, 0x75, 0xfd, 0x8d, 0x63, 0x08, 0xbd, 0x48, 0x74, 0x19, 0xe4, 0xf6, 0x5b,
+	0xa2, 0x15, 0x16, 0x94, 0xc6, 0x25, 0xd2, 0x4c, 0x70, 0xb3, 0x7a, 0x5a, 0x61, 0x41, 0x69, 0x6b,
+	0xa2, 0x55, 0xac, 0x76, 0x4c, 0xac, 0x2a, 0x88, 0xf1, 0x5f, 0x22, 0x55, 0xd8, 0xb7, 0xbb, 0xa7,
+	0x1e, 0x2e, 0x69, 0xcd, 0xbb, 0x66, 0x9c, 0x65, 0x37, 0x7d, 0xbb, 0x6e, 0xea, 0xe1, 0x92, 0xee,
+	0x1d, 0x41, 0xa3, 0x1f, 0x29, 0x26, 0x38, 0x69, 0x82, 0x7b, 0x21, 0x38, 0x7a, 0x5b, 0xa4, 0x0d,
+	0xcd, 0x71, 0x44, 0x13, 0xbc, 0xcc, 0x95, 0xe7, 0xf4, 0x5e, 0xac, 0xe2, 0xff, 0x56, 0xfb, 0xd3,
+	0x01, 0x38, 0x47, 0x1a, 0xa3, 0xd4, 0x94, 0xb7, 0x45, 0xf6, 0x61, 0x37, 0xc4, 0x34, 0x61, 0x11,
+	0x35, 0x80, 0xd3, 0x7b, 0xf5, 0x60, 0xfb, 0x23, 0x69, 0x40, 0x6d, 0x32, 0xf2, 0xb6, 0xc8, 0x2e,
+	0xec, 0x5c, 0x5e, 0x5f, 0x27, 0x8c, 0xa3, 0xe7, 0x90, 0x3d, 0x68, 0x5d, 0x89, 0xf9, 0x34, 0x53,
+	0xfa, 0xd1, 0x5a, 0xef, 0x0f, 0xeb, 0xdf, 0x7c, 0xa8, 0x85, 0xc3, 0x9c, 0x73, 0xc6, 0x67, 0xde,
+	0x16, 0x21, 0xd0, 0xf9, 0x96, 0x32, 0xa5, 0x18, 0x9f, 0x05, 0xc6, 0x2d, 0xcf, 0x31, 0x02, 0xa6,
+	0x81, 0x62, 0xaf, 0xd6, 0xfb, 0x1b, 0x74, 0x82, 0x1b, 0x93, 0x51, 0x44, 0xa9, 0xfb, 0x54, 0xb3,
+	0xfb, 0x71, 0x7c, 0x21, 0x62, 0xed, 0x52, 0x07, 0xc0, 0xca, 0x1a, 0xda, 0xd1, 0xf4, 0x24, 0x8d,
+	0xa9, 0xb2, 0x74, 0x4d, 0xeb, 0xef, 0xc7, 0xf1, 0x39, 0x52, 0xc9, 0x51, 0x1a, 0xac, 0xae, 0x0d,
+	0x34, 0x61, 0xd0, 0x1a, 0x3d, 0xb7, 0xf7, 0x1a, 0x9a, 0xe5, 0x87, 0x34, 0x69, 0xc1, 0xf6, 0x37,
+	0x42, 0xa1, 0xb4, 0x3e, 0x15, 0xd7, 0x3c, 0x87, 0x1c, 0xc0, 0xde, 0x90, 0x47, 0x62, 0xce, 0xf8,
+	0xcc, 0xf2, 0x6b, 0x1a, 0x3a, 0xc3, 0xb9, 0x50, 0x4b, 0xa8, 0xde, 0xfb, 0x23, 0xec, 0x06, 0x37,
+	0x18, 0xdd, 0x8e, 0x44, 0xc2, 0xa2, 0x85, 0x0e, 0xfc, 0x38, 0xe8, 0x5f, 0xd8, 0x50, 0xf6, 0x47,
+	0xa3, 0xf0, 0xf2, 0x2f, 0xc3, 0x77, 0xfd, 0xab, 0x81, 0xe7, 0x10, 0x80, 0xc6, 0x64, 0x3c, 0x78,
+	0x3b, 0xf8, 0xab, 0x57, 0xd3, 0x62, 0xe7, 0x97, 0xfd, 0x33, 0xaf, 0xde, 0x1b, 0x41, 0xa7, 0x2c,
+	0xd6, 0xb1, 0xad, 0x9a, 0x5d, 0xd8, 0x19, 0x4f, 0x82, 0x60, 0x30, 0x1e, 0x5b, 0x8b, 0xae, 0x86,
+	0xef, 0x06, 0x97, 0x93, 0x2b, 0xab, 0x21, 0xe8, 0x5f, 0x04, 0x83, 0x73, 0xaf, 0x66, 0x02, 0x36,
+	0x18, 0x9d, 0xf7, 0x83, 0x81, 0x57, 0x37, 0xc4, 0xe4, 0xe2, 0x62, 0x78, 0xf1, 0x67, 0xcf, 0xed,
+	0x7d, 0x07, 0x3b, 0xc5, 0x78, 0xd3, 0x91, 0x58, 0x1f, 0x4b, 0xde, 0x16, 0x79, 0x0a, 0xc4, 0x46,
+	0xbd, 0xda, 0x88, 0x56, 0xb9, 0x1d, 0x0b, 0x36, 0x82, 0xeb, 0x7d, 0xee, 0xd5, 0x89, 0xa7, 0x4b,
+	0xe7, 0xef, 0x39, 0x66, 0xea, 0xeb, 0x5c, 0x28, 0xea, 0xb9, 0x3a, 0x1a, 0x41, 0x9e, 0x29, 0x31,
+	0x1f, 0xeb, 0x19, 0xdc, 0x57, 0x5e, 0xdc, 0xfb, 0x12, 0x9a, 0xe5, 0x68, 0xd4, 0x46, 0xd9, 0x87,
+	0x62, 0xeb, 0xc7, 0xb7, 0x42, 0xde, 0xea, 0x02, 0x30, 0xd5, 0x12, 0x88, 0x79, 0x9a, 0xa0, 0xe6,
+	0xd5, 0x4e, 0xbd, 0x1f, 0xfe, 0x73, 0xe8, 0x7c, 0x7f, 0x7f, 0xe8, 0xfc, 0x70, 0x7f, 0xe8, 0xfc,
+	0xfb, 0xfe, 0xd0, 0x99, 0x36, 0xcc, 0xaf, 0xd8, 0x97, 0xff, 0x1b, 0x00, 0x58, 0x96, 0x16, 0x31,
+	0xd1, 0x0d, 0x00, 0x00,
 }
 
 func (m *ResourceEpoch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1480,30 +1823,36 @@ func (m *ResourceEpoch) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ResourceEpoch) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConfVer != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ConfVer))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Version != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ConfVer != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ConfVer))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Peer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1511,35 +1860,51 @@ func (m *Peer) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Peer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ContainerID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ContainerID))
+	if m.Witness {
+		i--
+		if m.Witness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Role != 0 {
-		dAtA[i] = 0x18
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ContainerID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *PeerStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1547,33 +1912,41 @@ func (m *PeerStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *PeerStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Peer.Size()))
-	n1, err := m.Peer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n1
 	if m.DownSeconds != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.DownSeconds))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	{
+		size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetapb(dAtA, i, uint64(size))
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *Pair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1581,32 +1954,40 @@ func (m *Pair) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Pair) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ResourceStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1614,65 +1995,93 @@ func (m *ResourceStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ResourceStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ResourceID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WrittenBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenBytes))
+	if m.ReadCPUTime != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadCPUTime))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.WrittenKeys != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenKeys))
+	if m.WriteCPUTime != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WriteCPUTime))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.ReadBytes != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadBytes))
+	if m.ReadQuery != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadQuery))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.ReadKeys != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadKeys))
+	if m.WrittenQuery != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenQuery))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.ApproximateSize != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ApproximateSize))
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetapb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
 	if m.ApproximateKeys != 0 {
-		dAtA[i] = 0x38
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.ApproximateKeys))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Interval != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Interval.Size()))
-		n2, err := m.Interval.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if m.ApproximateSize != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ApproximateSize))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ReadKeys != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadKeys))
+		i--
+		dAtA[i] = 0x28
 	}
-	return i, nil
+	if m.ReadBytes != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WrittenKeys != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenKeys))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WrittenBytes != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ResourceID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ResourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *ContainerStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1680,161 +2089,218 @@ func (m *ContainerStats) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ContainerStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContainerID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ContainerID))
-	}
-	if m.StartTime != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.StartTime))
-	}
-	if m.Interval != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Interval.Size()))
-		n3, err := m.Interval.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReceivedSnapBytes != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReceivedSnapBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SentSnapBytes != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.SentSnapBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SlowScore != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.SlowScore))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.InconsistentResources) > 0 {
+		dAtA4 := make([]byte, len(m.InconsistentResources)*10)
+		var j3 int
+		for _, num := range m.InconsistentResources {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i += n3
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintMetapb(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.Capacity != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Capacity))
-	}
-	if m.Available != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Available))
+	if len(m.OpLatencies) > 0 {
+		for iNdEx := len(m.OpLatencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpLatencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	if m.UsedSize != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.UsedSize))
+	if len(m.WriteIORates) > 0 {
+		for iNdEx := len(m.WriteIORates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WriteIORates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.IsBusy {
-		dAtA[i] = 0x38
-		i++
-		if m.IsBusy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.ReadIORates) > 0 {
+		for iNdEx := len(m.ReadIORates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReadIORates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
-		i++
 	}
-	if m.ResourceCount != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ResourceCount))
+	if len(m.CpuUsages) > 0 {
+		for iNdEx := len(m.CpuUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CpuUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	if m.SendingSnapCount != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.SendingSnapCount))
+	if m.ReadKeys != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadKeys))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.ReceivingSnapCount != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ReceivingSnapCount))
+	if m.WrittenKeys != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenKeys))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.ApplyingSnapCount != 0 {
-		dAtA[i] = 0x58
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ApplyingSnapCount))
+	if m.ReadBytes != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x68
 	}
 	if m.WrittenBytes != 0 {
-		dAtA[i] = 0x60
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenBytes))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.ReadBytes != 0 {
-		dAtA[i] = 0x68
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadBytes))
+	if m.ApplyingSnapCount != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ApplyingSnapCount))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.WrittenKeys != 0 {
-		dAtA[i] = 0x70
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.WrittenKeys))
+	if m.ReceivingSnapCount != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ReceivingSnapCount))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.ReadKeys != 0 {
-		dAtA[i] = 0x78
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ReadKeys))
+	if m.SendingSnapCount != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.SendingSnapCount))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.CpuUsages) > 0 {
-		for _, msg := range m.CpuUsages {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.ResourceCount != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ResourceCount))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.ReadIORates) > 0 {
-		for _, msg := range m.ReadIORates {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.IsBusy {
+		i--
+		if m.IsBusy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.WriteIORates) > 0 {
-		for _, msg := range m.WriteIORates {
-			dAtA[i] = 0x92
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.UsedSize != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.UsedSize))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.OpLatencies) > 0 {
-		for _, msg := range m.OpLatencies {
-			dAtA[i] = 0x9a
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.Available != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintMetapb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.StartTime != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *RecordPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1842,31 +2308,38 @@ func (m *RecordPair) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Value != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1874,37 +2347,45 @@ func (m *Member) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Addr) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
 		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
-		i += copy(dAtA[i:], m.Addr)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1912,30 +2393,36 @@ func (m *Cluster) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.MaxPeerCount != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.MaxPeerCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1943,30 +2430,36 @@ func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Start != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Start))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.End != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Start != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Job) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1974,36 +2467,43 @@ func (m *Job) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Job) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
-	}
-	if len(m.Content) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Content)))
-		i += copy(dAtA[i:], m.Content)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.State != 0 {
-		dAtA[i] = 0x18
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	if m.Type != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *RemoveResourceJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2011,70 +2511,170 @@ func (m *RemoveResourceJob) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RemoveResourceJob) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveResourceJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Peers) > 0 {
-		for _, msg := range m.Peers {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetapb(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourcePoolJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcePoolJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePoolJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetapb(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Barrier != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Barrier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourcePoolJob) Marshal() (dAtA []byte, err error) {
+func (m *UnsafeRecoveryJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ResourcePoolJob) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *UnsafeRecoveryJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsafeRecoveryJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, msg := range m.Pools {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FailedContainers) > 0 {
+		dAtA7 := make([]byte, len(m.FailedContainers)*10)
+		var j6 int
+		for _, num := range m.FailedContainers {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintMetapb(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ResourcePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2082,40 +2682,146 @@ func (m *ResourcePool) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ResourcePool) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Group != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Group))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RangePrefix) > 0 {
+		i -= len(m.RangePrefix)
+		copy(dAtA[i:], m.RangePrefix)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.RangePrefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Capacity != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.RangePrefix) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.RangePrefix)))
-		i += copy(dAtA[i:], m.RangePrefix)
+	if m.Group != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinishAt != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.FinishAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Containers) > 0 {
+		dAtA9 := make([]byte, len(m.Containers)*10)
+		var j8 int
+		for _, num := range m.Containers {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintMetapb(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Steps[iNdEx])
+			copy(dAtA[i:], m.Steps[iNdEx])
+			i = encodeVarintMetapb(dAtA, i, uint64(len(m.Steps[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Desc) > 0 {
+		i -= len(m.Desc)
+		copy(dAtA[i:], m.Desc)
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Desc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResourceID != 0 {
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ResourceID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintMetapb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMetapb(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *ResourceEpoch) Size() (n int) {
 	if m == nil {
@@ -2150,6 +2856,9 @@ func (m *Peer) Size() (n int) {
 	if m.Role != 0 {
 		n += 1 + sovMetapb(uint64(m.Role))
 	}
+	if m.Witness {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2224,6 +2933,18 @@ func (m *ResourceStats) Size() (n int) {
 		l = m.Interval.Size()
 		n += 1 + l + sovMetapb(uint64(l))
 	}
+	if m.WrittenQuery != 0 {
+		n += 1 + sovMetapb(uint64(m.WrittenQuery))
+	}
+	if m.ReadQuery != 0 {
+		n += 1 + sovMetapb(uint64(m.ReadQuery))
+	}
+	if m.WriteCPUTime != 0 {
+		n += 1 + sovMetapb(uint64(m.WriteCPUTime))
+	}
+	if m.ReadCPUTime != 0 {
+		n += 1 + sovMetapb(uint64(m.ReadCPUTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2306,6 +3027,22 @@ func (m *ContainerStats) Size() (n int) {
 			n += 2 + l + sovMetapb(uint64(l))
 		}
 	}
+	if len(m.InconsistentResources) > 0 {
+		l = 0
+		for _, e := range m.InconsistentResources {
+			l += sovMetapb(uint64(e))
+		}
+		n += 2 + sovMetapb(uint64(l)) + l
+	}
+	if m.SlowScore != 0 {
+		n += 2 + sovMetapb(uint64(m.SlowScore))
+	}
+	if m.SentSnapBytes != 0 {
+		n += 2 + sovMetapb(uint64(m.SentSnapBytes))
+	}
+	if m.ReceivedSnapBytes != 0 {
+		n += 2 + sovMetapb(uint64(m.ReceivedSnapBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2451,6 +3188,44 @@ func (m *ResourcePoolJob) Size() (n int) {
 	return n
 }
 
+func (m *BackupJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Barrier != 0 {
+		n += 1 + sovMetapb(uint64(m.Barrier))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnsafeRecoveryJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedContainers) > 0 {
+		l = 0
+		for _, e := range m.FailedContainers {
+			l += sovMetapb(uint64(e))
+		}
+		n += 1 + sovMetapb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ResourcePool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2473,16 +3248,59 @@ func (m *ResourcePool) Size() (n int) {
 	return n
 }
 
-func sovMetapb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *OperatorHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovMetapb(uint64(m.ResourceID))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, s := range m.Steps {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Containers) > 0 {
+		l = 0
+		for _, e := range m.Containers {
+			l += sovMetapb(uint64(e))
 		}
+		n += 1 + sovMetapb(uint64(l)) + l
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovMetapb(uint64(m.CreateAt))
+	}
+	if m.FinishAt != 0 {
+		n += 1 + sovMetapb(uint64(m.FinishAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
+
+func sovMetapb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozMetapb(x uint64) (n int) {
 	return sovMetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -2645,11 +3463,137 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= PeerRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Witness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Witness = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
 			}
-			m.Role = 0
+			m.DownSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2659,7 +3603,7 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= PeerRole(b&0x7F) << shift
+				m.DownSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2689,7 +3633,7 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeerStats) Unmarshal(dAtA []byte) error {
+func (m *Pair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,17 +3656,17 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2732,30 +3676,29 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.DownSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2765,11 +3708,24 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownSeconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -2795,7 +3751,7 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pair) Unmarshal(dAtA []byte) error {
+func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,17 +3774,150 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
+			}
+			m.ResourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
+			}
+			m.WrittenBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrittenBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenKeys", wireType)
+			}
+			m.WrittenKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrittenKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+			}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
+			}
+			m.ReadKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+			}
+			m.ApproximateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
+			}
+			m.ApproximateKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2838,29 +3927,90 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenQuery", wireType)
+			}
+			m.WrittenQuery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrittenQuery |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuery", wireType)
+			}
+			m.ReadQuery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadQuery |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCPUTime", wireType)
+			}
+			m.WriteCPUTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCPUTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCPUTime", wireType)
 			}
-			var stringLen uint64
+			m.ReadCPUTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2870,24 +4020,11 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadCPUTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -2913,7 +4050,7 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStats) Unmarshal(dAtA []byte) error {
+func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2936,17 +4073,17 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			m.ResourceID = 0
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2956,16 +4093,16 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourceID |= uint64(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.WrittenBytes = 0
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2975,16 +4112,16 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WrittenBytes |= uint64(b&0x7F) << shift
+				m.StartTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrittenKeys", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.WrittenKeys = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -2994,16 +4131,33 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WrittenKeys |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			m.ReadBytes = 0
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3013,16 +4167,16 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadBytes |= uint64(b&0x7F) << shift
+				m.Capacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			m.ReadKeys = 0
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3032,16 +4186,16 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadKeys |= uint64(b&0x7F) << shift
+				m.Available |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
 			}
-			m.ApproximateSize = 0
+			m.UsedSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3051,16 +4205,16 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateSize |= uint64(b&0x7F) << shift
+				m.UsedSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
 			}
-			m.ApproximateKeys = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3070,16 +4224,17 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateKeys |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsBusy = bool(v != 0)
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceCount", wireType)
 			}
-			var msglen int
+			m.ResourceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3089,87 +4244,111 @@ func (m *ResourceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ResourceCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
+			m.SendingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendingSnapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.ReceivingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivingSnapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMetapb
+			m.ApplyingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyingSnapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+			m.WrittenBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrittenBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenKeys", wireType)
 			}
-			m.ContainerID = 0
+			m.WrittenKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3179,16 +4358,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContainerID |= uint64(b&0x7F) << shift
+				m.WrittenKeys |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
 			}
-			m.StartTime = 0
+			m.ReadKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3198,14 +4377,14 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= uint64(b&0x7F) << shift
+				m.ReadKeys |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3232,18 +4411,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
-			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CpuUsages = append(m.CpuUsages, RecordPair{})
+			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadIORates", wireType)
 			}
-			m.Capacity = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3253,35 +4430,31 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.Available = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Available |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UsedSize = 0
+			m.ReadIORates = append(m.ReadIORates, RecordPair{})
+			if err := m.ReadIORates[len(m.ReadIORates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteIORates", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3291,16 +4464,31 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsedSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WriteIORates = append(m.WriteIORates, RecordPair{})
+			if err := m.WriteIORates[len(m.WriteIORates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3310,55 +4498,107 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBusy = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.ResourceCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpLatencies = append(m.OpLatencies, RecordPair{})
+			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.InconsistentResources = append(m.InconsistentResources, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResourceCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
 				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
-			}
-			m.SendingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SendingSnapCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InconsistentResources) == 0 {
+					m.InconsistentResources = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InconsistentResources = append(m.InconsistentResources, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InconsistentResources", wireType)
 			}
-		case 10:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlowScore", wireType)
 			}
-			m.ReceivingSnapCount = 0
+			m.SlowScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3368,16 +4608,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReceivingSnapCount |= uint64(b&0x7F) << shift
+				m.SlowScore |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentSnapBytes", wireType)
 			}
-			m.ApplyingSnapCount = 0
+			m.SentSnapBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3387,16 +4627,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplyingSnapCount |= uint64(b&0x7F) << shift
+				m.SentSnapBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSnapBytes", wireType)
 			}
-			m.WrittenBytes = 0
+			m.ReceivedSnapBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3406,16 +4646,70 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WrittenBytes |= uint64(b&0x7F) << shift
+				m.ReceivedSnapBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ReadBytes = 0
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3425,16 +4719,29 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadBytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrittenKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.WrittenKeys = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3444,16 +4751,70 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WrittenKeys |= uint64(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.ReadKeys = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3463,16 +4824,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadKeys |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3482,31 +4843,29 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpuUsages = append(m.CpuUsages, RecordPair{})
-			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadIORates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3516,31 +4875,83 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReadIORates = append(m.ReadIORates, RecordPair{})
-			if err := m.ReadIORates[len(m.ReadIORates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteIORates", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			var msglen int
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3550,31 +4961,16 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WriteIORates = append(m.WriteIORates, RecordPair{})
-			if err := m.WriteIORates[len(m.WriteIORates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPeerCount", wireType)
 			}
-			var msglen int
+			m.MaxPeerCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3584,26 +4980,11 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPeerCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OpLatencies = append(m.OpLatencies, RecordPair{})
-			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3629,7 +5010,7 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordPair) Unmarshal(dAtA []byte) error {
+func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3652,17 +5033,17 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3672,29 +5053,16 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Start |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.Value = 0
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3704,7 +5072,7 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				m.End |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3734,7 +5102,7 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *Job) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3757,17 +5125,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ID = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3777,16 +5145,16 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Type |= JobType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3796,29 +5164,31 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3828,24 +5198,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3871,7 +5228,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *RemoveResourceJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3894,10 +5251,10 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveResourceJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveResourceJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3920,10 +5277,10 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPeerCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
 			}
-			m.MaxPeerCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -3933,11 +5290,26 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPeerCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, Peer{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -3963,7 +5335,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeInterval) Unmarshal(dAtA []byte) error {
+func (m *ResourcePoolJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3986,17 +5358,17 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourcePoolJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourcePoolJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			m.Start = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4006,30 +5378,26 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.End = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.End |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, ResourcePool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4055,7 +5423,7 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
+func (m *BackupJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4078,36 +5446,17 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= JobType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4117,31 +5466,29 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
-			if m.Content == nil {
-				m.Content = []byte{}
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Barrier", wireType)
 			}
-			m.State = 0
+			m.Barrier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4151,7 +5498,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				m.Barrier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4181,7 +5528,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResourceJob) Unmarshal(dAtA []byte) error {
+func (m *UnsafeRecoveryJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4204,65 +5551,88 @@ func (m *RemoveResourceJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResourceJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsafeRecoveryJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResourceJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsafeRecoveryJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.FailedContainers = append(m.FailedContainers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
 				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedContainers) == 0 {
+					m.FailedContainers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedContainers = append(m.FailedContainers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedContainers", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Peers = append(m.Peers, Peer{})
-			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4288,7 +5658,7 @@ func (m *RemoveResourceJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourcePoolJob) Unmarshal(dAtA []byte) error {
+func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4307,21 +5677,59 @@ func (m *ResourcePoolJob) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcePoolJob: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcePoolJob: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourcePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourcePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4331,24 +5739,24 @@ func (m *ResourcePoolJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, ResourcePool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangePrefix == nil {
+				m.RangePrefix = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4376,7 +5784,7 @@ func (m *ResourcePoolJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourcePool) Unmarshal(dAtA []byte) error {
+func (m *OperatorHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4399,17 +5807,17 @@ func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
 			}
-			m.Group = 0
+			m.ResourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4419,16 +5827,16 @@ func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= uint64(b&0x7F) << shift
+				m.ResourceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
-			m.Capacity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4438,16 +5846,29 @@ func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4457,26 +5878,234 @@ func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
-			if m.RangePrefix == nil {
-				m.RangePrefix = []byte{}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Containers = append(m.Containers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Containers) == 0 {
+					m.Containers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Containers = append(m.Containers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishAt", wireType)
+			}
+			m.FinishAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4505,6 +6134,7 @@ func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 func skipMetapb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -4536,10 +6166,8 @@ func skipMetapb(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -4560,55 +6188,30 @@ func skipMetapb(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthMetapb
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthMetapb
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowMetapb
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipMetapb(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthMetapb
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMetapb
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMetapb
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthMetapb = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowMetapb   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthMetapb        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMetapb          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMetapb = fmt.Errorf("proto: unexpected end of group")
 )
@@ -4,9 +4,11 @@
 package rpcpb
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -22,49 +24,67 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Type rpc type
 type Type int32
 
 const (
-	TypeRegisterContainer     Type = 0
-	TypeResourceHeartbeatReq  Type = 1
-	TypeResourceHeartbeatRsp  Type = 2
-	TypeContainerHeartbeatReq Type = 3
-	TypeContainerHeartbeatRsp Type = 4
-	TypePutContainerReq       Type = 5
-	TypePutContainerRsp       Type = 6
-	TypeGetContainerReq       Type = 7
-	TypeGetContainerRsp       Type = 8
-	TypeAllocIDReq            Type = 9
-	TypeAllocIDRsp            Type = 10
-	TypeAskSplitReq           Type = 11
-	TypeAskSplitRsp           Type = 12
-	TypeAskBatchSplitReq      Type = 13
-	TypeAskBatchSplitRsp      Type = 14
-	TypeReportSplitReq        Type = 15
-	TypeReportSplitRsp        Type = 16
-	TypeBatchReportSplitReq   Type = 17
-	TypeBatchReportSplitRsp   Type = 18
-	TypeCreateWatcherReq      Type = 19
-	TypeEventNotify           Type = 20
-	TypeCreateResourcesReq    Type = 21
-	TypeCreateResourcesRsp    Type = 22
-	TypeRemoveResourcesReq    Type = 23
-	TypeRemoveResourcesRsp    Type = 24
-	TypeCheckResourceStateReq Type = 25
-	TypeCheckResourceStateRsp Type = 26
-	TypePutPlacementRuleReq   Type = 27
-	TypePutPlacementRuleRsp   Type = 28
-	TypeGetAppliedRulesReq    Type = 29
-	TypeGetAppliedRulesRsp    Type = 30
-	TypeCreateJobReq          Type = 31
-	TypeCreateJobRsp          Type = 32
-	TypeRemoveJobReq          Type = 33
-	TypeRemoveJobRsp          Type = 34
-	TypeExecuteJobReq         Type = 35
-	TypeExecuteJobRsp         Type = 36
+	TypeRegisterContainer       Type = 0
+	TypeResourceHeartbeatReq    Type = 1
+	TypeResourceHeartbeatRsp    Type = 2
+	TypeContainerHeartbeatReq   Type = 3
+	TypeContainerHeartbeatRsp   Type = 4
+	TypePutContainerReq         Type = 5
+	TypePutContainerRsp         Type = 6
+	TypeGetContainerReq         Type = 7
+	TypeGetContainerRsp         Type = 8
+	TypeAllocIDReq              Type = 9
+	TypeAllocIDRsp              Type = 10
+	TypeAskSplitReq             Type = 11
+	TypeAskSplitRsp             Type = 12
+	TypeAskBatchSplitReq        Type = 13
+	TypeAskBatchSplitRsp        Type = 14
+	TypeReportSplitReq          Type = 15
+	TypeReportSplitRsp          Type = 16
+	TypeBatchReportSplitReq     Type = 17
+	TypeBatchReportSplitRsp     Type = 18
+	TypeCreateWatcherReq        Type = 19
+	TypeEventNotify             Type = 20
+	TypeCreateResourcesReq      Type = 21
+	TypeCreateResourcesRsp      Type = 22
+	TypeRemoveResourcesReq      Type = 23
+	TypeRemoveResourcesRsp      Type = 24
+	TypeCheckResourceStateReq   Type = 25
+	TypeCheckResourceStateRsp   Type = 26
+	TypePutPlacementRuleReq     Type = 27
+	TypePutPlacementRuleRsp     Type = 28
+	TypeGetAppliedRulesReq      Type = 29
+	TypeGetAppliedRulesRsp      Type = 30
+	TypeCreateJobReq            Type = 31
+	TypeCreateJobRsp            Type = 32
+	TypeRemoveJobReq            Type = 33
+	TypeRemoveJobRsp            Type = 34
+	TypeExecuteJobReq           Type = 35
+	TypeExecuteJobRsp           Type = 36
+	TypeGetContainersReq        Type = 37
+	TypeGetContainersRsp        Type = 38
+	TypeGetResourcesReq         Type = 39
+	TypeGetResourcesRsp         Type = 40
+	TypeGetHotResourcesReq      Type = 41
+	TypeGetHotResourcesRsp      Type = 42
+	TypeUpdateContainerStateReq Type = 43
+	TypeUpdateContainerStateRsp Type = 44
+	TypeAddSchedulerReq         Type = 45
+	TypeAddSchedulerRsp         Type = 46
+	TypeRemoveSchedulerReq      Type = 47
+	TypeRemoveSchedulerRsp      Type = 48
+	TypeGetSchedulersReq        Type = 49
+	TypeGetSchedulersRsp        Type = 50
+	TypeGetPlacementRulesReq    Type = 51
+	TypeGetPlacementRulesRsp    Type = 52
+	TypeGetTimestampReq         Type = 53
+	TypeGetTimestampRsp         Type = 54
 )
 
 var Type_name = map[int32]string{
@@ -105,46 +125,82 @@ var Type_name = map[int32]string{
 	34: "TypeRemoveJobRsp",
 	35: "TypeExecuteJobReq",
 	36: "TypeExecuteJobRsp",
+	37: "TypeGetContainersReq",
+	38: "TypeGetContainersRsp",
+	39: "TypeGetResourcesReq",
+	40: "TypeGetResourcesRsp",
+	41: "TypeGetHotResourcesReq",
+	42: "TypeGetHotResourcesRsp",
+	43: "TypeUpdateContainerStateReq",
+	44: "TypeUpdateContainerStateRsp",
+	45: "TypeAddSchedulerReq",
+	46: "TypeAddSchedulerRsp",
+	47: "TypeRemoveSchedulerReq",
+	48: "TypeRemoveSchedulerRsp",
+	49: "TypeGetSchedulersReq",
+	50: "TypeGetSchedulersRsp",
+	51: "TypeGetPlacementRulesReq",
+	52: "TypeGetPlacementRulesRsp",
+	53: "TypeGetTimestampReq",
+	54: "TypeGetTimestampRsp",
 }
 
 var Type_value = map[string]int32{
-	"TypeRegisterContainer":     0,
-	"TypeResourceHeartbeatReq":  1,
-	"TypeResourceHeartbeatRsp":  2,
-	"TypeContainerHeartbeatReq": 3,
-	"TypeContainerHeartbeatRsp": 4,
-	"TypePutContainerReq":       5,
-	"TypePutContainerRsp":       6,
-	"TypeGetContainerReq":       7,
-	"TypeGetContainerRsp":       8,
-	"TypeAllocIDReq":            9,
-	"TypeAllocIDRsp":            10,
-	"TypeAskSplitReq":           11,
-	"TypeAskSplitRsp":           12,
-	"TypeAskBatchSplitReq":      13,
-	"TypeAskBatchSplitRsp":      14,
-	"TypeReportSplitReq":        15,
-	"TypeReportSplitRsp":        16,
-	"TypeBatchReportSplitReq":   17,
-	"TypeBatchReportSplitRsp":   18,
-	"TypeCreateWatcherReq":      19,
-	"TypeEventNotify":           20,
-	"TypeCreateResourcesReq":    21,
-	"TypeCreateResourcesRsp":    22,
-	"TypeRemoveResourcesReq":    23,
-	"TypeRemoveResourcesRsp":    24,
-	"TypeCheckResourceStateReq": 25,
-	"TypeCheckResourceStateRsp": 26,
-	"TypePutPlacementRuleReq":   27,
-	"TypePutPlacementRuleRsp":   28,
-	"TypeGetAppliedRulesReq":    29,
-	"TypeGetAppliedRulesRsp":    30,
-	"TypeCreateJobReq":          31,
-	"TypeCreateJobRsp":          32,
-	"TypeRemoveJobReq":          33,
-	"TypeRemoveJobRsp":          34,
-	"TypeExecuteJobReq":         35,
-	"TypeExecuteJobRsp":         36,
+	"TypeRegisterContainer":       0,
+	"TypeResourceHeartbeatReq":    1,
+	"TypeResourceHeartbeatRsp":    2,
+	"TypeContainerHeartbeatReq":   3,
+	"TypeContainerHeartbeatRsp":   4,
+	"TypePutContainerReq":         5,
+	"TypePutContainerRsp":         6,
+	"TypeGetContainerReq":         7,
+	"TypeGetContainerRsp":         8,
+	"TypeAllocIDReq":              9,
+	"TypeAllocIDRsp":              10,
+	"TypeAskSplitReq":             11,
+	"TypeAskSplitRsp":             12,
+	"TypeAskBatchSplitReq":        13,
+	"TypeAskBatchSplitRsp":        14,
+	"TypeReportSplitReq":          15,
+	"TypeReportSplitRsp":          16,
+	"TypeBatchReportSplitReq":     17,
+	"TypeBatchReportSplitRsp":     18,
+	"TypeCreateWatcherReq":        19,
+	"TypeEventNotify":             20,
+	"TypeCreateResourcesReq":      21,
+	"TypeCreateResourcesRsp":      22,
+	"TypeRemoveResourcesReq":      23,
+	"TypeRemoveResourcesRsp":      24,
+	"TypeCheckResourceStateReq":   25,
+	"TypeCheckResourceStateRsp":   26,
+	"TypePutPlacementRuleReq":     27,
+	"TypePutPlacementRuleRsp":     28,
+	"TypeGetAppliedRulesReq":      29,
+	"TypeGetAppliedRulesRsp":      30,
+	"TypeCreateJobReq":            31,
+	"TypeCreateJobRsp":            32,
+	"TypeRemoveJobReq":            33,
+	"TypeRemoveJobRsp":            34,
+	"TypeExecuteJobReq":           35,
+	"TypeExecuteJobRsp":           36,
+	"TypeGetContainersReq":        37,
+	"TypeGetContainersRsp":        38,
+	"TypeGetResourcesReq":         39,
+	"TypeGetResourcesRsp":         40,
+	"TypeGetHotResourcesReq":      41,
+	"TypeGetHotResourcesRsp":      42,
+	"TypeUpdateContainerStateReq": 43,
+	"TypeUpdateContainerStateRsp": 44,
+	"TypeAddSchedulerReq":         45,
+	"TypeAddSchedulerRsp":         46,
+	"TypeRemoveSchedulerReq":      47,
+	"TypeRemoveSchedulerRsp":      48,
+	"TypeGetSchedulersReq":        49,
+	"TypeGetSchedulersRsp":        50,
+	"TypeGetPlacementRulesReq":    51,
+	"TypeGetPlacementRulesRsp":    52,
+	"TypeGetTimestampReq":         53,
+	"TypeGetTimestampRsp":         54,
 }
 
 func (x Type) String() string {
@@ -167,6 +223,8 @@ const (
 	Follower PeerRoleType = 2
 	// Learner matches a learner.
 	Learner PeerRoleType = 3
+	// Witness matches a witness, which votes but stores no data.
+	Witness PeerRoleType = 4
 )
 
 var PeerRoleType_name = map[int32]string{
@@ -174,6 +232,7 @@ var PeerRoleType_name = map[int32]string{
 	1: "Leader",
 	2: "Follower",
 	3: "Learner",
+	4: "Witness",
 }
 
 var PeerRoleType_value = map[string]int32{
@@ -181,6 +240,7 @@ var PeerRoleType_value = map[string]int32{
 	"Leader":   1,
 	"Follower": 2,
 	"Learner":  3,
+	"Witness":  4,
 }
 
 func (x PeerRoleType) String() string {
@@ -230,30 +290,39 @@ func (LabelConstraintOp) EnumDescriptor() ([]byte, []int) {
 
 // Request the prophet rpc request
 type Request struct {
-	ID                   uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ContainerID          uint64                `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Type                 Type                  `protobuf:"varint,3,opt,name=type,proto3,enum=rpcpb.Type" json:"type,omitempty"`
-	ResourceHeartbeat    ResourceHeartbeatReq  `protobuf:"bytes,4,opt,name=resourceHeartbeat,proto3" json:"resourceHeartbeat"`
-	ContainerHeartbeat   ContainerHeartbeatReq `protobuf:"bytes,5,opt,name=containerHeartbeat,proto3" json:"containerHeartbeat"`
-	PutContainer         PutContainerReq       `protobuf:"bytes,6,opt,name=putContainer,proto3" json:"putContainer"`
-	GetContainer         GetContainerReq       `protobuf:"bytes,7,opt,name=getContainer,proto3" json:"getContainer"`
-	AllocID              AllocIDReq            `protobuf:"bytes,8,opt,name=allocID,proto3" json:"allocID"`
-	AskSplit             AskSplitReq           `protobuf:"bytes,9,opt,name=askSplit,proto3" json:"askSplit"`
-	AskBatchSplit        AskBatchSplitReq      `protobuf:"bytes,10,opt,name=askBatchSplit,proto3" json:"askBatchSplit"`
-	ReportSplit          ReportSplitReq        `protobuf:"bytes,11,opt,name=reportSplit,proto3" json:"reportSplit"`
-	BatchReportSplit     BatchReportSplitReq   `protobuf:"bytes,12,opt,name=batchReportSplit,proto3" json:"batchReportSplit"`
-	CreateWatcher        CreateWatcherReq      `protobuf:"bytes,13,opt,name=createWatcher,proto3" json:"createWatcher"`
-	CreateResources      CreateResourcesReq    `protobuf:"bytes,14,opt,name=createResources,proto3" json:"createResources"`
-	RemoveResources      RemoveResourcesReq    `protobuf:"bytes,15,opt,name=removeResources,proto3" json:"removeResources"`
-	CheckResourceState   CheckResourceStateReq `protobuf:"bytes,16,opt,name=checkResourceState,proto3" json:"checkResourceState"`
-	PutPlacementRule     PutPlacementRuleReq   `protobuf:"bytes,17,opt,name=putPlacementRule,proto3" json:"putPlacementRule"`
-	GetAppliedRules      GetAppliedRulesReq    `protobuf:"bytes,18,opt,name=getAppliedRules,proto3" json:"getAppliedRules"`
-	CreateJob            CreateJobReq          `protobuf:"bytes,19,opt,name=createJob,proto3" json:"createJob"`
-	RemoveJob            RemoveJobReq          `protobuf:"bytes,20,opt,name=removeJob,proto3" json:"removeJob"`
-	ExecuteJob           ExecuteJobReq         `protobuf:"bytes,21,opt,name=executeJob,proto3" json:"executeJob"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	ID                   uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContainerID          uint64                  `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Type                 Type                    `protobuf:"varint,3,opt,name=type,proto3,enum=rpcpb.Type" json:"type,omitempty"`
+	ResourceHeartbeat    ResourceHeartbeatReq    `protobuf:"bytes,4,opt,name=resourceHeartbeat,proto3" json:"resourceHeartbeat"`
+	ContainerHeartbeat   ContainerHeartbeatReq   `protobuf:"bytes,5,opt,name=containerHeartbeat,proto3" json:"containerHeartbeat"`
+	PutContainer         PutContainerReq         `protobuf:"bytes,6,opt,name=putContainer,proto3" json:"putContainer"`
+	GetContainer         GetContainerReq         `protobuf:"bytes,7,opt,name=getContainer,proto3" json:"getContainer"`
+	AllocID              AllocIDReq              `protobuf:"bytes,8,opt,name=allocID,proto3" json:"allocID"`
+	AskSplit             AskSplitReq             `protobuf:"bytes,9,opt,name=askSplit,proto3" json:"askSplit"`
+	AskBatchSplit        AskBatchSplitReq        `protobuf:"bytes,10,opt,name=askBatchSplit,proto3" json:"askBatchSplit"`
+	ReportSplit          ReportSplitReq          `protobuf:"bytes,11,opt,name=reportSplit,proto3" json:"reportSplit"`
+	BatchReportSplit     BatchReportSplitReq     `protobuf:"bytes,12,opt,name=batchReportSplit,proto3" json:"batchReportSplit"`
+	CreateWatcher        CreateWatcherReq        `protobuf:"bytes,13,opt,name=createWatcher,proto3" json:"createWatcher"`
+	CreateResources      CreateResourcesReq      `protobuf:"bytes,14,opt,name=createResources,proto3" json:"createResources"`
+	RemoveResources      RemoveResourcesReq      `protobuf:"bytes,15,opt,name=removeResources,proto3" json:"removeResources"`
+	CheckResourceState   CheckResourceStateReq   `protobuf:"bytes,16,opt,name=checkResourceState,proto3" json:"checkResourceState"`
+	PutPlacementRule     PutPlacementRuleReq     `protobuf:"bytes,17,opt,name=putPlacementRule,proto3" json:"putPlacementRule"`
+	GetAppliedRules      GetAppliedRulesReq      `protobuf:"bytes,18,opt,name=getAppliedRules,proto3" json:"getAppliedRules"`
+	CreateJob            CreateJobReq            `protobuf:"bytes,19,opt,name=createJob,proto3" json:"createJob"`
+	RemoveJob            RemoveJobReq            `protobuf:"bytes,20,opt,name=removeJob,proto3" json:"removeJob"`
+	ExecuteJob           ExecuteJobReq           `protobuf:"bytes,21,opt,name=executeJob,proto3" json:"executeJob"`
+	GetContainers        GetContainersReq        `protobuf:"bytes,22,opt,name=getContainers,proto3" json:"getContainers"`
+	GetResources         GetResourcesReq         `protobuf:"bytes,23,opt,name=getResources,proto3" json:"getResources"`
+	GetHotResources      GetHotResourcesReq      `protobuf:"bytes,24,opt,name=getHotResources,proto3" json:"getHotResources"`
+	UpdateContainerState UpdateContainerStateReq `protobuf:"bytes,25,opt,name=updateContainerState,proto3" json:"updateContainerState"`
+	AddScheduler         AddSchedulerReq         `protobuf:"bytes,26,opt,name=addScheduler,proto3" json:"addScheduler"`
+	RemoveScheduler      RemoveSchedulerReq      `protobuf:"bytes,27,opt,name=removeScheduler,proto3" json:"removeScheduler"`
+	GetSchedulers        GetSchedulersReq        `protobuf:"bytes,28,opt,name=getSchedulers,proto3" json:"getSchedulers"`
+	GetPlacementRules    GetPlacementRulesReq    `protobuf:"bytes,29,opt,name=getPlacementRules,proto3" json:"getPlacementRules"`
+	GetTimestamp         GetTimestampReq         `protobuf:"bytes,30,opt,name=getTimestamp,proto3" json:"getTimestamp"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -270,7 +339,7 @@ func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -436,33 +505,105 @@ func (m *Request) GetExecuteJob() ExecuteJobReq {
 	return ExecuteJobReq{}
 }
 
+func (m *Request) GetGetContainers() GetContainersReq {
+	if m != nil {
+		return m.GetContainers
+	}
+	return GetContainersReq{}
+}
+
+func (m *Request) GetGetResources() GetResourcesReq {
+	if m != nil {
+		return m.GetResources
+	}
+	return GetResourcesReq{}
+}
+
+func (m *Request) GetGetHotResources() GetHotResourcesReq {
+	if m != nil {
+		return m.GetHotResources
+	}
+	return GetHotResourcesReq{}
+}
+
+func (m *Request) GetUpdateContainerState() UpdateContainerStateReq {
+	if m != nil {
+		return m.UpdateContainerState
+	}
+	return UpdateContainerStateReq{}
+}
+
+func (m *Request) GetAddScheduler() AddSchedulerReq {
+	if m != nil {
+		return m.AddScheduler
+	}
+	return AddSchedulerReq{}
+}
+
+func (m *Request) GetRemoveScheduler() RemoveSchedulerReq {
+	if m != nil {
+		return m.RemoveScheduler
+	}
+	return RemoveSchedulerReq{}
+}
+
+func (m *Request) GetGetSchedulers() GetSchedulersReq {
+	if m != nil {
+		return m.GetSchedulers
+	}
+	return GetSchedulersReq{}
+}
+
+func (m *Request) GetGetPlacementRules() GetPlacementRulesReq {
+	if m != nil {
+		return m.GetPlacementRules
+	}
+	return GetPlacementRulesReq{}
+}
+
+func (m *Request) GetGetTimestamp() GetTimestampReq {
+	if m != nil {
+		return m.GetTimestamp
+	}
+	return GetTimestampReq{}
+}
+
 // Response the prophet rpc response
 type Response struct {
-	ID                   uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                 Type                  `protobuf:"varint,2,opt,name=type,proto3,enum=rpcpb.Type" json:"type,omitempty"`
-	Error                string                `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	Leader               string                `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	ResourceHeartbeat    ResourceHeartbeatRsp  `protobuf:"bytes,5,opt,name=resourceHeartbeat,proto3" json:"resourceHeartbeat"`
-	ContainerHeartbeat   ContainerHeartbeatRsp `protobuf:"bytes,6,opt,name=containerHeartbeat,proto3" json:"containerHeartbeat"`
-	PutContainer         PutContainerRsp       `protobuf:"bytes,7,opt,name=putContainer,proto3" json:"putContainer"`
-	GetContainer         GetContainerRsp       `protobuf:"bytes,8,opt,name=getContainer,proto3" json:"getContainer"`
-	AllocID              AllocIDRsp            `protobuf:"bytes,9,opt,name=allocID,proto3" json:"allocID"`
-	AskSplit             AskSplitRsp           `protobuf:"bytes,10,opt,name=askSplit,proto3" json:"askSplit"`
-	AskBatchSplit        AskBatchSplitRsp      `protobuf:"bytes,11,opt,name=askBatchSplit,proto3" json:"askBatchSplit"`
-	ReportSplit          ReportSplitRsp        `protobuf:"bytes,12,opt,name=reportSplit,proto3" json:"reportSplit"`
-	BatchReportSplit     BatchReportSplitRsp   `protobuf:"bytes,13,opt,name=batchReportSplit,proto3" json:"batchReportSplit"`
-	Event                EventNotify           `protobuf:"bytes,14,opt,name=event,proto3" json:"event"`
-	CreateResources      CreateResourcesRsp    `protobuf:"bytes,15,opt,name=createResources,proto3" json:"createResources"`
-	RemoveResources      RemoveResourcesRsp    `protobuf:"bytes,16,opt,name=removeResources,proto3" json:"removeResources"`
-	CheckResourceState   CheckResourceStateRsp `protobuf:"bytes,17,opt,name=checkResourceState,proto3" json:"checkResourceState"`
-	PutPlacementRule     PutPlacementRuleRsp   `protobuf:"bytes,18,opt,name=putPlacementRule,proto3" json:"putPlacementRule"`
-	GetAppliedRules      GetAppliedRulesRsp    `protobuf:"bytes,19,opt,name=getAppliedRules,proto3" json:"getAppliedRules"`
-	CreateJob            CreateJobRsp          `protobuf:"bytes,20,opt,name=createJob,proto3" json:"createJob"`
-	RemoveJob            RemoveJobRsp          `protobuf:"bytes,21,opt,name=removeJob,proto3" json:"removeJob"`
-	ExecuteJob           ExecuteJobRsp         `protobuf:"bytes,22,opt,name=executeJob,proto3" json:"executeJob"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	ID                   uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 Type                    `protobuf:"varint,2,opt,name=type,proto3,enum=rpcpb.Type" json:"type,omitempty"`
+	Error                string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Leader               string                  `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	ResourceHeartbeat    ResourceHeartbeatRsp    `protobuf:"bytes,5,opt,name=resourceHeartbeat,proto3" json:"resourceHeartbeat"`
+	ContainerHeartbeat   ContainerHeartbeatRsp   `protobuf:"bytes,6,opt,name=containerHeartbeat,proto3" json:"containerHeartbeat"`
+	PutContainer         PutContainerRsp         `protobuf:"bytes,7,opt,name=putContainer,proto3" json:"putContainer"`
+	GetContainer         GetContainerRsp         `protobuf:"bytes,8,opt,name=getContainer,proto3" json:"getContainer"`
+	AllocID              AllocIDRsp              `protobuf:"bytes,9,opt,name=allocID,proto3" json:"allocID"`
+	AskSplit             AskSplitRsp             `protobuf:"bytes,10,opt,name=askSplit,proto3" json:"askSplit"`
+	AskBatchSplit        AskBatchSplitRsp        `protobuf:"bytes,11,opt,name=askBatchSplit,proto3" json:"askBatchSplit"`
+	ReportSplit          ReportSplitRsp          `protobuf:"bytes,12,opt,name=reportSplit,proto3" json:"reportSplit"`
+	BatchReportSplit     BatchReportSplitRsp     `protobuf:"bytes,13,opt,name=batchReportSplit,proto3" json:"batchReportSplit"`
+	Event                EventNotify             `protobuf:"bytes,14,opt,name=event,proto3" json:"event"`
+	CreateResources      CreateResourcesRsp      `protobuf:"bytes,15,opt,name=createResources,proto3" json:"createResources"`
+	RemoveResources      RemoveResourcesRsp      `protobuf:"bytes,16,opt,name=removeResources,proto3" json:"removeResources"`
+	CheckResourceState   CheckResourceStateRsp   `protobuf:"bytes,17,opt,name=checkResourceState,proto3" json:"checkResourceState"`
+	PutPlacementRule     PutPlacementRuleRsp     `protobuf:"bytes,18,opt,name=putPlacementRule,proto3" json:"putPlacementRule"`
+	GetAppliedRules      GetAppliedRulesRsp      `protobuf:"bytes,19,opt,name=getAppliedRules,proto3" json:"getAppliedRules"`
+	CreateJob            CreateJobRsp            `protobuf:"bytes,20,opt,name=createJob,proto3" json:"createJob"`
+	RemoveJob            RemoveJobRsp            `protobuf:"bytes,21,opt,name=removeJob,proto3" json:"removeJob"`
+	ExecuteJob           ExecuteJobRsp           `protobuf:"bytes,22,opt,name=executeJob,proto3" json:"executeJob"`
+	GetContainers        GetContainersRsp        `protobuf:"bytes,23,opt,name=getContainers,proto3" json:"getContainers"`
+	GetResources         GetResourcesRsp         `protobuf:"bytes,24,opt,name=getResources,proto3" json:"getResources"`
+	GetHotResources      GetHotResourcesRsp      `protobuf:"bytes,25,opt,name=getHotResources,proto3" json:"getHotResources"`
+	UpdateContainerState UpdateContainerStateRsp `protobuf:"bytes,26,opt,name=updateContainerState,proto3" json:"updateContainerState"`
+	AddScheduler         AddSchedulerRsp         `protobuf:"bytes,27,opt,name=addScheduler,proto3" json:"addScheduler"`
+	RemoveScheduler      RemoveSchedulerRsp      `protobuf:"bytes,28,opt,name=removeScheduler,proto3" json:"removeScheduler"`
+	GetSchedulers        GetSchedulersRsp        `protobuf:"bytes,29,opt,name=getSchedulers,proto3" json:"getSchedulers"`
+	GetPlacementRules    GetPlacementRulesRsp    `protobuf:"bytes,30,opt,name=getPlacementRules,proto3" json:"getPlacementRules"`
+	GetTimestamp         GetTimestampRsp         `protobuf:"bytes,31,opt,name=getTimestamp,proto3" json:"getTimestamp"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
@@ -479,7 +620,7 @@ func (m *Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -652,6 +793,69 @@ func (m *Response) GetExecuteJob() ExecuteJobRsp {
 	return ExecuteJobRsp{}
 }
 
+func (m *Response) GetGetContainers() GetContainersRsp {
+	if m != nil {
+		return m.GetContainers
+	}
+	return GetContainersRsp{}
+}
+
+func (m *Response) GetGetResources() GetResourcesRsp {
+	if m != nil {
+		return m.GetResources
+	}
+	return GetResourcesRsp{}
+}
+
+func (m *Response) GetGetHotResources() GetHotResourcesRsp {
+	if m != nil {
+		return m.GetHotResources
+	}
+	return GetHotResourcesRsp{}
+}
+
+func (m *Response) GetUpdateContainerState() UpdateContainerStateRsp {
+	if m != nil {
+		return m.UpdateContainerState
+	}
+	return UpdateContainerStateRsp{}
+}
+
+func (m *Response) GetAddScheduler() AddSchedulerRsp {
+	if m != nil {
+		return m.AddScheduler
+	}
+	return AddSchedulerRsp{}
+}
+
+func (m *Response) GetRemoveScheduler() RemoveSchedulerRsp {
+	if m != nil {
+		return m.RemoveScheduler
+	}
+	return RemoveSchedulerRsp{}
+}
+
+func (m *Response) GetGetSchedulers() GetSchedulersRsp {
+	if m != nil {
+		return m.GetSchedulers
+	}
+	return GetSchedulersRsp{}
+}
+
+func (m *Response) GetGetPlacementRules() GetPlacementRulesRsp {
+	if m != nil {
+		return m.GetPlacementRules
+	}
+	return GetPlacementRulesRsp{}
+}
+
+func (m *Response) GetGetTimestamp() GetTimestampRsp {
+	if m != nil {
+		return m.GetTimestamp
+	}
+	return GetTimestampRsp{}
+}
+
 // ResourceHeartbeatReq resource heartbeat request
 type ResourceHeartbeatReq struct {
 	ContainerID uint64 `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
@@ -681,7 +885,7 @@ func (m *ResourceHeartbeatReq) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_ResourceHeartbeatReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -795,7 +999,7 @@ func (m *ResourceHeartbeatRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_ResourceHeartbeatRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -899,7 +1103,7 @@ func (m *PutContainerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_PutContainerReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -946,7 +1150,7 @@ func (m *PutContainerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_PutContainerRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -988,7 +1192,7 @@ func (m *ContainerHeartbeatReq) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_ContainerHeartbeatReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1023,10 +1227,13 @@ func (m *ContainerHeartbeatReq) GetData() []byte {
 
 // ContainerHeartbeatRsp container heartbeat response
 type ContainerHeartbeatRsp struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// jobs the types of the created jobs, the containers only fetch the tasks of
+	// these jobs
+	Jobs                 []metapb.JobType `protobuf:"varint,2,rep,packed,name=jobs,proto3,enum=metapb.JobType" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ContainerHeartbeatRsp) Reset()         { *m = ContainerHeartbeatRsp{} }
@@ -1043,7 +1250,7 @@ func (m *ContainerHeartbeatRsp) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_ContainerHeartbeatRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1069,6 +1276,13 @@ func (m *ContainerHeartbeatRsp) GetData() []byte {
 	return nil
 }
 
+func (m *ContainerHeartbeatRsp) GetJobs() []metapb.JobType {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
 // GetContainerReq get container request
 type GetContainerReq struct {
 	ID                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1091,7 +1305,7 @@ func (m *GetContainerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_GetContainerReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1140,7 +1354,7 @@ func (m *GetContainerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_GetContainerRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1194,7 +1408,7 @@ func (m *AllocIDReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_AllocIDReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1235,7 +1449,7 @@ func (m *AllocIDRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_AllocIDRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1261,6 +1475,103 @@ func (m *AllocIDRsp) GetID() uint64 {
 	return 0
 }
 
+// GetTimestampReq get timestamp request, allocate count continuous timestamps
+type GetTimestampReq struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTimestampReq) Reset()         { *m = GetTimestampReq{} }
+func (m *GetTimestampReq) String() string { return proto.CompactTextString(m) }
+func (*GetTimestampReq) ProtoMessage()    {}
+func (*GetTimestampReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{12}
+}
+func (m *GetTimestampReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTimestampReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTimestampReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTimestampReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimestampReq.Merge(m, src)
+}
+func (m *GetTimestampReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTimestampReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimestampReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimestampReq proto.InternalMessageInfo
+
+func (m *GetTimestampReq) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// GetTimestampRsp get timestamp response, the timestamps in [timestamp-count+1, timestamp]
+// are allocated
+type GetTimestampRsp struct {
+	Timestamp            uint64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTimestampRsp) Reset()         { *m = GetTimestampRsp{} }
+func (m *GetTimestampRsp) String() string { return proto.CompactTextString(m) }
+func (*GetTimestampRsp) ProtoMessage()    {}
+func (*GetTimestampRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{13}
+}
+func (m *GetTimestampRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTimestampRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTimestampRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTimestampRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimestampRsp.Merge(m, src)
+}
+func (m *GetTimestampRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTimestampRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimestampRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTimestampRsp proto.InternalMessageInfo
+
+func (m *GetTimestampRsp) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 // AskSplitReq ask split request
 type AskSplitReq struct {
 	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -1273,7 +1584,7 @@ func (m *AskSplitReq) Reset()         { *m = AskSplitReq{} }
 func (m *AskSplitReq) String() string { return proto.CompactTextString(m) }
 func (*AskSplitReq) ProtoMessage()    {}
 func (*AskSplitReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{12}
+	return fileDescriptor_25e491924c678914, []int{14}
 }
 func (m *AskSplitReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1594,7 @@ func (m *AskSplitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_AskSplitReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1321,7 +1632,7 @@ func (m *AskSplitRsp) Reset()         { *m = AskSplitRsp{} }
 func (m *AskSplitRsp) String() string { return proto.CompactTextString(m) }
 func (*AskSplitRsp) ProtoMessage()    {}
 func (*AskSplitRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{13}
+	return fileDescriptor_25e491924c678914, []int{15}
 }
 func (m *AskSplitRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1642,7 @@ func (m *AskSplitRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_AskSplitRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1370,7 +1681,7 @@ func (m *ReportSplitReq) Reset()         { *m = ReportSplitReq{} }
 func (m *ReportSplitReq) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitReq) ProtoMessage()    {}
 func (*ReportSplitReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{14}
+	return fileDescriptor_25e491924c678914, []int{16}
 }
 func (m *ReportSplitReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1691,7 @@ func (m *ReportSplitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_ReportSplitReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1424,7 +1735,7 @@ func (m *ReportSplitRsp) Reset()         { *m = ReportSplitRsp{} }
 func (m *ReportSplitRsp) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitRsp) ProtoMessage()    {}
 func (*ReportSplitRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{15}
+	return fileDescriptor_25e491924c678914, []int{17}
 }
 func (m *ReportSplitRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1745,7 @@ func (m *ReportSplitRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_ReportSplitRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1466,7 +1777,7 @@ func (m *AskBatchSplitReq) Reset()         { *m = AskBatchSplitReq{} }
 func (m *AskBatchSplitReq) String() string { return proto.CompactTextString(m) }
 func (*AskBatchSplitReq) ProtoMessage()    {}
 func (*AskBatchSplitReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{16}
+	return fileDescriptor_25e491924c678914, []int{18}
 }
 func (m *AskBatchSplitReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,7 +1787,7 @@ func (m *AskBatchSplitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_AskBatchSplitReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1521,7 +1832,7 @@ func (m *AskBatchSplitRsp) Reset()         { *m = AskBatchSplitRsp{} }
 func (m *AskBatchSplitRsp) String() string { return proto.CompactTextString(m) }
 func (*AskBatchSplitRsp) ProtoMessage()    {}
 func (*AskBatchSplitRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{17}
+	return fileDescriptor_25e491924c678914, []int{19}
 }
 func (m *AskBatchSplitRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1531,7 +1842,7 @@ func (m *AskBatchSplitRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_AskBatchSplitRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1569,7 +1880,7 @@ func (m *BatchReportSplitReq) Reset()         { *m = BatchReportSplitReq{} }
 func (m *BatchReportSplitReq) String() string { return proto.CompactTextString(m) }
 func (*BatchReportSplitReq) ProtoMessage()    {}
 func (*BatchReportSplitReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{18}
+	return fileDescriptor_25e491924c678914, []int{20}
 }
 func (m *BatchReportSplitReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1890,7 @@ func (m *BatchReportSplitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_BatchReportSplitReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1616,7 +1927,7 @@ func (m *BatchReportSplitRsp) Reset()         { *m = BatchReportSplitRsp{} }
 func (m *BatchReportSplitRsp) String() string { return proto.CompactTextString(m) }
 func (*BatchReportSplitRsp) ProtoMessage()    {}
 func (*BatchReportSplitRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{19}
+	return fileDescriptor_25e491924c678914, []int{21}
 }
 func (m *BatchReportSplitRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1937,7 @@ func (m *BatchReportSplitRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_BatchReportSplitRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1658,7 +1969,7 @@ func (m *SplitID) Reset()         { *m = SplitID{} }
 func (m *SplitID) String() string { return proto.CompactTextString(m) }
 func (*SplitID) ProtoMessage()    {}
 func (*SplitID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{20}
+	return fileDescriptor_25e491924c678914, []int{22}
 }
 func (m *SplitID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1979,7 @@ func (m *SplitID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_SplitID.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1713,7 +2024,7 @@ func (m *CreateWatcherReq) Reset()         { *m = CreateWatcherReq{} }
 func (m *CreateWatcherReq) String() string { return proto.CompactTextString(m) }
 func (*CreateWatcherReq) ProtoMessage()    {}
 func (*CreateWatcherReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{21}
+	return fileDescriptor_25e491924c678914, []int{23}
 }
 func (m *CreateWatcherReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +2034,7 @@ func (m *CreateWatcherReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_CreateWatcherReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1762,7 +2073,7 @@ func (m *CreateResourcesReq) Reset()         { *m = CreateResourcesReq{} }
 func (m *CreateResourcesReq) String() string { return proto.CompactTextString(m) }
 func (*CreateResourcesReq) ProtoMessage()    {}
 func (*CreateResourcesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{22}
+	return fileDescriptor_25e491924c678914, []int{24}
 }
 func (m *CreateResourcesReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1772,7 +2083,7 @@ func (m *CreateResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CreateResourcesReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1816,7 +2127,7 @@ func (m *CreateResourcesRsp) Reset()         { *m = CreateResourcesRsp{} }
 func (m *CreateResourcesRsp) String() string { return proto.CompactTextString(m) }
 func (*CreateResourcesRsp) ProtoMessage()    {}
 func (*CreateResourcesRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{23}
+	return fileDescriptor_25e491924c678914, []int{25}
 }
 func (m *CreateResourcesRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1826,7 +2137,7 @@ func (m *CreateResourcesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CreateResourcesRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1857,7 +2168,7 @@ func (m *RemoveResourcesReq) Reset()         { *m = RemoveResourcesReq{} }
 func (m *RemoveResourcesReq) String() string { return proto.CompactTextString(m) }
 func (*RemoveResourcesReq) ProtoMessage()    {}
 func (*RemoveResourcesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{24}
+	return fileDescriptor_25e491924c678914, []int{26}
 }
 func (m *RemoveResourcesReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +2178,7 @@ func (m *RemoveResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_RemoveResourcesReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1904,7 +2215,7 @@ func (m *RemoveResourcesRsp) Reset()         { *m = RemoveResourcesRsp{} }
 func (m *RemoveResourcesRsp) String() string { return proto.CompactTextString(m) }
 func (*RemoveResourcesRsp) ProtoMessage()    {}
 func (*RemoveResourcesRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{25}
+	return fileDescriptor_25e491924c678914, []int{27}
 }
 func (m *RemoveResourcesRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1914,7 +2225,7 @@ func (m *RemoveResourcesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_RemoveResourcesRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1945,7 +2256,7 @@ func (m *CheckResourceStateReq) Reset()         { *m = CheckResourceStateReq{} }
 func (m *CheckResourceStateReq) String() string { return proto.CompactTextString(m) }
 func (*CheckResourceStateReq) ProtoMessage()    {}
 func (*CheckResourceStateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{26}
+	return fileDescriptor_25e491924c678914, []int{28}
 }
 func (m *CheckResourceStateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,7 +2266,7 @@ func (m *CheckResourceStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CheckResourceStateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1993,7 +2304,7 @@ func (m *CheckResourceStateRsp) Reset()         { *m = CheckResourceStateRsp{} }
 func (m *CheckResourceStateRsp) String() string { return proto.CompactTextString(m) }
 func (*CheckResourceStateRsp) ProtoMessage()    {}
 func (*CheckResourceStateRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{27}
+	return fileDescriptor_25e491924c678914, []int{29}
 }
 func (m *CheckResourceStateRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2003,7 +2314,7 @@ func (m *CheckResourceStateRsp) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_CheckResourceStateRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2041,7 +2352,7 @@ func (m *PutPlacementRuleReq) Reset()         { *m = PutPlacementRuleReq{} }
 func (m *PutPlacementRuleReq) String() string { return proto.CompactTextString(m) }
 func (*PutPlacementRuleReq) ProtoMessage()    {}
 func (*PutPlacementRuleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{28}
+	return fileDescriptor_25e491924c678914, []int{30}
 }
 func (m *PutPlacementRuleReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2051,7 +2362,7 @@ func (m *PutPlacementRuleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_PutPlacementRuleReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2088,7 +2399,7 @@ func (m *PutPlacementRuleRsp) Reset()         { *m = PutPlacementRuleRsp{} }
 func (m *PutPlacementRuleRsp) String() string { return proto.CompactTextString(m) }
 func (*PutPlacementRuleRsp) ProtoMessage()    {}
 func (*PutPlacementRuleRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{29}
+	return fileDescriptor_25e491924c678914, []int{31}
 }
 func (m *PutPlacementRuleRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2409,7 @@ func (m *PutPlacementRuleRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_PutPlacementRuleRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2129,7 +2440,7 @@ func (m *GetAppliedRulesReq) Reset()         { *m = GetAppliedRulesReq{} }
 func (m *GetAppliedRulesReq) String() string { return proto.CompactTextString(m) }
 func (*GetAppliedRulesReq) ProtoMessage()    {}
 func (*GetAppliedRulesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{30}
+	return fileDescriptor_25e491924c678914, []int{32}
 }
 func (m *GetAppliedRulesReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2450,7 @@ func (m *GetAppliedRulesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_GetAppliedRulesReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2177,7 +2488,7 @@ func (m *GetAppliedRulesRsp) Reset()         { *m = GetAppliedRulesRsp{} }
 func (m *GetAppliedRulesRsp) String() string { return proto.CompactTextString(m) }
 func (*GetAppliedRulesRsp) ProtoMessage()    {}
 func (*GetAppliedRulesRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{31}
+	return fileDescriptor_25e491924c678914, []int{33}
 }
 func (m *GetAppliedRulesRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2187,7 +2498,7 @@ func (m *GetAppliedRulesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_GetAppliedRulesRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2225,7 +2536,7 @@ func (m *CreateJobReq) Reset()         { *m = CreateJobReq{} }
 func (m *CreateJobReq) String() string { return proto.CompactTextString(m) }
 func (*CreateJobReq) ProtoMessage()    {}
 func (*CreateJobReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{32}
+	return fileDescriptor_25e491924c678914, []int{34}
 }
 func (m *CreateJobReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2235,7 +2546,7 @@ func (m *CreateJobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CreateJobReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2272,7 +2583,7 @@ func (m *CreateJobRsp) Reset()         { *m = CreateJobRsp{} }
 func (m *CreateJobRsp) String() string { return proto.CompactTextString(m) }
 func (*CreateJobRsp) ProtoMessage()    {}
 func (*CreateJobRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{33}
+	return fileDescriptor_25e491924c678914, []int{35}
 }
 func (m *CreateJobRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2282,7 +2593,7 @@ func (m *CreateJobRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CreateJobRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2313,7 +2624,7 @@ func (m *RemoveJobReq) Reset()         { *m = RemoveJobReq{} }
 func (m *RemoveJobReq) String() string { return proto.CompactTextString(m) }
 func (*RemoveJobReq) ProtoMessage()    {}
 func (*RemoveJobReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{34}
+	return fileDescriptor_25e491924c678914, []int{36}
 }
 func (m *RemoveJobReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2323,7 +2634,7 @@ func (m *RemoveJobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_RemoveJobReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2360,7 +2671,7 @@ func (m *RemoveJobRsp) Reset()         { *m = RemoveJobRsp{} }
 func (m *RemoveJobRsp) String() string { return proto.CompactTextString(m) }
 func (*RemoveJobRsp) ProtoMessage()    {}
 func (*RemoveJobRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{35}
+	return fileDescriptor_25e491924c678914, []int{37}
 }
 func (m *RemoveJobRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2370,7 +2681,7 @@ func (m *RemoveJobRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_RemoveJobRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2402,7 +2713,7 @@ func (m *ExecuteJobReq) Reset()         { *m = ExecuteJobReq{} }
 func (m *ExecuteJobReq) String() string { return proto.CompactTextString(m) }
 func (*ExecuteJobReq) ProtoMessage()    {}
 func (*ExecuteJobReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{36}
+	return fileDescriptor_25e491924c678914, []int{38}
 }
 func (m *ExecuteJobReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2412,7 +2723,7 @@ func (m *ExecuteJobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_ExecuteJobReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2457,7 +2768,7 @@ func (m *ExecuteJobRsp) Reset()         { *m = ExecuteJobRsp{} }
 func (m *ExecuteJobRsp) String() string { return proto.CompactTextString(m) }
 func (*ExecuteJobRsp) ProtoMessage()    {}
 func (*ExecuteJobRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{37}
+	return fileDescriptor_25e491924c678914, []int{39}
 }
 func (m *ExecuteJobRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2467,7 +2778,7 @@ func (m *ExecuteJobRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_ExecuteJobRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -2493,4102 +2804,8334 @@ func (m *ExecuteJobRsp) GetData() []byte {
 	return nil
 }
 
-// EventNotify event notify
-type EventNotify struct {
-	Seq                  uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	Type                 uint32                 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	InitEvent            *InitEventData         `protobuf:"bytes,3,opt,name=initEvent,proto3" json:"initEvent,omitempty"`
-	ResourceEvent        *ResourceEventData     `protobuf:"bytes,4,opt,name=resourceEvent,proto3" json:"resourceEvent,omitempty"`
-	ContainerEvent       *ContainerEventData    `protobuf:"bytes,5,opt,name=containerEvent,proto3" json:"containerEvent,omitempty"`
-	ResourceStatsEvent   *metapb.ResourceStats  `protobuf:"bytes,6,opt,name=resourceStatsEvent,proto3" json:"resourceStatsEvent,omitempty"`
-	ContainerStatsEvent  *metapb.ContainerStats `protobuf:"bytes,7,opt,name=containerStatsEvent,proto3" json:"containerStatsEvent,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+// GetContainersReq get all containers req
+type GetContainersReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EventNotify) Reset()         { *m = EventNotify{} }
-func (m *EventNotify) String() string { return proto.CompactTextString(m) }
-func (*EventNotify) ProtoMessage()    {}
-func (*EventNotify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{38}
+func (m *GetContainersReq) Reset()         { *m = GetContainersReq{} }
+func (m *GetContainersReq) String() string { return proto.CompactTextString(m) }
+func (*GetContainersReq) ProtoMessage()    {}
+func (*GetContainersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{40}
 }
-func (m *EventNotify) XXX_Unmarshal(b []byte) error {
+func (m *GetContainersReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetContainersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNotify.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetContainersReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *EventNotify) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNotify.Merge(m, src)
+func (m *GetContainersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContainersReq.Merge(m, src)
 }
-func (m *EventNotify) XXX_Size() int {
+func (m *GetContainersReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNotify) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNotify.DiscardUnknown(m)
+func (m *GetContainersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContainersReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNotify proto.InternalMessageInfo
+var xxx_messageInfo_GetContainersReq proto.InternalMessageInfo
 
-func (m *EventNotify) GetSeq() uint64 {
-	if m != nil {
-		return m.Seq
-	}
-	return 0
+// GetContainersRsp get all containers rsp
+type GetContainersRsp struct {
+	Containers           [][]byte                `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	Stats                []metapb.ContainerStats `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *EventNotify) GetType() uint32 {
-	if m != nil {
-		return m.Type
+func (m *GetContainersRsp) Reset()         { *m = GetContainersRsp{} }
+func (m *GetContainersRsp) String() string { return proto.CompactTextString(m) }
+func (*GetContainersRsp) ProtoMessage()    {}
+func (*GetContainersRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{41}
+}
+func (m *GetContainersRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetContainersRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetContainersRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *GetContainersRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContainersRsp.Merge(m, src)
+}
+func (m *GetContainersRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetContainersRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContainersRsp.DiscardUnknown(m)
 }
 
-func (m *EventNotify) GetInitEvent() *InitEventData {
+var xxx_messageInfo_GetContainersRsp proto.InternalMessageInfo
+
+func (m *GetContainersRsp) GetContainers() [][]byte {
 	if m != nil {
-		return m.InitEvent
+		return m.Containers
 	}
 	return nil
 }
 
-func (m *EventNotify) GetResourceEvent() *ResourceEventData {
+func (m *GetContainersRsp) GetStats() []metapb.ContainerStats {
 	if m != nil {
-		return m.ResourceEvent
+		return m.Stats
 	}
 	return nil
 }
 
-func (m *EventNotify) GetContainerEvent() *ContainerEventData {
-	if m != nil {
-		return m.ContainerEvent
-	}
-	return nil
+// GetResourcesReq get all resources req
+type GetResourcesReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EventNotify) GetResourceStatsEvent() *metapb.ResourceStats {
-	if m != nil {
-		return m.ResourceStatsEvent
-	}
-	return nil
+func (m *GetResourcesReq) Reset()         { *m = GetResourcesReq{} }
+func (m *GetResourcesReq) String() string { return proto.CompactTextString(m) }
+func (*GetResourcesReq) ProtoMessage()    {}
+func (*GetResourcesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{42}
 }
-
-func (m *EventNotify) GetContainerStatsEvent() *metapb.ContainerStats {
-	if m != nil {
-		return m.ContainerStatsEvent
+func (m *GetResourcesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetResourcesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *GetResourcesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourcesReq.Merge(m, src)
+}
+func (m *GetResourcesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetResourcesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourcesReq.DiscardUnknown(m)
 }
 
-// InitEventData init event data
-type InitEventData struct {
-	Resources            [][]byte `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
-	Leaders              []uint64 `protobuf:"varint,2,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`
-	Containers           [][]byte `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+var xxx_messageInfo_GetResourcesReq proto.InternalMessageInfo
+
+// GetResourcesRsp get all resources rsp
+type GetResourcesRsp struct {
+	Resources [][]byte `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	// Leaders the leader container of the resources, 0 means no leader
+	Leaders              []uint64               `protobuf:"varint,2,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`
+	Stats                []metapb.ResourceStats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *InitEventData) Reset()         { *m = InitEventData{} }
-func (m *InitEventData) String() string { return proto.CompactTextString(m) }
-func (*InitEventData) ProtoMessage()    {}
-func (*InitEventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{39}
+func (m *GetResourcesRsp) Reset()         { *m = GetResourcesRsp{} }
+func (m *GetResourcesRsp) String() string { return proto.CompactTextString(m) }
+func (*GetResourcesRsp) ProtoMessage()    {}
+func (*GetResourcesRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{43}
 }
-func (m *InitEventData) XXX_Unmarshal(b []byte) error {
+func (m *GetResourcesRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetResourcesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitEventData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetResourcesRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *InitEventData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitEventData.Merge(m, src)
+func (m *GetResourcesRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourcesRsp.Merge(m, src)
 }
-func (m *InitEventData) XXX_Size() int {
+func (m *GetResourcesRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitEventData) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitEventData.DiscardUnknown(m)
+func (m *GetResourcesRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourcesRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitEventData proto.InternalMessageInfo
+var xxx_messageInfo_GetResourcesRsp proto.InternalMessageInfo
 
-func (m *InitEventData) GetResources() [][]byte {
+func (m *GetResourcesRsp) GetResources() [][]byte {
 	if m != nil {
 		return m.Resources
 	}
 	return nil
 }
 
-func (m *InitEventData) GetLeaders() []uint64 {
+func (m *GetResourcesRsp) GetLeaders() []uint64 {
 	if m != nil {
 		return m.Leaders
 	}
 	return nil
 }
 
-func (m *InitEventData) GetContainers() [][]byte {
+func (m *GetResourcesRsp) GetStats() []metapb.ResourceStats {
 	if m != nil {
-		return m.Containers
+		return m.Stats
 	}
 	return nil
 }
 
-// ResourceEventData resource created or updated
-type ResourceEventData struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Leader               uint64   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
-	Removed              bool     `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
-	Create               bool     `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`
+// HotResource hot resource stats
+type HotResource struct {
+	ResourceID           uint64   `protobuf:"varint,1,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
+	ContainerID          uint64   `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	IsLeader             bool     `protobuf:"varint,3,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	HotDegree            uint64   `protobuf:"varint,4,opt,name=hotDegree,proto3" json:"hotDegree,omitempty"`
+	ByteRate             float64  `protobuf:"fixed64,5,opt,name=byteRate,proto3" json:"byteRate,omitempty"`
+	KeyRate              float64  `protobuf:"fixed64,6,opt,name=keyRate,proto3" json:"keyRate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResourceEventData) Reset()         { *m = ResourceEventData{} }
-func (m *ResourceEventData) String() string { return proto.CompactTextString(m) }
-func (*ResourceEventData) ProtoMessage()    {}
-func (*ResourceEventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{40}
+func (m *HotResource) Reset()         { *m = HotResource{} }
+func (m *HotResource) String() string { return proto.CompactTextString(m) }
+func (*HotResource) ProtoMessage()    {}
+func (*HotResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{44}
 }
-func (m *ResourceEventData) XXX_Unmarshal(b []byte) error {
+func (m *HotResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HotResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResourceEventData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HotResource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ResourceEventData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceEventData.Merge(m, src)
+func (m *HotResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotResource.Merge(m, src)
 }
-func (m *ResourceEventData) XXX_Size() int {
+func (m *HotResource) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceEventData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceEventData.DiscardUnknown(m)
+func (m *HotResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceEventData proto.InternalMessageInfo
+var xxx_messageInfo_HotResource proto.InternalMessageInfo
 
-func (m *ResourceEventData) GetData() []byte {
+func (m *HotResource) GetResourceID() uint64 {
 	if m != nil {
-		return m.Data
+		return m.ResourceID
 	}
-	return nil
+	return 0
 }
 
-func (m *ResourceEventData) GetLeader() uint64 {
+func (m *HotResource) GetContainerID() uint64 {
 	if m != nil {
-		return m.Leader
+		return m.ContainerID
 	}
 	return 0
 }
 
-func (m *ResourceEventData) GetRemoved() bool {
+func (m *HotResource) GetIsLeader() bool {
 	if m != nil {
-		return m.Removed
+		return m.IsLeader
 	}
 	return false
 }
 
-func (m *ResourceEventData) GetCreate() bool {
+func (m *HotResource) GetHotDegree() uint64 {
 	if m != nil {
-		return m.Create
+		return m.HotDegree
 	}
-	return false
+	return 0
 }
 
-// ContainerEventData container created or updated
-type ContainerEventData struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *HotResource) GetByteRate() float64 {
+	if m != nil {
+		return m.ByteRate
+	}
+	return 0
+}
+
+func (m *HotResource) GetKeyRate() float64 {
+	if m != nil {
+		return m.KeyRate
+	}
+	return 0
+}
+
+// GetHotResourcesReq get hot resources req
+type GetHotResourcesReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContainerEventData) Reset()         { *m = ContainerEventData{} }
-func (m *ContainerEventData) String() string { return proto.CompactTextString(m) }
-func (*ContainerEventData) ProtoMessage()    {}
-func (*ContainerEventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{41}
+func (m *GetHotResourcesReq) Reset()         { *m = GetHotResourcesReq{} }
+func (m *GetHotResourcesReq) String() string { return proto.CompactTextString(m) }
+func (*GetHotResourcesReq) ProtoMessage()    {}
+func (*GetHotResourcesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{45}
 }
-func (m *ContainerEventData) XXX_Unmarshal(b []byte) error {
+func (m *GetHotResourcesReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContainerEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetHotResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContainerEventData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetHotResourcesReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ContainerEventData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerEventData.Merge(m, src)
+func (m *GetHotResourcesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotResourcesReq.Merge(m, src)
 }
-func (m *ContainerEventData) XXX_Size() int {
+func (m *GetHotResourcesReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContainerEventData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerEventData.DiscardUnknown(m)
+func (m *GetHotResourcesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotResourcesReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerEventData proto.InternalMessageInfo
-
-func (m *ContainerEventData) GetData() []byte {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
+var xxx_messageInfo_GetHotResourcesReq proto.InternalMessageInfo
 
-// ChangePeer change peer
-type ChangePeer struct {
-	Peer                 metapb.Peer           `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
-	ChangeType           metapb.ChangePeerType `protobuf:"varint,2,opt,name=changeType,proto3,enum=metapb.ChangePeerType" json:"changeType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// GetHotResourcesRsp get hot resources rsp
+type GetHotResourcesRsp struct {
+	Writes               []HotResource `protobuf:"bytes,1,rep,name=writes,proto3" json:"writes"`
+	Reads                []HotResource `protobuf:"bytes,2,rep,name=reads,proto3" json:"reads"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
-func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
-func (*ChangePeer) ProtoMessage()    {}
-func (*ChangePeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{42}
+func (m *GetHotResourcesRsp) Reset()         { *m = GetHotResourcesRsp{} }
+func (m *GetHotResourcesRsp) String() string { return proto.CompactTextString(m) }
+func (*GetHotResourcesRsp) ProtoMessage()    {}
+func (*GetHotResourcesRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{46}
 }
-func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
+func (m *GetHotResourcesRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetHotResourcesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePeer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetHotResourcesRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ChangePeer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePeer.Merge(m, src)
+func (m *GetHotResourcesRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotResourcesRsp.Merge(m, src)
 }
-func (m *ChangePeer) XXX_Size() int {
+func (m *GetHotResourcesRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePeer) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePeer.DiscardUnknown(m)
+func (m *GetHotResourcesRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotResourcesRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePeer proto.InternalMessageInfo
+var xxx_messageInfo_GetHotResourcesRsp proto.InternalMessageInfo
 
-func (m *ChangePeer) GetPeer() metapb.Peer {
+func (m *GetHotResourcesRsp) GetWrites() []HotResource {
 	if m != nil {
-		return m.Peer
+		return m.Writes
 	}
-	return metapb.Peer{}
+	return nil
 }
 
-func (m *ChangePeer) GetChangeType() metapb.ChangePeerType {
+func (m *GetHotResourcesRsp) GetReads() []HotResource {
 	if m != nil {
-		return m.ChangeType
+		return m.Reads
 	}
-	return metapb.ChangePeerType_AddNode
+	return nil
 }
 
-// TransferLeader transfer leader
-type TransferLeader struct {
-	Peer                 metapb.Peer `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// UpdateContainerStateReq update container state req
+type UpdateContainerStateReq struct {
+	ContainerID uint64                `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	State       metapb.ContainerState `protobuf:"varint,2,opt,name=state,proto3,enum=metapb.ContainerState" json:"state,omitempty"`
+	// Force offline with physically destroyed, or tombstone the container which still
+	// has resources.
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
-func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
-func (*TransferLeader) ProtoMessage()    {}
-func (*TransferLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{43}
+func (m *UpdateContainerStateReq) Reset()         { *m = UpdateContainerStateReq{} }
+func (m *UpdateContainerStateReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateContainerStateReq) ProtoMessage()    {}
+func (*UpdateContainerStateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{47}
 }
-func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
+func (m *UpdateContainerStateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransferLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateContainerStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransferLeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateContainerStateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *TransferLeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransferLeader.Merge(m, src)
+func (m *UpdateContainerStateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateContainerStateReq.Merge(m, src)
 }
-func (m *TransferLeader) XXX_Size() int {
+func (m *UpdateContainerStateReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *TransferLeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransferLeader.DiscardUnknown(m)
+func (m *UpdateContainerStateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateContainerStateReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransferLeader proto.InternalMessageInfo
+var xxx_messageInfo_UpdateContainerStateReq proto.InternalMessageInfo
 
-func (m *TransferLeader) GetPeer() metapb.Peer {
+func (m *UpdateContainerStateReq) GetContainerID() uint64 {
 	if m != nil {
-		return m.Peer
+		return m.ContainerID
 	}
-	return metapb.Peer{}
+	return 0
 }
 
-// ChangePeerV2 change peer v2
-type ChangePeerV2 struct {
-	// If changes is empty, it means that to exit joint state.
-	Changes              []ChangePeer `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *UpdateContainerStateReq) GetState() metapb.ContainerState {
+	if m != nil {
+		return m.State
+	}
+	return metapb.ContainerState_UP
 }
 
-func (m *ChangePeerV2) Reset()         { *m = ChangePeerV2{} }
-func (m *ChangePeerV2) String() string { return proto.CompactTextString(m) }
-func (*ChangePeerV2) ProtoMessage()    {}
-func (*ChangePeerV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{44}
+func (m *UpdateContainerStateReq) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
 }
-func (m *ChangePeerV2) XXX_Unmarshal(b []byte) error {
+
+// UpdateContainerStateRsp update container state rsp
+type UpdateContainerStateRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateContainerStateRsp) Reset()         { *m = UpdateContainerStateRsp{} }
+func (m *UpdateContainerStateRsp) String() string { return proto.CompactTextString(m) }
+func (*UpdateContainerStateRsp) ProtoMessage()    {}
+func (*UpdateContainerStateRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{48}
+}
+func (m *UpdateContainerStateRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePeerV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateContainerStateRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePeerV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateContainerStateRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ChangePeerV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePeerV2.Merge(m, src)
+func (m *UpdateContainerStateRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateContainerStateRsp.Merge(m, src)
 }
-func (m *ChangePeerV2) XXX_Size() int {
+func (m *UpdateContainerStateRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePeerV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePeerV2.DiscardUnknown(m)
+func (m *UpdateContainerStateRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateContainerStateRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePeerV2 proto.InternalMessageInfo
-
-func (m *ChangePeerV2) GetChanges() []ChangePeer {
-	if m != nil {
-		return m.Changes
-	}
-	return nil
-}
+var xxx_messageInfo_UpdateContainerStateRsp proto.InternalMessageInfo
 
-// Merge merge
-type Merge struct {
-	// target resource
-	Target               []byte   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+// AddSchedulerReq add scheduler req
+type AddSchedulerReq struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Args                 []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Merge) Reset()         { *m = Merge{} }
-func (m *Merge) String() string { return proto.CompactTextString(m) }
-func (*Merge) ProtoMessage()    {}
-func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{45}
+func (m *AddSchedulerReq) Reset()         { *m = AddSchedulerReq{} }
+func (m *AddSchedulerReq) String() string { return proto.CompactTextString(m) }
+func (*AddSchedulerReq) ProtoMessage()    {}
+func (*AddSchedulerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{49}
 }
-func (m *Merge) XXX_Unmarshal(b []byte) error {
+func (m *AddSchedulerReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Merge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddSchedulerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Merge.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddSchedulerReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *Merge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Merge.Merge(m, src)
+func (m *AddSchedulerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSchedulerReq.Merge(m, src)
 }
-func (m *Merge) XXX_Size() int {
+func (m *AddSchedulerReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *Merge) XXX_DiscardUnknown() {
-	xxx_messageInfo_Merge.DiscardUnknown(m)
+func (m *AddSchedulerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSchedulerReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Merge proto.InternalMessageInfo
+var xxx_messageInfo_AddSchedulerReq proto.InternalMessageInfo
 
-func (m *Merge) GetTarget() []byte {
+func (m *AddSchedulerReq) GetType() string {
 	if m != nil {
-		return m.Target
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AddSchedulerReq) GetArgs() []string {
+	if m != nil {
+		return m.Args
 	}
 	return nil
 }
 
-// SplitResource split resource
-type SplitResource struct {
-	Policy               metapb.CheckPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=metapb.CheckPolicy" json:"policy,omitempty"`
-	Keys                 [][]byte           `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+// AddSchedulerRsp add scheduler rsp
+type AddSchedulerRsp struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SplitResource) Reset()         { *m = SplitResource{} }
-func (m *SplitResource) String() string { return proto.CompactTextString(m) }
-func (*SplitResource) ProtoMessage()    {}
-func (*SplitResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{46}
+func (m *AddSchedulerRsp) Reset()         { *m = AddSchedulerRsp{} }
+func (m *AddSchedulerRsp) String() string { return proto.CompactTextString(m) }
+func (*AddSchedulerRsp) ProtoMessage()    {}
+func (*AddSchedulerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{50}
 }
-func (m *SplitResource) XXX_Unmarshal(b []byte) error {
+func (m *AddSchedulerRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SplitResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddSchedulerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SplitResource.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddSchedulerRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *SplitResource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SplitResource.Merge(m, src)
+func (m *AddSchedulerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSchedulerRsp.Merge(m, src)
 }
-func (m *SplitResource) XXX_Size() int {
+func (m *AddSchedulerRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *SplitResource) XXX_DiscardUnknown() {
-	xxx_messageInfo_SplitResource.DiscardUnknown(m)
+func (m *AddSchedulerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSchedulerRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SplitResource proto.InternalMessageInfo
+var xxx_messageInfo_AddSchedulerRsp proto.InternalMessageInfo
 
-func (m *SplitResource) GetPolicy() metapb.CheckPolicy {
+func (m *AddSchedulerRsp) GetName() string {
 	if m != nil {
-		return m.Policy
+		return m.Name
 	}
-	return metapb.CheckPolicy_SCAN
+	return ""
 }
 
-func (m *SplitResource) GetKeys() [][]byte {
+// RemoveSchedulerReq remove scheduler req
+type RemoveSchedulerReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveSchedulerReq) Reset()         { *m = RemoveSchedulerReq{} }
+func (m *RemoveSchedulerReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveSchedulerReq) ProtoMessage()    {}
+func (*RemoveSchedulerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{51}
+}
+func (m *RemoveSchedulerReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveSchedulerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveSchedulerReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveSchedulerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSchedulerReq.Merge(m, src)
+}
+func (m *RemoveSchedulerReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveSchedulerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSchedulerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSchedulerReq proto.InternalMessageInfo
+
+func (m *RemoveSchedulerReq) GetName() string {
 	if m != nil {
-		return m.Keys
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-// LabelConstraint is used to filter container when trying to place peer of a resource.
-type LabelConstraint struct {
-	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Op                   LabelConstraintOp `protobuf:"varint,2,opt,name=op,proto3,enum=rpcpb.LabelConstraintOp" json:"op,omitempty"`
-	Values               []string          `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// RemoveSchedulerRsp remove scheduler rsp
+type RemoveSchedulerRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LabelConstraint) Reset()         { *m = LabelConstraint{} }
-func (m *LabelConstraint) String() string { return proto.CompactTextString(m) }
-func (*LabelConstraint) ProtoMessage()    {}
-func (*LabelConstraint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{47}
+func (m *RemoveSchedulerRsp) Reset()         { *m = RemoveSchedulerRsp{} }
+func (m *RemoveSchedulerRsp) String() string { return proto.CompactTextString(m) }
+func (*RemoveSchedulerRsp) ProtoMessage()    {}
+func (*RemoveSchedulerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{52}
 }
-func (m *LabelConstraint) XXX_Unmarshal(b []byte) error {
+func (m *RemoveSchedulerRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LabelConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveSchedulerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LabelConstraint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveSchedulerRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *LabelConstraint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LabelConstraint.Merge(m, src)
+func (m *RemoveSchedulerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSchedulerRsp.Merge(m, src)
 }
-func (m *LabelConstraint) XXX_Size() int {
+func (m *RemoveSchedulerRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *LabelConstraint) XXX_DiscardUnknown() {
-	xxx_messageInfo_LabelConstraint.DiscardUnknown(m)
+func (m *RemoveSchedulerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSchedulerRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LabelConstraint proto.InternalMessageInfo
+var xxx_messageInfo_RemoveSchedulerRsp proto.InternalMessageInfo
 
-func (m *LabelConstraint) GetKey() string {
-	if m != nil {
-		return m.Key
+// GetSchedulersReq get schedulers req
+type GetSchedulersReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchedulersReq) Reset()         { *m = GetSchedulersReq{} }
+func (m *GetSchedulersReq) String() string { return proto.CompactTextString(m) }
+func (*GetSchedulersReq) ProtoMessage()    {}
+func (*GetSchedulersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{53}
+}
+func (m *GetSchedulersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSchedulersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSchedulersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetSchedulersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchedulersReq.Merge(m, src)
+}
+func (m *GetSchedulersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSchedulersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchedulersReq.DiscardUnknown(m)
 }
 
-func (m *LabelConstraint) GetOp() LabelConstraintOp {
-	if m != nil {
-		return m.Op
+var xxx_messageInfo_GetSchedulersReq proto.InternalMessageInfo
+
+// GetSchedulersRsp get schedulers rsp
+type GetSchedulersRsp struct {
+	Schedulers           []SchedulerInfo `protobuf:"bytes,1,rep,name=schedulers,proto3" json:"schedulers"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetSchedulersRsp) Reset()         { *m = GetSchedulersRsp{} }
+func (m *GetSchedulersRsp) String() string { return proto.CompactTextString(m) }
+func (*GetSchedulersRsp) ProtoMessage()    {}
+func (*GetSchedulersRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{54}
+}
+func (m *GetSchedulersRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSchedulersRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSchedulersRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return In
+}
+func (m *GetSchedulersRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchedulersRsp.Merge(m, src)
+}
+func (m *GetSchedulersRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSchedulersRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchedulersRsp.DiscardUnknown(m)
 }
 
-func (m *LabelConstraint) GetValues() []string {
+var xxx_messageInfo_GetSchedulersRsp proto.InternalMessageInfo
+
+func (m *GetSchedulersRsp) GetSchedulers() []SchedulerInfo {
 	if m != nil {
-		return m.Values
+		return m.Schedulers
 	}
 	return nil
 }
 
-// PlacementRule place rule
-type PlacementRule struct {
-	// ID unique ID within a group
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// GroupID mark the source that add the rule
-	GroupID string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	// Index rule apply order in a group, rule with less ID is applied first when indexes are equal
-	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	// Override when it is true, all rules with less indexes are disabled
-	Override bool   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
-	StartKey []byte `protobuf:"bytes,5,opt,name=startKey,proto3" json:"startKey,omitempty"`
-	EndKey   []byte `protobuf:"bytes,6,opt,name=endKey,proto3" json:"endKey,omitempty"`
-	// Role expected role of the peers
-	Role PeerRoleType `protobuf:"varint,7,opt,name=role,proto3,enum=rpcpb.PeerRoleType" json:"role,omitempty"`
-	// Count expected count of the peers
-	Count uint32 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
-	// LabelConstraints used to select containers to place peers
-	LabelConstraints []LabelConstraint `protobuf:"bytes,9,rep,name=labelConstraints,proto3" json:"labelConstraints"`
-	// LocationLabels used to make peers isolated physically
-	LocationLabels []string `protobuf:"bytes,10,rep,name=locationLabels,proto3" json:"locationLabels,omitempty"`
-	// IsolationLevelused to isolate replicas explicitly and forcibly
-	IsolationLevel       string   `protobuf:"bytes,11,opt,name=isolationLevel,proto3" json:"isolationLevel,omitempty"`
+// SchedulerInfo scheduler info
+type SchedulerInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Paused               bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Disabled             bool     `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlacementRule) Reset()         { *m = PlacementRule{} }
-func (m *PlacementRule) String() string { return proto.CompactTextString(m) }
-func (*PlacementRule) ProtoMessage()    {}
-func (*PlacementRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{48}
+func (m *SchedulerInfo) Reset()         { *m = SchedulerInfo{} }
+func (m *SchedulerInfo) String() string { return proto.CompactTextString(m) }
+func (*SchedulerInfo) ProtoMessage()    {}
+func (*SchedulerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{55}
 }
-func (m *PlacementRule) XXX_Unmarshal(b []byte) error {
+func (m *SchedulerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PlacementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SchedulerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PlacementRule.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SchedulerInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *PlacementRule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlacementRule.Merge(m, src)
+func (m *SchedulerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulerInfo.Merge(m, src)
 }
-func (m *PlacementRule) XXX_Size() int {
+func (m *SchedulerInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *PlacementRule) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlacementRule.DiscardUnknown(m)
+func (m *SchedulerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulerInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlacementRule proto.InternalMessageInfo
-
-func (m *PlacementRule) GetID() string {
-	if m != nil {
-		return m.ID
-	}
-	return ""
-}
+var xxx_messageInfo_SchedulerInfo proto.InternalMessageInfo
 
-func (m *PlacementRule) GetGroupID() string {
+func (m *SchedulerInfo) GetName() string {
 	if m != nil {
-		return m.GroupID
+		return m.Name
 	}
 	return ""
 }
 
-func (m *PlacementRule) GetIndex() uint32 {
+func (m *SchedulerInfo) GetPaused() bool {
 	if m != nil {
-		return m.Index
+		return m.Paused
 	}
-	return 0
+	return false
 }
 
-func (m *PlacementRule) GetOverride() bool {
+func (m *SchedulerInfo) GetDisabled() bool {
 	if m != nil {
-		return m.Override
+		return m.Disabled
 	}
 	return false
 }
 
-func (m *PlacementRule) GetStartKey() []byte {
-	if m != nil {
-		return m.StartKey
-	}
-	return nil
+// GetPlacementRulesReq get placement rules req
+type GetPlacementRulesReq struct {
+	// Group returns all rules if group is empty
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlacementRule) GetEndKey() []byte {
-	if m != nil {
-		return m.EndKey
+func (m *GetPlacementRulesReq) Reset()         { *m = GetPlacementRulesReq{} }
+func (m *GetPlacementRulesReq) String() string { return proto.CompactTextString(m) }
+func (*GetPlacementRulesReq) ProtoMessage()    {}
+func (*GetPlacementRulesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{56}
+}
+func (m *GetPlacementRulesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPlacementRulesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPlacementRulesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *GetPlacementRulesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlacementRulesReq.Merge(m, src)
+}
+func (m *GetPlacementRulesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPlacementRulesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlacementRulesReq.DiscardUnknown(m)
 }
 
-func (m *PlacementRule) GetRole() PeerRoleType {
+var xxx_messageInfo_GetPlacementRulesReq proto.InternalMessageInfo
+
+func (m *GetPlacementRulesReq) GetGroup() string {
 	if m != nil {
-		return m.Role
+		return m.Group
 	}
-	return Voter
+	return ""
 }
 
-func (m *PlacementRule) GetCount() uint32 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
+// GetPlacementRulesRsp get placement rules rsp
+type GetPlacementRulesRsp struct {
+	Rules                []PlacementRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *PlacementRule) GetLabelConstraints() []LabelConstraint {
-	if m != nil {
-		return m.LabelConstraints
+func (m *GetPlacementRulesRsp) Reset()         { *m = GetPlacementRulesRsp{} }
+func (m *GetPlacementRulesRsp) String() string { return proto.CompactTextString(m) }
+func (*GetPlacementRulesRsp) ProtoMessage()    {}
+func (*GetPlacementRulesRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{57}
+}
+func (m *GetPlacementRulesRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPlacementRulesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPlacementRulesRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *GetPlacementRulesRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlacementRulesRsp.Merge(m, src)
+}
+func (m *GetPlacementRulesRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPlacementRulesRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlacementRulesRsp.DiscardUnknown(m)
 }
 
-func (m *PlacementRule) GetLocationLabels() []string {
+var xxx_messageInfo_GetPlacementRulesRsp proto.InternalMessageInfo
+
+func (m *GetPlacementRulesRsp) GetRules() []PlacementRule {
 	if m != nil {
-		return m.LocationLabels
+		return m.Rules
 	}
 	return nil
 }
 
-func (m *PlacementRule) GetIsolationLevel() string {
-	if m != nil {
-		return m.IsolationLevel
-	}
-	return ""
+// EventNotify event notify
+type EventNotify struct {
+	Seq                  uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type                 uint32                 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	InitEvent            *InitEventData         `protobuf:"bytes,3,opt,name=initEvent,proto3" json:"initEvent,omitempty"`
+	ResourceEvent        *ResourceEventData     `protobuf:"bytes,4,opt,name=resourceEvent,proto3" json:"resourceEvent,omitempty"`
+	ContainerEvent       *ContainerEventData    `protobuf:"bytes,5,opt,name=containerEvent,proto3" json:"containerEvent,omitempty"`
+	ResourceStatsEvent   *metapb.ResourceStats  `protobuf:"bytes,6,opt,name=resourceStatsEvent,proto3" json:"resourceStatsEvent,omitempty"`
+	ContainerStatsEvent  *metapb.ContainerStats `protobuf:"bytes,7,opt,name=containerStatsEvent,proto3" json:"containerStatsEvent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func init() {
-	proto.RegisterEnum("rpcpb.Type", Type_name, Type_value)
-	proto.RegisterEnum("rpcpb.PeerRoleType", PeerRoleType_name, PeerRoleType_value)
-	proto.RegisterEnum("rpcpb.LabelConstraintOp", LabelConstraintOp_name, LabelConstraintOp_value)
-	proto.RegisterType((*Request)(nil), "rpcpb.Request")
-	proto.RegisterType((*Response)(nil), "rpcpb.Response")
-	proto.RegisterType((*ResourceHeartbeatReq)(nil), "rpcpb.ResourceHeartbeatReq")
-	proto.RegisterType((*ResourceHeartbeatRsp)(nil), "rpcpb.ResourceHeartbeatRsp")
-	proto.RegisterType((*PutContainerReq)(nil), "rpcpb.PutContainerReq")
-	proto.RegisterType((*PutContainerRsp)(nil), "rpcpb.PutContainerRsp")
-	proto.RegisterType((*ContainerHeartbeatReq)(nil), "rpcpb.ContainerHeartbeatReq")
-	proto.RegisterType((*ContainerHeartbeatRsp)(nil), "rpcpb.ContainerHeartbeatRsp")
-	proto.RegisterType((*GetContainerReq)(nil), "rpcpb.GetContainerReq")
-	proto.RegisterType((*GetContainerRsp)(nil), "rpcpb.GetContainerRsp")
-	proto.RegisterType((*AllocIDReq)(nil), "rpcpb.AllocIDReq")
-	proto.RegisterType((*AllocIDRsp)(nil), "rpcpb.AllocIDRsp")
-	proto.RegisterType((*AskSplitReq)(nil), "rpcpb.AskSplitReq")
-	proto.RegisterType((*AskSplitRsp)(nil), "rpcpb.AskSplitRsp")
-	proto.RegisterType((*ReportSplitReq)(nil), "rpcpb.ReportSplitReq")
-	proto.RegisterType((*ReportSplitRsp)(nil), "rpcpb.ReportSplitRsp")
-	proto.RegisterType((*AskBatchSplitReq)(nil), "rpcpb.AskBatchSplitReq")
-	proto.RegisterType((*AskBatchSplitRsp)(nil), "rpcpb.AskBatchSplitRsp")
-	proto.RegisterType((*BatchReportSplitReq)(nil), "rpcpb.BatchReportSplitReq")
-	proto.RegisterType((*BatchReportSplitRsp)(nil), "rpcpb.BatchReportSplitRsp")
-	proto.RegisterType((*SplitID)(nil), "rpcpb.SplitID")
-	proto.RegisterType((*CreateWatcherReq)(nil), "rpcpb.CreateWatcherReq")
-	proto.RegisterType((*CreateResourcesReq)(nil), "rpcpb.CreateResourcesReq")
-	proto.RegisterType((*CreateResourcesRsp)(nil), "rpcpb.CreateResourcesRsp")
-	proto.RegisterType((*RemoveResourcesReq)(nil), "rpcpb.RemoveResourcesReq")
-	proto.RegisterType((*RemoveResourcesRsp)(nil), "rpcpb.RemoveResourcesRsp")
-	proto.RegisterType((*CheckResourceStateReq)(nil), "rpcpb.CheckResourceStateReq")
-	proto.RegisterType((*CheckResourceStateRsp)(nil), "rpcpb.CheckResourceStateRsp")
-	proto.RegisterType((*PutPlacementRuleReq)(nil), "rpcpb.PutPlacementRuleReq")
-	proto.RegisterType((*PutPlacementRuleRsp)(nil), "rpcpb.PutPlacementRuleRsp")
-	proto.RegisterType((*GetAppliedRulesReq)(nil), "rpcpb.GetAppliedRulesReq")
-	proto.RegisterType((*GetAppliedRulesRsp)(nil), "rpcpb.GetAppliedRulesRsp")
-	proto.RegisterType((*CreateJobReq)(nil), "rpcpb.CreateJobReq")
-	proto.RegisterType((*CreateJobRsp)(nil), "rpcpb.CreateJobRsp")
-	proto.RegisterType((*RemoveJobReq)(nil), "rpcpb.RemoveJobReq")
-	proto.RegisterType((*RemoveJobRsp)(nil), "rpcpb.RemoveJobRsp")
-	proto.RegisterType((*ExecuteJobReq)(nil), "rpcpb.ExecuteJobReq")
-	proto.RegisterType((*ExecuteJobRsp)(nil), "rpcpb.ExecuteJobRsp")
-	proto.RegisterType((*EventNotify)(nil), "rpcpb.EventNotify")
-	proto.RegisterType((*InitEventData)(nil), "rpcpb.InitEventData")
-	proto.RegisterType((*ResourceEventData)(nil), "rpcpb.ResourceEventData")
-	proto.RegisterType((*ContainerEventData)(nil), "rpcpb.ContainerEventData")
-	proto.RegisterType((*ChangePeer)(nil), "rpcpb.ChangePeer")
-	proto.RegisterType((*TransferLeader)(nil), "rpcpb.TransferLeader")
-	proto.RegisterType((*ChangePeerV2)(nil), "rpcpb.ChangePeerV2")
-	proto.RegisterType((*Merge)(nil), "rpcpb.Merge")
-	proto.RegisterType((*SplitResource)(nil), "rpcpb.SplitResource")
-	proto.RegisterType((*LabelConstraint)(nil), "rpcpb.LabelConstraint")
-	proto.RegisterType((*PlacementRule)(nil), "rpcpb.PlacementRule")
+func (m *EventNotify) Reset()         { *m = EventNotify{} }
+func (m *EventNotify) String() string { return proto.CompactTextString(m) }
+func (*EventNotify) ProtoMessage()    {}
+func (*EventNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{58}
+}
+func (m *EventNotify) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNotify.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNotify.Merge(m, src)
+}
+func (m *EventNotify) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNotify.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("rpcpb.proto", fileDescriptor_25e491924c678914) }
+var xxx_messageInfo_EventNotify proto.InternalMessageInfo
 
-var fileDescriptor_25e491924c678914 = []byte{
-	// 2369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4b, 0x73, 0xdc, 0xc6,
-	0x11, 0xd6, 0xbe, 0x77, 0x7b, 0x1f, 0x1c, 0xce, 0x92, 0x14, 0x44, 0xc9, 0x24, 0x0d, 0xa9, 0x14,
-	0x46, 0x76, 0xc8, 0x88, 0x72, 0xec, 0x94, 0x2a, 0x4a, 0x4c, 0x8a, 0xb4, 0x45, 0x45, 0x91, 0x59,
-	0x90, 0x4a, 0x39, 0xa6, 0xb0, 0xbb, 0xa3, 0x25, 0x42, 0x10, 0x18, 0x61, 0x66, 0x25, 0xf1, 0x96,
-	0x9f, 0x94, 0x53, 0x8e, 0x39, 0xe5, 0xe0, 0xa3, 0x7f, 0x40, 0x4a, 0x95, 0xf0, 0x6f, 0xe4, 0x92,
-	0x9a, 0x07, 0x80, 0xc1, 0x63, 0x97, 0xf4, 0x89, 0x98, 0xee, 0xfe, 0xbe, 0x99, 0x69, 0x0c, 0xbe,
-	0xe9, 0x5e, 0x42, 0x37, 0xa2, 0x63, 0x3a, 0xda, 0xa1, 0x51, 0xc8, 0x43, 0xdc, 0x90, 0x83, 0xf5,
-	0x17, 0x53, 0x8f, 0x9f, 0xce, 0x46, 0x3b, 0xe3, 0xf0, 0x7c, 0xf7, 0xdc, 0xe5, 0x91, 0xf7, 0x31,
-	0x8c, 0xbc, 0xa9, 0x17, 0xe8, 0xc1, 0x78, 0x36, 0x22, 0xbb, 0xe3, 0xf0, 0x9c, 0x86, 0x01, 0x09,
-	0x38, 0xdb, 0xa5, 0x51, 0x48, 0x4f, 0x09, 0xdf, 0xa5, 0xa3, 0xdd, 0x73, 0xc2, 0xdd, 0xe4, 0x8f,
-	0x22, 0x5d, 0xff, 0x95, 0xc1, 0x36, 0x0d, 0xa7, 0xe1, 0xae, 0x34, 0x8f, 0x66, 0x6f, 0xe5, 0x48,
-	0x0e, 0xe4, 0x93, 0x0a, 0xb7, 0xff, 0x09, 0xd0, 0x72, 0xc8, 0xbb, 0x19, 0x61, 0x1c, 0xaf, 0x41,
-	0xd5, 0x9b, 0x58, 0x95, 0xad, 0xca, 0x76, 0xfd, 0xa0, 0x79, 0xf9, 0x69, 0xb3, 0x7a, 0x7c, 0xe8,
-	0x54, 0xbd, 0x09, 0xde, 0x82, 0xee, 0x38, 0x0c, 0xb8, 0xeb, 0x05, 0x24, 0x3a, 0x3e, 0xb4, 0xaa,
-	0x22, 0xc0, 0x31, 0x4d, 0x78, 0x13, 0xea, 0xfc, 0x82, 0x12, 0xab, 0xb6, 0x55, 0xd9, 0x1e, 0xec,
-	0x75, 0x77, 0xd4, 0x2e, 0x5f, 0x5f, 0x50, 0xe2, 0x48, 0x07, 0xfe, 0x01, 0x96, 0x23, 0xc2, 0xc2,
-	0x59, 0x34, 0x26, 0xcf, 0x88, 0x1b, 0xf1, 0x11, 0x71, 0xb9, 0x55, 0xdf, 0xaa, 0x6c, 0x77, 0xf7,
-	0x6e, 0xeb, 0x68, 0x27, 0xef, 0x77, 0xc8, 0xbb, 0x83, 0xfa, 0x8f, 0x9f, 0x36, 0x6f, 0x38, 0x45,
-	0x2c, 0x76, 0x00, 0x27, 0x0b, 0x48, 0x19, 0x1b, 0x92, 0xf1, 0x8e, 0x66, 0x7c, 0x5a, 0x08, 0x48,
-	0x29, 0x4b, 0xd0, 0xf8, 0x5b, 0xe8, 0xd1, 0x19, 0x4f, 0x50, 0x56, 0x53, 0xb2, 0xad, 0x69, 0xb6,
-	0x13, 0xc3, 0x95, 0xf2, 0x64, 0x10, 0x82, 0x61, 0x4a, 0x0c, 0x86, 0x56, 0x86, 0xe1, 0x7b, 0x52,
-	0xca, 0x60, 0x22, 0xf0, 0x43, 0x68, 0xb9, 0xbe, 0x1f, 0x8e, 0x8f, 0x0f, 0xad, 0xb6, 0x04, 0x2f,
-	0x6b, 0xf0, 0xbe, 0xb2, 0xa6, 0xb8, 0x38, 0x0e, 0x7f, 0x05, 0x6d, 0x97, 0x9d, 0xbd, 0xa2, 0xbe,
-	0xc7, 0xad, 0x8e, 0xc4, 0xe0, 0x18, 0xa3, 0xcd, 0x29, 0x28, 0x89, 0xc4, 0x4f, 0xa1, 0xef, 0xb2,
-	0xb3, 0x03, 0x97, 0x8f, 0x4f, 0x15, 0x14, 0x24, 0xf4, 0x66, 0x0a, 0x4d, 0x7d, 0x29, 0x3e, 0x8b,
-	0xc1, 0x4f, 0xa0, 0x1b, 0x11, 0x1a, 0x46, 0x5c, 0x51, 0x74, 0x25, 0xc5, 0x6a, 0xf2, 0x42, 0x13,
-	0x4f, 0x4a, 0x60, 0xc6, 0xe3, 0x17, 0x80, 0x46, 0x82, 0xcc, 0x88, 0xb4, 0x7a, 0x92, 0x63, 0x5d,
-	0x73, 0x1c, 0xe4, 0xdc, 0x29, 0x51, 0x01, 0x29, 0x76, 0x34, 0x8e, 0x88, 0xcb, 0xc9, 0x9f, 0x85,
-	0x87, 0x44, 0x56, 0x3f, 0xb3, 0xa3, 0xa7, 0xa6, 0xcf, 0xd8, 0x51, 0x06, 0x83, 0x8f, 0x61, 0x49,
-	0x19, 0xe2, 0xe3, 0xc8, 0xac, 0x81, 0xa4, 0xb9, 0x95, 0xa1, 0x49, 0xbc, 0x29, 0x51, 0x1e, 0x27,
-	0xa8, 0x22, 0x72, 0x1e, 0xbe, 0x37, 0xa8, 0x96, 0x32, 0x54, 0x4e, 0xd6, 0x6b, 0x50, 0xe5, 0x70,
-	0xf2, 0xb4, 0x9f, 0x92, 0xf1, 0x59, 0x6c, 0x79, 0xc5, 0x5d, 0x4e, 0x2c, 0x94, 0x3d, 0xed, 0x85,
-	0x00, 0xf3, 0xb4, 0x17, 0x9c, 0x22, 0xf9, 0x74, 0xc6, 0x4f, 0x7c, 0x77, 0x4c, 0xce, 0x49, 0xc0,
-	0x9d, 0x99, 0x4f, 0xac, 0xe5, 0x4c, 0xf2, 0x4f, 0x72, 0x6e, 0x23, 0xf9, 0x79, 0xa4, 0xd8, 0xec,
-	0x94, 0xf0, 0x7d, 0x4a, 0x7d, 0x8f, 0x4c, 0x84, 0x85, 0x59, 0x38, 0xb3, 0xd9, 0xef, 0xb3, 0x5e,
-	0x63, 0xb3, 0x39, 0x1c, 0xfe, 0x06, 0x3a, 0x2a, 0x95, 0xcf, 0xc3, 0x91, 0x35, 0x94, 0x24, 0xc3,
-	0x4c, 0xf2, 0x9f, 0x87, 0xa3, 0x14, 0x9e, 0xc6, 0x0a, 0xa0, 0x4a, 0x9c, 0x00, 0xae, 0x64, 0x80,
-	0x4e, 0x6c, 0x37, 0x80, 0x49, 0x2c, 0x7e, 0x0c, 0x40, 0x3e, 0x92, 0xf1, 0x4c, 0x4d, 0xb9, 0x2a,
-	0x91, 0x2b, 0x1a, 0x79, 0x94, 0x38, 0x52, 0xa8, 0x11, 0x6d, 0xff, 0x03, 0xa0, 0xed, 0x10, 0x46,
-	0xc3, 0x80, 0x91, 0xb9, 0x0a, 0x1a, 0xeb, 0x63, 0x75, 0x9e, 0x3e, 0xae, 0x40, 0x83, 0x44, 0x51,
-	0x18, 0x49, 0x05, 0xed, 0x38, 0x6a, 0x80, 0xd7, 0xa0, 0xe9, 0x13, 0x77, 0x42, 0x22, 0x29, 0x95,
-	0x1d, 0x47, 0x8f, 0xca, 0xd5, 0xb4, 0x71, 0x85, 0x9a, 0x32, 0xfa, 0x73, 0xd5, 0xb4, 0x79, 0x95,
-	0x9a, 0x26, 0x94, 0xd7, 0x51, 0xd3, 0xd6, 0x7c, 0x35, 0x4d, 0x78, 0x16, 0xab, 0x69, 0x7b, 0xbe,
-	0x9a, 0xa6, 0x0c, 0xf3, 0xd4, 0xb4, 0x53, 0xaa, 0xa6, 0x09, 0xae, 0x54, 0x4d, 0xa1, 0x5c, 0x4d,
-	0x13, 0xd0, 0x02, 0x35, 0xed, 0x2e, 0x50, 0xd3, 0x04, 0xbf, 0x58, 0x4d, 0x7b, 0x73, 0xd5, 0x34,
-	0x21, 0xb8, 0x52, 0x4d, 0xfb, 0x8b, 0xd5, 0x34, 0x21, 0x2a, 0xaa, 0xe9, 0x0e, 0x34, 0xc8, 0x7b,
-	0x12, 0x70, 0x2d, 0x7f, 0x71, 0x12, 0x8e, 0x84, 0xed, 0x65, 0xc8, 0xbd, 0xb7, 0x17, 0x1a, 0xaa,
-	0xc2, 0xca, 0x84, 0x73, 0x69, 0xa1, 0x70, 0x26, 0x73, 0x5f, 0x47, 0x38, 0xd1, 0x42, 0xe1, 0x4c,
-	0xa9, 0xae, 0x27, 0x9c, 0xcb, 0x57, 0x09, 0xa7, 0x71, 0xb0, 0xaf, 0x27, 0x9c, 0x78, 0xb1, 0x70,
-	0xa6, 0x79, 0xbe, 0x8e, 0x70, 0x0e, 0x17, 0x0a, 0x67, 0xba, 0xd9, 0x85, 0xc2, 0xb9, 0x32, 0x47,
-	0x38, 0x13, 0xf8, 0x3c, 0xe1, 0x5c, 0x9d, 0x23, 0x9c, 0x29, 0x70, 0x9e, 0x70, 0xae, 0xcd, 0x13,
-	0xce, 0x04, 0x6a, 0x0a, 0xe7, 0xdf, 0xab, 0xb0, 0x52, 0x56, 0xf3, 0xe5, 0xcb, 0xcd, 0x4a, 0xb1,
-	0xdc, 0x5c, 0x87, 0x76, 0xac, 0x61, 0x52, 0x52, 0x7b, 0x4e, 0x32, 0xc6, 0x18, 0xea, 0x9c, 0x44,
-	0xe7, 0x52, 0x48, 0xeb, 0x8e, 0x7c, 0xc6, 0xf7, 0x32, 0x3a, 0xda, 0xdd, 0xeb, 0xed, 0xe8, 0x92,
-	0xf9, 0x84, 0x90, 0x28, 0x51, 0xd5, 0xdf, 0x40, 0x67, 0x12, 0x7e, 0x08, 0x84, 0x8d, 0x59, 0x8d,
-	0xad, 0x9a, 0x94, 0x0b, 0x23, 0x50, 0xbc, 0x7d, 0x16, 0xe7, 0x20, 0x89, 0xc4, 0x5f, 0x43, 0x8f,
-	0x92, 0x60, 0xe2, 0x05, 0x53, 0x85, 0x6c, 0x4a, 0x64, 0x66, 0x8a, 0x44, 0xdd, 0x8c, 0x38, 0xfc,
-	0x10, 0x1a, 0x4c, 0x30, 0x6a, 0x61, 0x5c, 0x8d, 0x01, 0xe6, 0x61, 0x8b, 0xa7, 0x53, 0x91, 0xf6,
-	0xbf, 0x6b, 0x65, 0x29, 0x63, 0x14, 0x6f, 0x00, 0xc4, 0x09, 0x48, 0x32, 0x66, 0x58, 0xf0, 0x3e,
-	0xf4, 0xe3, 0xd1, 0x11, 0x0d, 0xc7, 0xa7, 0x32, 0x6b, 0x25, 0x73, 0x4a, 0x67, 0x2c, 0x4e, 0x19,
-	0x04, 0xfe, 0x12, 0x80, 0xbb, 0xd1, 0x94, 0x70, 0xb1, 0x7a, 0x99, 0xdd, 0x7c, 0x1e, 0x0d, 0x3f,
-	0x7e, 0x08, 0x30, 0x3e, 0x75, 0x83, 0x29, 0x91, 0xd1, 0xf5, 0x8c, 0xf6, 0x3e, 0x4d, 0x1c, 0x8e,
-	0x11, 0x84, 0x9f, 0xc0, 0x80, 0x47, 0x6e, 0xc0, 0xde, 0x92, 0xe8, 0x85, 0x7a, 0x59, 0x8d, 0x8c,
-	0x00, 0xbe, 0xce, 0x38, 0x9d, 0x5c, 0x30, 0xb6, 0xa1, 0x71, 0x4e, 0xa2, 0x29, 0xd1, 0xb7, 0x56,
-	0x4f, 0xa3, 0xfe, 0x24, 0x6c, 0x8e, 0x72, 0xe1, 0xc7, 0xd0, 0x67, 0xaa, 0x8a, 0xd4, 0x87, 0xa7,
-	0x95, 0x39, 0xb1, 0xaf, 0x4c, 0x9f, 0x93, 0x0d, 0xc5, 0xdf, 0x40, 0x2f, 0x5d, 0xec, 0x9b, 0x3d,
-	0x7d, 0x19, 0x0d, 0x0b, 0x7b, 0x7a, 0xb3, 0xe7, 0x64, 0x02, 0xf1, 0x36, 0x2c, 0x4d, 0x08, 0xe3,
-	0x61, 0x74, 0x71, 0xe8, 0x45, 0x64, 0xcc, 0xfd, 0x0b, 0x79, 0x17, 0xb5, 0x9d, 0xbc, 0xd9, 0xde,
-	0x85, 0xa5, 0x5c, 0x93, 0x81, 0xef, 0x40, 0x27, 0x39, 0xf8, 0xf2, 0xbd, 0xf6, 0x9c, 0xd4, 0x60,
-	0x2f, 0xe7, 0x00, 0x8c, 0xda, 0x7f, 0x81, 0xd5, 0xd2, 0xb6, 0x07, 0xef, 0xc5, 0xc7, 0xad, 0xa2,
-	0x6f, 0x51, 0xfd, 0xea, 0x92, 0xe8, 0xe2, 0x79, 0x13, 0xdf, 0xd2, 0xc4, 0xe5, 0xae, 0xfe, 0xc6,
-	0xe4, 0xb3, 0xfd, 0x45, 0xe9, 0x04, 0x8c, 0x26, 0xc1, 0x15, 0x23, 0xf8, 0x97, 0xb0, 0x94, 0x6b,
-	0x7a, 0xe6, 0x95, 0x48, 0xf6, 0xab, 0x5c, 0x68, 0x39, 0x23, 0xfe, 0x32, 0xde, 0x46, 0x75, 0xd1,
-	0x36, 0xe2, 0x0f, 0xa6, 0x07, 0x90, 0xf6, 0x4d, 0xf6, 0xbd, 0x74, 0xc4, 0xe8, 0xdc, 0x85, 0x7c,
-	0x0e, 0x5d, 0xa3, 0x6f, 0x2a, 0xdd, 0xd6, 0x13, 0x23, 0x84, 0x51, 0xbc, 0x03, 0x2d, 0x79, 0x56,
-	0xf4, 0xa7, 0xd7, 0xdd, 0x1b, 0x98, 0x07, 0xea, 0xf8, 0x30, 0x2e, 0x31, 0x74, 0x90, 0xfd, 0x18,
-	0x06, 0xd9, 0x96, 0x46, 0x4c, 0xe2, 0x93, 0xb7, 0x3c, 0x9e, 0x44, 0x3c, 0x8b, 0x92, 0x30, 0xf2,
-	0xa6, 0xa7, 0x5c, 0x67, 0x5f, 0x0d, 0x6c, 0x94, 0xc5, 0x32, 0x6a, 0xff, 0x0e, 0x50, 0xbe, 0x59,
-	0x2b, 0xcd, 0xdc, 0x0a, 0x34, 0xc6, 0xe1, 0x2c, 0x50, 0x7c, 0x7d, 0x47, 0x0d, 0xec, 0xc3, 0x3c,
-	0x9a, 0x51, 0xfc, 0x6b, 0x68, 0xeb, 0xa5, 0x8a, 0xd3, 0x52, 0x9b, 0xbb, 0xa1, 0x24, 0xca, 0x7e,
-	0x04, 0xc3, 0x92, 0x4e, 0x4d, 0x9c, 0xde, 0x28, 0xb9, 0xc2, 0x05, 0x53, 0xcf, 0x49, 0x0d, 0xf6,
-	0x6a, 0x09, 0x88, 0x51, 0xfb, 0x0f, 0xd0, 0xd2, 0xd3, 0x88, 0x25, 0x07, 0xe4, 0x43, 0xa2, 0x68,
-	0x6a, 0x20, 0xc4, 0x2e, 0x20, 0x1f, 0xc4, 0xd7, 0x25, 0x16, 0x58, 0xdd, 0xaa, 0x09, 0xb1, 0x4b,
-	0x2d, 0xf6, 0x7d, 0x40, 0xf9, 0x5e, 0x4f, 0x24, 0xe4, 0xad, 0xef, 0x4e, 0x25, 0x51, 0xdf, 0x91,
-	0xcf, 0xb6, 0x03, 0xb8, 0xd8, 0xcc, 0x2d, 0x5e, 0xb3, 0x98, 0xdb, 0x27, 0x2e, 0xe3, 0x4a, 0xea,
-	0xf5, 0xdc, 0xa9, 0xc5, 0x5e, 0x29, 0x72, 0x32, 0x6a, 0xef, 0x02, 0x2e, 0xf6, 0x7a, 0xf8, 0x16,
-	0xd4, 0xbc, 0x89, 0x9a, 0xa3, 0x7e, 0xd0, 0xba, 0xfc, 0xb4, 0x59, 0x3b, 0x3e, 0x64, 0x8e, 0xb0,
-	0x09, 0x9a, 0x62, 0x8d, 0x63, 0xef, 0xc1, 0x6a, 0x69, 0x93, 0x97, 0x32, 0x55, 0xb6, 0x7b, 0x39,
-	0xa6, 0x87, 0xa5, 0x18, 0x46, 0xb1, 0x05, 0x2d, 0x75, 0x8f, 0x4f, 0xd4, 0x0a, 0x9c, 0x78, 0x68,
-	0x1f, 0xc1, 0xb0, 0xa4, 0xf3, 0xc3, 0x3b, 0x50, 0x8f, 0x44, 0xa9, 0x53, 0xc9, 0x68, 0x66, 0x26,
-	0x4c, 0x9f, 0x0b, 0x19, 0x27, 0x5e, 0x6f, 0x49, 0x1d, 0x64, 0x7f, 0x05, 0xb8, 0xd8, 0x0a, 0x5e,
-	0x75, 0x81, 0xd9, 0xdf, 0x15, 0x51, 0xf2, 0xa0, 0x36, 0x22, 0x59, 0x31, 0xa9, 0x53, 0xba, 0x68,
-	0x4d, 0x2a, 0xd0, 0x7e, 0x04, 0x3d, 0xb3, 0x87, 0xc4, 0x77, 0xa1, 0xf6, 0xd7, 0x70, 0xa4, 0xf7,
-	0xd4, 0x8d, 0xc5, 0xe4, 0x79, 0x38, 0xd2, 0x30, 0xe1, 0xb5, 0x07, 0x26, 0x88, 0x51, 0x41, 0x62,
-	0xf6, 0x93, 0xd7, 0x26, 0x31, 0x6b, 0x29, 0xfb, 0x19, 0xf4, 0x33, 0xad, 0xe5, 0xb5, 0x58, 0x4a,
-	0x15, 0xf9, 0x6e, 0x86, 0x69, 0x8e, 0x12, 0xff, 0xaf, 0x0a, 0x5d, 0xa3, 0x76, 0xc7, 0x08, 0x6a,
-	0x8c, 0xbc, 0xd3, 0x99, 0x16, 0x8f, 0xb2, 0x70, 0x8a, 0x7b, 0xd4, 0xbe, 0x6e, 0x4b, 0xf7, 0xa0,
-	0xe3, 0x05, 0x1e, 0x97, 0x40, 0x7d, 0xe7, 0xc7, 0x49, 0x3e, 0x8e, 0xed, 0x87, 0x2e, 0x77, 0x9d,
-	0x34, 0x0c, 0xff, 0xde, 0xa8, 0x35, 0x24, 0x4e, 0xdd, 0xfe, 0x56, 0xae, 0x31, 0x4d, 0xb1, 0xd9,
-	0x70, 0xbc, 0x0f, 0x83, 0xe4, 0x86, 0x53, 0x04, 0x8d, 0x6c, 0x1f, 0x91, 0x71, 0x4a, 0x86, 0x1c,
-	0x00, 0x1f, 0x01, 0x8e, 0xcc, 0x2a, 0x4a, 0xd1, 0x34, 0x17, 0xd4, 0x59, 0x4e, 0x09, 0x00, 0x3f,
-	0x83, 0xe1, 0x38, 0x73, 0xad, 0x28, 0x9e, 0xd6, 0xc2, 0x9b, 0xa7, 0x0c, 0x62, 0x4f, 0xa1, 0x9f,
-	0xc9, 0xd7, 0x15, 0x2a, 0x63, 0x41, 0x4b, 0xd5, 0xa4, 0xb1, 0xc4, 0xc4, 0x43, 0xf1, 0x9d, 0x24,
-	0xfc, 0xcc, 0xaa, 0x49, 0xa0, 0x61, 0xb1, 0xdf, 0xc1, 0x72, 0x21, 0xc1, 0xa5, 0xb7, 0x41, 0xfa,
-	0xd3, 0x82, 0xfa, 0x39, 0x37, 0x2e, 0x82, 0x0d, 0x59, 0xa8, 0xc9, 0x2a, 0x25, 0x1e, 0x0a, 0x84,
-	0xea, 0x18, 0xe4, 0x0b, 0x6d, 0x3b, 0x7a, 0x64, 0x6f, 0x03, 0x2e, 0xbe, 0x92, 0xd2, 0x33, 0xe8,
-	0x03, 0xa4, 0x75, 0x12, 0xbe, 0x0f, 0x75, 0x4a, 0x74, 0x55, 0x53, 0x5e, 0x2f, 0x4b, 0x3f, 0xfe,
-	0x3a, 0x2e, 0x25, 0x5f, 0xa7, 0xbf, 0xa0, 0xa4, 0xc9, 0x4f, 0xf8, 0xe4, 0x8f, 0x29, 0x46, 0xa4,
-	0xfd, 0x5b, 0x18, 0x64, 0x4b, 0xc6, 0xeb, 0xce, 0x68, 0xef, 0x43, 0xcf, 0xac, 0xe7, 0xf0, 0x43,
-	0x68, 0x29, 0xde, 0x58, 0x68, 0x8a, 0x95, 0x6c, 0x7c, 0xc5, 0xeb, 0x38, 0x7b, 0x13, 0x1a, 0xb2,
-	0xf2, 0x14, 0x59, 0x53, 0x65, 0xb1, 0xce, 0x84, 0x1e, 0xd9, 0x27, 0xd0, 0xcf, 0x94, 0x9b, 0xf8,
-	0x0b, 0x68, 0xd2, 0xd0, 0xf7, 0xc6, 0x17, 0x32, 0x70, 0xb0, 0x37, 0x4c, 0xb7, 0x48, 0xc6, 0x67,
-	0x27, 0xd2, 0xe5, 0xe8, 0x10, 0x91, 0xdd, 0x33, 0x72, 0xa1, 0x4e, 0x47, 0xcf, 0x91, 0xcf, 0x36,
-	0x81, 0xa5, 0x17, 0xee, 0x88, 0xf8, 0x4f, 0xc3, 0x80, 0xf1, 0xc8, 0xf5, 0x02, 0x2e, 0x3e, 0xf2,
-	0x33, 0xa2, 0x08, 0x3b, 0x8e, 0x78, 0xc4, 0xdb, 0x50, 0x0d, 0xa9, 0x4e, 0x62, 0xfc, 0x45, 0xe6,
-	0x50, 0x3f, 0x50, 0xa7, 0x1a, 0x8a, 0xf2, 0xa8, 0xf9, 0xde, 0xf5, 0x67, 0x44, 0x9d, 0xb2, 0x8e,
-	0xa3, 0x47, 0xf6, 0xdf, 0x6a, 0xd0, 0xcf, 0x76, 0xb0, 0x69, 0x21, 0xd5, 0xc9, 0xfc, 0xe8, 0x65,
-	0x41, 0x6b, 0x1a, 0x85, 0x33, 0xaa, 0xff, 0x65, 0xd0, 0x71, 0xe2, 0xa1, 0xb8, 0xd7, 0xbd, 0x60,
-	0x42, 0x3e, 0xca, 0x23, 0xd6, 0x77, 0xd4, 0x40, 0x74, 0x75, 0xe1, 0x7b, 0x12, 0x45, 0xde, 0x24,
-	0x3e, 0x62, 0xc9, 0x58, 0xf8, 0x18, 0x77, 0x23, 0xfe, 0x47, 0x72, 0x21, 0xe5, 0xa0, 0xe7, 0x24,
-	0x63, 0xb1, 0x52, 0x12, 0x4c, 0x84, 0xa7, 0xa9, 0x52, 0xac, 0x46, 0xf8, 0x17, 0x50, 0x8f, 0x42,
-	0x5f, 0x15, 0xf9, 0x83, 0xa4, 0x52, 0x97, 0x7d, 0x47, 0xe8, 0x13, 0xf5, 0xe3, 0x9b, 0x08, 0x48,
-	0x2b, 0xa3, 0xb6, 0x51, 0x19, 0xe1, 0x67, 0x80, 0xfc, 0x6c, 0x66, 0x98, 0xd5, 0x91, 0xaf, 0x7f,
-	0xad, 0x3c, 0x71, 0x71, 0x8b, 0x9f, 0x47, 0xe1, 0xfb, 0x30, 0xf0, 0xc3, 0xb1, 0xcb, 0xbd, 0x30,
-	0x90, 0x10, 0x66, 0x81, 0x4c, 0x69, 0xce, 0x2a, 0xe2, 0x3c, 0x16, 0xfa, 0xca, 0x44, 0xde, 0x13,
-	0x5f, 0xfe, 0x8a, 0xd4, 0x71, 0x72, 0xd6, 0x07, 0xff, 0x6a, 0x41, 0x5d, 0x2c, 0x1f, 0xdf, 0x82,
-	0x55, 0xb9, 0x0d, 0x32, 0xf5, 0x18, 0x27, 0x51, 0xf2, 0x19, 0xa2, 0x1b, 0xf8, 0x0e, 0x58, 0xca,
-	0x55, 0x6c, 0xb0, 0x51, 0x65, 0xbe, 0x97, 0x51, 0x54, 0xc5, 0x9f, 0xc1, 0x2d, 0xe1, 0x2d, 0xed,
-	0x23, 0x50, 0x6d, 0x81, 0x9b, 0x51, 0x54, 0xc7, 0x37, 0x61, 0x28, 0xdc, 0xb9, 0x4e, 0x06, 0x35,
-	0x4a, 0x1d, 0x8c, 0xa2, 0x66, 0xec, 0xc8, 0x75, 0x0a, 0xa8, 0x55, 0xea, 0x60, 0x14, 0xb5, 0x31,
-	0x86, 0x81, 0x70, 0xa4, 0xb5, 0x3d, 0xea, 0xe4, 0x6d, 0x8c, 0x22, 0xc0, 0x43, 0x58, 0x92, 0xb6,
-	0xb4, 0x9e, 0x47, 0xdd, 0x82, 0x91, 0x51, 0xd4, 0xc3, 0x16, 0xac, 0x68, 0x63, 0xa6, 0x92, 0x46,
-	0xfd, 0x72, 0x0f, 0xa3, 0x68, 0x80, 0xd7, 0x00, 0xab, 0x2c, 0x9a, 0x45, 0x2f, 0x5a, 0x2a, 0xb3,
-	0x33, 0x8a, 0x10, 0xbe, 0x0d, 0x37, 0x85, 0xbd, 0xa4, 0x52, 0x46, 0xcb, 0x73, 0x9d, 0x8c, 0x22,
-	0x1c, 0xaf, 0x21, 0x5f, 0xd6, 0xa2, 0x61, 0xbc, 0x19, 0xe3, 0x6a, 0x47, 0x2b, 0x78, 0x1d, 0xd6,
-	0xd2, 0x70, 0xb3, 0xe6, 0x44, 0xab, 0xf3, 0x7c, 0x8c, 0xa2, 0xb5, 0xd8, 0x57, 0xac, 0x55, 0xd1,
-	0xcd, 0x79, 0x3e, 0x46, 0x91, 0x95, 0x9c, 0x88, 0xb2, 0xe2, 0x14, 0xdd, 0x5a, 0xe0, 0x66, 0x14,
-	0xad, 0xc7, 0x3b, 0x2f, 0xa9, 0x39, 0xd1, 0xed, 0xb9, 0x4e, 0x46, 0xd1, 0x9d, 0x78, 0x4d, 0xc5,
-	0x7a, 0x12, 0x7d, 0x36, 0xcf, 0xc7, 0x28, 0xda, 0xc0, 0x2b, 0x80, 0xd2, 0x1c, 0xa8, 0xf2, 0x0b,
-	0x6d, 0x16, 0xad, 0x8c, 0xa2, 0xad, 0xd8, 0x6a, 0x16, 0x7c, 0xe8, 0xf3, 0xa2, 0x95, 0x51, 0x64,
-	0xe3, 0x55, 0x58, 0x96, 0x2f, 0xc3, 0xac, 0xeb, 0xd0, 0xdd, 0x12, 0x33, 0xa3, 0xe8, 0xde, 0x83,
-	0x6f, 0xa1, 0x67, 0x8a, 0x11, 0xee, 0x40, 0xe3, 0x4d, 0xc8, 0xe5, 0xd7, 0x0b, 0xd0, 0x54, 0x77,
-	0x16, 0xaa, 0xe0, 0x1e, 0xb4, 0xbf, 0x0b, 0x7d, 0x3f, 0xfc, 0x40, 0x22, 0x54, 0xc5, 0x5d, 0x68,
-	0xbd, 0x20, 0x6e, 0x24, 0x3e, 0xf2, 0xda, 0x83, 0x7d, 0x58, 0x2e, 0x88, 0x37, 0x6e, 0x42, 0xf5,
-	0x38, 0x40, 0x37, 0x04, 0xdd, 0xcb, 0x90, 0x1f, 0x07, 0xa8, 0x22, 0xe8, 0x8e, 0x3e, 0x7a, 0x8c,
-	0x33, 0x54, 0xc5, 0x7d, 0xe8, 0xbc, 0x0c, 0xb9, 0x1e, 0xd6, 0x0e, 0xd0, 0x4f, 0xff, 0xdd, 0xb8,
-	0xf1, 0xe3, 0xe5, 0x46, 0xe5, 0xa7, 0xcb, 0x8d, 0xca, 0x7f, 0x2e, 0x37, 0x2a, 0xa3, 0xa6, 0xfc,
-	0xc7, 0xf0, 0xa3, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x54, 0x9b, 0xd7, 0xd0, 0xab, 0x1e, 0x00,
-	0x00,
+func (m *EventNotify) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *EventNotify) GetType() uint32 {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
-	}
-	if m.ContainerID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
-	}
-	if m.Type != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceHeartbeat.Size()))
-	n1, err := m.ResourceHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n1
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerHeartbeat.Size()))
-	n2, err := m.ContainerHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n2
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutContainer.Size()))
-	n3, err := m.PutContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n3
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetContainer.Size()))
-	n4, err := m.GetContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n4
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AllocID.Size()))
-	n5, err := m.AllocID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n5
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskSplit.Size()))
-	n6, err := m.AskSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n6
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskBatchSplit.Size()))
-	n7, err := m.AskBatchSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n7
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ReportSplit.Size()))
-	n8, err := m.ReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n8
-	dAtA[i] = 0x62
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.BatchReportSplit.Size()))
-	n9, err := m.BatchReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n9
-	dAtA[i] = 0x6a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateWatcher.Size()))
-	n10, err := m.CreateWatcher.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n10
-	dAtA[i] = 0x72
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateResources.Size()))
-	n11, err := m.CreateResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n11
-	dAtA[i] = 0x7a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveResources.Size()))
-	n12, err := m.RemoveResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n12
-	dAtA[i] = 0x82
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CheckResourceState.Size()))
-	n13, err := m.CheckResourceState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n13
-	dAtA[i] = 0x8a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutPlacementRule.Size()))
-	n14, err := m.PutPlacementRule.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n14
-	dAtA[i] = 0x92
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetAppliedRules.Size()))
-	n15, err := m.GetAppliedRules.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n15
-	dAtA[i] = 0x9a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateJob.Size()))
-	n16, err := m.CreateJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (m *EventNotify) GetInitEvent() *InitEventData {
+	if m != nil {
+		return m.InitEvent
 	}
-	i += n16
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveJob.Size()))
-	n17, err := m.RemoveJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *EventNotify) GetResourceEvent() *ResourceEventData {
+	if m != nil {
+		return m.ResourceEvent
 	}
-	i += n17
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ExecuteJob.Size()))
-	n18, err := m.ExecuteJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *EventNotify) GetContainerEvent() *ContainerEventData {
+	if m != nil {
+		return m.ContainerEvent
 	}
-	i += n18
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *EventNotify) GetResourceStatsEvent() *metapb.ResourceStats {
+	if m != nil {
+		return m.ResourceStatsEvent
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *EventNotify) GetContainerStatsEvent() *metapb.ContainerStats {
+	if m != nil {
+		return m.ContainerStatsEvent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+// InitEventData init event data
+type InitEventData struct {
+	Resources            [][]byte `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Leaders              []uint64 `protobuf:"varint,2,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`
+	Containers           [][]byte `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitEventData) Reset()         { *m = InitEventData{} }
+func (m *InitEventData) String() string { return proto.CompactTextString(m) }
+func (*InitEventData) ProtoMessage()    {}
+func (*InitEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{59}
+}
+func (m *InitEventData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitEventData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
-	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
-	}
-	if len(m.Leader) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Leader)))
-		i += copy(dAtA[i:], m.Leader)
-	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceHeartbeat.Size()))
-	n19, err := m.ResourceHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n19
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerHeartbeat.Size()))
-	n20, err := m.ContainerHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n20
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutContainer.Size()))
-	n21, err := m.PutContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n21
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetContainer.Size()))
-	n22, err := m.GetContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n22
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AllocID.Size()))
-	n23, err := m.AllocID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n23
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskSplit.Size()))
-	n24, err := m.AskSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n24
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskBatchSplit.Size()))
-	n25, err := m.AskBatchSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n25
-	dAtA[i] = 0x62
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ReportSplit.Size()))
-	n26, err := m.ReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n26
-	dAtA[i] = 0x6a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.BatchReportSplit.Size()))
-	n27, err := m.BatchReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n27
-	dAtA[i] = 0x72
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Event.Size()))
-	n28, err := m.Event.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n28
-	dAtA[i] = 0x7a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateResources.Size()))
-	n29, err := m.CreateResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n29
-	dAtA[i] = 0x82
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveResources.Size()))
-	n30, err := m.RemoveResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n30
-	dAtA[i] = 0x8a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CheckResourceState.Size()))
-	n31, err := m.CheckResourceState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n31
-	dAtA[i] = 0x92
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutPlacementRule.Size()))
-	n32, err := m.PutPlacementRule.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n32
-	dAtA[i] = 0x9a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetAppliedRules.Size()))
-	n33, err := m.GetAppliedRules.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n33
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateJob.Size()))
-	n34, err := m.CreateJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n34
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveJob.Size()))
-	n35, err := m.RemoveJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n35
-	dAtA[i] = 0xb2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ExecuteJob.Size()))
-	n36, err := m.ExecuteJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n36
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *InitEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitEventData.Merge(m, src)
+}
+func (m *InitEventData) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitEventData.DiscardUnknown(m)
 }
 
-func (m *ResourceHeartbeatReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InitEventData proto.InternalMessageInfo
+
+func (m *InitEventData) GetResources() [][]byte {
+	if m != nil {
+		return m.Resources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResourceHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ContainerID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
-	}
-	if len(m.Resource) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resource)))
-		i += copy(dAtA[i:], m.Resource)
+func (m *InitEventData) GetLeaders() []uint64 {
+	if m != nil {
+		return m.Leaders
 	}
-	if m.Term != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Term))
+	return nil
+}
+
+func (m *InitEventData) GetContainers() [][]byte {
+	if m != nil {
+		return m.Containers
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader.Size()))
-		n37, err := m.Leader.MarshalTo(dAtA[i:])
+	return nil
+}
+
+// ResourceEventData resource created or updated
+type ResourceEventData struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Leader               uint64   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	Removed              bool     `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	Create               bool     `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceEventData) Reset()         { *m = ResourceEventData{} }
+func (m *ResourceEventData) String() string { return proto.CompactTextString(m) }
+func (*ResourceEventData) ProtoMessage()    {}
+func (*ResourceEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{60}
+}
+func (m *ResourceEventData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceEventData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n37
-	}
-	if len(m.DownPeers) > 0 {
-		for _, msg := range m.DownPeers {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.PendingPeers) > 0 {
-		for _, msg := range m.PendingPeers {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
-	n38, err := m.Stats.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n38
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *ResourceEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceEventData.Merge(m, src)
+}
+func (m *ResourceEventData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceEventData.DiscardUnknown(m)
 }
 
-func (m *ResourceHeartbeatRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResourceEventData proto.InternalMessageInfo
+
+func (m *ResourceEventData) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResourceHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ResourceID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceEpoch.Size()))
-	n39, err := m.ResourceEpoch.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (m *ResourceEventData) GetLeader() uint64 {
+	if m != nil {
+		return m.Leader
 	}
-	i += n39
-	if m.TargetPeer != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.TargetPeer.Size()))
-		n40, err := m.TargetPeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n40
+	return 0
+}
+
+func (m *ResourceEventData) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
 	}
-	if m.ChangePeer != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n41, err := m.ChangePeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n41
+	return false
+}
+
+func (m *ResourceEventData) GetCreate() bool {
+	if m != nil {
+		return m.Create
 	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n42, err := m.TransferLeader.MarshalTo(dAtA[i:])
+	return false
+}
+
+// ContainerEventData container created or updated
+type ContainerEventData struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerEventData) Reset()         { *m = ContainerEventData{} }
+func (m *ContainerEventData) String() string { return proto.CompactTextString(m) }
+func (*ContainerEventData) ProtoMessage()    {}
+func (*ContainerEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{61}
+}
+func (m *ContainerEventData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerEventData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n42
+		return b[:n], nil
 	}
-	if m.Merge != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Merge.Size()))
-		n43, err := m.Merge.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
-	}
-	if m.SplitResource != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.SplitResource.Size()))
-		n44, err := m.SplitResource.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
-	}
-	if m.ChangePeerV2 != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
-		n45, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n45
-	}
-	if m.DestoryDirectly {
-		dAtA[i] = 0x48
-		i++
-		if m.DestoryDirectly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *PutContainerReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContainerEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerEventData.Merge(m, src)
 }
-
-func (m *PutContainerReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Container) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Container)))
-		i += copy(dAtA[i:], m.Container)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *ContainerEventData) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *PutContainerRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContainerEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerEventData.DiscardUnknown(m)
 }
 
-func (m *PutContainerRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
+var xxx_messageInfo_ContainerEventData proto.InternalMessageInfo
 
-func (m *ContainerHeartbeatReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ContainerEventData) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContainerHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
-	n46, err := m.Stats.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n46
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ChangePeer change peer
+type ChangePeer struct {
+	Peer                 metapb.Peer           `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
+	ChangeType           metapb.ChangePeerType `protobuf:"varint,2,opt,name=changeType,proto3,enum=metapb.ChangePeerType" json:"changeType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ContainerHeartbeatRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
+func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
+func (*ChangePeer) ProtoMessage()    {}
+func (*ChangePeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{62}
 }
-
-func (m *ContainerHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangePeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePeer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ChangePeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePeer.Merge(m, src)
+}
+func (m *ChangePeer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePeer.DiscardUnknown(m)
 }
 
-func (m *GetContainerReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChangePeer proto.InternalMessageInfo
+
+func (m *ChangePeer) GetPeer() metapb.Peer {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return metapb.Peer{}
 }
 
-func (m *GetContainerReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ChangePeer) GetChangeType() metapb.ChangePeerType {
+	if m != nil {
+		return m.ChangeType
 	}
-	return i, nil
+	return metapb.ChangePeerType_AddNode
 }
 
-func (m *GetContainerRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// TransferLeader transfer leader
+type TransferLeader struct {
+	Peer                 metapb.Peer `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *GetContainerRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.Stats != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
-		n47, err := m.Stats.MarshalTo(dAtA[i:])
+func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
+func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
+func (*TransferLeader) ProtoMessage()    {}
+func (*TransferLeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{63}
+}
+func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n47
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *AllocIDReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransferLeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeader.Merge(m, src)
 }
-
-func (m *AllocIDReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *TransferLeader) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *AllocIDRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransferLeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeader.DiscardUnknown(m)
 }
 
-func (m *AllocIDRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
+var xxx_messageInfo_TransferLeader proto.InternalMessageInfo
 
-func (m *AskSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *TransferLeader) GetPeer() metapb.Peer {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return metapb.Peer{}
 }
 
-func (m *AskSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ChangePeerV2 change peer v2
+type ChangePeerV2 struct {
+	// If changes is empty, it means that to exit joint state.
+	Changes              []ChangePeer `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *AskSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChangePeerV2) Reset()         { *m = ChangePeerV2{} }
+func (m *ChangePeerV2) String() string { return proto.CompactTextString(m) }
+func (*ChangePeerV2) ProtoMessage()    {}
+func (*ChangePeerV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{64}
 }
-
-func (m *AskSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.SplitID.Size()))
-	n48, err := m.SplitID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n48
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *ChangePeerV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ReportSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ChangePeerV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePeerV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Left) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Left)))
-		i += copy(dAtA[i:], m.Left)
-	}
-	if len(m.Right) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Right)))
-		i += copy(dAtA[i:], m.Right)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *ChangePeerV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePeerV2.Merge(m, src)
 }
-
-func (m *ReportSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChangePeerV2) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *ChangePeerV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePeerV2.DiscardUnknown(m)
 }
 
-func (m *AskBatchSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_ChangePeerV2 proto.InternalMessageInfo
 
-func (m *AskBatchSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ChangePeerV2) GetChanges() []ChangePeer {
+	if m != nil {
+		return m.Changes
 	}
-	return i, nil
+	return nil
 }
 
-func (m *AskBatchSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Merge merge
+type Merge struct {
+	// target resource
+	Target               []byte   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AskBatchSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.SplitIDs) > 0 {
-		for _, msg := range m.SplitIDs {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *Merge) Reset()         { *m = Merge{} }
+func (m *Merge) String() string { return proto.CompactTextString(m) }
+func (*Merge) ProtoMessage()    {}
+func (*Merge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{65}
+}
+func (m *Merge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Merge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Merge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *Merge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Merge.Merge(m, src)
+}
+func (m *Merge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Merge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Merge.DiscardUnknown(m)
 }
 
-func (m *BatchReportSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Merge proto.InternalMessageInfo
+
+func (m *Merge) GetTarget() []byte {
+	if m != nil {
+		return m.Target
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// SplitResource split resource
+type SplitResource struct {
+	Policy               metapb.CheckPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=metapb.CheckPolicy" json:"policy,omitempty"`
+	Keys                 [][]byte           `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *BatchReportSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SplitResource) Reset()         { *m = SplitResource{} }
+func (m *SplitResource) String() string { return proto.CompactTextString(m) }
+func (*SplitResource) ProtoMessage()    {}
+func (*SplitResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{66}
+}
+func (m *SplitResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SplitResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitResource.Merge(m, src)
+}
+func (m *SplitResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitResource.DiscardUnknown(m)
 }
 
-func (m *BatchReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_SplitResource proto.InternalMessageInfo
+
+func (m *SplitResource) GetPolicy() metapb.CheckPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return i, nil
+	return metapb.CheckPolicy_SCAN
 }
 
-func (m *SplitID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SplitResource) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NewID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.NewID))
-	}
-	if len(m.NewPeerIDs) > 0 {
-		dAtA50 := make([]byte, len(m.NewPeerIDs)*10)
-		var j49 int
-		for _, num := range m.NewPeerIDs {
-			for num >= 1<<7 {
-				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j49++
-			}
-			dAtA50[j49] = uint8(num)
-			j49++
+// LabelConstraint is used to filter container when trying to place peer of a resource.
+type LabelConstraint struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op                   LabelConstraintOp `protobuf:"varint,2,opt,name=op,proto3,enum=rpcpb.LabelConstraintOp" json:"op,omitempty"`
+	Values               []string          `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LabelConstraint) Reset()         { *m = LabelConstraint{} }
+func (m *LabelConstraint) String() string { return proto.CompactTextString(m) }
+func (*LabelConstraint) ProtoMessage()    {}
+func (*LabelConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{67}
+}
+func (m *LabelConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabelConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabelConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j49))
-		i += copy(dAtA[i:], dAtA50[:j49])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *LabelConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelConstraint.Merge(m, src)
+}
+func (m *LabelConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabelConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelConstraint.DiscardUnknown(m)
 }
 
-func (m *CreateWatcherReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LabelConstraint proto.InternalMessageInfo
+
+func (m *LabelConstraint) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateWatcherReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Flag != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Flag))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *LabelConstraint) GetOp() LabelConstraintOp {
+	if m != nil {
+		return m.Op
 	}
-	return i, nil
+	return In
 }
 
-func (m *CreateResourcesReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *LabelConstraint) GetValues() []string {
+	if m != nil {
+		return m.Values
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreateResourcesReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.LeastPeers) > 0 {
-		dAtA52 := make([]byte, len(m.LeastPeers)*10)
-		var j51 int
-		for _, num := range m.LeastPeers {
-			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j51++
-			}
-			dAtA52[j51] = uint8(num)
-			j51++
+// PlacementRule place rule
+type PlacementRule struct {
+	// ID unique ID within a group
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// GroupID mark the source that add the rule
+	GroupID string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	// Index rule apply order in a group, rule with less ID is applied first when indexes are equal
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Override when it is true, all rules with less indexes are disabled
+	Override bool   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
+	StartKey []byte `protobuf:"bytes,5,opt,name=startKey,proto3" json:"startKey,omitempty"`
+	EndKey   []byte `protobuf:"bytes,6,opt,name=endKey,proto3" json:"endKey,omitempty"`
+	// Role expected role of the peers
+	Role PeerRoleType `protobuf:"varint,7,opt,name=role,proto3,enum=rpcpb.PeerRoleType" json:"role,omitempty"`
+	// Count expected count of the peers
+	Count uint32 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	// LabelConstraints used to select containers to place peers
+	LabelConstraints []LabelConstraint `protobuf:"bytes,9,rep,name=labelConstraints,proto3" json:"labelConstraints"`
+	// LocationLabels used to make peers isolated physically
+	LocationLabels []string `protobuf:"bytes,10,rep,name=locationLabels,proto3" json:"locationLabels,omitempty"`
+	// IsolationLevelused to isolate replicas explicitly and forcibly
+	IsolationLevel       string   `protobuf:"bytes,11,opt,name=isolationLevel,proto3" json:"isolationLevel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementRule) Reset()         { *m = PlacementRule{} }
+func (m *PlacementRule) String() string { return proto.CompactTextString(m) }
+func (*PlacementRule) ProtoMessage()    {}
+func (*PlacementRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{68}
+}
+func (m *PlacementRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j51))
-		i += copy(dAtA[i:], dAtA52[:j51])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *PlacementRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementRule.Merge(m, src)
+}
+func (m *PlacementRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementRule.DiscardUnknown(m)
 }
 
-func (m *CreateResourcesRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlacementRule proto.InternalMessageInfo
+
+func (m *PlacementRule) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
 	}
-	return i, nil
+	return ""
 }
 
-func (m *RemoveResourcesReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RemoveResourcesReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.IDs) > 0 {
-		dAtA54 := make([]byte, len(m.IDs)*10)
-		var j53 int
-		for _, num := range m.IDs {
-			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j53++
-			}
-			dAtA54[j53] = uint8(num)
-			j53++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j53))
-		i += copy(dAtA[i:], dAtA54[:j53])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetOverride() bool {
+	if m != nil {
+		return m.Override
 	}
-	return i, nil
+	return false
 }
 
-func (m *RemoveResourcesRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RemoveResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CheckResourceStateReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetRole() PeerRoleType {
+	if m != nil {
+		return m.Role
 	}
-	return dAtA[:n], nil
+	return Voter
 }
 
-func (m *CheckResourceStateReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.IDs) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IDs)))
-		i += copy(dAtA[i:], m.IDs)
+func (m *PlacementRule) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *PlacementRule) GetLabelConstraints() []LabelConstraint {
+	if m != nil {
+		return m.LabelConstraints
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CheckResourceStateRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetLocationLabels() []string {
+	if m != nil {
+		return m.LocationLabels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckResourceStateRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Removed) > 0 {
-		dAtA56 := make([]byte, len(m.Removed)*10)
-		var j55 int
-		for _, num := range m.Removed {
-			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j55++
-			}
-			dAtA56[j55] = uint8(num)
-			j55++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j55))
-		i += copy(dAtA[i:], dAtA56[:j55])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetIsolationLevel() string {
+	if m != nil {
+		return m.IsolationLevel
 	}
-	return i, nil
+	return ""
 }
 
-func (m *PutPlacementRuleReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("rpcpb.Type", Type_name, Type_value)
+	proto.RegisterEnum("rpcpb.PeerRoleType", PeerRoleType_name, PeerRoleType_value)
+	proto.RegisterEnum("rpcpb.LabelConstraintOp", LabelConstraintOp_name, LabelConstraintOp_value)
+	proto.RegisterType((*Request)(nil), "rpcpb.Request")
+	proto.RegisterType((*Response)(nil), "rpcpb.Response")
+	proto.RegisterType((*ResourceHeartbeatReq)(nil), "rpcpb.ResourceHeartbeatReq")
+	proto.RegisterType((*ResourceHeartbeatRsp)(nil), "rpcpb.ResourceHeartbeatRsp")
+	proto.RegisterType((*PutContainerReq)(nil), "rpcpb.PutContainerReq")
+	proto.RegisterType((*PutContainerRsp)(nil), "rpcpb.PutContainerRsp")
+	proto.RegisterType((*ContainerHeartbeatReq)(nil), "rpcpb.ContainerHeartbeatReq")
+	proto.RegisterType((*ContainerHeartbeatRsp)(nil), "rpcpb.ContainerHeartbeatRsp")
+	proto.RegisterType((*GetContainerReq)(nil), "rpcpb.GetContainerReq")
+	proto.RegisterType((*GetContainerRsp)(nil), "rpcpb.GetContainerRsp")
+	proto.RegisterType((*AllocIDReq)(nil), "rpcpb.AllocIDReq")
+	proto.RegisterType((*AllocIDRsp)(nil), "rpcpb.AllocIDRsp")
+	proto.RegisterType((*GetTimestampReq)(nil), "rpcpb.GetTimestampReq")
+	proto.RegisterType((*GetTimestampRsp)(nil), "rpcpb.GetTimestampRsp")
+	proto.RegisterType((*AskSplitReq)(nil), "rpcpb.AskSplitReq")
+	proto.RegisterType((*AskSplitRsp)(nil), "rpcpb.AskSplitRsp")
+	proto.RegisterType((*ReportSplitReq)(nil), "rpcpb.ReportSplitReq")
+	proto.RegisterType((*ReportSplitRsp)(nil), "rpcpb.ReportSplitRsp")
+	proto.RegisterType((*AskBatchSplitReq)(nil), "rpcpb.AskBatchSplitReq")
+	proto.RegisterType((*AskBatchSplitRsp)(nil), "rpcpb.AskBatchSplitRsp")
+	proto.RegisterType((*BatchReportSplitReq)(nil), "rpcpb.BatchReportSplitReq")
+	proto.RegisterType((*BatchReportSplitRsp)(nil), "rpcpb.BatchReportSplitRsp")
+	proto.RegisterType((*SplitID)(nil), "rpcpb.SplitID")
+	proto.RegisterType((*CreateWatcherReq)(nil), "rpcpb.CreateWatcherReq")
+	proto.RegisterType((*CreateResourcesReq)(nil), "rpcpb.CreateResourcesReq")
+	proto.RegisterType((*CreateResourcesRsp)(nil), "rpcpb.CreateResourcesRsp")
+	proto.RegisterType((*RemoveResourcesReq)(nil), "rpcpb.RemoveResourcesReq")
+	proto.RegisterType((*RemoveResourcesRsp)(nil), "rpcpb.RemoveResourcesRsp")
+	proto.RegisterType((*CheckResourceStateReq)(nil), "rpcpb.CheckResourceStateReq")
+	proto.RegisterType((*CheckResourceStateRsp)(nil), "rpcpb.CheckResourceStateRsp")
+	proto.RegisterType((*PutPlacementRuleReq)(nil), "rpcpb.PutPlacementRuleReq")
+	proto.RegisterType((*PutPlacementRuleRsp)(nil), "rpcpb.PutPlacementRuleRsp")
+	proto.RegisterType((*GetAppliedRulesReq)(nil), "rpcpb.GetAppliedRulesReq")
+	proto.RegisterType((*GetAppliedRulesRsp)(nil), "rpcpb.GetAppliedRulesRsp")
+	proto.RegisterType((*CreateJobReq)(nil), "rpcpb.CreateJobReq")
+	proto.RegisterType((*CreateJobRsp)(nil), "rpcpb.CreateJobRsp")
+	proto.RegisterType((*RemoveJobReq)(nil), "rpcpb.RemoveJobReq")
+	proto.RegisterType((*RemoveJobRsp)(nil), "rpcpb.RemoveJobRsp")
+	proto.RegisterType((*ExecuteJobReq)(nil), "rpcpb.ExecuteJobReq")
+	proto.RegisterType((*ExecuteJobRsp)(nil), "rpcpb.ExecuteJobRsp")
+	proto.RegisterType((*GetContainersReq)(nil), "rpcpb.GetContainersReq")
+	proto.RegisterType((*GetContainersRsp)(nil), "rpcpb.GetContainersRsp")
+	proto.RegisterType((*GetResourcesReq)(nil), "rpcpb.GetResourcesReq")
+	proto.RegisterType((*GetResourcesRsp)(nil), "rpcpb.GetResourcesRsp")
+	proto.RegisterType((*HotResource)(nil), "rpcpb.HotResource")
+	proto.RegisterType((*GetHotResourcesReq)(nil), "rpcpb.GetHotResourcesReq")
+	proto.RegisterType((*GetHotResourcesRsp)(nil), "rpcpb.GetHotResourcesRsp")
+	proto.RegisterType((*UpdateContainerStateReq)(nil), "rpcpb.UpdateContainerStateReq")
+	proto.RegisterType((*UpdateContainerStateRsp)(nil), "rpcpb.UpdateContainerStateRsp")
+	proto.RegisterType((*AddSchedulerReq)(nil), "rpcpb.AddSchedulerReq")
+	proto.RegisterType((*AddSchedulerRsp)(nil), "rpcpb.AddSchedulerRsp")
+	proto.RegisterType((*RemoveSchedulerReq)(nil), "rpcpb.RemoveSchedulerReq")
+	proto.RegisterType((*RemoveSchedulerRsp)(nil), "rpcpb.RemoveSchedulerRsp")
+	proto.RegisterType((*GetSchedulersReq)(nil), "rpcpb.GetSchedulersReq")
+	proto.RegisterType((*GetSchedulersRsp)(nil), "rpcpb.GetSchedulersRsp")
+	proto.RegisterType((*SchedulerInfo)(nil), "rpcpb.SchedulerInfo")
+	proto.RegisterType((*GetPlacementRulesReq)(nil), "rpcpb.GetPlacementRulesReq")
+	proto.RegisterType((*GetPlacementRulesRsp)(nil), "rpcpb.GetPlacementRulesRsp")
+	proto.RegisterType((*EventNotify)(nil), "rpcpb.EventNotify")
+	proto.RegisterType((*InitEventData)(nil), "rpcpb.InitEventData")
+	proto.RegisterType((*ResourceEventData)(nil), "rpcpb.ResourceEventData")
+	proto.RegisterType((*ContainerEventData)(nil), "rpcpb.ContainerEventData")
+	proto.RegisterType((*ChangePeer)(nil), "rpcpb.ChangePeer")
+	proto.RegisterType((*TransferLeader)(nil), "rpcpb.TransferLeader")
+	proto.RegisterType((*ChangePeerV2)(nil), "rpcpb.ChangePeerV2")
+	proto.RegisterType((*Merge)(nil), "rpcpb.Merge")
+	proto.RegisterType((*SplitResource)(nil), "rpcpb.SplitResource")
+	proto.RegisterType((*LabelConstraint)(nil), "rpcpb.LabelConstraint")
+	proto.RegisterType((*PlacementRule)(nil), "rpcpb.PlacementRule")
 }
 
-func (m *PutPlacementRuleReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Rule.Size()))
-	n57, err := m.Rule.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n57
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func init() { proto.RegisterFile("rpcpb.proto", fileDescriptor_25e491924c678914) }
+
+var fileDescriptor_25e491924c678914 = []byte{
+	// 3078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0x5b, 0x73, 0xdc, 0xb6,
+	0x15, 0xf6, 0x5e, 0xb4, 0x97, 0xb3, 0x17, 0x41, 0xd0, 0xc5, 0x94, 0x6c, 0x4b, 0x0a, 0x9d, 0x38,
+	0x8a, 0xe3, 0x48, 0xb1, 0x9c, 0x4b, 0x9b, 0x69, 0xda, 0xda, 0x96, 0x13, 0x2b, 0x75, 0x1c, 0x0d,
+	0xed, 0x26, 0x7d, 0xeb, 0x70, 0x77, 0xa1, 0x15, 0xa3, 0xd5, 0x12, 0x26, 0xb8, 0xb6, 0x35, 0xd3,
+	0x99, 0xf6, 0x27, 0xe5, 0x1f, 0xf4, 0xb1, 0x79, 0x6b, 0x7e, 0x40, 0x27, 0xd3, 0xfa, 0x6f, 0xf4,
+	0xa5, 0x83, 0x0b, 0x49, 0x80, 0x04, 0x57, 0x6a, 0x9e, 0x44, 0x9c, 0x73, 0xbe, 0x43, 0xe2, 0xe0,
+	0x00, 0x1f, 0xce, 0x59, 0x41, 0x27, 0xa2, 0x43, 0x3a, 0xd8, 0xa5, 0x51, 0x18, 0x87, 0x78, 0x41,
+	0x0c, 0x36, 0x9e, 0x8c, 0x83, 0xf8, 0x64, 0x36, 0xd8, 0x1d, 0x86, 0x67, 0x7b, 0x67, 0x7e, 0x1c,
+	0x05, 0xaf, 0xc3, 0x28, 0x18, 0x07, 0x53, 0x35, 0x18, 0xce, 0x06, 0x64, 0x6f, 0x18, 0x9e, 0xd1,
+	0x70, 0x4a, 0xa6, 0x31, 0xdb, 0xa3, 0x51, 0x48, 0x4f, 0x48, 0xbc, 0x47, 0x07, 0x7b, 0x67, 0x24,
+	0xf6, 0xd3, 0x3f, 0xd2, 0xe9, 0xc6, 0x07, 0x9a, 0xb7, 0x71, 0x38, 0x0e, 0xf7, 0x84, 0x78, 0x30,
+	0x3b, 0x16, 0x23, 0x31, 0x10, 0x4f, 0xd2, 0xdc, 0xfd, 0xe7, 0x22, 0x34, 0x3d, 0xf2, 0x62, 0x46,
+	0x58, 0x8c, 0xd7, 0xa0, 0x1a, 0x8c, 0x9c, 0xca, 0x76, 0x65, 0xa7, 0xfe, 0xa0, 0xf1, 0xe6, 0xe7,
+	0xad, 0xea, 0xe1, 0x81, 0x57, 0x0d, 0x46, 0x78, 0x1b, 0x3a, 0xc3, 0x70, 0x1a, 0xfb, 0xc1, 0x94,
+	0x44, 0x87, 0x07, 0x4e, 0x95, 0x1b, 0x78, 0xba, 0x08, 0x6f, 0x41, 0x3d, 0x3e, 0xa7, 0xc4, 0xa9,
+	0x6d, 0x57, 0x76, 0xfa, 0xfb, 0x9d, 0x5d, 0x39, 0xcb, 0xe7, 0xe7, 0x94, 0x78, 0x42, 0x81, 0xbf,
+	0x81, 0xa5, 0x88, 0xb0, 0x70, 0x16, 0x0d, 0xc9, 0x63, 0xe2, 0x47, 0xf1, 0x80, 0xf8, 0xb1, 0x53,
+	0xdf, 0xae, 0xec, 0x74, 0xf6, 0xaf, 0x29, 0x6b, 0x2f, 0xaf, 0xf7, 0xc8, 0x8b, 0x07, 0xf5, 0x1f,
+	0x7f, 0xde, 0xba, 0xe2, 0x15, 0xb1, 0xd8, 0x03, 0x9c, 0x7e, 0x40, 0xe6, 0x71, 0x41, 0x78, 0xbc,
+	0xae, 0x3c, 0x3e, 0x2c, 0x18, 0x64, 0x2e, 0x2d, 0x68, 0xfc, 0x7b, 0xe8, 0xd2, 0x59, 0x9c, 0xa2,
+	0x9c, 0x86, 0xf0, 0xb6, 0xa6, 0xbc, 0x1d, 0x69, 0xaa, 0xcc, 0x8f, 0x81, 0xe0, 0x1e, 0xc6, 0x44,
+	0xf3, 0xd0, 0x34, 0x3c, 0x7c, 0x49, 0xac, 0x1e, 0x74, 0x04, 0xbe, 0x0b, 0x4d, 0x7f, 0x32, 0x09,
+	0x87, 0x87, 0x07, 0x4e, 0x4b, 0x80, 0x97, 0x14, 0xf8, 0xbe, 0x94, 0x66, 0xb8, 0xc4, 0x0e, 0x7f,
+	0x04, 0x2d, 0x9f, 0x9d, 0x3e, 0xa3, 0x93, 0x20, 0x76, 0xda, 0x02, 0x83, 0x13, 0x8c, 0x12, 0x67,
+	0xa0, 0xd4, 0x12, 0x3f, 0x84, 0x9e, 0xcf, 0x4e, 0x1f, 0xf8, 0xf1, 0xf0, 0x44, 0x42, 0x41, 0x40,
+	0xaf, 0x66, 0xd0, 0x4c, 0x97, 0xe1, 0x4d, 0x0c, 0xfe, 0x1c, 0x3a, 0x11, 0xa1, 0x61, 0x14, 0x4b,
+	0x17, 0x1d, 0xe1, 0x62, 0x35, 0x5d, 0xd0, 0x54, 0x93, 0x39, 0xd0, 0xed, 0xf1, 0x13, 0x40, 0x03,
+	0xee, 0x4c, 0xb3, 0x74, 0xba, 0xc2, 0xc7, 0x86, 0xf2, 0xf1, 0x20, 0xa7, 0xce, 0x1c, 0x15, 0x90,
+	0x7c, 0x46, 0xc3, 0x88, 0xf8, 0x31, 0xf9, 0x8e, 0x6b, 0x48, 0xe4, 0xf4, 0x8c, 0x19, 0x3d, 0xd4,
+	0x75, 0xda, 0x8c, 0x0c, 0x0c, 0x3e, 0x84, 0x45, 0x29, 0x48, 0xd2, 0x91, 0x39, 0x7d, 0xe1, 0x66,
+	0xdd, 0x70, 0x93, 0x6a, 0x33, 0x47, 0x79, 0x1c, 0x77, 0x15, 0x91, 0xb3, 0xf0, 0xa5, 0xe6, 0x6a,
+	0xd1, 0x70, 0xe5, 0x99, 0x5a, 0xcd, 0x55, 0x0e, 0x27, 0xb2, 0xfd, 0x84, 0x0c, 0x4f, 0x13, 0xc9,
+	0xb3, 0xd8, 0x8f, 0x89, 0x83, 0xcc, 0x6c, 0x2f, 0x18, 0xe8, 0xd9, 0x5e, 0x50, 0xf2, 0xe0, 0xd3,
+	0x59, 0x7c, 0x34, 0xf1, 0x87, 0xe4, 0x8c, 0x4c, 0x63, 0x6f, 0x36, 0x21, 0xce, 0x92, 0x11, 0xfc,
+	0xa3, 0x9c, 0x5a, 0x0b, 0x7e, 0x1e, 0xc9, 0x27, 0x3b, 0x26, 0xf1, 0x7d, 0x4a, 0x27, 0x01, 0x19,
+	0x71, 0x09, 0x73, 0xb0, 0x31, 0xd9, 0x2f, 0x4d, 0xad, 0x36, 0xd9, 0x1c, 0x0e, 0x7f, 0x0a, 0x6d,
+	0x19, 0xca, 0xaf, 0xc2, 0x81, 0xb3, 0x2c, 0x9c, 0x2c, 0x1b, 0xc1, 0xff, 0x2a, 0x1c, 0x64, 0xf0,
+	0xcc, 0x96, 0x03, 0x65, 0xe0, 0x38, 0x70, 0xc5, 0x00, 0x7a, 0x89, 0x5c, 0x03, 0xa6, 0xb6, 0xf8,
+	0x33, 0x00, 0xf2, 0x9a, 0x0c, 0x67, 0xf2, 0x95, 0xab, 0x02, 0xb9, 0xa2, 0x90, 0x8f, 0x52, 0x45,
+	0x06, 0xd5, 0xac, 0x79, 0xd6, 0xe9, 0x1b, 0x98, 0x39, 0x6b, 0x46, 0xd6, 0xe9, 0x7b, 0x5e, 0x9b,
+	0xb4, 0x89, 0x51, 0xe7, 0x46, 0x96, 0x27, 0x57, 0xf3, 0xe7, 0x86, 0x25, 0x49, 0x0c, 0x84, 0x8a,
+	0xff, 0xe3, 0x50, 0x73, 0xe2, 0xe4, 0xe3, 0xff, 0x38, 0xb4, 0xf9, 0xc9, 0xe3, 0xf0, 0x9f, 0x60,
+	0x65, 0x46, 0x47, 0x7e, 0x4c, 0xd2, 0x0f, 0x94, 0xe9, 0xb6, 0x2e, 0xfc, 0x6d, 0x2a, 0x7f, 0x7f,
+	0xb4, 0x98, 0x64, 0x4e, 0xad, 0x1e, 0xf8, 0x34, 0xfd, 0xd1, 0xe8, 0xd9, 0xf0, 0x84, 0x8c, 0x66,
+	0x13, 0x12, 0x39, 0x1b, 0xc6, 0x34, 0xef, 0x6b, 0x2a, 0x6d, 0x9a, 0x3a, 0x22, 0xdb, 0x53, 0x99,
+	0x93, 0x6b, 0x96, 0x3d, 0x65, 0xf1, 0x93, 0xc7, 0xa9, 0x85, 0x4b, 0xc7, 0xcc, 0xb9, 0x9e, 0x5f,
+	0xb8, 0x4c, 0x67, 0x2e, 0x5c, 0x26, 0xe7, 0xbc, 0x36, 0x26, 0xe6, 0x56, 0x60, 0xce, 0x0d, 0x83,
+	0xd7, 0xbe, 0xcc, 0xeb, 0x35, 0x5e, 0x2b, 0x60, 0x55, 0x26, 0x3c, 0x0f, 0xce, 0x08, 0x8b, 0xfd,
+	0x33, 0xea, 0x6c, 0xe6, 0x33, 0x21, 0x55, 0x99, 0x99, 0x90, 0x8a, 0xdd, 0x7f, 0x2c, 0x42, 0xcb,
+	0x23, 0x8c, 0x86, 0x53, 0x46, 0x4a, 0x29, 0x3d, 0x21, 0xec, 0x6a, 0x19, 0x61, 0xaf, 0xc0, 0x02,
+	0x89, 0xa2, 0x30, 0x12, 0x94, 0xde, 0xf6, 0xe4, 0x00, 0xaf, 0x41, 0x63, 0x42, 0xfc, 0x11, 0x89,
+	0x04, 0x77, 0xb7, 0x3d, 0x35, 0xb2, 0xd3, 0xfb, 0xc2, 0x05, 0xf4, 0xce, 0xe8, 0xff, 0x4b, 0xef,
+	0x8d, 0x8b, 0xe8, 0x3d, 0x75, 0x79, 0x19, 0x7a, 0x6f, 0x96, 0xd3, 0x7b, 0xea, 0x67, 0x3e, 0xbd,
+	0xb7, 0xca, 0xe9, 0x3d, 0xf3, 0x50, 0x46, 0xef, 0x6d, 0x2b, 0xbd, 0xa7, 0x38, 0x2b, 0xbd, 0x83,
+	0x9d, 0xde, 0x53, 0xd0, 0x1c, 0x7a, 0xef, 0xcc, 0xa1, 0xf7, 0x14, 0x3f, 0x9f, 0xde, 0xbb, 0xa5,
+	0xf4, 0x9e, 0x3a, 0xb8, 0x90, 0xde, 0x7b, 0xf3, 0xe9, 0x3d, 0x75, 0x54, 0x40, 0xe2, 0x5d, 0x58,
+	0x20, 0x2f, 0xc9, 0x34, 0x76, 0xfa, 0x46, 0x10, 0x1e, 0x71, 0xd9, 0xd3, 0x30, 0x0e, 0x8e, 0xcf,
+	0x15, 0x54, 0x9a, 0xd9, 0x98, 0x7c, 0x71, 0x2e, 0x93, 0xa7, 0xef, 0xbe, 0x0c, 0x93, 0xa3, 0xb9,
+	0x4c, 0x9e, 0xb9, 0xba, 0x1c, 0x93, 0x2f, 0x5d, 0xc4, 0xe4, 0x5a, 0x62, 0x5f, 0x8e, 0xc9, 0xf1,
+	0x7c, 0x26, 0xcf, 0xe2, 0x7c, 0x19, 0x26, 0x5f, 0x9e, 0xcb, 0xe4, 0xd9, 0x64, 0xe7, 0x32, 0xf9,
+	0x4a, 0x09, 0x93, 0xa7, 0xf0, 0x32, 0x26, 0x5f, 0x2d, 0x61, 0xf2, 0x0c, 0x58, 0xc6, 0xe4, 0x6b,
+	0x65, 0x4c, 0x9e, 0x42, 0xe7, 0x32, 0xf9, 0xd5, 0x39, 0x4c, 0x9e, 0x6d, 0x99, 0xf9, 0x4c, 0xee,
+	0x94, 0x33, 0xb9, 0x71, 0x44, 0xcc, 0x65, 0xf2, 0xf5, 0xb9, 0x4c, 0x6e, 0xc4, 0xff, 0x52, 0x4c,
+	0xbe, 0x71, 0x31, 0x93, 0xa7, 0x4e, 0x2f, 0xc7, 0xe4, 0xd7, 0xca, 0x99, 0x3c, 0x9b, 0xe6, 0x45,
+	0x4c, 0x7e, 0x7d, 0x2e, 0x93, 0xe7, 0xf7, 0xd4, 0x1c, 0x26, 0xbf, 0x31, 0x87, 0xc9, 0x8d, 0x85,
+	0xbb, 0x88, 0xc9, 0x37, 0x2f, 0x60, 0xf2, 0x8c, 0xc2, 0x2e, 0x66, 0xf2, 0xad, 0x72, 0x26, 0x37,
+	0x32, 0x21, 0x63, 0xf2, 0x1f, 0xaa, 0xb0, 0x62, 0xab, 0x8a, 0xf3, 0x05, 0x79, 0xa5, 0x58, 0x90,
+	0x6f, 0x40, 0x2b, 0x21, 0x55, 0xc1, 0xf1, 0x5d, 0x2f, 0x1d, 0x63, 0x0c, 0xf5, 0x98, 0x44, 0x67,
+	0x82, 0xd9, 0xeb, 0x9e, 0x78, 0xc6, 0x6f, 0x1b, 0xc4, 0xde, 0xd9, 0xef, 0xee, 0xaa, 0xa6, 0xc2,
+	0x11, 0x21, 0x51, 0x4a, 0xf3, 0x1f, 0x43, 0x7b, 0x14, 0xbe, 0x9a, 0x72, 0x19, 0x73, 0x16, 0xb6,
+	0x6b, 0x82, 0xbf, 0x34, 0x43, 0x9e, 0x1b, 0x2c, 0xd9, 0x94, 0xa9, 0x25, 0xfe, 0x04, 0xba, 0x94,
+	0x4c, 0x47, 0xc1, 0x74, 0x2c, 0x91, 0x8d, 0xed, 0x5a, 0xfe, 0x15, 0x29, 0xdd, 0x6a, 0x76, 0xf8,
+	0x2e, 0x2c, 0x30, 0xee, 0x51, 0x31, 0xf5, 0x6a, 0x02, 0xd0, 0x4f, 0xbf, 0xe4, 0x75, 0xd2, 0xd2,
+	0xfd, 0x57, 0xcd, 0x16, 0x32, 0x46, 0xf1, 0x26, 0x40, 0x12, 0x80, 0x34, 0x62, 0x9a, 0x04, 0xdf,
+	0x87, 0x5e, 0x32, 0x7a, 0x44, 0xc3, 0xe1, 0x89, 0x53, 0xb5, 0xbf, 0x53, 0x28, 0x93, 0x0c, 0x32,
+	0x10, 0xf8, 0x0e, 0x40, 0xec, 0x47, 0x3c, 0x11, 0x08, 0x91, 0xf7, 0xa6, 0x7c, 0x1c, 0x35, 0x3d,
+	0xbe, 0x0b, 0x30, 0x3c, 0xf1, 0xa7, 0x63, 0x72, 0x44, 0xd2, 0xa8, 0x2f, 0xa5, 0x04, 0x90, 0x28,
+	0x3c, 0xcd, 0x08, 0x7f, 0x0e, 0xfd, 0x38, 0xf2, 0xa7, 0xec, 0x98, 0x44, 0x4f, 0xe4, 0x62, 0x2d,
+	0x18, 0x8c, 0xfc, 0xdc, 0x50, 0x7a, 0x39, 0x63, 0xec, 0xc2, 0xc2, 0x19, 0x89, 0xc6, 0x44, 0x5d,
+	0xa3, 0xba, 0x0a, 0xf5, 0x35, 0x97, 0x79, 0x52, 0x85, 0x3f, 0x83, 0x1e, 0x93, 0x75, 0xb6, 0x4a,
+	0x9e, 0xa6, 0x71, 0x84, 0x3e, 0xd3, 0x75, 0x9e, 0x69, 0x8a, 0x3f, 0x85, 0x6e, 0xf6, 0xb1, 0xdf,
+	0xee, 0x3b, 0x2d, 0xe3, 0xdc, 0x7e, 0xa8, 0xa9, 0x3c, 0xc3, 0x10, 0xef, 0xc0, 0xe2, 0x88, 0xb0,
+	0x38, 0x8c, 0xce, 0x0f, 0x82, 0x88, 0x0c, 0xe3, 0xc9, 0xb9, 0xb8, 0x1c, 0xb5, 0xbc, 0xbc, 0xd8,
+	0xdd, 0x83, 0xc5, 0x5c, 0x1b, 0x06, 0x5f, 0x87, 0x76, 0x9a, 0xf8, 0x62, 0x5d, 0xbb, 0x5e, 0x26,
+	0x70, 0x97, 0x72, 0x00, 0x46, 0xdd, 0x3f, 0xc3, 0xaa, 0xb5, 0x31, 0x84, 0xf7, 0x93, 0x74, 0xab,
+	0xa8, 0x9d, 0xaa, 0x96, 0xce, 0x38, 0xfa, 0xcc, 0x7c, 0xe3, 0x7b, 0x69, 0xe4, 0xc7, 0xbe, 0xda,
+	0x63, 0xe2, 0xd9, 0x3d, 0xb2, 0xbe, 0x80, 0xd1, 0xd4, 0xb8, 0x92, 0x19, 0xe3, 0x9b, 0x50, 0xff,
+	0x3e, 0x1c, 0x30, 0xa7, 0xba, 0x5d, 0xdb, 0xe9, 0xef, 0x2f, 0x26, 0xef, 0xfc, 0x2a, 0x1c, 0xc8,
+	0xcb, 0x38, 0x57, 0xba, 0xef, 0xc1, 0x62, 0xae, 0x77, 0x54, 0x76, 0xb1, 0x77, 0x9f, 0xe5, 0x4c,
+	0x4b, 0x5e, 0x7b, 0x27, 0x99, 0x6b, 0x75, 0xde, 0x5c, 0x93, 0x5d, 0xd5, 0x05, 0xc8, 0xda, 0x4f,
+	0xee, 0xdb, 0xd9, 0x88, 0xd1, 0xd2, 0x0f, 0x79, 0x57, 0x7c, 0x88, 0x5e, 0xad, 0xf0, 0x9a, 0x62,
+	0x18, 0xce, 0xa6, 0xb1, 0xb0, 0xee, 0x79, 0x72, 0xe0, 0xee, 0xe5, 0x0c, 0x19, 0xe5, 0x6b, 0x1a,
+	0x27, 0x63, 0xb5, 0x57, 0x33, 0x81, 0xfb, 0x16, 0x74, 0xb4, 0xc6, 0x96, 0x6d, 0x7a, 0xee, 0xe7,
+	0x9a, 0x09, 0xa3, 0x78, 0x17, 0x9a, 0x22, 0x55, 0xd5, 0xce, 0xef, 0xec, 0xf7, 0xf5, 0x7c, 0x3e,
+	0x3c, 0x48, 0xae, 0xdc, 0xca, 0xc8, 0xfd, 0x0c, 0xfa, 0x66, 0xcf, 0x89, 0xbf, 0x64, 0x42, 0x8e,
+	0xe3, 0xe4, 0x25, 0xfc, 0x99, 0x4f, 0x27, 0x0a, 0xc6, 0x27, 0xb1, 0x5a, 0x7c, 0x39, 0x70, 0x91,
+	0x89, 0x65, 0xd4, 0xfd, 0x0d, 0xa0, 0x7c, 0x37, 0xcd, 0xba, 0x26, 0x69, 0x78, 0xaa, 0x7a, 0x78,
+	0x0e, 0xf2, 0x68, 0x46, 0xf1, 0x87, 0xd0, 0x52, 0x9f, 0xca, 0x93, 0xb5, 0x56, 0x3a, 0xa1, 0xd4,
+	0xca, 0xbd, 0x07, 0xcb, 0x96, 0x56, 0x1a, 0x0f, 0x74, 0x94, 0xde, 0x32, 0xb8, 0xa7, 0xae, 0x97,
+	0x09, 0xdc, 0x55, 0x0b, 0x88, 0x51, 0xf7, 0x77, 0xd0, 0x54, 0xaf, 0xe1, 0x9f, 0x3c, 0x25, 0xaf,
+	0xd2, 0x03, 0x55, 0x0e, 0xf8, 0x59, 0x3b, 0x25, 0xaf, 0xf8, 0xe6, 0x3e, 0x3c, 0x90, 0x99, 0x5d,
+	0xf7, 0x34, 0x89, 0x7b, 0x0b, 0x50, 0xbe, 0x19, 0xc7, 0x03, 0x72, 0x3c, 0xf1, 0xc7, 0x2a, 0x35,
+	0xc4, 0xb3, 0xeb, 0x01, 0x2e, 0x76, 0xdb, 0xe6, 0x7f, 0x33, 0x7f, 0xf7, 0x84, 0xf8, 0x2c, 0x96,
+	0x4c, 0xa3, 0xde, 0x9d, 0x49, 0xdc, 0x95, 0xa2, 0x4f, 0x46, 0xdd, 0x3d, 0xc0, 0xc5, 0x66, 0x1c,
+	0x5e, 0x87, 0x5a, 0x30, 0x92, 0xef, 0xa8, 0x3f, 0x68, 0xbe, 0xf9, 0x79, 0xab, 0x76, 0x78, 0xc0,
+	0x3c, 0x2e, 0x73, 0x57, 0x8a, 0x00, 0x46, 0xdd, 0x7d, 0x58, 0xb5, 0x76, 0xe1, 0x32, 0x4f, 0x95,
+	0x9d, 0x6e, 0xce, 0xd3, 0x5d, 0x2b, 0x86, 0x51, 0xec, 0x40, 0x53, 0x5e, 0x74, 0x46, 0xf2, 0x0b,
+	0xbc, 0x64, 0xe8, 0x3e, 0x82, 0x65, 0x4b, 0x6b, 0x0e, 0xef, 0x42, 0x3d, 0xe2, 0x57, 0xff, 0x8a,
+	0x71, 0x64, 0x1b, 0x66, 0x2a, 0x2f, 0x84, 0x9d, 0xbb, 0x6a, 0x71, 0xc3, 0xa8, 0xfb, 0x11, 0xe0,
+	0x62, 0xaf, 0xee, 0x22, 0xfe, 0x74, 0xbf, 0x28, 0xa2, 0x44, 0xa2, 0x2e, 0xf0, 0x57, 0x25, 0x59,
+	0x3a, 0xef, 0x9b, 0xa4, 0xa1, 0x7b, 0x0f, 0xba, 0x7a, 0x93, 0x0f, 0xdf, 0x84, 0xda, 0xf7, 0xe1,
+	0x40, 0xcd, 0xa9, 0xa3, 0x1d, 0x8f, 0x0a, 0xc6, 0xb5, 0x6e, 0x5f, 0x07, 0x31, 0xca, 0x9d, 0xe8,
+	0x0d, 0xbf, 0x4b, 0x3b, 0xd1, 0x6b, 0x0b, 0xf7, 0x31, 0xf4, 0x8c, 0xde, 0xdf, 0xa5, 0xbc, 0x58,
+	0x09, 0xe1, 0xa6, 0xe1, 0xc9, 0x7e, 0x22, 0xbb, 0x18, 0x50, 0xbe, 0x57, 0xe8, 0x1e, 0xe7, 0x65,
+	0xf2, 0x22, 0x93, 0xd2, 0x5b, 0x92, 0xff, 0x9a, 0x24, 0x63, 0xb1, 0xea, 0x76, 0xad, 0xfc, 0x64,
+	0x37, 0x6f, 0x4d, 0x4b, 0xe2, 0x08, 0xd6, 0x73, 0xdf, 0xfd, 0x4b, 0x4e, 0x24, 0x4f, 0xe5, 0x39,
+	0x1b, 0xcf, 0x81, 0xa6, 0xbc, 0x25, 0x26, 0xbb, 0x2e, 0x19, 0x66, 0xd7, 0xb8, 0xda, 0x76, 0xcd,
+	0x76, 0xa5, 0xb2, 0x7c, 0xd0, 0xdf, 0x2b, 0xd0, 0xd1, 0x2a, 0x99, 0x0b, 0x6f, 0x6f, 0x17, 0xff,
+	0x42, 0xb5, 0x01, 0xad, 0x80, 0xa9, 0x5b, 0x53, 0x4d, 0x5c, 0x2e, 0xd2, 0x31, 0x9f, 0xd8, 0x49,
+	0x18, 0x1f, 0x90, 0x71, 0x44, 0x88, 0xb8, 0x89, 0xd5, 0xbd, 0x4c, 0xc0, 0x91, 0x83, 0xf3, 0x98,
+	0x78, 0xbc, 0x70, 0xe2, 0xf7, 0xad, 0x8a, 0x97, 0x8e, 0xf9, 0xa4, 0x4f, 0xc9, 0xb9, 0x50, 0x35,
+	0x84, 0x2a, 0x19, 0xf2, 0x03, 0xa2, 0xd8, 0x71, 0x75, 0x5f, 0x16, 0xa5, 0x62, 0x97, 0x34, 0x5e,
+	0x45, 0x41, 0x9c, 0x6e, 0x93, 0xa4, 0xb5, 0xa1, 0xd9, 0xa9, 0xf0, 0x28, 0x3b, 0xde, 0x0b, 0x89,
+	0x88, 0x3f, 0x4a, 0x16, 0xb9, 0x1c, 0x20, 0xcd, 0xdc, 0xbf, 0xc2, 0xd5, 0x92, 0x7e, 0xed, 0x25,
+	0x6a, 0x09, 0x75, 0x57, 0x48, 0x9a, 0x85, 0xf6, 0x8c, 0x22, 0x72, 0xe9, 0x44, 0xe3, 0xf0, 0x38,
+	0xe4, 0x37, 0x47, 0x19, 0x65, 0x39, 0x70, 0xd7, 0x4b, 0x3e, 0x80, 0x51, 0xf7, 0xd7, 0xb0, 0x98,
+	0xeb, 0xfc, 0x62, 0xac, 0xba, 0x93, 0x15, 0xd1, 0x64, 0x14, 0xcf, 0x5c, 0xe6, 0x47, 0x63, 0x39,
+	0xe3, 0xb6, 0x27, 0x9e, 0xdd, 0x77, 0x72, 0x50, 0xb9, 0xb5, 0xa6, 0xfe, 0x59, 0x0a, 0xe5, 0xcf,
+	0xee, 0x4e, 0x72, 0x58, 0xe7, 0x5f, 0x52, 0xb0, 0x5c, 0x29, 0x5a, 0x32, 0xaa, 0xb6, 0xa6, 0xd1,
+	0x0d, 0x76, 0x9f, 0xe6, 0x65, 0x8c, 0xf2, 0xe6, 0x03, 0x4b, 0x05, 0xb9, 0x23, 0x2f, 0xb5, 0x3c,
+	0x9c, 0x1e, 0x87, 0x49, 0xf3, 0x21, 0xb3, 0x76, 0xbf, 0x83, 0x9e, 0x61, 0x62, 0xfb, 0x3c, 0xde,
+	0x7e, 0xa5, 0xfe, 0x8c, 0x91, 0x91, 0x58, 0x8a, 0x96, 0xa7, 0x46, 0x3c, 0x45, 0x47, 0x01, 0xf3,
+	0x07, 0x13, 0x32, 0x4a, 0x92, 0x3b, 0x19, 0xbb, 0x77, 0x60, 0xc5, 0xd6, 0x81, 0xe6, 0xeb, 0x34,
+	0x8e, 0xc2, 0x19, 0x55, 0x2f, 0x90, 0x03, 0xf7, 0xb1, 0xcd, 0xfa, 0x17, 0x1d, 0xe4, 0xff, 0xad,
+	0x42, 0x47, 0xeb, 0xcd, 0x61, 0x04, 0x35, 0x46, 0x5e, 0xa8, 0xfc, 0xe2, 0x8f, 0xe9, 0x2a, 0xcb,
+	0xeb, 0x8e, 0x78, 0xc6, 0xfb, 0xd0, 0x0e, 0xa6, 0x41, 0x2c, 0x80, 0xaa, 0x84, 0x4a, 0xde, 0x75,
+	0x98, 0xc8, 0x0f, 0xfc, 0xd8, 0xf7, 0x32, 0x33, 0xfc, 0x5b, 0xad, 0x74, 0x13, 0x38, 0x59, 0x4c,
+	0x39, 0xb9, 0xc6, 0x73, 0x86, 0x35, 0xcd, 0xf1, 0x7d, 0xe8, 0xa7, 0xe9, 0x2e, 0x1d, 0x2c, 0x98,
+	0x7d, 0x42, 0x43, 0x29, 0x3c, 0xe4, 0x00, 0xf8, 0x11, 0xe0, 0x48, 0x3f, 0xcd, 0xa4, 0x9b, 0xc6,
+	0x9c, 0xb2, 0xd5, 0xb3, 0x00, 0xf0, 0x63, 0x58, 0x1e, 0x1a, 0xc7, 0xb4, 0xf4, 0xd3, 0x9c, 0x7b,
+	0x47, 0xb7, 0x41, 0xdc, 0x31, 0xf4, 0x8c, 0x78, 0xfd, 0xe2, 0xc3, 0xdb, 0xa4, 0x9b, 0x5a, 0x9e,
+	0x6e, 0xdc, 0x17, 0xb0, 0x54, 0x08, 0xb0, 0xf5, 0x76, 0x9b, 0xfd, 0x74, 0x20, 0x4f, 0x67, 0x35,
+	0xd2, 0xaf, 0x39, 0x32, 0x75, 0x93, 0x21, 0x47, 0xc8, 0x8e, 0xa0, 0x58, 0xd0, 0x96, 0xa7, 0x46,
+	0x7c, 0x3b, 0x17, 0x97, 0xc4, 0xca, 0xa9, 0x13, 0x80, 0xac, 0xec, 0xc4, 0xb7, 0xa0, 0x4e, 0x89,
+	0x2a, 0x12, 0xed, 0xed, 0x07, 0xa1, 0xc7, 0x9f, 0x24, 0x95, 0xf9, 0xf3, 0xec, 0x17, 0x92, 0x2c,
+	0xf8, 0xa9, 0x3f, 0xae, 0xf5, 0x34, 0x4b, 0xf7, 0x57, 0xd0, 0x37, 0x2b, 0xf0, 0xcb, 0xbe, 0xd1,
+	0xbd, 0x0f, 0x5d, 0xbd, 0x3c, 0xe6, 0xbf, 0x12, 0x48, 0xbf, 0xc9, 0x7e, 0x2b, 0x36, 0x06, 0x92,
+	0x92, 0x45, 0xd9, 0xb9, 0x5b, 0xb0, 0x20, 0x0a, 0x79, 0x1e, 0x35, 0xd9, 0x65, 0x50, 0x91, 0x50,
+	0x23, 0xf7, 0x08, 0x7a, 0x46, 0xf5, 0x8e, 0xdf, 0x87, 0x06, 0x0d, 0x27, 0xc1, 0xf0, 0x5c, 0x18,
+	0xf6, 0xf7, 0x97, 0xb3, 0x29, 0x92, 0xe1, 0xe9, 0x91, 0x50, 0x79, 0xca, 0x84, 0x47, 0xf7, 0x94,
+	0x9c, 0xcb, 0xec, 0xe8, 0x7a, 0xe2, 0xd9, 0x25, 0xb0, 0xf8, 0xc4, 0x1f, 0x90, 0xc9, 0xc3, 0x70,
+	0xca, 0xe2, 0xc8, 0x0f, 0xa6, 0x31, 0xdf, 0xe4, 0xa7, 0xe4, 0x5c, 0x1d, 0x29, 0xfc, 0x11, 0xef,
+	0x40, 0x35, 0xa4, 0x2a, 0x88, 0xc9, 0x8e, 0xcc, 0xa1, 0xbe, 0xa1, 0x5e, 0x35, 0xe4, 0x85, 0x64,
+	0xe3, 0xa5, 0x3f, 0x99, 0x11, 0x99, 0x65, 0x6d, 0x4f, 0x8d, 0xdc, 0xbf, 0xd5, 0xa0, 0x67, 0x76,
+	0xa8, 0xb3, 0x92, 0xb3, 0x6d, 0xfc, 0xa8, 0xe5, 0x40, 0x53, 0x9c, 0x62, 0xea, 0x06, 0xd0, 0xf6,
+	0x92, 0x21, 0x3f, 0xec, 0x82, 0xe9, 0x88, 0xbc, 0x16, 0x29, 0xd6, 0xf3, 0xe4, 0x80, 0x1f, 0x9b,
+	0xe1, 0x4b, 0x12, 0x45, 0xc1, 0x28, 0x49, 0xb1, 0x74, 0xcc, 0x75, 0x2c, 0xf6, 0xa3, 0xf8, 0x0f,
+	0xe4, 0x5c, 0x1c, 0x07, 0x5d, 0x2f, 0x1d, 0xf3, 0x2f, 0x25, 0xd3, 0x11, 0xd7, 0x34, 0x64, 0x88,
+	0xe5, 0x08, 0xbf, 0x0b, 0xf5, 0x28, 0x9c, 0xc8, 0x9e, 0x49, 0x3f, 0x6d, 0x7c, 0x88, 0x36, 0x4e,
+	0x38, 0x21, 0xb2, 0x9e, 0xe7, 0x06, 0x59, 0xa5, 0xd7, 0xd2, 0x2a, 0x3d, 0xfc, 0x18, 0xd0, 0xc4,
+	0x8c, 0x0c, 0x73, 0xda, 0xea, 0x12, 0x67, 0x0d, 0x5c, 0xd2, 0xc2, 0xcf, 0xa3, 0xf0, 0x2d, 0xe8,
+	0x4f, 0xc2, 0xa1, 0x1f, 0x07, 0xe1, 0x54, 0x40, 0x98, 0x03, 0x22, 0xa4, 0x39, 0x29, 0xb7, 0x0b,
+	0x58, 0x38, 0x91, 0x22, 0xf2, 0x92, 0x4c, 0xc4, 0xaf, 0x44, 0x6d, 0x2f, 0x27, 0xbd, 0xfd, 0x43,
+	0x07, 0xea, 0xfc, 0xf3, 0xf1, 0x3a, 0xac, 0x8a, 0x69, 0x90, 0x71, 0xc0, 0x62, 0x12, 0xa5, 0xdb,
+	0x10, 0x5d, 0xc1, 0xd7, 0xc1, 0x91, 0xaa, 0x62, 0xbf, 0x12, 0x55, 0xca, 0xb5, 0x8c, 0xa2, 0x2a,
+	0xbe, 0x01, 0xeb, 0x5c, 0x6b, 0x6d, 0xcb, 0xa0, 0xda, 0x1c, 0x35, 0xa3, 0xa8, 0x8e, 0xaf, 0xc2,
+	0x32, 0x57, 0xe7, 0x1a, 0x43, 0x68, 0xc1, 0xaa, 0x60, 0x14, 0x35, 0x12, 0x45, 0xae, 0xa7, 0x82,
+	0x9a, 0x56, 0x05, 0xa3, 0xa8, 0x85, 0x31, 0xf4, 0xb9, 0x22, 0xeb, 0x82, 0xa0, 0x76, 0x5e, 0xc6,
+	0x28, 0x02, 0xbc, 0x0c, 0x8b, 0x42, 0x96, 0xf5, 0x27, 0x50, 0xa7, 0x20, 0x64, 0x14, 0x75, 0xb1,
+	0x03, 0x2b, 0x4a, 0x68, 0x74, 0x06, 0x50, 0xcf, 0xae, 0x61, 0x14, 0xf5, 0xf1, 0x1a, 0x60, 0x19,
+	0x45, 0xbd, 0x88, 0x47, 0x8b, 0x36, 0x39, 0xa3, 0x08, 0xe1, 0x6b, 0x70, 0x95, 0xcb, 0x2d, 0x95,
+	0x3f, 0x5a, 0x2a, 0x55, 0x32, 0x8a, 0x70, 0xf2, 0x0d, 0xf9, 0x32, 0x1d, 0x2d, 0x27, 0x93, 0xd1,
+	0xa8, 0x1d, 0xad, 0xe0, 0x0d, 0x58, 0xcb, 0xcc, 0xf5, 0x1b, 0x2f, 0x5a, 0x2d, 0xd3, 0x31, 0x8a,
+	0xd6, 0x12, 0x5d, 0xb1, 0xf6, 0x46, 0x57, 0xcb, 0x74, 0x8c, 0x22, 0x27, 0xcd, 0x08, 0x5b, 0xb1,
+	0x8d, 0xd6, 0xe7, 0xa8, 0x19, 0x45, 0x1b, 0xc9, 0xcc, 0x2d, 0x35, 0x34, 0xba, 0x56, 0xaa, 0x64,
+	0x14, 0x5d, 0x4f, 0xbe, 0xa9, 0x58, 0x1f, 0xa3, 0x1b, 0x65, 0x3a, 0x46, 0xd1, 0x26, 0x5e, 0x01,
+	0x94, 0xc5, 0x40, 0x96, 0x93, 0x68, 0xab, 0x28, 0x65, 0x14, 0x6d, 0x27, 0x52, 0xbd, 0x80, 0x45,
+	0x6f, 0x15, 0xa5, 0x8c, 0x22, 0x17, 0xaf, 0xc2, 0x92, 0x58, 0x0c, 0xbd, 0x4e, 0x45, 0x37, 0x2d,
+	0x62, 0x46, 0xd1, 0xdb, 0xc9, 0xa2, 0xe6, 0xcb, 0x4c, 0xf4, 0x8e, 0x5d, 0xc3, 0x28, 0xba, 0xa5,
+	0xed, 0x08, 0x63, 0x79, 0xde, 0xb5, 0x2a, 0x18, 0x45, 0x3b, 0x5a, 0x1c, 0x72, 0xd5, 0x0f, 0x7a,
+	0xaf, 0x4c, 0xc7, 0x28, 0xba, 0x8d, 0xb7, 0xe0, 0x1a, 0xd7, 0x95, 0xd4, 0x29, 0xe8, 0xfd, 0xb9,
+	0x06, 0x8c, 0xa2, 0x3b, 0xc9, 0x27, 0xe5, 0xaa, 0x09, 0xf4, 0x81, 0x55, 0xc1, 0x28, 0xda, 0x35,
+	0x73, 0xcc, 0x00, 0xed, 0x95, 0xe9, 0x18, 0x45, 0x1f, 0x6a, 0xf1, 0x32, 0xaa, 0x02, 0x74, 0xd7,
+	0xae, 0x61, 0x14, 0xed, 0x27, 0x47, 0xa0, 0xed, 0x32, 0x8e, 0xee, 0x95, 0x6b, 0x19, 0x45, 0x1f,
+	0x69, 0xc1, 0xd6, 0x1b, 0xaa, 0xe8, 0x63, 0xab, 0x82, 0x51, 0xf4, 0xc9, 0xed, 0xaf, 0xa1, 0xab,
+	0x13, 0x0f, 0x6e, 0xc3, 0xc2, 0xb7, 0x61, 0x2c, 0x4e, 0x6a, 0x80, 0x86, 0xbc, 0x9f, 0xa0, 0x0a,
+	0xee, 0x42, 0xeb, 0x8b, 0x70, 0x32, 0x09, 0x5f, 0x91, 0x08, 0x55, 0x71, 0x07, 0x9a, 0x4f, 0x88,
+	0x1f, 0xf1, 0x03, 0xbd, 0xc6, 0x07, 0xdf, 0x05, 0xf1, 0x94, 0x30, 0x86, 0xea, 0xb7, 0xef, 0xc3,
+	0x52, 0x81, 0xb5, 0x71, 0x03, 0xaa, 0x87, 0x53, 0x74, 0x85, 0xfb, 0x7e, 0x1a, 0xc6, 0x87, 0x53,
+	0x54, 0xe1, 0xbe, 0x1f, 0xbd, 0x0e, 0x58, 0xcc, 0x50, 0x15, 0xf7, 0xa0, 0xfd, 0x34, 0x8c, 0xd5,
+	0xb0, 0xf6, 0x00, 0xfd, 0xf4, 0x9f, 0xcd, 0x2b, 0x3f, 0xbe, 0xd9, 0xac, 0xfc, 0xf4, 0x66, 0xb3,
+	0xf2, 0xef, 0x37, 0x9b, 0x95, 0x41, 0x43, 0xfc, 0x0b, 0xea, 0xbd, 0xff, 0x0d, 0x00, 0x1f, 0x60,
+	0x28, 0xdf, 0x15, 0x2b, 0x00, 0x00,
 }
 
-func (m *PutPlacementRuleRsp) Marshal() (dAtA []byte, err error) {
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *PutPlacementRuleRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *GetAppliedRulesReq) Marshal() (dAtA []byte, err error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetAppliedRulesReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
-	}
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *GetAppliedRulesRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAppliedRulesRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rules) > 0 {
-		for _, msg := range m.Rules {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	{
+		size, err := m.GetTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size, err := m.GetPlacementRules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *CreateJobReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	{
+		size, err := m.GetSchedulers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateJobReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
-	n58, err := m.Job.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size, err := m.RemoveScheduler.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	i += n58
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	{
+		size, err := m.AddScheduler.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *CreateJobRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size, err := m.UpdateContainerState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateJobRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size, err := m.GetHotResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *RemoveJobReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size, err := m.GetResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveJobReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
-	n59, err := m.Job.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size, err := m.GetContainers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	i += n59
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size, err := m.ExecuteJob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *RemoveJobRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size, err := m.RemoveJob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveJobRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.CreateJob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *ExecuteJobReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size, err := m.GetAppliedRules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteJobReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
-	n60, err := m.Job.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size, err := m.PutPlacementRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	i += n60
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.CheckResourceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.RemoveResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *ExecuteJobRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.CreateResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteJobRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.CreateWatcher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.BatchReportSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *EventNotify) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.ReportSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventNotify) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Seq))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.AskBatchSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.AskSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.InitEvent != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.InitEvent.Size()))
-		n61, err := m.InitEvent.MarshalTo(dAtA[i:])
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.AllocID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.ResourceEvent != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceEvent.Size()))
-		n62, err := m.ResourceEvent.MarshalTo(dAtA[i:])
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.GetContainer.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.ContainerEvent != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerEvent.Size()))
-		n63, err := m.ContainerEvent.MarshalTo(dAtA[i:])
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.PutContainer.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.ResourceStatsEvent != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceStatsEvent.Size()))
-		n64, err := m.ResourceStatsEvent.MarshalTo(dAtA[i:])
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ContainerHeartbeat.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.ContainerStatsEvent != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerStatsEvent.Size()))
-		n65, err := m.ContainerStatsEvent.MarshalTo(dAtA[i:])
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ResourceHeartbeat.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x22
+	if m.Type != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return i, nil
+	if m.ID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InitEventData) Marshal() (dAtA []byte, err error) {
+func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *InitEventData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.GetTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if len(m.Leaders) > 0 {
-		dAtA67 := make([]byte, len(m.Leaders)*10)
-		var j66 int
-		for _, num := range m.Leaders {
-			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j66++
-			}
-			dAtA67[j66] = uint8(num)
-			j66++
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	{
+		size, err := m.GetPlacementRules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j66))
-		i += copy(dAtA[i:], dAtA67[:j66])
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if len(m.Containers) > 0 {
-		for _, b := range m.Containers {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size, err := m.GetSchedulers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	{
+		size, err := m.RemoveScheduler.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *ResourceEventData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size, err := m.AddScheduler.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResourceEventData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	{
+		size, err := m.UpdateContainerState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.Leader != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size, err := m.GetHotResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.Removed {
-		dAtA[i] = 0x18
-		i++
-		if m.Removed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size, err := m.GetResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i++
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.Create {
-		dAtA[i] = 0x20
-		i++
-		if m.Create {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size, err := m.GetContainers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i++
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size, err := m.ExecuteJob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *ContainerEventData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size, err := m.RemoveJob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContainerEventData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size, err := m.CreateJob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.GetAppliedRules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return i, nil
-}
-
-func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size, err := m.PutPlacementRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Peer.Size()))
-	n68, err := m.Peer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size, err := m.CheckResourceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	i += n68
-	if m.ChangeType != 0 {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.RemoveResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.CreateResources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.BatchReportSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.ReportSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.AskBatchSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.AskSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.AllocID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.GetContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.PutContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.ContainerHeartbeat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ResourceHeartbeat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+		i--
 		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangeType))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+func (m *ResourceHeartbeatReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *ResourceHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceHeartbeatReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Peer.Size()))
-	n69, err := m.Peer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n69
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Stats.Size()
+		i -= size
+		if _, err := m.Stats.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PendingPeers) > 0 {
+		for iNdEx := len(m.PendingPeers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PendingPeers[iNdEx].Size()
+				i -= size
+				if _, err := m.PendingPeers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DownPeers) > 0 {
+		for iNdEx := len(m.DownPeers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DownPeers[iNdEx].Size()
+				i -= size
+				if _, err := m.DownPeers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Leader != nil {
+		{
+			size := m.Leader.Size()
+			i -= size
+			if _, err := m.Leader.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Term != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangePeerV2) Marshal() (dAtA []byte, err error) {
+func (m *ResourceHeartbeatRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeerV2) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *ResourceHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceHeartbeatRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Changes) > 0 {
-		for _, msg := range m.Changes {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DestoryDirectly {
+		i--
+		if m.DestoryDirectly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChangePeerV2 != nil {
+		{
+			size, err := m.ChangePeerV2.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.SplitResource != nil {
+		{
+			size, err := m.SplitResource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Merge != nil {
+		{
+			size, err := m.Merge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TransferLeader != nil {
+		{
+			size, err := m.TransferLeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChangePeer != nil {
+		{
+			size, err := m.ChangePeer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TargetPeer != nil {
+		{
+			size := m.TargetPeer.Size()
+			i -= size
+			if _, err := m.TargetPeer.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ResourceEpoch.Size()
+		i -= size
+		if _, err := m.ResourceEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ResourceID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *Merge) Marshal() (dAtA []byte, err error) {
+func (m *PutContainerReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *PutContainerReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutContainerReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Target) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Target)))
-		i += copy(dAtA[i:], m.Target)
-	}
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Container) > 0 {
+		i -= len(m.Container)
+		copy(dAtA[i:], m.Container)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Container)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *SplitResource) Marshal() (dAtA []byte, err error) {
+func (m *PutContainerRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *SplitResource) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *PutContainerRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutContainerRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Policy))
-	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *LabelConstraint) Marshal() (dAtA []byte, err error) {
+func (m *ContainerHeartbeatReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *LabelConstraint) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *ContainerHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerHeartbeatReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Op != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Op))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	{
+		size := m.Stats.Size()
+		i -= size
+		if _, err := m.Stats.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PlacementRule) Marshal() (dAtA []byte, err error) {
+func (m *ContainerHeartbeatRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *PlacementRule) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *ContainerHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerHeartbeatRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupID) > 0 {
+	if len(m.Jobs) > 0 {
+		dAtA66 := make([]byte, len(m.Jobs)*10)
+		var j65 int
+		for _, num := range m.Jobs {
+			for num >= 1<<7 {
+				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j65++
+			}
+			dAtA66[j65] = uint8(num)
+			j65++
+		}
+		i -= j65
+		copy(dAtA[i:], dAtA66[:j65])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j65))
+		i--
 		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.GroupID)))
-		i += copy(dAtA[i:], m.GroupID)
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Index))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Override {
-		dAtA[i] = 0x20
-		i++
-		if m.Override {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return len(dAtA) - i, nil
+}
+
+func (m *GetContainerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StartKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.StartKey)))
-		i += copy(dAtA[i:], m.StartKey)
+	return dAtA[:n], nil
+}
+
+func (m *GetContainerReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetContainerReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.EndKey) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.EndKey)))
-		i += copy(dAtA[i:], m.EndKey)
+	if m.ID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Role != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Role))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetContainerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
+	return dAtA[:n], nil
+}
+
+func (m *GetContainerRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetContainerRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.LabelConstraints) > 0 {
-		for _, msg := range m.LabelConstraints {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
+	if m.Stats != nil {
+		{
+			size := m.Stats.Size()
+			i -= size
+			if _, err := m.Stats.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i += n
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.LocationLabels) > 0 {
-		for _, s := range m.LocationLabels {
-			dAtA[i] = 0x52
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.IsolationLevel) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IsolationLevel)))
-		i += copy(dAtA[i:], m.IsolationLevel)
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocIDReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocIDReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocIDReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func encodeVarintRpcpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllocIDRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AllocIDRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocIDRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ContainerID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	if m.ID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Type != 0 {
-		n += 1 + sovRpcpb(uint64(m.Type))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTimestampReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.ResourceHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ContainerHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.PutContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.GetContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AllocID.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskBatchSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.BatchReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CreateWatcher.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CreateResources.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.RemoveResources.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CheckResourceState.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.PutPlacementRule.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.GetAppliedRules.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.CreateJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.RemoveJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.ExecuteJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetTimestampReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTimestampReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Count != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTimestampRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTimestampRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTimestampRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Type != 0 {
-		n += 1 + sovRpcpb(uint64(m.Type))
+	if m.Timestamp != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AskSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AskSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AskSplitReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = m.ResourceHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ContainerHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.PutContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.GetContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AllocID.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskBatchSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.BatchReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.Event.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CreateResources.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.RemoveResources.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.CheckResourceState.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.PutPlacementRule.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.GetAppliedRules.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.CreateJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.RemoveJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.ExecuteJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceHeartbeatReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AskSplitRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContainerID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ContainerID))
-	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Term != 0 {
-		n += 1 + sovRpcpb(uint64(m.Term))
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if len(m.DownPeers) > 0 {
-		for _, e := range m.DownPeers {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PendingPeers) > 0 {
-		for _, e := range m.PendingPeers {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
+	{
+		size, err := m.SplitID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	l = m.Stats.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceHeartbeatRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReportSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportSplitReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ResourceID))
-	}
-	l = m.ResourceEpoch.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	if m.TargetPeer != nil {
-		l = m.TargetPeer.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Merge != nil {
-		l = m.Merge.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SplitResource != nil {
-		l = m.SplitResource.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Right) > 0 {
+		i -= len(m.Right)
+		copy(dAtA[i:], m.Right)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Right)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChangePeerV2 != nil {
-		l = m.ChangePeerV2.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Left) > 0 {
+		i -= len(m.Left)
+		copy(dAtA[i:], m.Left)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Left)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.DestoryDirectly {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportSplitRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PutContainerReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskBatchSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskBatchSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AskBatchSplitReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Container)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Count != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PutContainerRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskBatchSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskBatchSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AskBatchSplitRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.SplitIDs) > 0 {
+		for iNdEx := len(m.SplitIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitIDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContainerHeartbeatReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchReportSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchReportSplitReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Stats.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContainerHeartbeatRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchReportSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchReportSplitRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetContainerReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SplitID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetContainerRsp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.NewPeerIDs) > 0 {
+		dAtA70 := make([]byte, len(m.NewPeerIDs)*10)
+		var j69 int
+		for _, num := range m.NewPeerIDs {
+			for num >= 1<<7 {
+				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j69++
+			}
+			dAtA70[j69] = uint8(num)
+			j69++
+		}
+		i -= j69
+		copy(dAtA[i:], dAtA70[:j69])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j69))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.NewID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.NewID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllocIDReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateWatcherReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateWatcherReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateWatcherReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Flag != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Flag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllocIDRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateResourcesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.LeastPeers) > 0 {
+		dAtA72 := make([]byte, len(m.LeastPeers)*10)
+		var j71 int
+		for _, num := range m.LeastPeers {
+			for num >= 1<<7 {
+				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j71++
+			}
+			dAtA72[j71] = uint8(num)
+			j71++
+		}
+		i -= j71
+		copy(dAtA[i:], dAtA72[:j71])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j71))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AskSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateResourcesRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AskSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveResourcesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SplitID.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.IDs) > 0 {
+		dAtA74 := make([]byte, len(m.IDs)*10)
+		var j73 int
+		for _, num := range m.IDs {
+			for num >= 1<<7 {
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j73++
+			}
+			dAtA74[j73] = uint8(num)
+			j73++
+		}
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j73))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReportSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveResourcesRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Left)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	l = len(m.Right)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReportSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckResourceStateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckResourceStateReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckResourceStateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.IDs) > 0 {
+		i -= len(m.IDs)
+		copy(dAtA[i:], m.IDs)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IDs)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AskBatchSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckResourceStateRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckResourceStateRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckResourceStateRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovRpcpb(uint64(m.Count))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Removed) > 0 {
+		dAtA76 := make([]byte, len(m.Removed)*10)
+		var j75 int
+		for _, num := range m.Removed {
+			for num >= 1<<7 {
+				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j75++
+			}
+			dAtA76[j75] = uint8(num)
+			j75++
+		}
+		i -= j75
+		copy(dAtA[i:], dAtA76[:j75])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j75))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AskBatchSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PutPlacementRuleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PutPlacementRuleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutPlacementRuleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SplitIDs) > 0 {
-		for _, e := range m.SplitIDs {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchReportSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PutPlacementRuleRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PutPlacementRuleRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutPlacementRuleRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchReportSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAppliedRulesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAppliedRulesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAppliedRulesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ResourceID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SplitID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAppliedRulesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAppliedRulesRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAppliedRulesRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewID != 0 {
-		n += 1 + sovRpcpb(uint64(m.NewID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NewPeerIDs) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIDs {
-			l += sovRpcpb(uint64(e))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateWatcherReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Flag != 0 {
-		n += 1 + sovRpcpb(uint64(m.Flag))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CreateJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateResourcesReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CreateJobReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateJobReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.LeastPeers) > 0 {
-		l = 0
-		for _, e := range m.LeastPeers {
-			l += sovRpcpb(uint64(e))
+	{
+		size := m.Job.Size()
+		i -= size
+		if _, err := m.Job.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateResourcesRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateJobRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveResourcesReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveJobReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveJobReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IDs) > 0 {
-		l = 0
-		for _, e := range m.IDs {
-			l += sovRpcpb(uint64(e))
-		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size := m.Job.Size()
+		i -= size
+		if _, err := m.Job.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveResourcesRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveJobRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckResourceStateReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteJobReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteJobReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.IDs)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *CheckResourceStateRsp) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if len(m.Removed) > 0 {
-		l = 0
-		for _, e := range m.Removed {
-			l += sovRpcpb(uint64(e))
+	{
+		size := m.Job.Size()
+		i -= size
+		if _, err := m.Job.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PutPlacementRuleReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteJobRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Rule.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PutPlacementRuleRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetContainersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetContainersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetContainersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAppliedRulesReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetContainersRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetContainersRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetContainersRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ResourceID))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Stats[iNdEx].Size()
+				i -= size
+				if _, err := m.Stats[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Containers[iNdEx])
+			copy(dAtA[i:], m.Containers[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Containers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAppliedRulesRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResourcesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rules) > 0 {
-		for _, e := range m.Rules {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateJobReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResourcesRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Job.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Stats[iNdEx].Size()
+				i -= size
+				if _, err := m.Stats[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.Leaders) > 0 {
+		dAtA82 := make([]byte, len(m.Leaders)*10)
+		var j81 int
+		for _, num := range m.Leaders {
+			for num >= 1<<7 {
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j81++
+			}
+			dAtA82[j81] = uint8(num)
+			j81++
+		}
+		i -= j81
+		copy(dAtA[i:], dAtA82[:j81])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j81))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateJobRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HotResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HotResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeyRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.KeyRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.ByteRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ByteRate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.HotDegree != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.HotDegree))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.IsLeader {
+		i--
+		if m.IsLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ResourceID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveJobReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHotResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHotResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotResourcesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Job.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveJobRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetHotResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHotResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotResourcesRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Reads) > 0 {
+		for iNdEx := len(m.Reads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Writes) > 0 {
+		for iNdEx := len(m.Writes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Writes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExecuteJobReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateContainerStateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateContainerStateReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateContainerStateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Job.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExecuteJobRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateContainerStateRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateContainerStateRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateContainerStateRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNotify) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddSchedulerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSchedulerReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSchedulerReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovRpcpb(uint64(m.Seq))
-	}
-	if m.Type != 0 {
-		n += 1 + sovRpcpb(uint64(m.Type))
-	}
-	if m.InitEvent != nil {
-		l = m.InitEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ResourceEvent != nil {
-		l = m.ResourceEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ContainerEvent != nil {
-		l = m.ContainerEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ResourceStatsEvent != nil {
-		l = m.ResourceStatsEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ContainerStatsEvent != nil {
-		l = m.ContainerStatsEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InitEventData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddSchedulerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSchedulerRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSchedulerRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
-	if len(m.Leaders) > 0 {
-		l = 0
-		for _, e := range m.Leaders {
-			l += sovRpcpb(uint64(e))
-		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
-	if len(m.Containers) > 0 {
-		for _, b := range m.Containers {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceEventData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveSchedulerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSchedulerReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSchedulerReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Leader != 0 {
-		n += 1 + sovRpcpb(uint64(m.Leader))
-	}
-	if m.Removed {
-		n += 2
-	}
-	if m.Create {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContainerEventData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveSchedulerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSchedulerRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSchedulerRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangePeer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSchedulersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSchedulersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSchedulersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Peer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	if m.ChangeType != 0 {
-		n += 1 + sovRpcpb(uint64(m.ChangeType))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferLeader) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSchedulersRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSchedulersRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSchedulersRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Peer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Schedulers) > 0 {
+		for iNdEx := len(m.Schedulers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedulers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangePeerV2) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchedulerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Merge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPlacementRulesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPlacementRulesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPlacementRulesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SplitResource) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPlacementRulesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPlacementRulesRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPlacementRulesRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Policy != 0 {
-		n += 1 + sovRpcpb(uint64(m.Policy))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LabelConstraint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNotify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNotify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNotify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Op != 0 {
-		n += 1 + sovRpcpb(uint64(m.Op))
+	if m.ContainerStatsEvent != nil {
+		{
+			size := m.ContainerStatsEvent.Size()
+			i -= size
+			if _, err := m.ContainerStatsEvent.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovRpcpb(uint64(l))
+	if m.ResourceStatsEvent != nil {
+		{
+			size := m.ResourceStatsEvent.Size()
+			i -= size
+			if _, err := m.ResourceStatsEvent.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ContainerEvent != nil {
+		{
+			size, err := m.ContainerEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ResourceEvent != nil {
+		{
+			size, err := m.ResourceEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InitEvent != nil {
+		{
+			size, err := m.InitEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seq != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitEventData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InitEventData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitEventData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Containers[iNdEx])
+			copy(dAtA[i:], m.Containers[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Containers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Leaders) > 0 {
+		dAtA89 := make([]byte, len(m.Leaders)*10)
+		var j88 int
+		for _, num := range m.Leaders {
+			for num >= 1<<7 {
+				dAtA89[j88] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j88++
+			}
+			dAtA89[j88] = uint8(num)
+			j88++
+		}
+		i -= j88
+		copy(dAtA[i:], dAtA89[:j88])
+		i = encodeVarintRpcpb(dAtA, i, uint64(j88))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PlacementRule) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceEventData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceEventData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceEventData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.GroupID)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.Create {
+		i--
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Index != 0 {
-		n += 1 + sovRpcpb(uint64(m.Index))
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Override {
-		n += 2
+	if m.Leader != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerEventData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Role != 0 {
-		n += 1 + sovRpcpb(uint64(m.Role))
+	return dAtA[:n], nil
+}
+
+func (m *ContainerEventData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerEventData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Count != 0 {
-		n += 1 + sovRpcpb(uint64(m.Count))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.LabelConstraints) > 0 {
-		for _, e := range m.LabelConstraints {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LocationLabels) > 0 {
-		for _, s := range m.LocationLabels {
-			l = len(s)
-			n += 1 + l + sovRpcpb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePeer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangeType != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangeType))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Peer.Size()
+		i -= size
+		if _, err := m.Peer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
 	}
-	l = len(m.IsolationLevel)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size := m.Peer.Size()
+		i -= size
+		if _, err := m.Peer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovRpcpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *ChangePeerV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func sozRpcpb(x uint64) (n int) {
-	return sovRpcpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ChangePeerV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcpb
+
+func (m *ChangePeerV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Merge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Merge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Policy != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LabelConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabelConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabelConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Op != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlacementRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IsolationLevel) > 0 {
+		i -= len(m.IsolationLevel)
+		copy(dAtA[i:], m.IsolationLevel)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IsolationLevel)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.LocationLabels) > 0 {
+		for iNdEx := len(m.LocationLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LocationLabels[iNdEx])
+			copy(dAtA[i:], m.LocationLabels[iNdEx])
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(m.LocationLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LabelConstraints) > 0 {
+		for iNdEx := len(m.LabelConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Role != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Override {
+		i--
+		if m.Override {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Index != 0 {
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GroupID) > 0 {
+		i -= len(m.GroupID)
+		copy(dAtA[i:], m.GroupID)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.GroupID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcpb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcpb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	l = m.ResourceHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ContainerHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.PutContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.GetContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AllocID.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskBatchSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.BatchReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CreateWatcher.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CreateResources.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.RemoveResources.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CheckResourceState.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.PutPlacementRule.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetAppliedRules.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.CreateJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ExecuteJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetContainers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetHotResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.UpdateContainerState.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.AddScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetSchedulers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetPlacementRules.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetTimestamp.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = m.ResourceHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ContainerHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.PutContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.GetContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AllocID.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskBatchSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.BatchReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.Event.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CreateResources.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.RemoveResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.CheckResourceState.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.PutPlacementRule.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetAppliedRules.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.CreateJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ExecuteJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetContainers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetHotResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.UpdateContainerState.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.AddScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetSchedulers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetPlacementRules.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetTimestamp.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceHeartbeatReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Term != 0 {
+		n += 1 + sovRpcpb(uint64(m.Term))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if len(m.DownPeers) > 0 {
+		for _, e := range m.DownPeers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, e := range m.PendingPeers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceHeartbeatRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	l = m.ResourceEpoch.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.TargetPeer != nil {
+		l = m.TargetPeer.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.SplitResource != nil {
+		l = m.SplitResource.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ChangePeerV2 != nil {
+		l = m.ChangePeerV2.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.DestoryDirectly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutContainerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Container)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutContainerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerHeartbeatReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerHeartbeatRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if len(m.Jobs) > 0 {
+		l = 0
+		for _, e := range m.Jobs {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContainerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContainerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocIDReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocIDRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTimestampReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovRpcpb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTimestampRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovRpcpb(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SplitID.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Left)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.Right)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpcpb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SplitIDs) > 0 {
+		for _, e := range m.SplitIDs {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchReportSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchReportSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewID != 0 {
+		n += 1 + sovRpcpb(uint64(m.NewID))
+	}
+	if len(m.NewPeerIDs) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIDs {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateWatcherReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Flag != 0 {
+		n += 1 + sovRpcpb(uint64(m.Flag))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.LeastPeers) > 0 {
+		l = 0
+		for _, e := range m.LeastPeers {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		l = 0
+		for _, e := range m.IDs {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckResourceStateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IDs)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckResourceStateRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		l = 0
+		for _, e := range m.Removed {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutPlacementRuleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rule.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutPlacementRuleRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAppliedRulesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAppliedRulesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecuteJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecuteJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContainersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContainersRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, b := range m.Containers {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		l = 0
+		for _, e := range m.Leaders {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HotResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.IsLeader {
+		n += 2
+	}
+	if m.HotDegree != 0 {
+		n += 1 + sovRpcpb(uint64(m.HotDegree))
+	}
+	if m.ByteRate != 0 {
+		n += 9
+	}
+	if m.KeyRate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Writes) > 0 {
+		for _, e := range m.Writes {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.Reads) > 0 {
+		for _, e := range m.Reads {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateContainerStateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.State != 0 {
+		n += 1 + sovRpcpb(uint64(m.State))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateContainerStateRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSchedulerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSchedulerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveSchedulerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveSchedulerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSchedulersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSchedulersRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedulers) > 0 {
+		for _, e := range m.Schedulers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPlacementRulesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPlacementRulesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventNotify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovRpcpb(uint64(m.Seq))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	if m.InitEvent != nil {
+		l = m.InitEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ResourceEvent != nil {
+		l = m.ResourceEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ContainerEvent != nil {
+		l = m.ContainerEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ResourceStatsEvent != nil {
+		l = m.ResourceStatsEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ContainerStatsEvent != nil {
+		l = m.ContainerStatsEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InitEventData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		l = 0
+		for _, e := range m.Leaders {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if len(m.Containers) > 0 {
+		for _, b := range m.Containers {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceEventData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Leader != 0 {
+		n += 1 + sovRpcpb(uint64(m.Leader))
+	}
+	if m.Removed {
+		n += 2
+	}
+	if m.Create {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerEventData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Peer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.ChangeType != 0 {
+		n += 1 + sovRpcpb(uint64(m.ChangeType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Peer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Merge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovRpcpb(uint64(m.Policy))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LabelConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovRpcpb(uint64(m.Op))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlacementRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRpcpb(uint64(m.Index))
+	}
+	if m.Override {
+		n += 2
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRpcpb(uint64(m.Role))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpcpb(uint64(m.Count))
+	}
+	if len(m.LabelConstraints) > 0 {
+		for _, e := range m.LabelConstraints {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.LocationLabels) > 0 {
+		for _, s := range m.LocationLabels {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	l = len(m.IsolationLevel)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcpb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcpb(x uint64) (n int) {
+	return sovRpcpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWatcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateWatcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetContainers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetHotResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetHotResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateContainerState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdateContainerState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetSchedulers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetSchedulers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetPlacementRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetPlacementRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6598,16 +11141,30 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			m.ContainerID = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6617,16 +11174,30 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContainerID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			m.Type = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6636,14 +11207,28 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6670,13 +11255,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6703,13 +11288,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6736,13 +11321,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6769,13 +11354,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6802,13 +11387,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6835,13 +11420,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6868,13 +11453,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetContainers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6901,13 +11486,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetContainers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6934,13 +11519,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateWatcher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetHotResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6967,13 +11552,112 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateWatcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetHotResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateContainerState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdateContainerState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetSchedulers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7000,13 +11684,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetSchedulers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetPlacementRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7033,13 +11717,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetPlacementRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7066,15 +11750,88 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GetTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceHeartbeatReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7084,28 +11841,48 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Resource = append(m.Resource[:0], dAtA[iNdEx:postIndex]...)
+			if m.Resource == nil {
+				m.Resource = []byte{}
 			}
 			iNdEx = postIndex
-		case 18:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7132,13 +11909,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7165,13 +11945,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DownPeers = append(m.DownPeers, metapb.PeerStats{})
+			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7198,13 +11979,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingPeers = append(m.PendingPeers, metapb.Peer{})
+			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7231,7 +12013,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7260,7 +12042,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7283,17 +12065,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceHeartbeatRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
 			}
-			m.ID = 0
+			m.ResourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7303,16 +12085,121 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.ResourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetPeer == nil {
+				m.TargetPeer = &metapb.Peer{}
+			}
+			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeer{}
 			}
-			m.Type = 0
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7322,16 +12209,33 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeader{}
+			}
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7341,29 +12245,33 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if m.Merge == nil {
+				m.Merge = &Merge{}
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitResource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7373,27 +12281,31 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
+			if m.SplitResource == nil {
+				m.SplitResource = &SplitResource{}
+			}
+			if err := m.SplitResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7420,15 +12332,18 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChangePeerV2 == nil {
+				m.ChangePeerV2 = &ChangePeerV2{}
+			}
+			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestoryDirectly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7438,30 +12353,71 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.DestoryDirectly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutContainerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7471,28 +12427,137 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = append(m.Container[:0], dAtA[iNdEx:postIndex]...)
+			if m.Container == nil {
+				m.Container = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutContainerRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerHeartbeatReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7519,15 +12584,15 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7537,63 +12602,85 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 11:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerHeartbeatRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7603,63 +12690,154 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+		case 2:
+			if wireType == 0 {
+				var v metapb.JobType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= metapb.JobType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Jobs = append(m.Jobs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Jobs) == 0 {
+					m.Jobs = make([]metapb.JobType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v metapb.JobType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= metapb.JobType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Jobs = append(m.Jobs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContainerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7669,30 +12847,70 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 14:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContainerRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7702,28 +12920,29 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7750,15 +12969,126 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &metapb.ContainerStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7768,30 +13098,70 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTimestampReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTimestampReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTimestampReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7801,30 +13171,70 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTimestampRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTimestampRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTimestampRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7834,30 +13244,70 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 19:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7867,28 +13317,83 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7915,15 +13420,69 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SplitID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportSplitReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7933,30 +13492,31 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Left = append(m.Left[:0], dAtA[iNdEx:postIndex]...)
+			if m.Left == nil {
+				m.Left = []byte{}
 			}
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7966,23 +13526,24 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Right = append(m.Right[:0], dAtA[iNdEx:postIndex]...)
+			if m.Right == nil {
+				m.Right = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8010,7 +13571,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8033,89 +13594,71 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceHeartbeatReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
-			}
-			m.ContainerID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContainerID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = append(m.Resource[:0], dAtA[iNdEx:postIndex]...)
-			if m.Resource == nil {
-				m.Resource = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Term = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Term |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskBatchSplitReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskBatchSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8125,33 +13668,31 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8161,63 +13702,68 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownPeers = append(m.DownPeers, metapb.PeerStats{})
-			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingPeers = append(m.PendingPeers, metapb.Peer{})
-			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskBatchSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskBatchSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8244,7 +13790,8 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SplitIDs = append(m.SplitIDs, SplitID{})
+			if err := m.SplitIDs[len(m.SplitIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8273,7 +13820,7 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
+func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8296,36 +13843,17 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceHeartbeatRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchReportSplitReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
-			}
-			m.ResourceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResourceID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8335,66 +13863,137 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
+			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			if msglen < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchReportSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetPeer == nil {
-				m.TargetPeer = &metapb.Peer{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewID", wireType)
+			}
+			m.NewID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8404,69 +14003,146 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeer{}
-			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIDs = append(m.NewPeerIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.NewPeerIDs) == 0 {
+					m.NewPeerIDs = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIDs = append(m.NewPeerIDs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIDs", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeader{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWatcherReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWatcherReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+			}
+			m.Flag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8476,33 +14152,70 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Flag |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &Merge{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateResourcesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8512,84 +14225,100 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SplitResource == nil {
-				m.SplitResource = &SplitResource{}
-			}
-			if err := m.SplitResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
+			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.LeastPeers = append(m.LeastPeers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangePeerV2 == nil {
-				m.ChangePeerV2 = &ChangePeerV2{}
-			}
-			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestoryDirectly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.LeastPeers) == 0 {
+					m.LeastPeers = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LeastPeers = append(m.LeastPeers, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeastPeers", wireType)
 			}
-			m.DestoryDirectly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8615,7 +14344,7 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
+func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8638,46 +14367,12 @@ func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutContainerReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateResourcesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Container = append(m.Container[:0], dAtA[iNdEx:postIndex]...)
-			if m.Container == nil {
-				m.Container = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8703,7 +14398,7 @@ func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
+func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8726,12 +14421,88 @@ func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutContainerRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveResourcesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IDs = append(m.IDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IDs) == 0 {
+					m.IDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IDs = append(m.IDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8757,7 +14528,7 @@ func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8780,79 +14551,12 @@ func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerHeartbeatReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveResourcesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8878,7 +14582,7 @@ func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
+func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8901,15 +14605,15 @@ func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerHeartbeatRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckResourceStateReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckResourceStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8936,9 +14640,9 @@ func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.IDs = append(m.IDs[:0], dAtA[iNdEx:postIndex]...)
+			if m.IDs == nil {
+				m.IDs = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8966,7 +14670,7 @@ func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
+func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8989,30 +14693,87 @@ func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetContainerReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckResourceStateRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+			return fmt.Errorf("proto: CheckResourceStateRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Removed = append(m.Removed, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Removed) == 0 {
+					m.Removed = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Removed = append(m.Removed, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -9039,7 +14800,7 @@ func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
+func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9062,49 +14823,15 @@ func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetContainerRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutPlacementRuleReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutPlacementRuleReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9131,10 +14858,7 @@ func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &metapb.ContainerStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9163,7 +14887,7 @@ func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
+func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9186,10 +14910,10 @@ func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutPlacementRuleRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutPlacementRuleRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9217,7 +14941,7 @@ func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
+func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9240,17 +14964,17 @@ func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAppliedRulesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAppliedRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
 			}
-			m.ID = 0
+			m.ResourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9260,7 +14984,7 @@ func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.ResourceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9290,7 +15014,7 @@ func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
+func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9313,17 +15037,17 @@ func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAppliedRulesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAppliedRulesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9333,24 +15057,24 @@ func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Rules = append(m.Rules, PlacementRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9378,7 +15102,7 @@ func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9401,15 +15125,15 @@ func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateJobReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9436,7 +15160,7 @@ func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SplitID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9465,7 +15189,7 @@ func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
+func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9488,51 +15212,71 @@ func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateJobRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Left = append(m.Left[:0], dAtA[iNdEx:postIndex]...)
-			if m.Left == nil {
-				m.Left = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveJobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9542,24 +15286,23 @@ func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Right = append(m.Right[:0], dAtA[iNdEx:postIndex]...)
-			if m.Right == nil {
-				m.Right = []byte{}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9587,7 +15330,7 @@ func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *RemoveJobRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9610,10 +15353,10 @@ func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveJobRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9641,7 +15384,7 @@ func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
+func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9664,17 +15407,17 @@ func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteJobReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9684,104 +15427,30 @@ func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitRsp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9791,24 +15460,24 @@ func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SplitIDs = append(m.SplitIDs, SplitID{})
-			if err := m.SplitIDs[len(m.SplitIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9836,7 +15505,7 @@ func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
+func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9859,15 +15528,15 @@ func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchReportSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteJobRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9894,8 +15563,10 @@ func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
-			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9922,7 +15593,7 @@ func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *GetContainersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9945,10 +15616,10 @@ func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchReportSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetContainersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetContainersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9976,7 +15647,7 @@ func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitID) Unmarshal(dAtA []byte) error {
+func (m *GetContainersRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9999,17 +15670,17 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetContainersRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetContainersRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
-			m.NewID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -10019,87 +15690,58 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, make([]byte, postIndex-iNdEx))
+			copy(m.Containers[len(m.Containers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIDs = append(m.NewPeerIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.NewPeerIDs) == 0 {
-					m.NewPeerIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIDs = append(m.NewPeerIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIDs", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, metapb.ContainerStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10125,7 +15767,7 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
+func (m *GetResourcesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10148,31 +15790,12 @@ func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWatcherReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResourcesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWatcherReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
-			}
-			m.Flag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Flag |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10198,7 +15821,7 @@ func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
+func (m *GetResourcesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10221,10 +15844,10 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResourcesReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResourcesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10276,7 +15899,7 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.LeastPeers = append(m.LeastPeers, v)
+				m.Leaders = append(m.Leaders, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -10311,8 +15934,8 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.LeastPeers) == 0 {
-					m.LeastPeers = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Leaders) == 0 {
+					m.Leaders = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -10330,65 +15953,45 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.LeastPeers = append(m.LeastPeers, v)
+					m.Leaders = append(m.Leaders, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeastPeers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpcpb
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcpb
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Stats = append(m.Stats, metapb.ResourceStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResourcesRsp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10414,7 +16017,7 @@ func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
+func (m *HotResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10437,88 +16040,111 @@ func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResourcesReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: HotResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HotResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
+			}
+			m.ResourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				m.IDs = append(m.IDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.IDs) == 0 {
-					m.IDs = make([]uint64, 0, elementCount)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IDs = append(m.IDs, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeader = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotDegree", wireType)
+			}
+			m.HotDegree = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HotDegree |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ByteRate = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.KeyRate = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10544,7 +16170,7 @@ func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
+func (m *GetHotResourcesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10567,10 +16193,10 @@ func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResourcesRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHotResourcesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHotResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10598,7 +16224,7 @@ func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
+func (m *GetHotResourcesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10621,17 +16247,17 @@ func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckResourceStateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHotResourcesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckResourceStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHotResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -10641,24 +16267,58 @@ func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IDs = append(m.IDs[:0], dAtA[iNdEx:postIndex]...)
-			if m.IDs == nil {
-				m.IDs = []byte{}
+			m.Writes = append(m.Writes, HotResource{})
+			if err := m.Writes[len(m.Writes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reads = append(m.Reads, HotResource{})
+			if err := m.Reads[len(m.Reads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10686,7 +16346,7 @@ func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
+func (m *UpdateContainerStateReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10709,88 +16369,70 @@ func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckResourceStateRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateContainerStateReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckResourceStateRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateContainerStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				m.Removed = append(m.Removed, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= metapb.ContainerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Removed) == 0 {
-					m.Removed = make([]uint64, 0, elementCount)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Removed = append(m.Removed, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10816,7 +16458,7 @@ func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
+func (m *UpdateContainerStateRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10839,45 +16481,12 @@ func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutPlacementRuleReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateContainerStateRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutPlacementRuleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateContainerStateRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10903,7 +16512,7 @@ func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
+func (m *AddSchedulerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10926,12 +16535,76 @@ func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutPlacementRuleRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSchedulerReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutPlacementRuleRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSchedulerReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10957,7 +16630,7 @@ func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
+func (m *AddSchedulerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10980,17 +16653,17 @@ func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAppliedRulesReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSchedulerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAppliedRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSchedulerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ResourceID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11000,11 +16673,24 @@ func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourceID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -11030,7 +16716,7 @@ func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
+func (m *RemoveSchedulerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11053,17 +16739,17 @@ func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAppliedRulesRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSchedulerReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAppliedRulesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSchedulerReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11073,25 +16759,23 @@ func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rules = append(m.Rules, PlacementRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11118,7 +16802,7 @@ func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveSchedulerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11141,45 +16825,12 @@ func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSchedulerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSchedulerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -11205,7 +16856,7 @@ func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
+func (m *GetSchedulersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11228,10 +16879,10 @@ func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSchedulersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSchedulersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11259,7 +16910,7 @@ func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
+func (m *GetSchedulersRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11282,15 +16933,15 @@ func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveJobReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSchedulersRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSchedulersRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedulers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11317,7 +16968,8 @@ func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedulers = append(m.Schedulers, SchedulerInfo{})
+			if err := m.Schedulers[len(m.Schedulers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11346,7 +16998,7 @@ func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveJobRsp) Unmarshal(dAtA []byte) error {
+func (m *SchedulerInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11369,12 +17021,84 @@ func (m *RemoveJobRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveJobRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchedulerInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchedulerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -11400,7 +17124,7 @@ func (m *RemoveJobRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
+func (m *GetPlacementRulesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11423,50 +17147,17 @@ func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteJobReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPlacementRulesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPlacementRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11476,25 +17167,23 @@ func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11521,7 +17210,7 @@ func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
+func (m *GetPlacementRulesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11544,17 +17233,17 @@ func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteJobRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPlacementRulesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPlacementRulesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11564,24 +17253,24 @@ func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Rules = append(m.Rules, PlacementRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -13285,6 +18974,7 @@ func (m *PlacementRule) Unmarshal(dAtA []byte) error {
 func skipRpcpb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -13316,10 +19006,8 @@ func skipRpcpb(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -13340,55 +19028,30 @@ func skipRpcpb(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthRpcpb
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthRpcpb
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipRpcpb(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthRpcpb
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRpcpb
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRpcpb
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthRpcpb = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowRpcpb   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthRpcpb        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcpb          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRpcpb = fmt.Errorf("proto: unexpected end of group")
 )
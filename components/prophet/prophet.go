@@ -17,7 +17,9 @@ import (
 	"context"
 	"errors"
 	"math/rand"
+	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fagongzi/goetty"
@@ -32,8 +34,10 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/hbstream"
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/components/prophet/tso"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/embed"
 )
@@ -92,11 +96,24 @@ type defaultProphet struct {
 	client     Client
 	clientOnce sync.Once
 
+	// http admin api
+	httpServer *http.Server
+
+	// tls is nil if the tls is not enabled
+	tls *tlsutil.Loader
+
+	// timestamp oracle
+	tso       *tso.Allocator
+	tsoCancel context.CancelFunc
+
 	// job task ctx
 	jobMu struct {
 		sync.RWMutex
 		jobs map[metapb.JobType]metapb.Job
 	}
+	// jobTypes the types of the created jobs, it's returned to the containers in
+	// the heartbeat responses without holding the jobMu.
+	jobTypes atomic.Value
 }
 
 // NewProphet returns a prophet instance
@@ -107,9 +124,14 @@ func NewProphet(cfg *config.Config) Prophet {
 	var err error
 	ctx, cancel := context.WithCancel(context.Background())
 
+	tls, err := tlsutil.NewLoader(cfg.TLS)
+	if err != nil {
+		util.GetLogger().Fatalf("load tls certificates failed with %+v", err)
+	}
+
 	if cfg.StorageNode {
-		join.PrepareJoinCluster(cfg)
-		etcdClient, etcd, err = startEmbedEtcd(ctx, cfg)
+		join.PrepareJoinCluster(cfg, tls)
+		etcdClient, etcd, err = startEmbedEtcd(ctx, cfg, tls)
 		if err != nil {
 			util.GetLogger().Fatalf("start embed etcd failed with %+v", err)
 		}
@@ -118,6 +140,7 @@ func NewProphet(cfg *config.Config) Prophet {
 			Endpoints:        cfg.ExternalEtcd,
 			AutoSyncInterval: time.Second * 30,
 			DialTimeout:      etcdTimeout,
+			TLS:              tls.ClientConfig(),
 		})
 		if err != nil {
 			util.GetLogger().Fatalf("create external etcd client failed with %+v", err)
@@ -138,7 +161,8 @@ func NewProphet(cfg *config.Config) Prophet {
 	p.cancel = cancel
 	p.elector = elector
 	p.etcd = etcd
-	p.member = member.NewMember(etcdClient, etcd, elector, cfg.StorageNode, p.enableLeader, p.disableLeader)
+	p.tls = tls
+	p.member = member.NewMember(etcdClient, etcd, elector, cfg.StorageNode, tls, p.enableLeader, p.disableLeader)
 	p.runner = task.NewRunner()
 	p.completeC = make(chan struct{})
 	p.jobMu.jobs = make(map[metapb.JobType]metapb.Job)
@@ -154,12 +178,14 @@ func (p *defaultProphet) Start() {
 	p.storage = storage.NewStorage(rootPath,
 		storage.NewEtcdKV(rootPath, p.elector.Client(), p.member.GetLeadership()),
 		p.cfg.Adapter)
+	p.tso = tso.NewAllocator(p.storage, p.member.GetLeadership(), p.cfg.TSOSaveInterval.Duration)
 	p.basicCluster = core.NewBasicCluster(p.cfg.Adapter.NewResource)
 	p.cluster = cluster.NewRaftCluster(p.ctx, rootPath, p.clusterID, p.elector.Client(), p.cfg.Adapter, p.cfg.ResourceStateChangedHandler)
 	p.hbStreams = hbstream.NewHeartbeatStreams(p.ctx, p.clusterID, p.cluster)
 
 	p.startSystemMonitor(context.Background())
 	p.startListen()
+	p.startHTTPServer()
 	p.startLeaderLoop()
 }
 
@@ -168,6 +194,7 @@ func (p *defaultProphet) Stop() {
 		p.client.Close()
 	}
 	p.trans.Stop()
+	p.stopHTTPServer()
 	p.runner.Stop()
 	p.member.Stop()
 	p.cancel()
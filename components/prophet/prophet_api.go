@@ -0,0 +1,800 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prophet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/cluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/placement"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+const (
+	apiPrefix = "/prophet/api/v1"
+
+	transferLeaderOperator = "transfer-leader"
+	movePeerOperator       = "move-peer"
+	splitResourceOperator  = "split-resource"
+
+	adminOperatorDesc = "admin-"
+
+	defaultOperatorHistoryLimit = 1000
+)
+
+var (
+	errResourceNotFound  = errors.New("resource not found")
+	errContainerNotFound = errors.New("container not found")
+	errOperatorNotFound  = errors.New("operator not found")
+	errRuleNotFound      = errors.New("rule not found")
+	errMethodNotAllowed  = errors.New("method not allowed")
+	errAdminDisabled     = errors.New("http admin api is disabled")
+)
+
+// SchedulerInfo the scheduler info of the http api
+type SchedulerInfo struct {
+	Name     string `json:"name"`
+	Paused   bool   `json:"paused"`
+	Disabled bool   `json:"disabled"`
+}
+
+// AddSchedulerRequest the request to add a scheduler, the args are the same
+// as the scheduler args in the schedule config.
+type AddSchedulerRequest struct {
+	Type string   `json:"type"`
+	Args []string `json:"args"`
+}
+
+// PauseSchedulerRequest the request to pause or resume a scheduler, resume the scheduler
+// if delay is 0.
+type PauseSchedulerRequest struct {
+	// Delay pause seconds
+	Delay int64 `json:"delay"`
+}
+
+// AddOperatorRequest the request to create a manual operator.
+type AddOperatorRequest struct {
+	// Name operator name, transfer-leader, move-peer or split-resource
+	Name            string `json:"name"`
+	ResourceID      uint64 `json:"resource_id"`
+	FromContainerID uint64 `json:"from_container_id,omitempty"`
+	ToContainerID   uint64 `json:"to_container_id,omitempty"`
+	// Policy split policy, scan, approximate or usekey
+	Policy string `json:"policy,omitempty"`
+	// Keys hex format split keys, used with usekey policy
+	Keys []string `json:"keys,omitempty"`
+}
+
+// OperatorInfo the operator info of the http api
+type OperatorInfo struct {
+	ResourceID uint64 `json:"resource_id"`
+	Desc       string `json:"desc"`
+	Kind       string `json:"kind"`
+	Status     string `json:"status"`
+	Operator   string `json:"operator"`
+}
+
+// OperatorHistoryInfo the persisted history of a finished operator of the http api
+type OperatorHistoryInfo struct {
+	ResourceID uint64    `json:"resource_id"`
+	Desc       string    `json:"desc"`
+	Kind       string    `json:"kind"`
+	Status     string    `json:"status"`
+	Reason     string    `json:"reason,omitempty"`
+	Steps      []string  `json:"steps"`
+	Containers []uint64  `json:"containers,omitempty"`
+	CreateAt   time.Time `json:"create_at"`
+	FinishAt   time.Time `json:"finish_at"`
+}
+
+// ContainerInfo the container info of the http api
+type ContainerInfo struct {
+	ID                  uint64        `json:"id"`
+	Addr                string        `json:"addr"`
+	ShardAddr           string        `json:"shard_addr"`
+	Labels              []metapb.Pair `json:"labels,omitempty"`
+	Version             string        `json:"version"`
+	GitHash             string        `json:"git_hash"`
+	DeployPath          string        `json:"deploy_path"`
+	State               string        `json:"state"`
+	PhysicallyDestroyed bool          `json:"physically_destroyed"`
+	Capacity            uint64        `json:"capacity"`
+	Available           uint64        `json:"available"`
+	UsedSize            uint64        `json:"used_size"`
+	IsBusy              bool          `json:"is_busy"`
+	LeaderWeight        float64       `json:"leader_weight"`
+	ResourceWeight      float64       `json:"resource_weight"`
+	LeaderCount         int           `json:"leader_count"`
+	ResourceCount       int           `json:"resource_count"`
+	LeaderSize          int64         `json:"leader_size"`
+	ResourceSize        int64         `json:"resource_size"`
+	PendingPeerCount    int           `json:"pending_peer_count"`
+	LastHeartbeat       int64         `json:"last_heartbeat"`
+	Uptime              string        `json:"uptime"`
+}
+
+// DecommissionInfo the pre-check result or the progress of decommissioning a container
+// of the http api
+type DecommissionInfo struct {
+	ContainerID uint64    `json:"container_id"`
+	State       string    `json:"state"`
+	StartAt     time.Time `json:"start_at"`
+	FinishAt    time.Time `json:"finish_at"`
+	Resources   int       `json:"resources"`
+	Leaders     int       `json:"leaders"`
+	Size        int64     `json:"size"`
+	MovedSize   int64     `json:"moved_size"`
+	ETA         string    `json:"eta"`
+	Blockers    []string  `json:"blockers,omitempty"`
+}
+
+// ResourceInfo the resource info of the http api
+type ResourceInfo struct {
+	ID              uint64               `json:"id"`
+	Group           uint64               `json:"group"`
+	StartKey        string               `json:"start_key"`
+	EndKey          string               `json:"end_key"`
+	Epoch           metapb.ResourceEpoch `json:"epoch"`
+	State           string               `json:"state"`
+	Peers           []metapb.Peer        `json:"peers"`
+	Leader          *metapb.Peer         `json:"leader,omitempty"`
+	DownPeers       []metapb.PeerStats   `json:"down_peers,omitempty"`
+	PendingPeers    []metapb.Peer        `json:"pending_peers,omitempty"`
+	ApproximateSize int64                `json:"approximate_size"`
+	ApproximateKeys int64                `json:"approximate_keys"`
+}
+
+type apiHandler func(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error
+
+type apiError struct {
+	Error  string `json:"error"`
+	Leader string `json:"leader,omitempty"`
+}
+
+func (p *defaultProphet) startHTTPServer() {
+	if p.cfg.HTTPAddr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	p.handleAPI(mux, "/schedulers", p.handleSchedulers)
+	p.handleAPI(mux, "/scheduler-config", p.handleSchedulerConfig)
+	p.handleAPI(mux, "/operators", p.handleOperators)
+	p.handleAPI(mux, "/operator-histories", p.handleOperatorHistories)
+	p.handleAPI(mux, "/rules", p.handleRules)
+	p.handleAPI(mux, "/containers", p.handleContainers)
+	p.handleAPI(mux, "/resources", p.handleResources)
+
+	l, err := p.tls.Listen(p.cfg.HTTPAddr)
+	if err != nil {
+		util.GetLogger().Fatalf("start http server failed with %+v", err)
+	}
+
+	if p.cfg.EnableHTTPAdmin && !(p.cfg.TLS.Enabled() && p.cfg.TLS.ClientCertAuth) {
+		util.GetLogger().Warningf("http admin api is enabled without tls client-cert-auth, anyone who can reach %s can change the cluster",
+			p.cfg.HTTPAddr)
+	}
+
+	p.httpServer = &http.Server{Handler: mux}
+	go func() {
+		if err := p.httpServer.Serve(l); err != nil && err != http.ErrServerClosed {
+			util.GetLogger().Errorf("http server stopped with %+v", err)
+		}
+	}()
+	util.GetLogger().Infof("http server started at %s", p.cfg.HTTPAddr)
+}
+
+func (p *defaultProphet) stopHTTPServer() {
+	if p.httpServer != nil {
+		p.httpServer.Close()
+	}
+}
+
+// handleAPI register the handler for the path and its sub paths, the sub paths are passed
+// to the handler as params. All the apis are only served by the prophet leader, and
+// the apis which change the cluster are rejected unless EnableHTTPAdmin is set.
+func (p *defaultProphet) handleAPI(mux *http.ServeMux, path string, handler apiHandler) {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		if !p.cfg.EnableHTTPAdmin && r.Method != http.MethodGet && r.Method != http.MethodHead {
+			writeJSON(w, http.StatusForbidden, apiError{Error: errAdminDisabled.Error()})
+			return
+		}
+
+		rc := p.GetRaftCluster()
+		if rc == nil || !p.member.IsLeader() {
+			resp := apiError{Error: util.ErrNotLeader.Error()}
+			if leader := p.member.GetLeader(); leader != nil {
+				resp.Leader = leader.GetName()
+			}
+			writeJSON(w, http.StatusServiceUnavailable, resp)
+			return
+		}
+
+		var params []string
+		if sub := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix+path), "/"); sub != "" {
+			params = strings.Split(sub, "/")
+		}
+
+		if err := handler(rc, w, r, params); err != nil {
+			writeError(w, err)
+		}
+	}
+
+	mux.HandleFunc(apiPrefix+path, fn)
+	mux.HandleFunc(apiPrefix+path+"/", fn)
+}
+
+// handleSchedulers
+// GET    /schedulers        list all the schedulers
+// POST   /schedulers        add a scheduler
+// POST   /schedulers/{name} pause or resume the scheduler
+// DELETE /schedulers/{name} remove the scheduler
+func (p *defaultProphet) handleSchedulers(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	switch {
+	case r.Method == http.MethodGet && len(params) == 0:
+		names := rc.GetSchedulers()
+		sort.Strings(names)
+		infos := make([]SchedulerInfo, 0, len(names))
+		for _, name := range names {
+			info := SchedulerInfo{Name: name}
+			info.Paused, _ = rc.IsSchedulerPaused(name)
+			info.Disabled, _ = rc.IsSchedulerDisabled(name)
+			infos = append(infos, info)
+		}
+		writeJSON(w, http.StatusOK, infos)
+		return nil
+	case r.Method == http.MethodPost && len(params) == 0:
+		req := &AddSchedulerRequest{}
+		if err := readJSON(r, req); err != nil {
+			return err
+		}
+		name, err := rc.AddSchedulerWithArgs(req.Type, req.Args)
+		if errors.Is(err, cluster.ErrInvalidScheduler) {
+			return newBadRequestError(err)
+		} else if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, SchedulerInfo{Name: name})
+		return nil
+	case r.Method == http.MethodPost && len(params) == 1:
+		req := &PauseSchedulerRequest{}
+		if err := readJSON(r, req); err != nil {
+			return err
+		}
+		if err := rc.PauseOrResumeScheduler(params[0], req.Delay); err != nil {
+			return err
+		}
+		util.GetLogger().Infof("scheduler %s paused %d seconds by http api",
+			params[0],
+			req.Delay)
+		writeJSON(w, http.StatusOK, nil)
+		return nil
+	case r.Method == http.MethodDelete && len(params) == 1:
+		if err := rc.RemoveScheduler(params[0]); err != nil {
+			return err
+		}
+		util.GetLogger().Infof("scheduler %s removed by http api",
+			params[0])
+		writeJSON(w, http.StatusOK, nil)
+		return nil
+	}
+
+	return errMethodNotAllowed
+}
+
+// handleSchedulerConfig forward the request to the scheduler's own http handler
+// ANY /scheduler-config/{name}/...
+func (p *defaultProphet) handleSchedulerConfig(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	if len(params) == 0 {
+		return errMethodNotAllowed
+	}
+
+	handler, ok := rc.GetSchedulerHandlers()[params[0]]
+	if !ok {
+		return newNotFoundError(fmt.Errorf("scheduler %s not found", params[0]))
+	}
+	http.StripPrefix(apiPrefix+"/scheduler-config/"+params[0], handler).ServeHTTP(w, r)
+	return nil
+}
+
+// handleOperators
+// GET    /operators              list all the pending operators
+// GET    /operators/{resourceID} get the operator of the resource
+// POST   /operators              create a manual operator
+// DELETE /operators/{resourceID} cancel the operator of the resource
+func (p *defaultProphet) handleOperators(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	oc := rc.GetOperatorController()
+	switch {
+	case r.Method == http.MethodGet && len(params) == 0:
+		ops := oc.GetOperators()
+		sort.Slice(ops, func(i, j int) bool { return ops[i].ResourceID() < ops[j].ResourceID() })
+		infos := make([]OperatorInfo, 0, len(ops))
+		for _, op := range ops {
+			infos = append(infos, newOperatorInfo(op))
+		}
+		writeJSON(w, http.StatusOK, infos)
+		return nil
+	case r.Method == http.MethodGet && len(params) == 1:
+		op, err := getOperator(oc, params[0])
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, newOperatorInfo(op))
+		return nil
+	case r.Method == http.MethodPost && len(params) == 0:
+		req := &AddOperatorRequest{}
+		if err := readJSON(r, req); err != nil {
+			return err
+		}
+		op, err := createOperator(rc, req)
+		if err != nil {
+			return err
+		}
+		if !oc.AddOperator(op) {
+			return fmt.Errorf("add operator %s failed", op)
+		}
+		util.GetLogger().Infof("resource %d operator %s added by http api",
+			op.ResourceID(),
+			op)
+		writeJSON(w, http.StatusOK, newOperatorInfo(op))
+		return nil
+	case r.Method == http.MethodDelete && len(params) == 1:
+		op, err := getOperator(oc, params[0])
+		if err != nil {
+			return err
+		}
+		if !oc.RemoveOperator(op, "removed by http api") {
+			return errOperatorNotFound
+		}
+		writeJSON(w, http.StatusOK, nil)
+		return nil
+	}
+
+	return errMethodNotAllowed
+}
+
+// handleOperatorHistories
+// GET /operator-histories?resource=1&container=1&scheduler=balance-leader-scheduler&start=2021-09-01T03:00:00Z&end=2021-09-01T04:00:00Z&limit=1000
+// list the persisted histories of the finished operators in the order of the finish time,
+// all the query params are optional, start and end are in RFC3339 format.
+func (p *defaultProphet) handleOperatorHistories(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	if r.Method != http.MethodGet || len(params) > 0 {
+		return errMethodNotAllowed
+	}
+
+	var err error
+	filter := schedule.OperatorHistoryFilter{Desc: r.URL.Query().Get("scheduler")}
+	if filter.ResourceID, err = parseUint64Query(r, "resource"); err != nil {
+		return err
+	}
+	if filter.ContainerID, err = parseUint64Query(r, "container"); err != nil {
+		return err
+	}
+	if filter.Start, err = parseTimeQuery(r, "start"); err != nil {
+		return err
+	}
+	if filter.End, err = parseTimeQuery(r, "end"); err != nil {
+		return err
+	}
+	limit, err := parseUint64Query(r, "limit")
+	if err != nil {
+		return err
+	}
+	if limit == 0 {
+		limit = defaultOperatorHistoryLimit
+	}
+
+	histories, err := rc.GetOperatorHistories(filter, int(limit))
+	if err != nil {
+		return err
+	}
+	infos := make([]OperatorHistoryInfo, 0, len(histories))
+	for _, h := range histories {
+		infos = append(infos, newOperatorHistoryInfo(h))
+	}
+	writeJSON(w, http.StatusOK, infos)
+	return nil
+}
+
+// handleRules
+// GET    /rules             list all the placement rules
+// GET    /rules/{group}     list the placement rules of the group
+// GET    /rules/{group}/{id} get the placement rule
+// POST   /rules             create or update a placement rule
+// DELETE /rules/{group}/{id} delete the placement rule
+func (p *defaultProphet) handleRules(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	rm := rc.GetRuleManager()
+	if rm == nil || !rm.IsInitialized() {
+		return errors.New("placement rules feature is disabled")
+	}
+
+	switch {
+	case r.Method == http.MethodGet && len(params) == 0:
+		writeJSON(w, http.StatusOK, rm.GetAllRules())
+		return nil
+	case r.Method == http.MethodGet && len(params) == 1:
+		writeJSON(w, http.StatusOK, rm.GetRulesByGroup(params[0]))
+		return nil
+	case r.Method == http.MethodGet && len(params) == 2:
+		rule := rm.GetRule(params[0], params[1])
+		if rule == nil {
+			return newNotFoundError(errRuleNotFound)
+		}
+		writeJSON(w, http.StatusOK, rule)
+		return nil
+	case r.Method == http.MethodPost && len(params) == 0:
+		rule := &placement.Rule{}
+		if err := readJSON(r, rule); err != nil {
+			return err
+		}
+		if err := rm.SetRule(rule); err != nil {
+			return newBadRequestError(err)
+		}
+		writeJSON(w, http.StatusOK, rule)
+		return nil
+	case r.Method == http.MethodDelete && len(params) == 2:
+		if err := rm.DeleteRule(params[0], params[1]); err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, nil)
+		return nil
+	}
+
+	return errMethodNotAllowed
+}
+
+// handleContainers
+// GET    /containers?group=0                      list all the containers, the leader and resource counts are of the group
+// GET    /containers/{id}?group=0                 get the container
+// GET    /containers/{id}/decommission            get the decommission progress, or the pre-check result if the container is up
+// POST   /containers/{id}/decommission?force=true start to decommission the container, force to ignore the pre-check blockers
+// DELETE /containers/{id}/decommission            cancel the decommission and set the container up
+func (p *defaultProphet) handleContainers(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	if len(params) == 2 && params[1] == "decommission" {
+		return p.handleDecommission(rc, w, r, params[0])
+	}
+	if r.Method != http.MethodGet || len(params) > 1 {
+		return errMethodNotAllowed
+	}
+
+	group, err := parseUint64Query(r, "group")
+	if err != nil {
+		return err
+	}
+
+	if len(params) == 1 {
+		id, err := parseUint64(params[0])
+		if err != nil {
+			return err
+		}
+		container := rc.GetContainer(id)
+		if container == nil {
+			return newNotFoundError(errContainerNotFound)
+		}
+		writeJSON(w, http.StatusOK, newContainerInfo(container, group))
+		return nil
+	}
+
+	containers := rc.GetContainers()
+	sort.Slice(containers, func(i, j int) bool { return containers[i].Meta.ID() < containers[j].Meta.ID() })
+	infos := make([]ContainerInfo, 0, len(containers))
+	for _, container := range containers {
+		infos = append(infos, newContainerInfo(container, group))
+	}
+	writeJSON(w, http.StatusOK, infos)
+	return nil
+}
+
+func (p *defaultProphet) handleDecommission(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, value string) error {
+	id, err := parseUint64(value)
+	if err != nil {
+		return err
+	}
+	if rc.GetContainer(id) == nil {
+		return newNotFoundError(errContainerNotFound)
+	}
+
+	var progress *cluster.DecommissionProgress
+	switch r.Method {
+	case http.MethodGet:
+		progress, err = rc.GetDecommissionProgress(id)
+	case http.MethodPost:
+		progress, err = rc.DecommissionContainer(id, r.URL.Query().Get("force") == "true")
+	case http.MethodDelete:
+		if err := rc.CancelDecommission(id); err != nil {
+			return newBadRequestError(err)
+		}
+		writeJSON(w, http.StatusOK, nil)
+		return nil
+	default:
+		return errMethodNotAllowed
+	}
+	if err != nil {
+		return newBadRequestError(err)
+	}
+	writeJSON(w, http.StatusOK, newDecommissionInfo(progress))
+	return nil
+}
+
+// handleResources
+// GET /resources      list all the resources
+// GET /resources/{id} get the resource
+func (p *defaultProphet) handleResources(rc *cluster.RaftCluster, w http.ResponseWriter, r *http.Request, params []string) error {
+	if r.Method != http.MethodGet || len(params) > 1 {
+		return errMethodNotAllowed
+	}
+
+	if len(params) == 1 {
+		id, err := parseUint64(params[0])
+		if err != nil {
+			return err
+		}
+		res := rc.GetResource(id)
+		if res == nil {
+			return newNotFoundError(errResourceNotFound)
+		}
+		writeJSON(w, http.StatusOK, newResourceInfo(res))
+		return nil
+	}
+
+	resources := rc.GetResources()
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Meta.ID() < resources[j].Meta.ID() })
+	infos := make([]ResourceInfo, 0, len(resources))
+	for _, res := range resources {
+		infos = append(infos, newResourceInfo(res))
+	}
+	writeJSON(w, http.StatusOK, infos)
+	return nil
+}
+
+func getOperator(oc *schedule.OperatorController, value string) (*operator.Operator, error) {
+	id, err := parseUint64(value)
+	if err != nil {
+		return nil, err
+	}
+	op := oc.GetOperator(id)
+	if op == nil {
+		return nil, newNotFoundError(errOperatorNotFound)
+	}
+	return op, nil
+}
+
+func createOperator(rc *cluster.RaftCluster, req *AddOperatorRequest) (*operator.Operator, error) {
+	res := rc.GetResource(req.ResourceID)
+	if res == nil {
+		return nil, newNotFoundError(errResourceNotFound)
+	}
+
+	var op *operator.Operator
+	var err error
+	desc := adminOperatorDesc + req.Name
+	switch req.Name {
+	case transferLeaderOperator:
+		if rc.GetContainer(req.ToContainerID) == nil {
+			return nil, newNotFoundError(errContainerNotFound)
+		}
+		op, err = operator.CreateTransferLeaderOperator(desc, rc, res,
+			res.GetLeader().GetContainerID(), req.ToContainerID, operator.OpAdmin)
+	case movePeerOperator:
+		if rc.GetContainer(req.ToContainerID) == nil {
+			return nil, newNotFoundError(errContainerNotFound)
+		}
+		old, ok := res.GetContainerPeer(req.FromContainerID)
+		if !ok {
+			return nil, newBadRequestError(fmt.Errorf("resource %d has no peer on container %d",
+				req.ResourceID,
+				req.FromContainerID))
+		}
+		op, err = operator.CreateMovePeerOperator(desc, rc, res, operator.OpAdmin,
+			req.FromContainerID, metapb.Peer{ContainerID: req.ToContainerID, Role: old.Role})
+	case splitResourceOperator:
+		policy, ok := metapb.CheckPolicy_value[strings.ToUpper(req.Policy)]
+		if !ok {
+			return nil, newBadRequestError(fmt.Errorf("invalid split policy %s", req.Policy))
+		}
+		keys := make([][]byte, 0, len(req.Keys))
+		for _, key := range req.Keys {
+			k, err := hex.DecodeString(key)
+			if err != nil {
+				return nil, newBadRequestError(err)
+			}
+			keys = append(keys, k)
+		}
+		op, err = operator.CreateSplitResourceOperator(desc, res, operator.OpAdmin,
+			metapb.CheckPolicy(policy), keys)
+	default:
+		return nil, newBadRequestError(fmt.Errorf("invalid operator %s", req.Name))
+	}
+	if err != nil {
+		return nil, newBadRequestError(err)
+	}
+	return op, nil
+}
+
+func newOperatorInfo(op *operator.Operator) OperatorInfo {
+	return OperatorInfo{
+		ResourceID: op.ResourceID(),
+		Desc:       op.Desc(),
+		Kind:       op.Kind().String(),
+		Status:     operator.OpStatusToString(op.Status()),
+		Operator:   op.String(),
+	}
+}
+
+func newOperatorHistoryInfo(h metapb.OperatorHistory) OperatorHistoryInfo {
+	return OperatorHistoryInfo{
+		ResourceID: h.ResourceID,
+		Desc:       h.Desc,
+		Kind:       h.Kind,
+		Status:     h.Status,
+		Reason:     h.Reason,
+		Steps:      h.Steps,
+		Containers: h.Containers,
+		CreateAt:   time.Unix(0, h.CreateAt),
+		FinishAt:   time.Unix(0, h.FinishAt),
+	}
+}
+
+func newContainerInfo(container *core.CachedContainer, group uint64) ContainerInfo {
+	version, githash := container.Meta.Version()
+	return ContainerInfo{
+		ID:                  container.Meta.ID(),
+		Addr:                container.Meta.Addr(),
+		ShardAddr:           container.Meta.ShardAddr(),
+		Labels:              container.Meta.Labels(),
+		Version:             version,
+		GitHash:             githash,
+		DeployPath:          container.Meta.DeployPath(),
+		State:               container.GetState().String(),
+		PhysicallyDestroyed: container.IsPhysicallyDestroyed(),
+		Capacity:            container.GetCapacity(),
+		Available:           container.GetAvailable(),
+		UsedSize:            container.GetUsedSize(),
+		IsBusy:              container.IsBusy(),
+		LeaderWeight:        container.GetLeaderWeight(),
+		ResourceWeight:      container.GetResourceWeight(),
+		LeaderCount:         container.GetLeaderCount(group),
+		ResourceCount:       container.GetResourceCount(group),
+		LeaderSize:          container.GetLeaderSize(group),
+		ResourceSize:        container.GetResourceSize(group),
+		PendingPeerCount:    container.GetPendingPeerCount(),
+		LastHeartbeat:       container.Meta.LastHeartbeat(),
+		Uptime:              container.GetUptime().String(),
+	}
+}
+
+func newDecommissionInfo(p *cluster.DecommissionProgress) DecommissionInfo {
+	return DecommissionInfo{
+		ContainerID: p.ContainerID,
+		State:       p.State,
+		StartAt:     p.StartAt,
+		FinishAt:    p.FinishAt,
+		Resources:   p.Resources,
+		Leaders:     p.Leaders,
+		Size:        p.Size,
+		MovedSize:   p.MovedSize,
+		ETA:         p.ETA.String(),
+		Blockers:    p.Blockers,
+	}
+}
+
+func newResourceInfo(res *core.CachedResource) ResourceInfo {
+	return ResourceInfo{
+		ID:              res.Meta.ID(),
+		Group:           res.Meta.Group(),
+		StartKey:        hex.EncodeToString(res.GetStartKey()),
+		EndKey:          hex.EncodeToString(res.GetEndKey()),
+		Epoch:           res.Meta.Epoch(),
+		State:           res.Meta.State().String(),
+		Peers:           res.Meta.Peers(),
+		Leader:          res.GetLeader(),
+		DownPeers:       res.GetDownPeers(),
+		PendingPeers:    res.GetPendingPeers(),
+		ApproximateSize: res.GetApproximateSize(),
+		ApproximateKeys: res.GetApproximateKeys(),
+	}
+}
+
+type statusError struct {
+	code int
+	err  error
+}
+
+func (e statusError) Error() string {
+	return e.err.Error()
+}
+
+func newBadRequestError(err error) error {
+	return statusError{code: http.StatusBadRequest, err: err}
+}
+
+func newNotFoundError(err error) error {
+	return statusError{code: http.StatusNotFound, err: err}
+}
+
+func parseUint64(value string) (uint64, error) {
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, newBadRequestError(err)
+	}
+	return v, nil
+}
+
+func parseUint64Query(r *http.Request, name string) (uint64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	return parseUint64(value)
+}
+
+func parseTimeQuery(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	v, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, newBadRequestError(err)
+	}
+	return v, nil
+}
+
+func readJSON(r *http.Request, value interface{}) error {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return newBadRequestError(err)
+	}
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if e, ok := err.(statusError); ok {
+		code = e.code
+	} else if err == errMethodNotAllowed {
+		code = http.StatusMethodNotAllowed
+	}
+	writeJSON(w, code, apiError{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		util.GetLogger().Errorf("marshal http response failed with %+v", err)
+		code = http.StatusInternalServerError
+		data = []byte(`{"error":"marshal response failed"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(data); err != nil {
+		util.GetLogger().Errorf("write http response failed with %+v", err)
+	}
+}
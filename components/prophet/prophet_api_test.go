@@ -0,0 +1,184 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prophet
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/placement"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedulers"
+	"github.com/stretchr/testify/assert"
+)
+
+const testHTTPAddr = "127.0.0.1:29880"
+
+func TestHTTPAPI(t *testing.T) {
+	p := newTestSingleProphet(t, func(c *config.Config) {
+		c.HTTPAddr = testHTTPAddr
+		c.EnableHTTPAdmin = true
+	})
+	defer p.Stop()
+
+	c := p.GetClient()
+	assert.NoError(t, c.PutContainer(newTestContainerMeta(1)))
+	_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(1, 1))
+	assert.NoError(t, err)
+	peer := metapb.Peer{ID: 1, ContainerID: 1}
+	assert.NoError(t, c.ResourceHeartbeat(newTestResourceMeta(2, peer), rpcpb.ResourceHeartbeatReq{
+		ContainerID: 1,
+		Leader:      &peer}))
+
+	// containers
+	var containers []ContainerInfo
+	doTestHTTPRequest(t, http.MethodGet, "/containers", nil, http.StatusOK, &containers)
+	assert.Equal(t, 1, len(containers))
+	assert.Equal(t, uint64(1), containers[0].ID)
+	var container ContainerInfo
+	doTestHTTPRequest(t, http.MethodGet, "/containers/1", nil, http.StatusOK, &container)
+	assert.Equal(t, "127.0.0.1:1", container.Addr)
+	doTestHTTPRequest(t, http.MethodGet, "/containers/100", nil, http.StatusNotFound, nil)
+
+	// decommission, the only container cannot be decommissioned
+	var decommission DecommissionInfo
+	doTestHTTPRequest(t, http.MethodGet, "/containers/1/decommission", nil, http.StatusOK, &decommission)
+	assert.Equal(t, "UP", decommission.State)
+	assert.Equal(t, []string{"no up container to accept the peers"}, decommission.Blockers)
+	doTestHTTPRequest(t, http.MethodPost, "/containers/1/decommission", nil, http.StatusBadRequest, nil)
+	doTestHTTPRequest(t, http.MethodDelete, "/containers/1/decommission", nil, http.StatusBadRequest, nil)
+	doTestHTTPRequest(t, http.MethodGet, "/containers/100/decommission", nil, http.StatusNotFound, nil)
+
+	// resources
+	var resource ResourceInfo
+	for i := 0; i < 10; i++ {
+		if p.(*defaultProphet).GetRaftCluster().GetResource(2) != nil {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	doTestHTTPRequest(t, http.MethodGet, "/resources/2", nil, http.StatusOK, &resource)
+	assert.Equal(t, uint64(2), resource.ID)
+	assert.Equal(t, uint64(1), resource.Leader.ContainerID)
+	doTestHTTPRequest(t, http.MethodGet, "/resources/100", nil, http.StatusNotFound, nil)
+
+	// schedulers
+	doTestHTTPRequest(t, http.MethodPost, "/schedulers",
+		AddSchedulerRequest{Type: schedulers.EvictLeaderType, Args: []string{"1"}}, http.StatusOK, nil)
+	var infos []SchedulerInfo
+	doTestHTTPRequest(t, http.MethodGet, "/schedulers", nil, http.StatusOK, &infos)
+	assert.True(t, hasTestScheduler(infos, schedulers.EvictLeaderName, false))
+	doTestHTTPRequest(t, http.MethodPost, "/schedulers/"+schedulers.EvictLeaderName,
+		PauseSchedulerRequest{Delay: 60}, http.StatusOK, nil)
+	doTestHTTPRequest(t, http.MethodGet, "/schedulers", nil, http.StatusOK, &infos)
+	assert.True(t, hasTestScheduler(infos, schedulers.EvictLeaderName, true))
+	doTestHTTPRequest(t, http.MethodDelete, "/schedulers/"+schedulers.EvictLeaderName, nil, http.StatusOK, nil)
+	doTestHTTPRequest(t, http.MethodGet, "/schedulers", nil, http.StatusOK, &infos)
+	assert.False(t, hasTestScheduler(infos, schedulers.EvictLeaderName, false))
+	doTestHTTPRequest(t, http.MethodPost, "/schedulers",
+		AddSchedulerRequest{Type: "not-exists"}, http.StatusBadRequest, nil)
+
+	// operators
+	var ops []OperatorInfo
+	doTestHTTPRequest(t, http.MethodGet, "/operators", nil, http.StatusOK, &ops)
+	assert.Empty(t, ops)
+	doTestHTTPRequest(t, http.MethodGet, "/operators/2", nil, http.StatusNotFound, nil)
+	var op OperatorInfo
+	doTestHTTPRequest(t, http.MethodPost, "/operators",
+		AddOperatorRequest{Name: splitResourceOperator, ResourceID: 2, Policy: "scan"}, http.StatusOK, &op)
+	assert.Equal(t, uint64(2), op.ResourceID)
+	doTestHTTPRequest(t, http.MethodGet, "/operators/2", nil, http.StatusOK, &op)
+	assert.Equal(t, "admin-"+splitResourceOperator, op.Desc)
+	doTestHTTPRequest(t, http.MethodDelete, "/operators/2", nil, http.StatusOK, nil)
+	doTestHTTPRequest(t, http.MethodGet, "/operators/2", nil, http.StatusNotFound, nil)
+	doTestHTTPRequest(t, http.MethodPost, "/operators",
+		AddOperatorRequest{Name: transferLeaderOperator, ResourceID: 100}, http.StatusNotFound, nil)
+
+	// operator histories
+	var histories []OperatorHistoryInfo
+	doTestHTTPRequest(t, http.MethodGet, "/operator-histories?resource=2&scheduler=admin-"+splitResourceOperator,
+		nil, http.StatusOK, &histories)
+	assert.Equal(t, 1, len(histories))
+	assert.Equal(t, "Canceled", histories[0].Status)
+	doTestHTTPRequest(t, http.MethodGet, "/operator-histories?resource=3", nil, http.StatusOK, &histories)
+	assert.Empty(t, histories)
+	doTestHTTPRequest(t, http.MethodGet, "/operator-histories?end="+time.Now().Add(-time.Hour).Format(time.RFC3339),
+		nil, http.StatusOK, &histories)
+	assert.Empty(t, histories)
+	doTestHTTPRequest(t, http.MethodGet, "/operator-histories?start=yesterday", nil, http.StatusBadRequest, nil)
+
+	// rules
+	doTestHTTPRequest(t, http.MethodPost, "/rules",
+		placement.Rule{GroupID: "g1", ID: "r1", Role: placement.Voter, Count: 3}, http.StatusOK, nil)
+	var rule placement.Rule
+	doTestHTTPRequest(t, http.MethodGet, "/rules/g1/r1", nil, http.StatusOK, &rule)
+	assert.Equal(t, 3, rule.Count)
+	var rules []placement.Rule
+	doTestHTTPRequest(t, http.MethodGet, "/rules/g1", nil, http.StatusOK, &rules)
+	assert.Equal(t, 1, len(rules))
+	doTestHTTPRequest(t, http.MethodDelete, "/rules/g1/r1", nil, http.StatusOK, nil)
+	doTestHTTPRequest(t, http.MethodGet, "/rules/g1/r1", nil, http.StatusNotFound, nil)
+
+	doTestHTTPRequest(t, http.MethodPut, "/rules", nil, http.StatusMethodNotAllowed, nil)
+}
+
+func hasTestScheduler(infos []SchedulerInfo, name string, paused bool) bool {
+	for _, info := range infos {
+		if info.Name == name && info.Paused == paused {
+			return true
+		}
+	}
+	return false
+}
+
+func TestHTTPAPIAdminDisabled(t *testing.T) {
+	p := newTestSingleProphet(t, func(c *config.Config) {
+		c.HTTPAddr = testHTTPAddr
+	})
+	defer p.Stop()
+
+	var infos []SchedulerInfo
+	doTestHTTPRequest(t, http.MethodGet, "/schedulers", nil, http.StatusOK, &infos)
+	doTestHTTPRequest(t, http.MethodPost, "/schedulers",
+		AddSchedulerRequest{Type: schedulers.EvictLeaderType, Args: []string{"1"}}, http.StatusForbidden, nil)
+	doTestHTTPRequest(t, http.MethodDelete, "/operators/1", nil, http.StatusForbidden, nil)
+}
+
+func doTestHTTPRequest(t *testing.T, method, path string, body interface{}, code int, value interface{}) {
+	var data []byte
+	if body != nil {
+		v, err := json.Marshal(body)
+		assert.NoError(t, err)
+		data = v
+	}
+
+	req, err := http.NewRequest(method, "http://"+testHTTPAddr+apiPrefix+path, bytes.NewReader(data))
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	data, err = ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, code, resp.StatusCode, "%s %s: %s", method, path, string(data))
+	if value != nil {
+		assert.NoError(t, json.Unmarshal(data, value))
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/option"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/embed"
 	"go.etcd.io/etcd/pkg/types"
@@ -31,7 +32,7 @@ var (
 	etcdTimeout = time.Second * 3
 )
 
-func startEmbedEtcd(ctx context.Context, cfg *config.Config) (*clientv3.Client, *embed.Etcd, error) {
+func startEmbedEtcd(ctx context.Context, cfg *config.Config, tls *tlsutil.Loader) (*clientv3.Client, *embed.Etcd, error) {
 	etcdCfg, err := cfg.GenEmbedEtcdConfig()
 	if err != nil {
 		return nil, nil, err
@@ -51,7 +52,7 @@ func startEmbedEtcd(ctx context.Context, cfg *config.Config) (*clientv3.Client,
 		return nil, nil, err
 	}
 
-	if err = util.CheckClusterID(etcd.Server.Cluster().ID(), urlMap); err != nil {
+	if err = util.CheckClusterID(etcd.Server.Cluster().ID(), urlMap, tls.ClientConfig()); err != nil {
 		return nil, nil, err
 	}
 
@@ -69,6 +70,7 @@ func startEmbedEtcd(ctx context.Context, cfg *config.Config) (*clientv3.Client,
 		Endpoints:        endpoints,
 		AutoSyncInterval: time.Second * 30,
 		DialTimeout:      etcdTimeout,
+		TLS:              tls.ClientConfig(),
 	})
 	if err != nil {
 		return nil, nil, err
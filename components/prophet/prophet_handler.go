@@ -25,6 +25,10 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
 )
 
+var (
+	errAdminRPCDisabled = errors.New("admin rpc is disabled, enable-http-admin is not set")
+)
+
 type heartbeatStream struct {
 	containerID uint64
 	rs          goetty.IOSession
@@ -87,6 +91,12 @@ func (p *defaultProphet) handleRPCRequest(rs goetty.IOSession, data interface{},
 		if err != nil {
 			resp.Error = err.Error()
 		}
+	case rpcpb.TypeGetTimestampReq:
+		resp.Type = rpcpb.TypeGetTimestampRsp
+		err := p.handleGetTimestamp(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
 	case rpcpb.TypeGetContainerReq:
 		resp.Type = rpcpb.TypeGetContainerRsp
 		err := p.handleGetContainer(rc, req, resp)
@@ -175,6 +185,54 @@ func (p *defaultProphet) handleRPCRequest(rs goetty.IOSession, data interface{},
 		if err != nil {
 			resp.Error = err.Error()
 		}
+	case rpcpb.TypeGetContainersReq:
+		resp.Type = rpcpb.TypeGetContainersRsp
+		err := p.handleGetContainers(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeGetResourcesReq:
+		resp.Type = rpcpb.TypeGetResourcesRsp
+		err := p.handleGetResources(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeGetHotResourcesReq:
+		resp.Type = rpcpb.TypeGetHotResourcesRsp
+		err := p.handleGetHotResources(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeUpdateContainerStateReq:
+		resp.Type = rpcpb.TypeUpdateContainerStateRsp
+		err := p.handleUpdateContainerState(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeAddSchedulerReq:
+		resp.Type = rpcpb.TypeAddSchedulerRsp
+		err := p.handleAddScheduler(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeRemoveSchedulerReq:
+		resp.Type = rpcpb.TypeRemoveSchedulerRsp
+		err := p.handleRemoveScheduler(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeGetSchedulersReq:
+		resp.Type = rpcpb.TypeGetSchedulersRsp
+		err := p.handleGetSchedulers(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeGetPlacementRulesReq:
+		resp.Type = rpcpb.TypeGetPlacementRulesRsp
+		err := p.handleGetPlacementRules(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
 	default:
 		return fmt.Errorf("type %s not support", req.Type.String())
 	}
@@ -248,6 +306,7 @@ func (p *defaultProphet) handleContainerHeartbeat(rc *cluster.RaftCluster, req *
 	if err != nil {
 		return err
 	}
+	resp.ContainerHeartbeat.Jobs = p.getJobTypes()
 
 	if p.cfg.ContainerHeartbeatDataProcessor != nil {
 		data, err := p.cfg.ContainerHeartbeatDataProcessor.HandleHeartbeatReq(req.ContainerHeartbeat.Stats.ContainerID,
@@ -355,7 +414,14 @@ func (p *defaultProphet) handleCheckResourceState(rc *cluster.RaftCluster, req *
 	return nil
 }
 
+// handlePutPlacementRule is gated by EnableHTTPAdmin, except the requests from the registered
+// containers, the stores restore the rules of the backup by it after bootstrapping the cluster.
 func (p *defaultProphet) handlePutPlacementRule(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	if !p.cfg.EnableHTTPAdmin &&
+		(req.ContainerID == 0 || rc.GetContainer(req.ContainerID) == nil) {
+		return errAdminRPCDisabled
+	}
+
 	return rc.HandlePutPlacementRule(req)
 }
 
@@ -369,6 +435,98 @@ func (p *defaultProphet) handleGetAppliedRule(rc *cluster.RaftCluster, req *rpcp
 	return nil
 }
 
+func (p *defaultProphet) handleGetContainers(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	rsp, err := rc.HandleGetContainers(req)
+	if err != nil {
+		return err
+	}
+
+	resp.GetContainers = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleGetResources(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	rsp, err := rc.HandleGetResources(req)
+	if err != nil {
+		return err
+	}
+
+	resp.GetResources = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleGetHotResources(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	rsp, err := rc.HandleGetHotResources(req)
+	if err != nil {
+		return err
+	}
+
+	resp.GetHotResources = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleUpdateContainerState(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	if !p.cfg.EnableHTTPAdmin {
+		return errAdminRPCDisabled
+	}
+
+	rsp, err := rc.HandleUpdateContainerState(req)
+	if err != nil {
+		return err
+	}
+
+	resp.UpdateContainerState = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleAddScheduler(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	if !p.cfg.EnableHTTPAdmin {
+		return errAdminRPCDisabled
+	}
+
+	rsp, err := rc.HandleAddScheduler(req)
+	if err != nil {
+		return err
+	}
+
+	resp.AddScheduler = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleRemoveScheduler(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	if !p.cfg.EnableHTTPAdmin {
+		return errAdminRPCDisabled
+	}
+
+	rsp, err := rc.HandleRemoveScheduler(req)
+	if err != nil {
+		return err
+	}
+
+	resp.RemoveScheduler = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleGetSchedulers(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	rsp, err := rc.HandleGetSchedulers(req)
+	if err != nil {
+		return err
+	}
+
+	resp.GetSchedulers = *rsp
+	return nil
+}
+
+func (p *defaultProphet) handleGetPlacementRules(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	rsp, err := rc.HandleGetPlacementRules(req)
+	if err != nil {
+		return err
+	}
+
+	resp.GetPlacementRules = *rsp
+	return nil
+}
+
 // checkContainer returns an error response if the store exists and is in tombstone state.
 // It returns nil if it can't get the store.
 func checkContainer(rc *cluster.RaftCluster, storeID uint64) error {
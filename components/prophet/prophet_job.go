@@ -15,6 +15,7 @@ package prophet
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/cluster"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
@@ -61,6 +62,7 @@ func (p *defaultProphet) startJobs() {
 
 			util.GetLogger().Errorf("job %d missing processor", job.Type)
 		}
+		p.updateJobTypesLocked()
 	}()
 }
 
@@ -90,6 +92,7 @@ func (p *defaultProphet) stopJobs() {
 		}
 
 		p.jobMu.jobs = make(map[metapb.JobType]metapb.Job)
+		p.updateJobTypesLocked()
 	}()
 }
 
@@ -113,6 +116,7 @@ func (p *defaultProphet) handleCreateJob(rc *cluster.RaftCluster, req *rpcpb.Req
 
 	processor.Start(job, p.storage, p.basicCluster)
 	p.jobMu.jobs[job.Type] = job
+	p.updateJobTypesLocked()
 	p.updateJobStatus(job, metapb.JobState_Working)
 	return nil
 }
@@ -137,6 +141,7 @@ func (p *defaultProphet) handleRemoveJob(rc *cluster.RaftCluster, req *rpcpb.Req
 
 	processor.Remove(job, p.storage, p.basicCluster)
 	delete(p.jobMu.jobs, job.Type)
+	p.updateJobTypesLocked()
 	return nil
 }
 
@@ -164,3 +169,22 @@ func (p *defaultProphet) updateJobStatus(job metapb.Job, state metapb.JobState)
 	job.State = state
 	return p.GetStorage().PutJob(job)
 }
+
+func (p *defaultProphet) updateJobTypesLocked() {
+	types := make([]metapb.JobType, 0, len(p.jobMu.jobs))
+	for jobType, job := range p.jobMu.jobs {
+		if job.State != metapb.JobState_Completed {
+			types = append(types, jobType)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	p.jobTypes.Store(types)
+}
+
+// getJobTypes returns the types of the created jobs
+func (p *defaultProphet) getJobTypes() []metapb.JobType {
+	if v := p.jobTypes.Load(); v != nil {
+		return v.([]metapb.JobType)
+	}
+	return nil
+}
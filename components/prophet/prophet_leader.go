@@ -25,6 +25,11 @@ func (p *defaultProphet) startLeaderLoop() {
 func (p *defaultProphet) enableLeader() error {
 	util.GetLogger().Infof("********%s become to leader now********", p.cfg.Name)
 
+	if err := p.startTSO(); err != nil {
+		util.GetLogger().Errorf("start timestamp oracle failed with %+v", err)
+		return err
+	}
+
 	if err := p.createRaftCluster(); err != nil {
 		util.GetLogger().Errorf("create raft cluster failed with %+v", err)
 		return err
@@ -43,6 +48,7 @@ func (p *defaultProphet) disableLeader() error {
 	util.GetLogger().Infof("********%s become to follower now********", p.cfg.Name)
 
 	p.initClient()
+	p.stopTSO()
 	p.stopRaftCluster()
 	p.stopEventNotifer()
 	p.notifyElectionComplete()
@@ -85,6 +91,7 @@ func (p *defaultProphet) initClient() {
 	p.clientOnce.Do(func() {
 		p.client = NewClient(p.cfg.Adapter,
 			WithRPCTimeout(p.cfg.RPCTimeout.Duration),
-			WithLeaderGetter(p.GetLeader))
+			WithLeaderGetter(p.GetLeader),
+			WithTLS(p.tls))
 	})
 }
@@ -22,7 +22,11 @@ import (
 
 func (p *defaultProphet) startListen() {
 	encoder, decoder := codec.NewServerCodec(10 * buf.MB)
-	app, err := goetty.NewTCPApplication(p.cfg.RPCAddr,
+	listener, err := p.tls.Listen(p.cfg.RPCAddr)
+	if err != nil {
+		util.GetLogger().Fatalf("start transport failed with %+v", err)
+	}
+	app, err := goetty.NewApplication(listener,
 		p.handleRPCRequest,
 		goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder),
 			goetty.WithEnableAsyncWrite(16),
@@ -0,0 +1,68 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prophet
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/cluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/tso"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+func (p *defaultProphet) startTSO() error {
+	if err := p.tso.Initialize(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	p.tsoCancel = cancel
+	go func() {
+		ticker := time.NewTicker(tso.UpdateInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				util.GetLogger().Infof("timestamp update loop stopped")
+				return
+			case <-ticker.C:
+				if err := p.tso.Update(); err != nil {
+					util.GetLogger().Errorf("update timestamp failed with %+v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *defaultProphet) stopTSO() {
+	if p.tsoCancel != nil {
+		p.tsoCancel()
+		p.tsoCancel = nil
+	}
+	p.tso.Reset()
+}
+
+func (p *defaultProphet) handleGetTimestamp(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	ts, err := p.tso.Generate(req.GetTimestamp.Count)
+	if err != nil {
+		return err
+	}
+
+	resp.GetTimestamp.Timestamp = ts
+	return nil
+}
@@ -250,7 +250,9 @@ func (r *ReplicaChecker) checkLocationReplacement(res *core.CachedResource) *ope
 		return nil
 	}
 
-	newPeer := metapb.Peer{ContainerID: newContainer}
+	// keep the witness as a witness on the new container
+	old, _ := res.GetContainerPeer(oldContainer)
+	newPeer := metapb.Peer{ContainerID: newContainer, Witness: old.Witness}
 	op, err := operator.CreateMovePeerOperator("move-to-better-location", r.cluster, res, operator.OpReplica, oldContainer, newPeer)
 	if err != nil {
 		checkerCounter.WithLabelValues("replica_checker", "create-operator-fail").Inc()
@@ -282,7 +284,8 @@ func (r *ReplicaChecker) fixPeer(res *core.CachedResource, containerID uint64, s
 			res.Meta.ID())
 		return nil
 	}
-	newPeer := metapb.Peer{ContainerID: target}
+	old, _ := res.GetContainerPeer(containerID)
+	newPeer := metapb.Peer{ContainerID: target, Witness: old.Witness}
 	replace := fmt.Sprintf("replace-%s-replica", status)
 	op, err := operator.CreateMovePeerOperator(replace, r.cluster, res, operator.OpReplica, containerID, newPeer)
 	if err != nil {
@@ -75,16 +75,8 @@ func (c *RuleChecker) FillReplicas(res *core.CachedResource, leastPeers int) err
 				break
 			}
 
-			p := metapb.Peer{ContainerID: container}
-			switch rf.Rule.Role {
-			case placement.Voter, placement.Follower, placement.Leader:
-				p.Role = metapb.PeerRole_Voter
-			default:
-				p.Role = metapb.PeerRole_Learner
-			}
-
 			peers := res.Meta.Peers()
-			peers = append(peers, metapb.Peer{ContainerID: container})
+			peers = append(peers, rf.Rule.Role.MetaPeer(container))
 			res.Meta.SetPeers(peers)
 		}
 	}
@@ -187,7 +179,7 @@ func (c *RuleChecker) addRulePeer(res *core.CachedResource, rf *placement.RuleFi
 		c.resourceWaitingList.Put(res.Meta.ID(), nil)
 		return nil, errors.New("no container to add peer")
 	}
-	peer := metapb.Peer{ContainerID: container, Role: rf.Rule.Role.MetaPeerRole()}
+	peer := rf.Rule.Role.MetaPeer(container)
 	return operator.CreateAddPeerOperator("add-rule-peer", c.cluster, res, peer, operator.OpReplica)
 }
 
@@ -199,7 +191,7 @@ func (c *RuleChecker) replaceRulePeer(res *core.CachedResource, rf *placement.Ru
 		c.resourceWaitingList.Put(res.Meta.ID(), nil)
 		return nil, errors.New("no container to replace peer")
 	}
-	newPeer := metapb.Peer{ContainerID: container, Role: rf.Rule.Role.MetaPeerRole()}
+	newPeer := rf.Rule.Role.MetaPeer(container)
 	return operator.CreateMovePeerOperator("replace-rule-"+status+"-peer",
 		c.cluster, res, operator.OpReplica, peer.ContainerID, newPeer)
 }
@@ -218,7 +210,8 @@ func (c *RuleChecker) fixLooseMatchPeer(res *core.CachedResource, fit *placement
 		checkerCounter.WithLabelValues("rule_checker", "not-allow-leader")
 		return nil, errors.New("peer cannot be leader")
 	}
-	if res.GetLeader().GetID() == peer.GetID() && rf.Rule.Role == placement.Follower {
+	if res.GetLeader().GetID() == peer.GetID() &&
+		(rf.Rule.Role == placement.Follower || rf.Rule.Role == placement.Witness) {
 		checkerCounter.WithLabelValues("rule_checker", "fix-follower-role").Inc()
 		for _, p := range res.Meta.Peers() {
 			if c.allowLeader(fit, p) {
@@ -233,7 +226,7 @@ func (c *RuleChecker) fixLooseMatchPeer(res *core.CachedResource, fit *placement
 }
 
 func (c *RuleChecker) allowLeader(fit *placement.ResourceFit, peer metapb.Peer) bool {
-	if metadata.IsLearner(peer) {
+	if metadata.IsLearner(peer) || metadata.IsWitness(peer) {
 		return false
 	}
 	s := c.cluster.GetContainer(peer.ContainerID)
@@ -270,7 +263,7 @@ func (c *RuleChecker) fixBetterLocation(res *core.CachedResource, rf *placement.
 		return nil, nil
 	}
 	checkerCounter.WithLabelValues("rule_checker", "move-to-better-location").Inc()
-	newPeer := metapb.Peer{ContainerID: newContainer, Role: rf.Rule.Role.MetaPeerRole()}
+	newPeer := rf.Rule.Role.MetaPeer(newContainer)
 	return operator.CreateMovePeerOperator("move-to-better-location", c.cluster, res, operator.OpReplica, oldContainer, newPeer)
 }
 
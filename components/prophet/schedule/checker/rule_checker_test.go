@@ -80,6 +80,42 @@ func TestAddRulePeer(t *testing.T) {
 	assert.Equal(t, uint64(3), op.Step(0).(operator.AddLearner).ToContainer)
 }
 
+func TestAddRuleWitness(t *testing.T) {
+	s := &testRuleChecker{}
+	s.setup()
+
+	s.cluster.AddLeaderContainer(1, 1)
+	s.cluster.AddLeaderContainer(2, 1)
+	s.cluster.AddLeaderContainer(3, 1)
+	s.cluster.AddLeaderResourceWithRange(1, "", "", 1, 2)
+	s.ruleManager.SetRule(&placement.Rule{
+		GroupID: "prophet",
+		ID:      "default",
+		Role:    placement.Voter,
+		Count:   2,
+	})
+	s.ruleManager.SetRule(&placement.Rule{
+		GroupID: "prophet",
+		ID:      "witness",
+		Role:    placement.Witness,
+		Count:   1,
+	})
+	op := s.rc.Check(s.cluster.GetResource(1))
+	assert.NotNil(t, op)
+	assert.Equal(t, "add-rule-peer", op.Desc())
+	assert.Equal(t, uint64(3), op.Step(0).(operator.AddLearner).ToContainer)
+	assert.True(t, op.Step(0).(operator.AddLearner).IsWitness)
+
+	// the witness leader is transferred to another voter
+	res := s.cluster.GetResource(1)
+	p := metapb.Peer{ID: 100, ContainerID: 3, Witness: true}
+	res = res.Clone(core.WithAddPeer(p), core.WithLeader(&p))
+	op = s.rc.Check(res)
+	assert.NotNil(t, op)
+	assert.Equal(t, "fix-follower-role", op.Desc())
+	assert.NotEqual(t, uint64(3), op.Step(0).(operator.TransferLeader).ToContainer)
+}
+
 func TestFillReplicasWithRule(t *testing.T) {
 	s := &testRuleChecker{}
 	s.setup()
@@ -0,0 +1,84 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/opt"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+// SplitChecker ensures the hot resource to be split by its load. The split key is
+// chosen by the resource leader from the sampled request keys.
+type SplitChecker struct {
+	cluster    opt.Cluster
+	opts       *config.PersistOptions
+	splitCache *util.TTLUint64
+}
+
+// NewSplitChecker creates a split checker.
+func NewSplitChecker(ctx context.Context, cluster opt.Cluster) *SplitChecker {
+	opts := cluster.GetOpts()
+	return &SplitChecker{
+		cluster:    cluster,
+		opts:       opts,
+		splitCache: util.NewIDTTL(ctx, time.Minute, opts.GetSplitMergeInterval()),
+	}
+}
+
+// GetType return SplitChecker's type
+func (s *SplitChecker) GetType() string {
+	return "split-checker"
+}
+
+// Check verifies whether the resource is hot, creating an Operator if need.
+func (s *SplitChecker) Check(res *core.CachedResource) *operator.Operator {
+	if !s.opts.IsLoadSplitEnabled() {
+		return nil
+	}
+
+	// the leader needs time to sample the requests after the last split
+	if s.splitCache.Exists(res.Meta.ID()) {
+		checkerCounter.WithLabelValues("split_checker", "recently-split").Inc()
+		return nil
+	}
+
+	checkerCounter.WithLabelValues("split_checker", "check").Inc()
+
+	if !s.cluster.IsResourceHot(res) {
+		checkerCounter.WithLabelValues("split_checker", "no-need").Inc()
+		return nil
+	}
+
+	if !opt.IsResourceHealthy(s.cluster, res) {
+		checkerCounter.WithLabelValues("split_checker", "special-peer").Inc()
+		return nil
+	}
+
+	op, err := operator.CreateSplitResourceOperator("split-hot-resource", res, 0, metapb.CheckPolicy_LOAD, nil)
+	if err != nil {
+		checkerCounter.WithLabelValues("split_checker", "create-operator-fail").Inc()
+		return nil
+	}
+
+	checkerCounter.WithLabelValues("split_checker", "new-operator").Inc()
+	s.splitCache.PutWithTTL(res.Meta.ID(), nil, s.opts.GetSplitMergeInterval())
+	return op
+}
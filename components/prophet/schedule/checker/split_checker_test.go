@@ -0,0 +1,62 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/mock/mockcluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/matrixorigin/matrixcube/components/prophet/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitHotResource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster := mockcluster.NewCluster(config.NewTestOptions())
+	sc := NewSplitChecker(ctx, cluster)
+	for id := uint64(1); id <= 3; id++ {
+		cluster.PutContainerWithLabels(id)
+	}
+
+	cold := cluster.AddLeaderResource(1, 1, 2, 3)
+	cluster.SetEnableLoadSplit(true)
+	assert.Nil(t, sc.Check(cold))
+
+	for i := 0; i < 5; i++ {
+		cluster.AddLeaderResourceWithWriteInfo(2, 1, 512*1024*statistics.ResourceHeartBeatReportInterval, 0,
+			statistics.ResourceHeartBeatReportInterval, []uint64{2, 3})
+	}
+	hot := cluster.GetResource(2)
+	assert.True(t, cluster.IsResourceHot(hot))
+
+	cluster.SetEnableLoadSplit(false)
+	assert.Nil(t, sc.Check(hot))
+
+	cluster.SetEnableLoadSplit(true)
+	op := sc.Check(hot)
+	assert.NotNil(t, op)
+	assert.Equal(t, "split-hot-resource", op.Desc())
+	step, ok := op.Step(0).(operator.SplitResource)
+	assert.True(t, ok)
+	assert.Equal(t, metapb.CheckPolicy_LOAD, step.Policy)
+
+	// skip the recently split resource
+	assert.Nil(t, sc.Check(hot))
+}
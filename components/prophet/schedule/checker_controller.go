@@ -38,6 +38,7 @@ type CheckerController struct {
 	replicaChecker      *checker.ReplicaChecker
 	ruleChecker         *checker.RuleChecker
 	mergeChecker        *checker.MergeChecker
+	splitChecker        *checker.SplitChecker
 	jointStateChecker   *checker.JointStateChecker
 	resourceWaitingList cache.Cache
 }
@@ -54,6 +55,7 @@ func NewCheckerController(ctx context.Context, cluster opt.Cluster, ruleManager
 		replicaChecker:      checker.NewReplicaChecker(cluster, resourceWaitingList),
 		ruleChecker:         checker.NewRuleChecker(cluster, ruleManager, resourceWaitingList),
 		mergeChecker:        checker.NewMergeChecker(ctx, cluster),
+		splitChecker:        checker.NewSplitChecker(ctx, cluster),
 		jointStateChecker:   checker.NewJointStateChecker(cluster),
 		resourceWaitingList: resourceWaitingList,
 	}
@@ -99,6 +101,10 @@ func (c *CheckerController) CheckResource(res *core.CachedResource) []*operator.
 		}
 	}
 
+	if op := c.splitChecker.Check(res); op != nil {
+		return []*operator.Operator{op}
+	}
+
 	if c.mergeChecker != nil && opController.OperatorCount(operator.OpMerge) < c.opts.GetMergeScheduleLimit() {
 		allowed := opController.OperatorCount(operator.OpMerge) < c.opts.GetMergeScheduleLimit()
 		if !allowed {
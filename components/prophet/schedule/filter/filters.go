@@ -810,6 +810,7 @@ func createResourceForRuleFit(startKey, endKey []byte,
 			ID:          p.ID,
 			ContainerID: p.ContainerID,
 			Role:        p.Role,
+			Witness:     p.Witness,
 		}
 		copyPeers = append(copyPeers, peer)
 	}
@@ -204,6 +204,7 @@ func (b *Builder) PromoteLearner(containerID uint64) *Builder {
 			ID:          peer.ID,
 			ContainerID: peer.ContainerID,
 			Role:        metapb.PeerRole_Voter,
+			Witness:     peer.Witness,
 		})
 	}
 	return b
@@ -223,6 +224,7 @@ func (b *Builder) DemoteVoter(containerID uint64) *Builder {
 			ID:          peer.ID,
 			ContainerID: peer.ContainerID,
 			Role:        metapb.PeerRole_Learner,
+			Witness:     peer.Witness,
 		})
 	}
 	return b
@@ -237,6 +239,8 @@ func (b *Builder) SetLeader(containerID uint64) *Builder {
 		b.err = fmt.Errorf("cannot transfer leader to %d: not found", containerID)
 	} else if metadata.IsLearner(peer) {
 		b.err = fmt.Errorf("cannot transfer leader to %d: not voter", containerID)
+	} else if metadata.IsWitness(peer) {
+		b.err = fmt.Errorf("cannot transfer leader to %d: is witness", containerID)
 	} else if _, ok := b.unhealthyPeers[containerID]; ok {
 		b.err = fmt.Errorf("cannot transfer leader to %d: unhealthy", containerID)
 	} else {
@@ -287,7 +291,7 @@ func (b *Builder) SetExpectedRoles(roles map[uint64]placement.PeerRoleType) *Bui
 			leaderCount++
 		case placement.Voter:
 			voterCount++
-		case placement.Follower, placement.Learner:
+		case placement.Follower, placement.Learner, placement.Witness:
 			if b.targetLeaderContainerID == id {
 				b.targetLeaderContainerID = 0
 			}
@@ -347,7 +351,7 @@ func (b *Builder) prepareBuild() (string, error) {
 
 	voterCount := 0
 	for _, peer := range b.targetPeers {
-		if !metadata.IsLearner(peer) {
+		if !metadata.IsLearner(peer) && !metadata.IsWitness(peer) {
 			voterCount++
 		}
 	}
@@ -364,6 +368,11 @@ func (b *Builder) prepareBuild() (string, error) {
 			continue
 		}
 
+		// A witness can not be changed to a normal peer in place, and vice versa.
+		if metadata.IsWitness(o) != metadata.IsWitness(n) {
+			return "", fmt.Errorf("cannot create operator: peer on container %d changes witness", o.ContainerID)
+		}
+
 		// If the peer id in the target is different from that in the origin,
 		// modify it to the peer id of the origin.
 		if o.ID != n.ID {
@@ -371,6 +380,7 @@ func (b *Builder) prepareBuild() (string, error) {
 				ID:          o.ID,
 				ContainerID: o.ContainerID,
 				Role:        n.Role,
+				Witness:     n.Witness,
 			}
 		}
 
@@ -405,6 +415,7 @@ func (b *Builder) prepareBuild() (string, error) {
 					ID:          id,
 					ContainerID: n.ContainerID,
 					Role:        n.Role,
+					Witness:     n.Witness,
 				}
 			}
 			// It is a pair with `b.toRemove.Set(o)` when `o != nil`.
@@ -412,8 +423,8 @@ func (b *Builder) prepareBuild() (string, error) {
 		}
 	}
 
-	// If the target leader does not exist or is a Learner, the target is cancelled.
-	if peer, ok := b.targetPeers[b.targetLeaderContainerID]; !ok || metadata.IsLearner(peer) {
+	// If the target leader does not exist or is a Learner or a Witness, the target is cancelled.
+	if peer, ok := b.targetPeers[b.targetLeaderContainerID]; !ok || metadata.IsLearner(peer) || metadata.IsWitness(peer) {
 		b.targetLeaderContainerID = 0
 	}
 
@@ -470,6 +481,7 @@ func (b *Builder) buildStepsWithJointConsensus(kind OpKind) (OpKind, error) {
 				ID:          peer.ID,
 				ContainerID: peer.ContainerID,
 				Role:        metapb.PeerRole_Learner,
+				Witness:     peer.Witness,
 			})
 			b.toPromote.Set(peer)
 		} else {
@@ -491,6 +503,7 @@ func (b *Builder) buildStepsWithJointConsensus(kind OpKind) (OpKind, error) {
 				ID:          peer.ID,
 				ContainerID: peer.ContainerID,
 				Role:        metapb.PeerRole_Learner,
+				Witness:     peer.Witness,
 			})
 		}
 	}
@@ -653,9 +666,9 @@ func (b *Builder) execDemoteFollower(peer metapb.Peer) {
 
 func (b *Builder) execAddPeer(peer metapb.Peer) {
 	if b.lightWeight {
-		b.steps = append(b.steps, AddLightLearner{ToContainer: peer.ContainerID, PeerID: peer.ID})
+		b.steps = append(b.steps, AddLightLearner{ToContainer: peer.ContainerID, PeerID: peer.ID, IsWitness: peer.Witness})
 	} else {
-		b.steps = append(b.steps, AddLearner{ToContainer: peer.ContainerID, PeerID: peer.ID})
+		b.steps = append(b.steps, AddLearner{ToContainer: peer.ContainerID, PeerID: peer.ID, IsWitness: peer.Witness})
 	}
 	if !metadata.IsLearner(peer) {
 		b.steps = append(b.steps, PromoteLearner{ToContainer: peer.ContainerID, PeerID: peer.ID})
@@ -710,6 +723,10 @@ func (b *Builder) allowLeader(peer metapb.Peer, ignoreClusterLimit bool) bool {
 	case metapb.PeerRole_Learner, metapb.PeerRole_DemotingVoter:
 		return false
 	}
+	// the witness has no data, it can't be the leader.
+	if metadata.IsWitness(peer) {
+		return false
+	}
 
 	// container does not exist
 	if peer.ContainerID == b.currentLeaderContainerID {
@@ -507,3 +507,45 @@ func TestTargetUnhealthyPeer(t *testing.T) {
 	builder.SetLeader(2)
 	assert.Error(t, builder.err)
 }
+
+func TestWitnessPeer(t *testing.T) {
+	s := &testBuilder{}
+	s.setup()
+
+	w := metapb.Peer{ID: 2, ContainerID: 2, Witness: true}
+	resource := core.NewCachedResource(&metadata.TestResource{ResID: 1, ResPeers: []metapb.Peer{{ID: 1, ContainerID: 1},
+		w}}, &metapb.Peer{ID: 1, ContainerID: 1})
+
+	// the witness can't be the leader
+	builder := NewBuilder("test", s.cluster, resource)
+	builder.SetLeader(2)
+	assert.Error(t, builder.err)
+
+	// the witness can't be changed to a normal peer in place
+	_, err := NewBuilder("test", s.cluster, resource).
+		SetPeers(map[uint64]metapb.Peer{1: {ID: 1, ContainerID: 1}, 2: {ID: 2, ContainerID: 2}}).
+		Build(0)
+	assert.Error(t, err)
+
+	// the target peers have no voter except the witness
+	_, err = NewBuilder("test", s.cluster, resource).
+		SetPeers(map[uint64]metapb.Peer{2: w, 3: {ContainerID: 3, Role: metapb.PeerRole_Learner}}).
+		Build(0)
+	assert.Error(t, err)
+
+	// move the witness to another container
+	op, err := NewBuilder("test", s.cluster, resource).
+		RemovePeer(2).
+		AddPeer(metapb.Peer{ContainerID: 3, Witness: true}).
+		Build(0)
+	assert.NoError(t, err)
+	added := false
+	for i := 0; i < op.Len(); i++ {
+		if step, ok := op.Step(i).(AddLearner); ok {
+			assert.Equal(t, uint64(3), step.ToContainer)
+			assert.True(t, step.IsWitness)
+			added = true
+		}
+	}
+	assert.True(t, added)
+}
@@ -69,10 +69,7 @@ func CreateMoveResourceOperator(desc string, cluster opt.Cluster, res *core.Cach
 	// construct the peers from roles
 	peers := make(map[uint64]metapb.Peer)
 	for containerID, role := range roles {
-		peers[containerID] = metapb.Peer{
-			ContainerID: containerID,
-			Role:        role.MetaPeerRole(),
-		}
+		peers[containerID] = role.MetaPeer(containerID)
 	}
 	builder := NewBuilder(desc, cluster, res).SetPeers(peers).SetExpectedRoles(roles)
 	return builder.Build(kind)
@@ -132,6 +129,7 @@ func CreateMergeResourceOperator(desc string, cluster opt.Cluster, source *core.
 			peers[p.ContainerID] = metapb.Peer{
 				ContainerID: p.ContainerID,
 				Role:        p.Role,
+				Witness:     p.Witness,
 			}
 		}
 		matchOp, err := NewBuilder("", cluster, source).
@@ -335,6 +335,62 @@ func (o *Operator) History() []OpHistory {
 	return histories
 }
 
+// Containers returns the ids of the containers involved in the operator's steps,
+// in the order of their first appearance.
+func (o *Operator) Containers() []uint64 {
+	var containers []uint64
+	add := func(ids ...uint64) {
+		for _, id := range ids {
+			exists := false
+			for _, c := range containers {
+				if c == id {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				containers = append(containers, id)
+			}
+		}
+	}
+
+	for _, step := range o.steps {
+		switch s := step.(type) {
+		case TransferLeader:
+			add(s.FromContainer, s.ToContainer)
+		case AddPeer:
+			add(s.ToContainer)
+		case AddLightPeer:
+			add(s.ToContainer)
+		case AddLearner:
+			add(s.ToContainer)
+		case AddLightLearner:
+			add(s.ToContainer)
+		case PromoteLearner:
+			add(s.ToContainer)
+		case DemoteFollower:
+			add(s.ToContainer)
+		case RemovePeer:
+			add(s.FromContainer)
+		case ChangePeerV2Enter:
+			for _, pl := range s.PromoteLearners {
+				add(pl.ToContainer)
+			}
+			for _, dv := range s.DemoteVoters {
+				add(dv.ToContainer)
+			}
+		case ChangePeerV2Leave:
+			for _, pl := range s.PromoteLearners {
+				add(pl.ToContainer)
+			}
+			for _, dv := range s.DemoteVoters {
+				add(dv.ToContainer)
+			}
+		}
+	}
+	return containers
+}
+
 // GetAdditionalInfo returns additional info with string
 func (o *Operator) GetAdditionalInfo() string {
 	if len(o.AdditionalInfos) != 0 {
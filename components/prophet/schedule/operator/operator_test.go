@@ -398,3 +398,23 @@ func TestCheck(t *testing.T) {
 		assert.Equal(t, SUCCESS, op.Status())
 	}
 }
+
+func TestOperatorContainers(t *testing.T) {
+	s := &testOperator{}
+	s.setup()
+
+	steps := []OpStep{
+		AddLearner{ToContainer: 3, PeerID: 3},
+		ChangePeerV2Enter{
+			PromoteLearners: []PromoteLearner{{ToContainer: 3, PeerID: 3}},
+			DemoteVoters:    []DemoteVoter{{ToContainer: 2, PeerID: 2}},
+		},
+		TransferLeader{FromContainer: 1, ToContainer: 3},
+		RemovePeer{FromContainer: 2, PeerID: 2},
+	}
+	op := s.newTestOperator(1, OpLeader|OpResource, steps...)
+	assert.Equal(t, []uint64{3, 2, 1}, op.Containers())
+
+	op = s.newTestOperator(1, OpResource, SplitResource{})
+	assert.Empty(t, op.Containers())
+}
@@ -83,6 +83,8 @@ func (tl TransferLeader) Influence(opInfluence OpInfluence, res *core.CachedReso
 // AddPeer is an OpStep that adds a resource peer.
 type AddPeer struct {
 	ToContainer, PeerID uint64
+	// IsWitness the added peer is a witness
+	IsWitness bool
 }
 
 // ConfVerChanged returns the delta value for version increased by this step.
@@ -92,6 +94,9 @@ func (ap AddPeer) ConfVerChanged(res *core.CachedResource) uint64 {
 }
 
 func (ap AddPeer) String() string {
+	if ap.IsWitness {
+		return fmt.Sprintf("add witness peer %v on container %v", ap.PeerID, ap.ToContainer)
+	}
 	return fmt.Sprintf("add peer %v on container %v", ap.PeerID, ap.ToContainer)
 }
 
@@ -130,6 +135,8 @@ func (ap AddPeer) CheckSafety(res *core.CachedResource) error {
 // AddLearner is an OpStep that adds a resource learner peer.
 type AddLearner struct {
 	ToContainer, PeerID uint64
+	// IsWitness the added peer is a witness
+	IsWitness bool
 }
 
 // ConfVerChanged returns the delta value for version increased by this step.
@@ -139,6 +146,9 @@ func (al AddLearner) ConfVerChanged(res *core.CachedResource) uint64 {
 }
 
 func (al AddLearner) String() string {
+	if al.IsWitness {
+		return fmt.Sprintf("add witness learner peer %v on container %v", al.PeerID, al.ToContainer)
+	}
 	return fmt.Sprintf("add learner peer %v on container %v", al.PeerID, al.ToContainer)
 }
 
@@ -359,6 +369,8 @@ func (sr SplitResource) CheckSafety(res *core.CachedResource) error {
 // AddLightPeer is an OpStep that adds a resource peer without considering the influence.
 type AddLightPeer struct {
 	ToContainer, PeerID uint64
+	// IsWitness the added peer is a witness
+	IsWitness bool
 }
 
 // ConfVerChanged returns the delta value for version increased by this step.
@@ -368,6 +380,9 @@ func (ap AddLightPeer) ConfVerChanged(res *core.CachedResource) uint64 {
 }
 
 func (ap AddLightPeer) String() string {
+	if ap.IsWitness {
+		return fmt.Sprintf("add witness peer %v on container %v", ap.PeerID, ap.ToContainer)
+	}
 	return fmt.Sprintf("add peer %v on container %v", ap.PeerID, ap.ToContainer)
 }
 
@@ -405,6 +420,8 @@ func (ap AddLightPeer) Influence(opInfluence OpInfluence, res *core.CachedResour
 // AddLightLearner is an OpStep that adds a resource learner peer without considering the influence.
 type AddLightLearner struct {
 	ToContainer, PeerID uint64
+	// IsWitness the added peer is a witness
+	IsWitness bool
 }
 
 // ConfVerChanged returns the delta value for version increased by this step.
@@ -414,6 +431,9 @@ func (al AddLightLearner) ConfVerChanged(res *core.CachedResource) uint64 {
 }
 
 func (al AddLightLearner) String() string {
+	if al.IsWitness {
+		return fmt.Sprintf("add witness learner peer %v on container %v", al.PeerID, al.ToContainer)
+	}
 	return fmt.Sprintf("add learner peer %v on container %v", al.PeerID, al.ToContainer)
 }
 
@@ -62,9 +62,13 @@ type OperatorController struct {
 	counts          map[operator.OpKind]uint64
 	opRecords       *OperatorRecords
 	containersLimit map[uint64]map[limit.Type]*limit.ContainerLimit
+	limitClock      limit.Clock
 	wop             WaitingOperator
 	wopStatus       *WaitingOperatorStatus
 	opNotifierQueue operatorQueue
+
+	finishedMu sync.Mutex
+	finished   []metapb.OperatorHistory
 }
 
 // NewOperatorController creates a OperatorController.
@@ -90,6 +94,14 @@ func (oc *OperatorController) Ctx() context.Context {
 	return oc.ctx
 }
 
+// SetContainerLimitClock sets the clock to refill the container limits created later,
+// the simulator uses it to drive the limits by the virtual time.
+func (oc *OperatorController) SetContainerLimitClock(clock limit.Clock) {
+	oc.Lock()
+	defer oc.Unlock()
+	oc.limitClock = clock
+}
+
 // GetCluster exports cluster to evict-scheduler for check container status.
 func (oc *OperatorController) GetCluster() opt.Cluster {
 	oc.RLock()
@@ -566,6 +578,7 @@ func (oc *OperatorController) buryOperator(op *operator.Operator, extra string)
 	}
 
 	oc.opRecords.Put(op)
+	oc.addFinishedHistory(op, extra)
 }
 
 // GetOperatorStatus gets the operator and its status with the specify id.
@@ -637,6 +650,7 @@ func (oc *OperatorController) SendScheduleCommand(res *core.CachedResource, step
 					ID:          st.PeerID,
 					ContainerID: st.ToContainer,
 					Role:        metapb.PeerRole_Voter,
+					Witness:     st.IsWitness,
 				},
 			},
 		}
@@ -652,6 +666,7 @@ func (oc *OperatorController) SendScheduleCommand(res *core.CachedResource, step
 					ID:          st.PeerID,
 					ContainerID: st.ToContainer,
 					Role:        metapb.PeerRole_Voter,
+					Witness:     st.IsWitness,
 				},
 			},
 		}
@@ -667,6 +682,7 @@ func (oc *OperatorController) SendScheduleCommand(res *core.CachedResource, step
 					ID:          st.PeerID,
 					ContainerID: st.ToContainer,
 					Role:        metapb.PeerRole_Learner,
+					Witness:     st.IsWitness,
 				},
 			},
 		}
@@ -682,6 +698,7 @@ func (oc *OperatorController) SendScheduleCommand(res *core.CachedResource, step
 					ID:          st.PeerID,
 					ContainerID: st.ToContainer,
 					Role:        metapb.PeerRole_Learner,
+					Witness:     st.IsWitness,
 				},
 			},
 		}
@@ -934,7 +951,7 @@ func (oc *OperatorController) newContainerLimit(containerID uint64, ratePerSec f
 	if oc.containersLimit[containerID] == nil {
 		oc.containersLimit[containerID] = make(map[limit.Type]*limit.ContainerLimit)
 	}
-	oc.containersLimit[containerID][limitType] = limit.NewContainerLimit(ratePerSec, limit.ResourceInfluence[limitType])
+	oc.containersLimit[containerID][limitType] = limit.NewContainerLimitWithClock(ratePerSec, limit.ResourceInfluence[limitType], oc.limitClock)
 }
 
 // getOrCreateContainerLimit is used to get or create the limit of a container.
@@ -714,6 +714,45 @@ func TestAddWaitingOperator(t *testing.T) {
 	assert.Equal(t, 0, controller.AddWaitingOperator(addPeerOp(0)))
 }
 
+func TestFinishedHistories(t *testing.T) {
+	s := &testOperatorController{}
+	s.setup(t)
+	defer s.tearDown()
+
+	opt := config.NewTestOptions()
+	tc := mockcluster.NewCluster(opt)
+	stream := hbstream.NewTestHeartbeatStreams(s.ctx, tc.ID, tc, false /* no need to run */)
+	oc := NewOperatorController(s.ctx, tc, stream)
+	tc.AddLeaderContainer(1, 1)
+	tc.AddLeaderContainer(2, 0)
+	tc.AddLeaderResource(1, 1, 2)
+	op := operator.NewOperator("test", "test", 1, metapb.ResourceEpoch{}, operator.OpLeader,
+		operator.TransferLeader{FromContainer: 1, ToContainer: 2})
+	assert.True(t, oc.AddOperator(op))
+	assert.Empty(t, oc.TakeFinishedHistories())
+
+	assert.True(t, oc.RemoveOperator(op, "removed by test"))
+	histories := oc.TakeFinishedHistories()
+	assert.Equal(t, 1, len(histories))
+	h := histories[0]
+	assert.Equal(t, uint64(1), h.ResourceID)
+	assert.Equal(t, "test", h.Desc)
+	assert.Equal(t, "Canceled", h.Status)
+	assert.Equal(t, "removed by test", h.Reason)
+	assert.Equal(t, []uint64{1, 2}, h.Containers)
+	assert.Equal(t, 1, len(h.Steps))
+	assert.True(t, h.FinishAt >= h.CreateAt)
+	assert.Empty(t, oc.TakeFinishedHistories())
+
+	assert.True(t, OperatorHistoryFilter{}.Match(h))
+	assert.True(t, OperatorHistoryFilter{ResourceID: 1, ContainerID: 2, Desc: "test"}.Match(h))
+	assert.False(t, OperatorHistoryFilter{ResourceID: 2}.Match(h))
+	assert.False(t, OperatorHistoryFilter{ContainerID: 3}.Match(h))
+	assert.False(t, OperatorHistoryFilter{Desc: "balance-leader-scheduler"}.Match(h))
+	assert.False(t, OperatorHistoryFilter{Start: time.Unix(0, h.FinishAt+1)}.Match(h))
+	assert.False(t, OperatorHistoryFilter{End: time.Unix(0, h.FinishAt)}.Match(h))
+}
+
 func checkRemoveOperatorSuccess(t *testing.T, oc *OperatorController, op *operator.Operator) {
 	assert.True(t, oc.RemoveOperator(op, ""))
 	assert.True(t, op.IsEnd())
@@ -0,0 +1,102 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+)
+
+// maxFinishedHistories the max count of the finished operator histories waiting to be
+// persisted, the oldest are dropped if the storage can't keep up.
+const maxFinishedHistories = 10000
+
+// OperatorHistoryFilter filters the persisted operator histories, the zero value
+// fields match all the histories.
+type OperatorHistoryFilter struct {
+	ResourceID  uint64
+	ContainerID uint64
+	// Desc the scheduler or checker which created the operator
+	Desc  string
+	Start time.Time
+	End   time.Time
+}
+
+// Match returns true if the history matches the filter
+func (f OperatorHistoryFilter) Match(h metapb.OperatorHistory) bool {
+	if f.ResourceID > 0 && h.ResourceID != f.ResourceID {
+		return false
+	}
+	if f.Desc != "" && h.Desc != f.Desc {
+		return false
+	}
+	if !f.Start.IsZero() && h.FinishAt < f.Start.UnixNano() {
+		return false
+	}
+	if !f.End.IsZero() && h.FinishAt >= f.End.UnixNano() {
+		return false
+	}
+	if f.ContainerID > 0 {
+		for _, id := range h.Containers {
+			if id == f.ContainerID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func newOperatorHistory(op *operator.Operator, extra string) metapb.OperatorHistory {
+	steps := make([]string, 0, op.Len())
+	for i := 0; i < op.Len(); i++ {
+		steps = append(steps, op.Step(i).String())
+	}
+
+	return metapb.OperatorHistory{
+		ResourceID: op.ResourceID(),
+		Desc:       op.Desc(),
+		Kind:       op.Kind().String(),
+		Steps:      steps,
+		Status:     operator.OpStatusToString(op.Status()),
+		Reason:     extra,
+		Containers: op.Containers(),
+		CreateAt:   op.GetCreateTime().UnixNano(),
+		FinishAt:   op.GetReachTimeOf(op.Status()).UnixNano(),
+	}
+}
+
+func (oc *OperatorController) addFinishedHistory(op *operator.Operator, extra string) {
+	oc.finishedMu.Lock()
+	defer oc.finishedMu.Unlock()
+
+	if len(oc.finished) >= maxFinishedHistories {
+		operatorCounter.WithLabelValues(oc.finished[0].Desc, "drop-history").Inc()
+		oc.finished = oc.finished[1:]
+	}
+	oc.finished = append(oc.finished, newOperatorHistory(op, extra))
+}
+
+// TakeFinishedHistories returns the histories of the operators finished since the last
+// call, the caller is responsible for persisting them.
+func (oc *OperatorController) TakeFinishedHistories() []metapb.OperatorHistory {
+	oc.finishedMu.Lock()
+	defer oc.finishedMu.Unlock()
+
+	histories := oc.finished
+	oc.finished = nil
+	return histories
+}
@@ -270,6 +270,10 @@ type fitPeer struct {
 }
 
 func (p *fitPeer) matchRoleStrict(role PeerRoleType) bool {
+	if metadata.IsWitness(p.Peer) != (role == Witness) {
+		return false
+	}
+
 	switch role {
 	case Voter: // Voter matches either Leader or Follower.
 		return !metadata.IsLearner(p.Peer)
@@ -279,11 +283,18 @@ func (p *fitPeer) matchRoleStrict(role PeerRoleType) bool {
 		return !metadata.IsLearner(p.Peer) && !p.isLeader
 	case Learner:
 		return metadata.IsLearner(p.Peer)
+	case Witness: // Witness matches a voter witness which is not the leader.
+		return !metadata.IsLearner(p.Peer) && !p.isLeader
 	}
 	return false
 }
 
 func (p *fitPeer) matchRoleLoose(role PeerRoleType) bool {
+	// A peer cannot become or stop being a witness, it has to be replaced.
+	if metadata.IsWitness(p.Peer) != (role == Witness) {
+		return false
+	}
+
 	// non-learner cannot become learner. All other roles can migrate to
 	// others by scheduling. For example, Leader->Follower, Learner->Leader
 	// are possible, but Voter->Learner is impossible.
@@ -58,7 +58,8 @@ func makeTestResource(def string) *core.CachedResource {
 			idStr, role = splits[0], PeerRoleType(splits[1])
 		}
 		id, _ := strconv.Atoi(idStr)
-		peer := metapb.Peer{ID: uint64(id), ContainerID: uint64(id), Role: role.MetaPeerRole()}
+		peer := role.MetaPeer(uint64(id))
+		peer.ID = uint64(id)
 		resourceMeta.ResPeers = append(resourceMeta.ResPeers, peer)
 		if role == Leader {
 			leader = &peer
@@ -131,6 +132,10 @@ func TestFitresource(t *testing.T) {
 		{"1111_learner,1112,1113", []string{"2/voter//"}, "1112,1113"},
 		{"1111_learner,1112,1113", []string{"3/voter//"}, "1111,1112,1113"},
 		{"1111,1112_learner,1121_learner,1122_learner,1131_learner,1132,1141,1142", []string{"3/follower//zone,rack,host"}, "1111,1132,1141"},
+		// test witness match
+		{"1111,1112,1113_witness", []string{"3/voter//"}, "1111,1112"},
+		{"1111,1112,1113_witness", []string{"2/voter//", "1/witness//"}, "1111,1112/1113"},
+		{"1111,1112,1113", []string{"2/voter//", "1/witness//"}, "1111,1112//1113"},
 		// test 2 rule
 		{"1111,1112,1113,1114", []string{"3/voter//", "1/voter/id=id1/"}, "1112,1113,1114/1111"},
 		{"1111,2211,3111,3112", []string{"3/voter//zone", "1/voter/rack=rack2/"}, "1111,2211,3111//3112"},
@@ -35,6 +35,8 @@ const (
 	Follower PeerRoleType = "follower"
 	// Learner matches a learner.
 	Learner PeerRoleType = "learner"
+	// Witness matches a witness, which votes but stores no data and never becomes leader.
+	Witness PeerRoleType = "witness"
 )
 
 func getPeerRoleTypeFromRPC(tpe rpcpb.PeerRoleType) PeerRoleType {
@@ -47,12 +49,14 @@ func getPeerRoleTypeFromRPC(tpe rpcpb.PeerRoleType) PeerRoleType {
 		return Follower
 	case rpcpb.Learner:
 		return Learner
+	case rpcpb.Witness:
+		return Witness
 	}
 	return Voter
 }
 
 func validateRole(s PeerRoleType) bool {
-	return s == Voter || s == Leader || s == Follower || s == Learner
+	return s == Voter || s == Leader || s == Follower || s == Learner || s == Witness
 }
 
 // MetaPeerRole converts placement.PeerRoleType to metapb.PeerRole.
@@ -63,6 +67,16 @@ func (s PeerRoleType) MetaPeerRole() metapb.PeerRole {
 	return metapb.PeerRole_Voter
 }
 
+// MetaPeer returns the metapb.Peer with the role on the container, the peer ID
+// is allocated by the operator builder.
+func (s PeerRoleType) MetaPeer(containerID uint64) metapb.Peer {
+	return metapb.Peer{
+		ContainerID: containerID,
+		Role:        s.MetaPeerRole(),
+		Witness:     s == Witness,
+	}
+}
+
 // RPCPeerRole converts placement.PeerRoleType to rpcpb.PeerRoleType.
 func (s PeerRoleType) RPCPeerRole() rpcpb.PeerRoleType {
 	switch s {
@@ -74,6 +88,8 @@ func (s PeerRoleType) RPCPeerRole() rpcpb.PeerRoleType {
 		return rpcpb.Follower
 	case Learner:
 		return rpcpb.Learner
+	case Witness:
+		return rpcpb.Witness
 	}
 	return rpcpb.Voter
 }
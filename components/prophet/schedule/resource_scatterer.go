@@ -341,6 +341,7 @@ func (r *ResourceScatterer) selectContainer(group string, peer *metapb.Peer, sou
 			newPeer = &metapb.Peer{
 				ContainerID: storeID,
 				Role:        peer.GetRole(),
+				Witness:     peer.GetWitness(),
 			}
 		}
 	}
@@ -39,6 +39,7 @@ func ApplyOperatorStep(resource *core.CachedResource, op *operator.Operator) *co
 			peer := metapb.Peer{
 				ID:          s.PeerID,
 				ContainerID: s.ToContainer,
+				Witness:     s.IsWitness,
 			}
 			resource = resource.Clone(core.WithAddPeer(peer))
 		case operator.AddLightPeer:
@@ -48,6 +49,7 @@ func ApplyOperatorStep(resource *core.CachedResource, op *operator.Operator) *co
 			peer := metapb.Peer{
 				ID:          s.PeerID,
 				ContainerID: s.ToContainer,
+				Witness:     s.IsWitness,
 			}
 			resource = resource.Clone(core.WithAddPeer(peer))
 		case operator.RemovePeer:
@@ -66,6 +68,7 @@ func ApplyOperatorStep(resource *core.CachedResource, op *operator.Operator) *co
 				ID:          s.PeerID,
 				ContainerID: s.ToContainer,
 				Role:        metapb.PeerRole_Learner,
+				Witness:     s.IsWitness,
 			}
 			resource = resource.Clone(core.WithAddPeer(peer))
 		case operator.AddLightLearner:
@@ -76,15 +79,18 @@ func ApplyOperatorStep(resource *core.CachedResource, op *operator.Operator) *co
 				ID:          s.PeerID,
 				ContainerID: s.ToContainer,
 				Role:        metapb.PeerRole_Learner,
+				Witness:     s.IsWitness,
 			}
 			resource = resource.Clone(core.WithAddPeer(peer))
 		case operator.PromoteLearner:
-			if _, ok := resource.GetContainerLearner(s.ToContainer); !ok {
+			learner, ok := resource.GetContainerLearner(s.ToContainer)
+			if !ok {
 				panic("Promote peer that doesn't exist")
 			}
 			peer := metapb.Peer{
 				ID:          s.PeerID,
 				ContainerID: s.ToContainer,
+				Witness:     learner.Witness,
 			}
 			resource = resource.Clone(core.WithRemoveContainerPeer(s.ToContainer), core.WithAddPeer(peer))
 		default:
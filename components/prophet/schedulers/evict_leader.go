@@ -180,36 +180,64 @@ func (s *evictLeaderScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
 	return allowed
 }
 
-func (s *evictLeaderScheduler) scheduleOnce(cluster opt.Cluster) []*operator.Operator {
+func (s *evictLeaderScheduler) Schedule(cluster opt.Cluster) []*operator.Operator {
+	schedulerCounter.WithLabelValues(s.GetName(), "schedule").Inc()
+	s.conf.mu.RLock()
+	defer s.conf.mu.RUnlock()
+
+	return scheduleEvictLeaderBatch(s.GetName(), s.GetType(), cluster, s.conf.ContainerIDWithRanges)
+}
+
+// scheduleEvictLeaderBatch creates at most EvictLeaderBatchSize operators to transfer the
+// leaders out of the containers.
+func scheduleEvictLeaderBatch(name, typ string, cluster opt.Cluster, containerRanges map[uint64][]core.KeyRange) []*operator.Operator {
+	var ops []*operator.Operator
+	for i := 0; i < EvictLeaderBatchSize; i++ {
+		once := scheduleEvictLeaderOnce(name, typ, cluster, containerRanges)
+		// no more resources
+		if len(once) == 0 {
+			break
+		}
+		ops = uniqueAppend(ops, once...)
+		// the batch has been fulfilled
+		if len(ops) > EvictLeaderBatchSize {
+			break
+		}
+	}
+
+	return ops
+}
+
+func scheduleEvictLeaderOnce(name, typ string, cluster opt.Cluster, containerRanges map[uint64][]core.KeyRange) []*operator.Operator {
 	var ops []*operator.Operator
-	for id, ranges := range s.conf.ContainerIDWithRanges {
+	for id, ranges := range containerRanges {
 		res := cluster.RandLeaderResource(id, ranges, opt.HealthResource(cluster))
 		if res == nil {
-			schedulerCounter.WithLabelValues(s.GetName(), "no-leader").Inc()
+			schedulerCounter.WithLabelValues(name, "no-leader").Inc()
 			continue
 		}
 
 		target := filter.NewCandidates(cluster.GetFollowerContainers(res)).
-			FilterTarget(cluster.GetOpts(), &filter.ContainerStateFilter{ActionScope: EvictLeaderName, TransferLeader: true}).
+			FilterTarget(cluster.GetOpts(), &filter.ContainerStateFilter{ActionScope: name, TransferLeader: true}).
 			RandomPick()
 		if target == nil {
-			schedulerCounter.WithLabelValues(s.GetName(), "no-target-container").Inc()
+			schedulerCounter.WithLabelValues(name, "no-target-container").Inc()
 			continue
 		}
-		op, err := operator.CreateTransferLeaderOperator(EvictLeaderType, cluster, res, res.GetLeader().GetContainerID(), target.Meta.ID(), operator.OpLeader)
+		op, err := operator.CreateTransferLeaderOperator(typ, cluster, res, res.GetLeader().GetContainerID(), target.Meta.ID(), operator.OpLeader)
 		if err != nil {
 			util.GetLogger().Debugf("create evict leader operator failed with %+v",
 				err)
 			continue
 		}
 		op.SetPriorityLevel(core.HighPriority)
-		op.Counters = append(op.Counters, schedulerCounter.WithLabelValues(s.GetName(), "new-operator"))
+		op.Counters = append(op.Counters, schedulerCounter.WithLabelValues(name, "new-operator"))
 		ops = append(ops, op)
 	}
 	return ops
 }
 
-func (s *evictLeaderScheduler) uniqueAppend(dst []*operator.Operator, src ...*operator.Operator) []*operator.Operator {
+func uniqueAppend(dst []*operator.Operator, src ...*operator.Operator) []*operator.Operator {
 	resIDs := make(map[uint64]struct{})
 	for i := range dst {
 		resIDs[dst[i].ResourceID()] = struct{}{}
@@ -223,25 +251,3 @@ func (s *evictLeaderScheduler) uniqueAppend(dst []*operator.Operator, src ...*op
 	}
 	return dst
 }
-
-func (s *evictLeaderScheduler) Schedule(cluster opt.Cluster) []*operator.Operator {
-	schedulerCounter.WithLabelValues(s.GetName(), "schedule").Inc()
-	var ops []*operator.Operator
-	s.conf.mu.RLock()
-	defer s.conf.mu.RUnlock()
-
-	for i := 0; i < EvictLeaderBatchSize; i++ {
-		once := s.scheduleOnce(cluster)
-		// no more resources
-		if len(once) == 0 {
-			break
-		}
-		ops = s.uniqueAppend(ops, once...)
-		// the batch has been fulfilled
-		if len(ops) > EvictLeaderBatchSize {
-			break
-		}
-	}
-
-	return ops
-}
@@ -0,0 +1,213 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/opt"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+const (
+	// EvictSlowStoreName is evict slow store scheduler name.
+	EvictSlowStoreName = "evict-slow-store-scheduler"
+	// EvictSlowStoreType is evict slow store scheduler type.
+	EvictSlowStoreType = "evict-slow-store"
+
+	// slowStoreEvictThreshold the leaders of the container are evicted if the slow score
+	// reaches the threshold
+	slowStoreEvictThreshold = 100
+	// slowStoreRecoverThreshold the leaders are allowed to be transferred back to the
+	// evicted container if the slow score drops to the threshold
+	slowStoreRecoverThreshold = 1
+)
+
+func init() {
+	schedule.RegisterSliceDecoderBuilder(EvictSlowStoreType, func(args []string) schedule.ConfigDecoder {
+		return func(v interface{}) error {
+			if _, ok := v.(*evictSlowStoreSchedulerConfig); !ok {
+				return errors.New("scheduler not found")
+			}
+			return nil
+		}
+	})
+
+	schedule.RegisterScheduler(EvictSlowStoreType, func(opController *schedule.OperatorController, storage storage.Storage, decoder schedule.ConfigDecoder) (schedule.Scheduler, error) {
+		conf := &evictSlowStoreSchedulerConfig{storage: storage}
+		if err := decoder(conf); err != nil {
+			return nil, err
+		}
+		return newEvictSlowStoreScheduler(opController, conf), nil
+	})
+}
+
+type evictSlowStoreSchedulerConfig struct {
+	storage storage.Storage
+	// EvictedContainers the containers whose leaders are evicted, at most one container
+	// is evicted at the same time.
+	EvictedContainers []uint64 `json:"evict-containers"`
+}
+
+func (conf *evictSlowStoreSchedulerConfig) Persist() error {
+	data, err := schedule.EncodeConfig(conf)
+	if err != nil {
+		return err
+	}
+	return conf.storage.SaveScheduleConfig(EvictSlowStoreName, data)
+}
+
+func (conf *evictSlowStoreSchedulerConfig) evictedContainer() uint64 {
+	if len(conf.EvictedContainers) == 0 {
+		return 0
+	}
+	return conf.EvictedContainers[0]
+}
+
+func (conf *evictSlowStoreSchedulerConfig) setEvictedContainer(id uint64) error {
+	old := conf.EvictedContainers
+	conf.EvictedContainers = nil
+	if id > 0 {
+		conf.EvictedContainers = []uint64{id}
+	}
+
+	err := conf.Persist()
+	if err != nil {
+		conf.EvictedContainers = old
+	}
+	return err
+}
+
+type evictSlowStoreScheduler struct {
+	*BaseScheduler
+	conf *evictSlowStoreSchedulerConfig
+}
+
+// newEvictSlowStoreScheduler creates a scheduler that transfers all leaders out of the
+// container whose slow score reported by the container heartbeat stays high, the leaders
+// are allowed to be transferred back after the container recovers.
+func newEvictSlowStoreScheduler(opController *schedule.OperatorController, conf *evictSlowStoreSchedulerConfig) schedule.Scheduler {
+	return &evictSlowStoreScheduler{
+		BaseScheduler: NewBaseScheduler(opController),
+		conf:          conf,
+	}
+}
+
+func (s *evictSlowStoreScheduler) GetName() string {
+	return EvictSlowStoreName
+}
+
+func (s *evictSlowStoreScheduler) GetType() string {
+	return EvictSlowStoreType
+}
+
+func (s *evictSlowStoreScheduler) EncodeConfig() ([]byte, error) {
+	return schedule.EncodeConfig(s.conf)
+}
+
+func (s *evictSlowStoreScheduler) Prepare(cluster opt.Cluster) error {
+	if id := s.conf.evictedContainer(); id > 0 {
+		return cluster.PauseLeaderTransfer(id)
+	}
+	return nil
+}
+
+func (s *evictSlowStoreScheduler) Cleanup(cluster opt.Cluster) {
+	if id := s.conf.evictedContainer(); id > 0 {
+		cluster.ResumeLeaderTransfer(id)
+	}
+}
+
+func (s *evictSlowStoreScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
+	if s.conf.evictedContainer() == 0 {
+		return true
+	}
+
+	allowed := s.OpController.OperatorCount(operator.OpLeader) < cluster.GetOpts().GetLeaderScheduleLimit()
+	if !allowed {
+		operator.OperatorLimitCounter.WithLabelValues(s.GetType(), operator.OpLeader.String()).Inc()
+	}
+	return allowed
+}
+
+func (s *evictSlowStoreScheduler) Schedule(cluster opt.Cluster) []*operator.Operator {
+	schedulerCounter.WithLabelValues(s.GetName(), "schedule").Inc()
+
+	if id := s.conf.evictedContainer(); id > 0 {
+		container := cluster.GetContainer(id)
+		if container == nil || container.IsTombstone() ||
+			container.GetSlowScore() <= slowStoreRecoverThreshold {
+			s.recover(cluster, id)
+			return nil
+		}
+		return s.evict(cluster, id)
+	}
+
+	var slow *core.CachedContainer
+	for _, container := range cluster.GetContainers() {
+		if !container.IsUp() || container.GetSlowScore() < slowStoreEvictThreshold {
+			continue
+		}
+
+		// evicting the leaders of many slow containers may overload the other containers
+		if slow != nil {
+			schedulerCounter.WithLabelValues(s.GetName(), "multi-slow-container").Inc()
+			return nil
+		}
+		slow = container
+	}
+	if slow == nil {
+		return nil
+	}
+
+	id := slow.Meta.ID()
+	if err := cluster.PauseLeaderTransfer(id); err != nil {
+		util.GetLogger().Errorf("pause leader transfer of slow container %d failed with %+v",
+			id, err)
+		return nil
+	}
+	if err := s.conf.setEvictedContainer(id); err != nil {
+		util.GetLogger().Errorf("persist evicted slow container %d failed with %+v",
+			id, err)
+		cluster.ResumeLeaderTransfer(id)
+		return nil
+	}
+
+	util.GetLogger().Infof("container %d is slow with score %d, evict its leaders",
+		id, slow.GetSlowScore())
+	schedulerCounter.WithLabelValues(s.GetName(), "evict-slow-container").Inc()
+	return s.evict(cluster, id)
+}
+
+func (s *evictSlowStoreScheduler) evict(cluster opt.Cluster, id uint64) []*operator.Operator {
+	return scheduleEvictLeaderBatch(s.GetName(), s.GetType(), cluster,
+		map[uint64][]core.KeyRange{id: {core.NewKeyRange("", "")}})
+}
+
+func (s *evictSlowStoreScheduler) recover(cluster opt.Cluster, id uint64) {
+	if err := s.conf.setEvictedContainer(0); err != nil {
+		util.GetLogger().Errorf("persist recovered slow container %d failed with %+v",
+			id, err)
+		return
+	}
+
+	cluster.ResumeLeaderTransfer(id)
+	util.GetLogger().Infof("slow container %d recovered, resume its leader transfer",
+		id)
+	schedulerCounter.WithLabelValues(s.GetName(), "recover-slow-container").Inc()
+}
@@ -274,6 +274,8 @@ func summaryContainersLoad(
 	loadDetail := make(map[uint64]*containerLoadDetail, len(containersLoads))
 	allByteSum := 0.0
 	allKeySum := 0.0
+	allQuerySum := 0.0
+	allCPUSum := 0.0
 	allCount := 0.0
 
 	for id, loads := range containersLoads {
@@ -287,14 +289,19 @@ func summaryContainersLoad(
 
 		// Find all hot peers first
 		var hotPeers []*statistics.HotPeerStat
+		var queryRate, cpuRate float64
 		{
 			byteSum := 0.0
 			keySum := 0.0
 			for _, peer := range filterHotPeers(kind, containerHotPeers[id]) {
 				byteSum += peer.GetByteRate()
 				keySum += peer.GetKeyRate()
+				queryRate += peer.GetQueryRate()
+				cpuRate += peer.GetCPURate()
 				hotPeers = append(hotPeers, peer.Clone())
 			}
+			// The container heartbeat does not report the requests and the cpu time,
+			// so the sum of hot peers is used as the query and cpu rate of the container.
 			// Use sum of hot peers to estimate leader-only byte rate.
 			// For write requests, Write{Bytes, Keys} is applied to all Peers at the same time, while the Leader and Follower are under different loads (usually the Leader consumes more CPU).
 			// But none of the current dimension reflect this difference, so we create a new dimension to reflect it.
@@ -312,16 +319,28 @@ func summaryContainersLoad(
 				ty := "key-rate-" + rwTy.String() + "-" + kind.String()
 				hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(keySum)
 			}
+			{
+				ty := "query-rate-" + rwTy.String() + "-" + kind.String()
+				hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(queryRate)
+			}
+			{
+				ty := "cpu-rate-" + rwTy.String() + "-" + kind.String()
+				hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(cpuRate)
+			}
 		}
 		allByteSum += byteRate
 		allKeySum += keyRate
+		allQuerySum += queryRate
+		allCPUSum += cpuRate
 		allCount += float64(len(hotPeers))
 
 		// Build store load prediction from current load and pending influence.
 		stLoadPred := (&containerLoad{
-			ByteRate: byteRate,
-			KeyRate:  keyRate,
-			Count:    float64(len(hotPeers)),
+			ByteRate:  byteRate,
+			KeyRate:   keyRate,
+			QueryRate: queryRate,
+			CPURate:   cpuRate,
+			Count:     float64(len(hotPeers)),
 		}).ToLoadPred(containerPendings[id])
 
 		// Construct store load info.
@@ -336,9 +355,13 @@ func summaryContainersLoad(
 	for id, detail := range loadDetail {
 		byteExp := allByteSum / containerLen
 		keyExp := allKeySum / containerLen
+		queryExp := allQuerySum / containerLen
+		cpuExp := allCPUSum / containerLen
 		countExp := allCount / containerLen
 		detail.LoadPred.Expect.ByteRate = byteExp
 		detail.LoadPred.Expect.KeyRate = keyExp
+		detail.LoadPred.Expect.QueryRate = queryExp
+		detail.LoadPred.Expect.CPURate = cpuExp
 		detail.LoadPred.Expect.Count = countExp
 		// Debug
 		{
@@ -349,6 +372,14 @@ func summaryContainersLoad(
 			ty := "exp-key-rate-" + rwTy.String() + "-" + kind.String()
 			hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(keyExp)
 		}
+		{
+			ty := "exp-query-rate-" + rwTy.String() + "-" + kind.String()
+			hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(queryExp)
+		}
+		{
+			ty := "exp-cpu-rate-" + rwTy.String() + "-" + kind.String()
+			hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(cpuExp)
+		}
 		{
 			ty := "exp-count-rate-" + rwTy.String() + "-" + kind.String()
 			hotPeerSummary.WithLabelValues(ty, fmt.Sprintf("%v", id)).Set(countExp)
@@ -448,6 +479,10 @@ type balanceSolver struct {
 	maxSrc   *containerLoad
 	minDst   *containerLoad
 	rankStep *containerLoad
+
+	// firstPriority and secondPriority are the statistics dimensions to balance
+	firstPriority  int
+	secondPriority int
 }
 
 type solution struct {
@@ -473,11 +508,15 @@ func (bs *balanceSolver) init() {
 	}
 	// And it will be unnecessary to filter unhealthy container, because it has been solved in process heartbeat
 
+	bs.firstPriority, bs.secondPriority = bs.sche.conf.GetPriorities(toResourceType(bs.rwTy, bs.opTy))
+
 	bs.maxSrc = &containerLoad{}
 	bs.minDst = &containerLoad{
-		ByteRate: math.MaxFloat64,
-		KeyRate:  math.MaxFloat64,
-		Count:    math.MaxFloat64,
+		ByteRate:  math.MaxFloat64,
+		KeyRate:   math.MaxFloat64,
+		QueryRate: math.MaxFloat64,
+		CPURate:   math.MaxFloat64,
+		Count:     math.MaxFloat64,
 	}
 	maxCur := &containerLoad{}
 
@@ -488,9 +527,25 @@ func (bs *balanceSolver) init() {
 	}
 
 	bs.rankStep = &containerLoad{
-		ByteRate: maxCur.ByteRate * bs.sche.conf.GetByteRankStepRatio(),
-		KeyRate:  maxCur.KeyRate * bs.sche.conf.GetKeyRankStepRatio(),
-		Count:    maxCur.Count * bs.sche.conf.GetCountRankStepRatio(),
+		ByteRate:  maxCur.ByteRate * bs.sche.conf.GetByteRankStepRatio(),
+		KeyRate:   maxCur.KeyRate * bs.sche.conf.GetKeyRankStepRatio(),
+		QueryRate: maxCur.QueryRate * bs.sche.conf.GetQueryRankStepRatio(),
+		CPURate:   maxCur.CPURate * bs.sche.conf.GetCPURankStepRatio(),
+		Count:     maxCur.Count * bs.sche.conf.GetCountRankStepRatio(),
+	}
+}
+
+// minHotRate returns the min rate of the dimension that a peer is considered as hot
+func (bs *balanceSolver) minHotRate(dim int) float64 {
+	switch dim {
+	case statistics.KeyDim:
+		return bs.sche.conf.GetMinHotKeyRate()
+	case statistics.QueryDim:
+		return bs.sche.conf.GetMinHotQueryRate()
+	case statistics.CPUDim:
+		return bs.sche.conf.GetMinHotCPURate()
+	default:
+		return bs.sche.conf.GetMinHotByteRate()
 	}
 }
 
@@ -580,7 +635,7 @@ func (bs *balanceSolver) allowBalance() bool {
 	}
 }
 
-// filterSrcContainers compare the min rate and the ratio * expectation rate, if both key and byte rate is greater than
+// filterSrcContainers compare the min rate and the ratio * expectation rate, if both rates of the priorities are greater than
 // its expectation * ratio, the container would be selected as hot source container
 func (bs *balanceSolver) filterSrcContainers() map[uint64]*containerLoadDetail {
 	ret := make(map[uint64]*containerLoadDetail)
@@ -593,8 +648,8 @@ func (bs *balanceSolver) filterSrcContainers() map[uint64]*containerLoadDetail {
 		if len(detail.HotPeers) == 0 {
 			continue
 		}
-		if detail.LoadPred.min().ByteRate > bs.sche.conf.GetSrcToleranceRatio()*detail.LoadPred.Expect.ByteRate &&
-			detail.LoadPred.min().KeyRate > bs.sche.conf.GetSrcToleranceRatio()*detail.LoadPred.Expect.KeyRate {
+		if detail.LoadPred.min().rate(bs.firstPriority) > bs.sche.conf.GetSrcToleranceRatio()*detail.LoadPred.Expect.rate(bs.firstPriority) &&
+			detail.LoadPred.min().rate(bs.secondPriority) > bs.sche.conf.GetSrcToleranceRatio()*detail.LoadPred.Expect.rate(bs.secondPriority) {
 			ret[id] = detail
 			hotSchedulerResultCounter.WithLabelValues("src-container-succ", strconv.FormatUint(id, 10)).Inc()
 		}
@@ -627,30 +682,30 @@ func (bs *balanceSolver) filterHotPeers() []*statistics.HotPeerStat {
 		return nret
 	}
 
-	byteSort := make([]*statistics.HotPeerStat, len(ret))
-	copy(byteSort, ret)
-	sort.Slice(byteSort, func(i, j int) bool {
-		return byteSort[i].GetByteRate() > byteSort[j].GetByteRate()
+	firstSort := make([]*statistics.HotPeerStat, len(ret))
+	copy(firstSort, ret)
+	sort.Slice(firstSort, func(i, j int) bool {
+		return firstSort[i].GetLoad(bs.firstPriority) > firstSort[j].GetLoad(bs.firstPriority)
 	})
-	keySort := make([]*statistics.HotPeerStat, len(ret))
-	copy(keySort, ret)
-	sort.Slice(keySort, func(i, j int) bool {
-		return keySort[i].GetKeyRate() > keySort[j].GetKeyRate()
+	secondSort := make([]*statistics.HotPeerStat, len(ret))
+	copy(secondSort, ret)
+	sort.Slice(secondSort, func(i, j int) bool {
+		return secondSort[i].GetLoad(bs.secondPriority) > secondSort[j].GetLoad(bs.secondPriority)
 	})
 
 	union := make(map[*statistics.HotPeerStat]struct{}, maxPeerNum)
 	for len(union) < maxPeerNum {
-		for len(byteSort) > 0 {
-			peer := byteSort[0]
-			byteSort = byteSort[1:]
+		for len(firstSort) > 0 {
+			peer := firstSort[0]
+			firstSort = firstSort[1:]
 			if _, ok := union[peer]; !ok {
 				union[peer] = struct{}{}
 				break
 			}
 		}
-		for len(keySort) > 0 {
-			peer := keySort[0]
-			keySort = keySort[1:]
+		for len(secondSort) > 0 {
+			peer := secondSort[0]
+			secondSort = secondSort[1:]
 			if _, ok := union[peer]; !ok {
 				union[peer] = struct{}{}
 				break
@@ -775,8 +830,8 @@ func (bs *balanceSolver) pickDstContainers(filters []filter.Filter, candidates [
 	for _, container := range candidates {
 		if filter.Target(bs.cluster.GetOpts(), container, filters) {
 			detail := bs.stLoadDetail[container.Meta.ID()]
-			if detail.LoadPred.max().ByteRate*dstToleranceRatio < detail.LoadPred.Expect.ByteRate &&
-				detail.LoadPred.max().KeyRate*dstToleranceRatio < detail.LoadPred.Expect.KeyRate {
+			if detail.LoadPred.max().rate(bs.firstPriority)*dstToleranceRatio < detail.LoadPred.Expect.rate(bs.firstPriority) &&
+				detail.LoadPred.max().rate(bs.secondPriority)*dstToleranceRatio < detail.LoadPred.Expect.rate(bs.secondPriority) {
 				ret[container.Meta.ID()] = bs.stLoadDetail[container.Meta.ID()]
 				hotSchedulerResultCounter.WithLabelValues("dst-container-succ", strconv.FormatUint(container.Meta.ID(), 10)).Inc()
 			}
@@ -793,10 +848,11 @@ func (bs *balanceSolver) calcProgressiveRank() {
 	dstLd := bs.stLoadDetail[bs.cur.dstContainerID].LoadPred.max()
 	peer := bs.cur.srcPeerStat
 	rank := int64(0)
+	first, second := bs.firstPriority, bs.secondPriority
 	if bs.rwTy == write && bs.opTy == transferLeader {
 		// In this condition, CPU usage is the matter.
-		// Only consider about key rate.
-		if srcLd.KeyRate-peer.GetKeyRate() >= dstLd.KeyRate+peer.GetKeyRate() {
+		// Only consider about the first priority, which is key rate by default.
+		if srcLd.rate(first)-peer.GetLoad(first) >= dstLd.rate(first)+peer.GetLoad(first) {
 			rank = -1
 		}
 	} else {
@@ -806,22 +862,22 @@ func (bs *balanceSolver) calcProgressiveRank() {
 			}
 			return a - b
 		}
-		// we use DecRatio(Decline Ratio) to expect that the dst container's (key/byte) rate should still be less
-		// than the src container's (key/byte) rate after scheduling one peer.
-		keyDecRatio := (dstLd.KeyRate + peer.GetKeyRate()) / getSrcDecRate(srcLd.KeyRate, peer.GetKeyRate())
-		keyHot := peer.GetKeyRate() >= bs.sche.conf.GetMinHotKeyRate()
-		byteDecRatio := (dstLd.ByteRate + peer.GetByteRate()) / getSrcDecRate(srcLd.ByteRate, peer.GetByteRate())
-		byteHot := peer.GetByteRate() > bs.sche.conf.GetMinHotByteRate()
+		// we use DecRatio(Decline Ratio) to expect that the dst container's rate of the priorities should still be less
+		// than the src container's rate after scheduling one peer.
+		secondDecRatio := (dstLd.rate(second) + peer.GetLoad(second)) / getSrcDecRate(srcLd.rate(second), peer.GetLoad(second))
+		secondHot := peer.GetLoad(second) >= bs.minHotRate(second)
+		firstDecRatio := (dstLd.rate(first) + peer.GetLoad(first)) / getSrcDecRate(srcLd.rate(first), peer.GetLoad(first))
+		firstHot := peer.GetLoad(first) > bs.minHotRate(first)
 		greatDecRatio, minorDecRatio := bs.sche.conf.GetGreatDecRatio(), bs.sche.conf.GetMinorGreatDecRatio()
 		switch {
-		case byteHot && byteDecRatio <= greatDecRatio && keyHot && keyDecRatio <= greatDecRatio:
-			// If belong to the case, both byte rate and key rate will be more balanced, the best choice.
+		case firstHot && firstDecRatio <= greatDecRatio && secondHot && secondDecRatio <= greatDecRatio:
+			// If belong to the case, both rates of the priorities will be more balanced, the best choice.
 			rank = -3
-		case byteDecRatio <= minorDecRatio && keyHot && keyDecRatio <= greatDecRatio:
-			// If belong to the case, byte rate will be not worsened, key rate will be more balanced.
+		case firstDecRatio <= minorDecRatio && secondHot && secondDecRatio <= greatDecRatio:
+			// If belong to the case, the first priority will be not worsened, the second priority will be more balanced.
 			rank = -2
-		case byteHot && byteDecRatio <= greatDecRatio:
-			// If belong to the case, byte rate will be more balanced, ignore the key rate.
+		case firstHot && firstDecRatio <= greatDecRatio:
+			// If belong to the case, the first priority will be more balanced, ignore the second priority.
 			rank = -1
 		}
 	}
@@ -856,35 +912,36 @@ func (bs *balanceSolver) betterThan(old *solution) bool {
 	if bs.cur.srcPeerStat != old.srcPeerStat {
 		// compare resource
 
+		first, second := bs.firstPriority, bs.secondPriority
 		if bs.rwTy == write && bs.opTy == transferLeader {
 			switch {
-			case bs.cur.srcPeerStat.GetKeyRate() > old.srcPeerStat.GetKeyRate():
+			case bs.cur.srcPeerStat.GetLoad(first) > old.srcPeerStat.GetLoad(first):
 				return true
-			case bs.cur.srcPeerStat.GetKeyRate() < old.srcPeerStat.GetKeyRate():
+			case bs.cur.srcPeerStat.GetLoad(first) < old.srcPeerStat.GetLoad(first):
 				return false
 			}
 		} else {
-			byteRkCmp := rankCmp(bs.cur.srcPeerStat.GetByteRate(), old.srcPeerStat.GetByteRate(), stepRank(0, 100))
-			keyRkCmp := rankCmp(bs.cur.srcPeerStat.GetKeyRate(), old.srcPeerStat.GetKeyRate(), stepRank(0, 10))
+			firstRkCmp := rankCmp(bs.cur.srcPeerStat.GetLoad(first), old.srcPeerStat.GetLoad(first), stepRank(0, peerRankStep(first)))
+			secondRkCmp := rankCmp(bs.cur.srcPeerStat.GetLoad(second), old.srcPeerStat.GetLoad(second), stepRank(0, peerRankStep(second)))
 
 			switch bs.cur.progressiveRank {
-			case -2: // greatDecRatio < byteDecRatio <= minorDecRatio && keyDecRatio <= greatDecRatio
-				if keyRkCmp != 0 {
-					return keyRkCmp > 0
+			case -2: // greatDecRatio < firstDecRatio <= minorDecRatio && secondDecRatio <= greatDecRatio
+				if secondRkCmp != 0 {
+					return secondRkCmp > 0
 				}
-				if byteRkCmp != 0 {
-					// prefer smaller byte rate, to reduce oscillation
-					return byteRkCmp < 0
+				if firstRkCmp != 0 {
+					// prefer smaller rate of the first priority, to reduce oscillation
+					return firstRkCmp < 0
 				}
-			case -3: // byteDecRatio <= greatDecRatio && keyDecRatio <= greatDecRatio
-				if keyRkCmp != 0 {
-					return keyRkCmp > 0
+			case -3: // firstDecRatio <= greatDecRatio && secondDecRatio <= greatDecRatio
+				if secondRkCmp != 0 {
+					return secondRkCmp > 0
 				}
 				fallthrough
-			case -1: // byteDecRatio <= greatDecRatio
-				if byteRkCmp != 0 {
-					// prefer resource with larger byte rate, to converge faster
-					return byteRkCmp > 0
+			case -1: // firstDecRatio <= greatDecRatio
+				if firstRkCmp != 0 {
+					// prefer resource with larger rate of the first priority, to converge faster
+					return firstRkCmp > 0
 				}
 			}
 		}
@@ -893,31 +950,42 @@ func (bs *balanceSolver) betterThan(old *solution) bool {
 	return false
 }
 
+// peerRankStep returns the step of the rate of the dimension when comparing two hot peers
+func peerRankStep(dim int) float64 {
+	switch dim {
+	case statistics.KeyDim, statistics.QueryDim:
+		return 10
+	default:
+		return 100
+	}
+}
+
 // smaller is better
 func (bs *balanceSolver) compareSrcContainer(st1, st2 uint64) int {
 	if st1 != st2 {
 		// compare source container
 		var lpCmp containerLPCmp
+		first, second := bs.firstPriority, bs.secondPriority
 		if bs.rwTy == write && bs.opTy == transferLeader {
 			lpCmp = sliceLPCmp(
 				minLPCmp(negLoadCmp(sliceLoadCmp(
-					stLdRankCmp(stLdKeyRate, stepRank(bs.maxSrc.KeyRate, bs.rankStep.KeyRate)),
-					stLdRankCmp(stLdByteRate, stepRank(bs.maxSrc.ByteRate, bs.rankStep.ByteRate)),
+					stLdRankCmp(stLdRate(first), stepRank(bs.maxSrc.rate(first), bs.rankStep.rate(first))),
+					stLdRankCmp(stLdRate(second), stepRank(bs.maxSrc.rate(second), bs.rankStep.rate(second))),
 				))),
 				diffCmp(sliceLoadCmp(
 					stLdRankCmp(stLdCount, stepRank(0, bs.rankStep.Count)),
-					stLdRankCmp(stLdKeyRate, stepRank(0, bs.rankStep.KeyRate)),
-					stLdRankCmp(stLdByteRate, stepRank(0, bs.rankStep.ByteRate)),
+					stLdRankCmp(stLdRate(first), stepRank(0, bs.rankStep.rate(first))),
+					stLdRankCmp(stLdRate(second), stepRank(0, bs.rankStep.rate(second))),
 				)),
 			)
 		} else {
 			lpCmp = sliceLPCmp(
 				minLPCmp(negLoadCmp(sliceLoadCmp(
-					stLdRankCmp(stLdByteRate, stepRank(bs.maxSrc.ByteRate, bs.rankStep.ByteRate)),
-					stLdRankCmp(stLdKeyRate, stepRank(bs.maxSrc.KeyRate, bs.rankStep.KeyRate)),
+					stLdRankCmp(stLdRate(first), stepRank(bs.maxSrc.rate(first), bs.rankStep.rate(first))),
+					stLdRankCmp(stLdRate(second), stepRank(bs.maxSrc.rate(second), bs.rankStep.rate(second))),
 				))),
 				diffCmp(
-					stLdRankCmp(stLdByteRate, stepRank(0, bs.rankStep.ByteRate)),
+					stLdRankCmp(stLdRate(first), stepRank(0, bs.rankStep.rate(first))),
 				),
 			)
 		}
@@ -934,25 +1002,26 @@ func (bs *balanceSolver) compareDstContainer(st1, st2 uint64) int {
 	if st1 != st2 {
 		// compare destination container
 		var lpCmp containerLPCmp
+		first, second := bs.firstPriority, bs.secondPriority
 		if bs.rwTy == write && bs.opTy == transferLeader {
 			lpCmp = sliceLPCmp(
 				maxLPCmp(sliceLoadCmp(
-					stLdRankCmp(stLdKeyRate, stepRank(bs.minDst.KeyRate, bs.rankStep.KeyRate)),
-					stLdRankCmp(stLdByteRate, stepRank(bs.minDst.ByteRate, bs.rankStep.ByteRate)),
+					stLdRankCmp(stLdRate(first), stepRank(bs.minDst.rate(first), bs.rankStep.rate(first))),
+					stLdRankCmp(stLdRate(second), stepRank(bs.minDst.rate(second), bs.rankStep.rate(second))),
 				)),
 				diffCmp(sliceLoadCmp(
 					stLdRankCmp(stLdCount, stepRank(0, bs.rankStep.Count)),
-					stLdRankCmp(stLdKeyRate, stepRank(0, bs.rankStep.KeyRate)),
-					stLdRankCmp(stLdByteRate, stepRank(0, bs.rankStep.ByteRate)),
+					stLdRankCmp(stLdRate(first), stepRank(0, bs.rankStep.rate(first))),
+					stLdRankCmp(stLdRate(second), stepRank(0, bs.rankStep.rate(second))),
 				)))
 		} else {
 			lpCmp = sliceLPCmp(
 				maxLPCmp(sliceLoadCmp(
-					stLdRankCmp(stLdByteRate, stepRank(bs.minDst.ByteRate, bs.rankStep.ByteRate)),
-					stLdRankCmp(stLdKeyRate, stepRank(bs.minDst.KeyRate, bs.rankStep.KeyRate)),
+					stLdRankCmp(stLdRate(first), stepRank(bs.minDst.rate(first), bs.rankStep.rate(first))),
+					stLdRankCmp(stLdRate(second), stepRank(bs.minDst.rate(second), bs.rankStep.rate(second))),
 				)),
 				diffCmp(
-					stLdRankCmp(stLdByteRate, stepRank(0, bs.rankStep.ByteRate)),
+					stLdRankCmp(stLdRate(first), stepRank(0, bs.rankStep.rate(first))),
 				),
 			)
 		}
@@ -1040,9 +1109,11 @@ func (bs *balanceSolver) buildOperators() ([]*operator.Operator, []Influence) {
 		schedulerCounter.WithLabelValues(bs.sche.GetName(), bs.opTy.String()))
 
 	infl := Influence{
-		ByteRate: bs.cur.srcPeerStat.GetByteRate(),
-		KeyRate:  bs.cur.srcPeerStat.GetKeyRate(),
-		Count:    1,
+		ByteRate:  bs.cur.srcPeerStat.GetByteRate(),
+		KeyRate:   bs.cur.srcPeerStat.GetKeyRate(),
+		QueryRate: bs.cur.srcPeerStat.GetQueryRate(),
+		CPURate:   bs.cur.srcPeerStat.GetCPURate(),
+		Count:     1,
 	}
 
 	return []*operator.Operator{op}, []Influence{infl}
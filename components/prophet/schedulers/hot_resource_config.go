@@ -23,20 +23,48 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
 )
 
+// the dimensions used by the priorities of the hot resource scheduler
+const (
+	BytePriority  = "byte"
+	KeyPriority   = "key"
+	QueryPriority = "query"
+	CPUPriority   = "cpu"
+)
+
+var (
+	defaultReadPriorities        = []string{BytePriority, KeyPriority}
+	defaultWriteLeaderPriorities = []string{KeyPriority, BytePriority}
+	defaultWritePeerPriorities   = []string{BytePriority, KeyPriority}
+
+	priorityDims = map[string]int{
+		BytePriority:  statistics.ByteDim,
+		KeyPriority:   statistics.KeyDim,
+		QueryPriority: statistics.QueryDim,
+		CPUPriority:   statistics.CPUDim,
+	}
+)
+
 // params about hot resource.
 func initHotResourceScheduleConfig() *hotResourceSchedulerConfig {
 	return &hotResourceSchedulerConfig{
-		MinHotByteRate:        100,
-		MinHotKeyRate:         10,
-		MaxZombieRounds:       3,
-		ByteRateRankStepRatio: 0.05,
-		KeyRateRankStepRatio:  0.05,
-		CountRankStepRatio:    0.01,
-		GreatDecRatio:         0.95,
-		MinorDecRatio:         0.99,
-		MaxPeerNum:            1000,
-		SrcToleranceRatio:     1.05, // Tolerate 5% difference
-		DstToleranceRatio:     1.05, // Tolerate 5% difference
+		MinHotByteRate:         100,
+		MinHotKeyRate:          10,
+		MinHotQueryRate:        10,
+		MinHotCPURate:          1000,
+		MaxZombieRounds:        3,
+		ByteRateRankStepRatio:  0.05,
+		KeyRateRankStepRatio:   0.05,
+		QueryRateRankStepRatio: 0.05,
+		CPURateRankStepRatio:   0.05,
+		CountRankStepRatio:     0.01,
+		GreatDecRatio:          0.95,
+		MinorDecRatio:          0.99,
+		MaxPeerNum:             1000,
+		SrcToleranceRatio:      1.05, // Tolerate 5% difference
+		DstToleranceRatio:      1.05, // Tolerate 5% difference
+		ReadPriorities:         append([]string(nil), defaultReadPriorities...),
+		WriteLeaderPriorities:  append([]string(nil), defaultWriteLeaderPriorities...),
+		WritePeerPriorities:    append([]string(nil), defaultWritePeerPriorities...),
 	}
 }
 
@@ -46,18 +74,30 @@ type hotResourceSchedulerConfig struct {
 
 	MinHotByteRate  float64 `json:"min-hot-byte-rate"`
 	MinHotKeyRate   float64 `json:"min-hot-key-rate"`
+	MinHotQueryRate float64 `json:"min-hot-query-rate"`
+	// MinHotCPURate the min cpu time in microseconds per second of the hot peer
+	MinHotCPURate   float64 `json:"min-hot-cpu-rate"`
 	MaxZombieRounds int     `json:"max-zombie-rounds"`
 	MaxPeerNum      int     `json:"max-peer-number"`
 
 	// rank step ratio decide the step when calculate rank
 	// step = max current * rank step ratio
-	ByteRateRankStepRatio float64 `json:"byte-rate-rank-step-ratio"`
-	KeyRateRankStepRatio  float64 `json:"key-rate-rank-step-ratio"`
-	CountRankStepRatio    float64 `json:"count-rank-step-ratio"`
-	GreatDecRatio         float64 `json:"great-dec-ratio"`
-	MinorDecRatio         float64 `json:"minor-dec-ratio"`
-	SrcToleranceRatio     float64 `json:"src-tolerance-ratio"`
-	DstToleranceRatio     float64 `json:"dst-tolerance-ratio"`
+	ByteRateRankStepRatio  float64 `json:"byte-rate-rank-step-ratio"`
+	KeyRateRankStepRatio   float64 `json:"key-rate-rank-step-ratio"`
+	QueryRateRankStepRatio float64 `json:"query-rate-rank-step-ratio"`
+	CPURateRankStepRatio   float64 `json:"cpu-rate-rank-step-ratio"`
+	CountRankStepRatio     float64 `json:"count-rank-step-ratio"`
+	GreatDecRatio          float64 `json:"great-dec-ratio"`
+	MinorDecRatio          float64 `json:"minor-dec-ratio"`
+	SrcToleranceRatio      float64 `json:"src-tolerance-ratio"`
+	DstToleranceRatio      float64 `json:"dst-tolerance-ratio"`
+
+	// priorities decide the dimensions which the scheduler balances, the first one is
+	// balanced at first and the second one should not become worse. The dimension is
+	// one of byte, key, query and cpu.
+	ReadPriorities        []string `json:"read-priorities"`
+	WriteLeaderPriorities []string `json:"write-leader-priorities"`
+	WritePeerPriorities   []string `json:"write-peer-priorities"`
 }
 
 func (conf *hotResourceSchedulerConfig) EncodeConfig() ([]byte, error) {
@@ -143,3 +183,71 @@ func (conf *hotResourceSchedulerConfig) GetMinHotByteRate() float64 {
 	defer conf.RUnlock()
 	return conf.MinHotByteRate
 }
+
+func (conf *hotResourceSchedulerConfig) GetMinHotQueryRate() float64 {
+	conf.RLock()
+	defer conf.RUnlock()
+	return conf.MinHotQueryRate
+}
+
+func (conf *hotResourceSchedulerConfig) GetMinHotCPURate() float64 {
+	conf.RLock()
+	defer conf.RUnlock()
+	return conf.MinHotCPURate
+}
+
+func (conf *hotResourceSchedulerConfig) GetQueryRankStepRatio() float64 {
+	conf.RLock()
+	defer conf.RUnlock()
+	return conf.QueryRateRankStepRatio
+}
+
+func (conf *hotResourceSchedulerConfig) GetCPURankStepRatio() float64 {
+	conf.RLock()
+	defer conf.RUnlock()
+	return conf.CPURateRankStepRatio
+}
+
+func (conf *hotResourceSchedulerConfig) SetReadPriorities(priorities ...string) {
+	conf.Lock()
+	defer conf.Unlock()
+	conf.ReadPriorities = priorities
+}
+
+func (conf *hotResourceSchedulerConfig) SetWriteLeaderPriorities(priorities ...string) {
+	conf.Lock()
+	defer conf.Unlock()
+	conf.WriteLeaderPriorities = priorities
+}
+
+func (conf *hotResourceSchedulerConfig) SetWritePeerPriorities(priorities ...string) {
+	conf.Lock()
+	defer conf.Unlock()
+	conf.WritePeerPriorities = priorities
+}
+
+// GetPriorities returns the first and the second statistics dimensions of the resource
+// type, the default priorities are used if the configured priorities are invalid.
+func (conf *hotResourceSchedulerConfig) GetPriorities(rcTy resourceType) (int, int) {
+	conf.RLock()
+	defer conf.RUnlock()
+	switch rcTy {
+	case readLeader:
+		return adjustPriorities(conf.ReadPriorities, defaultReadPriorities)
+	case writeLeader:
+		return adjustPriorities(conf.WriteLeaderPriorities, defaultWriteLeaderPriorities)
+	default:
+		return adjustPriorities(conf.WritePeerPriorities, defaultWritePeerPriorities)
+	}
+}
+
+func adjustPriorities(priorities, defaults []string) (int, int) {
+	if len(priorities) >= 2 && priorities[0] != priorities[1] {
+		first, ok1 := priorityDims[priorities[0]]
+		second, ok2 := priorityDims[priorities[1]]
+		if ok1 && ok2 {
+			return first, second
+		}
+	}
+	return priorityDims[defaults[0]], priorityDims[defaults[1]]
+}
@@ -1168,3 +1168,77 @@ func TestCheckResourceFlowWithDifferentThreshold(t *testing.T) {
 		}
 	}
 }
+
+func TestHotReadWithQueryPriorities(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	statistics.Denoising = false
+	opt := config.NewTestOptions()
+	hb, err := schedule.CreateScheduler(HotReadResourceType, schedule.NewOperatorController(ctx, nil, nil), storage.NewTestStorage(), nil)
+	assert.NoError(t, err)
+	hb.(*hotScheduler).conf.SetDstToleranceRatio(1)
+	hb.(*hotScheduler).conf.SetSrcToleranceRatio(1)
+
+	tc := mockcluster.NewCluster(opt)
+	tc.SetHotResourceCacheHitsThreshold(0)
+	tc.DisableJointConsensus()
+	tc.AddResourceContainer(1, 20)
+	tc.AddResourceContainer(2, 20)
+	tc.AddResourceContainer(3, 20)
+	for id := uint64(1); id <= 3; id++ {
+		tc.UpdateStorageReadStats(id, 1*MB*statistics.ContainerHeartBeatReportInterval, 1*MB*statistics.ContainerHeartBeatReportInterval)
+	}
+
+	// the requests of the resources are small in bytes but cost much cpu time
+	for _, r := range []struct {
+		id     uint64
+		peers  []uint64
+		query  float64
+		cpuUse float64
+	}{
+		{1, []uint64{1, 2, 3}, 1000, 500000},
+		{2, []uint64{1, 2, 3}, 1000, 500000},
+		{3, []uint64{1, 2, 3}, 1000, 500000},
+		{4, []uint64{2, 1, 3}, 100, 50000},
+		{5, []uint64{3, 1, 2}, 100, 50000},
+	} {
+		tc.AddLeaderResourceWithReadQueryInfo(r.id, r.peers[0],
+			uint64(r.query*statistics.ResourceHeartBeatReportInterval),
+			uint64(r.cpuUse*statistics.ResourceHeartBeatReportInterval),
+			statistics.ResourceHeartBeatReportInterval, r.peers[1:])
+	}
+
+	// the byte and key rates of the containers are balanced
+	hb.(*hotScheduler).clearPendingInfluence()
+	assert.Empty(t, hb.Schedule(tc))
+
+	hb.(*hotScheduler).conf.SetReadPriorities(QueryPriority, CPUPriority)
+	for i := 0; i < 100; i++ {
+		hb.(*hotScheduler).clearPendingInfluence()
+		op := hb.Schedule(tc)[0]
+		testutil.CheckTransferLeaderFrom(t, op, operator.OpHotResource, 1)
+	}
+}
+
+func TestHotResourcePriorities(t *testing.T) {
+	conf := initHotResourceScheduleConfig()
+	first, second := conf.GetPriorities(readLeader)
+	assert.Equal(t, statistics.ByteDim, first)
+	assert.Equal(t, statistics.KeyDim, second)
+	first, second = conf.GetPriorities(writeLeader)
+	assert.Equal(t, statistics.KeyDim, first)
+	assert.Equal(t, statistics.ByteDim, second)
+
+	conf.SetWritePeerPriorities(QueryPriority, CPUPriority)
+	first, second = conf.GetPriorities(writePeer)
+	assert.Equal(t, statistics.QueryDim, first)
+	assert.Equal(t, statistics.CPUDim, second)
+
+	// the invalid priorities fallback to the default priorities
+	for _, priorities := range [][]string{{QueryPriority}, {QueryPriority, QueryPriority}, {QueryPriority, "unknown"}} {
+		conf.SetWritePeerPriorities(priorities...)
+		first, second = conf.GetPriorities(writePeer)
+		assert.Equal(t, statistics.ByteDim, first)
+		assert.Equal(t, statistics.KeyDim, second)
+	}
+}
@@ -182,6 +182,55 @@ func TestEvictLeader(t *testing.T) {
 	testutil.CheckTransferLeader(t, op[0], operator.OpLeader, 1, 2)
 }
 
+func TestEvictSlowStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opt := config.NewTestOptions()
+	tc := mockcluster.NewCluster(opt)
+
+	// Add containers 1, 2, 3
+	tc.AddLeaderContainer(1, 0)
+	tc.AddLeaderContainer(2, 0)
+	tc.AddLeaderContainer(3, 0)
+	// Add resources 1, 2, 3 with leaders in containers 1, 2, 3
+	tc.AddLeaderResource(1, 1, 2)
+	tc.AddLeaderResource(2, 2, 1)
+	tc.AddLeaderResource(3, 3, 1)
+
+	s := storage.NewTestStorage()
+	sl, err := schedule.CreateScheduler(EvictSlowStoreType, schedule.NewOperatorController(ctx, tc, nil), s, schedule.ConfigSliceDecoder(EvictSlowStoreType, nil))
+	assert.NoError(t, err)
+	assert.True(t, sl.IsScheduleAllowed(tc))
+	assert.Empty(t, sl.Schedule(tc))
+
+	// not slow enough
+	tc.UpdateContainerSlowScore(1, slowStoreEvictThreshold-1)
+	assert.Empty(t, sl.Schedule(tc))
+
+	// more than one slow containers
+	tc.UpdateContainerSlowScore(1, slowStoreEvictThreshold)
+	tc.UpdateContainerSlowScore(2, slowStoreEvictThreshold)
+	assert.Empty(t, sl.Schedule(tc))
+
+	tc.UpdateContainerSlowScore(2, 1)
+	op := sl.Schedule(tc)
+	testutil.CheckTransferLeader(t, op[0], operator.OpLeader, 1, 2)
+	assert.False(t, tc.GetContainer(1).AllowLeaderTransfer())
+	_, data, err := s.LoadAllScheduleConfig()
+	assert.NoError(t, err)
+	assert.Contains(t, data[0], "evict-containers")
+
+	// keep evicting until recovered
+	tc.UpdateContainerSlowScore(1, slowStoreEvictThreshold/2)
+	op = sl.Schedule(tc)
+	testutil.CheckTransferLeader(t, op[0], operator.OpLeader, 1, 2)
+
+	tc.UpdateContainerSlowScore(1, slowStoreRecoverThreshold)
+	assert.Empty(t, sl.Schedule(tc))
+	assert.True(t, tc.GetContainer(1).AllowLeaderTransfer())
+	assert.Equal(t, uint64(0), sl.(*evictSlowStoreScheduler).conf.evictedContainer())
+}
+
 func TestShuffleresource(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -160,14 +160,18 @@ func getKeyRanges(args []string) ([]core.KeyRange, error) {
 
 // Influence records operator influence.
 type Influence struct {
-	ByteRate float64
-	KeyRate  float64
-	Count    float64
+	ByteRate  float64
+	KeyRate   float64
+	QueryRate float64
+	CPURate   float64
+	Count     float64
 }
 
 func (infl Influence) add(rhs *Influence, w float64) Influence {
 	infl.ByteRate += rhs.ByteRate * w
 	infl.KeyRate += rhs.KeyRate * w
+	infl.QueryRate += rhs.QueryRate * w
+	infl.CPURate += rhs.CPURate * w
 	infl.Count += rhs.Count * w
 	return infl
 }
@@ -204,15 +208,34 @@ func summaryPendingInfluence(pendings map[*pendingInfluence]struct{}, f func(*op
 }
 
 type containerLoad struct {
-	ByteRate float64
-	KeyRate  float64
-	Count    float64
+	ByteRate  float64
+	KeyRate   float64
+	QueryRate float64
+	CPURate   float64
+	Count     float64
+}
+
+// rate returns the rate of the statistics dimension, the byte rate is returned
+// for the unknown dimension.
+func (load *containerLoad) rate(dim int) float64 {
+	switch dim {
+	case statistics.KeyDim:
+		return load.KeyRate
+	case statistics.QueryDim:
+		return load.QueryRate
+	case statistics.CPUDim:
+		return load.CPURate
+	default:
+		return load.ByteRate
+	}
 }
 
 func (load *containerLoad) ToLoadPred(infl Influence) *containerLoadPred {
 	future := *load
 	future.ByteRate += infl.ByteRate
 	future.KeyRate += infl.KeyRate
+	future.QueryRate += infl.QueryRate
+	future.CPURate += infl.CPURate
 	future.Count += infl.Count
 	return &containerLoadPred{
 		Current: *load,
@@ -220,12 +243,10 @@ func (load *containerLoad) ToLoadPred(infl Influence) *containerLoadPred {
 	}
 }
 
-func stLdByteRate(ld *containerLoad) float64 {
-	return ld.ByteRate
-}
-
-func stLdKeyRate(ld *containerLoad) float64 {
-	return ld.KeyRate
+func stLdRate(dim int) func(ld *containerLoad) float64 {
+	return func(ld *containerLoad) float64 {
+		return ld.rate(dim)
+	}
 }
 
 func stLdCount(ld *containerLoad) float64 {
@@ -285,9 +306,11 @@ func (lp *containerLoadPred) max() *containerLoad {
 func (lp *containerLoadPred) diff() *containerLoad {
 	mx, mn := lp.max(), lp.min()
 	return &containerLoad{
-		ByteRate: mx.ByteRate - mn.ByteRate,
-		KeyRate:  mx.KeyRate - mn.KeyRate,
-		Count:    mx.Count - mn.Count,
+		ByteRate:  mx.ByteRate - mn.ByteRate,
+		KeyRate:   mx.KeyRate - mn.KeyRate,
+		QueryRate: mx.QueryRate - mn.QueryRate,
+		CPURate:   mx.CPURate - mn.CPURate,
+		Count:     mx.Count - mn.Count,
 	}
 }
 
@@ -324,17 +347,21 @@ func diffCmp(ldCmp containerLoadCmp) containerLPCmp {
 
 func minLoad(a, b *containerLoad) *containerLoad {
 	return &containerLoad{
-		ByteRate: math.Min(a.ByteRate, b.ByteRate),
-		KeyRate:  math.Min(a.KeyRate, b.KeyRate),
-		Count:    math.Min(a.Count, b.Count),
+		ByteRate:  math.Min(a.ByteRate, b.ByteRate),
+		KeyRate:   math.Min(a.KeyRate, b.KeyRate),
+		QueryRate: math.Min(a.QueryRate, b.QueryRate),
+		CPURate:   math.Min(a.CPURate, b.CPURate),
+		Count:     math.Min(a.Count, b.Count),
 	}
 }
 
 func maxLoad(a, b *containerLoad) *containerLoad {
 	return &containerLoad{
-		ByteRate: math.Max(a.ByteRate, b.ByteRate),
-		KeyRate:  math.Max(a.KeyRate, b.KeyRate),
-		Count:    math.Max(a.Count, b.Count),
+		ByteRate:  math.Max(a.ByteRate, b.ByteRate),
+		KeyRate:   math.Max(a.KeyRate, b.KeyRate),
+		QueryRate: math.Max(a.QueryRate, b.QueryRate),
+		CPURate:   math.Max(a.CPURate, b.CPURate),
+		Count:     math.Max(a.Count, b.Count),
 	}
 }
 
@@ -345,17 +372,21 @@ type containerLoadDetail struct {
 
 func (li *containerLoadDetail) toHotPeersStat() *statistics.HotPeersStat {
 	peers := make([]statistics.HotPeerStat, 0, len(li.HotPeers))
-	var totalBytesRate, totalKeysRate float64
+	var totalBytesRate, totalKeysRate, totalQueryRate, totalCPURate float64
 	for _, peer := range li.HotPeers {
 		if peer.HotDegree > 0 {
 			peers = append(peers, *peer.Clone())
 			totalBytesRate += peer.ByteRate
 			totalKeysRate += peer.KeyRate
+			totalQueryRate += peer.QueryRate
+			totalCPURate += peer.CPURate
 		}
 	}
 	return &statistics.HotPeersStat{
 		TotalBytesRate: math.Round(totalBytesRate),
 		TotalKeysRate:  math.Round(totalKeysRate),
+		TotalQueryRate: math.Round(totalQueryRate),
+		TotalCPURate:   math.Round(totalCPURate),
 		Count:          len(peers),
 		Stats:          peers,
 	}
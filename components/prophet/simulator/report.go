@@ -0,0 +1,334 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/statistics"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+)
+
+// Report the result of a scenario
+type Report struct {
+	Name     string            `json:"name"`
+	Duration typeutil.Duration `json:"duration"`
+	Ticks    int               `json:"ticks"`
+	// LastStoreEventAt the time of the last event changed the stores
+	LastStoreEventAt typeutil.Duration `json:"last-store-event-at"`
+	// LeaderConvergedAt the time since which the leader counts of the stores are balanced
+	// to the end, nil if not converged.
+	LeaderConvergedAt *typeutil.Duration `json:"leader-converged-at,omitempty"`
+	// ResourceConvergedAt the time since which the shard counts of the stores are balanced
+	// to the end, nil if not converged.
+	ResourceConvergedAt *typeutil.Duration `json:"resource-converged-at,omitempty"`
+	Final               Sample             `json:"final"`
+	Samples             []Sample           `json:"samples"`
+	Stores              []StoreReport      `json:"stores"`
+	Operators           []OperatorCount    `json:"operators"`
+	// Failures the expectations of the scenario are not met
+	Failures []string `json:"failures,omitempty"`
+
+	threshold float64
+	expect    Expect
+	counts    map[OperatorCount]int
+}
+
+// Sample the balance and the hot spot metrics of the up stores at a time
+type Sample struct {
+	Time typeutil.Duration `json:"time"`
+	// LeaderCV the coefficient of variation of the leader counts
+	LeaderCV float64 `json:"leader-cv"`
+	// ResourceCV the coefficient of variation of the shard counts
+	ResourceCV float64 `json:"resource-cv"`
+	// WriteImbalance the ratio of the max write bytes of the stores to the average
+	WriteImbalance float64 `json:"write-imbalance"`
+	// ReadImbalance the ratio of the max read bytes of the stores to the average
+	ReadImbalance float64 `json:"read-imbalance"`
+	// HotWriteLeaders the count of the hot write shards found by the prophet
+	HotWriteLeaders int `json:"hot-write-leaders"`
+	// HotReadLeaders the count of the hot read shards found by the prophet
+	HotReadLeaders   int `json:"hot-read-leaders"`
+	RunningOperators int `json:"running-operators"`
+}
+
+// StoreReport the final state of a store
+type StoreReport struct {
+	ID         uint64  `json:"id"`
+	State      string  `json:"state"`
+	Leaders    int     `json:"leaders"`
+	Resources  int     `json:"resources"`
+	Size       int64   `json:"size"`
+	WriteBytes float64 `json:"write-bytes"`
+	ReadBytes  float64 `json:"read-bytes"`
+}
+
+// OperatorCount the count of the finished operators by the creator and the status
+type OperatorCount struct {
+	Desc   string `json:"desc"`
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+func newReport(scenario *Scenario) *Report {
+	return &Report{
+		Name:      scenario.Name,
+		Duration:  scenario.Duration,
+		threshold: scenario.ConvergenceThreshold,
+		expect:    scenario.Expect,
+		counts:    make(map[OperatorCount]int),
+	}
+}
+
+// Passed returns true if all the expectations are met
+func (r *Report) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+// Print prints the report as the text
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "scenario %q, virtual duration %s, %d ticks\n", r.Name, r.Duration, r.Ticks)
+
+	fmt.Fprintln(w, "\nstores:")
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  ID\tSTATE\tLEADERS\tSHARDS\tSIZE(MiB)\tWRITE(B/s)\tREAD(B/s)")
+	for _, s := range r.Stores {
+		fmt.Fprintf(tw, "  %d\t%s\t%d\t%d\t%d\t%.0f\t%.0f\n",
+			s.ID, s.State, s.Leaders, s.Resources, s.Size, s.WriteBytes, s.ReadBytes)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "\nbalance:")
+	fmt.Fprintf(w, "  leader cv %.3f, converged at %s\n", r.Final.LeaderCV, formatConvergedAt(r.LeaderConvergedAt))
+	fmt.Fprintf(w, "  shard cv %.3f, converged at %s\n", r.Final.ResourceCV, formatConvergedAt(r.ResourceConvergedAt))
+	fmt.Fprintf(w, "  last store event at %s\n", r.LastStoreEventAt)
+
+	fmt.Fprintln(w, "\nhot spot:")
+	fmt.Fprintf(w, "  write imbalance %.2f, hot write leaders %d\n", r.Final.WriteImbalance, r.Final.HotWriteLeaders)
+	fmt.Fprintf(w, "  read imbalance %.2f, hot read leaders %d\n", r.Final.ReadImbalance, r.Final.HotReadLeaders)
+
+	fmt.Fprintln(w, "\noperators:")
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  DESC\tSTATUS\tCOUNT")
+	for _, c := range r.Operators {
+		fmt.Fprintf(tw, "  %s\t%s\t%d\n", c.Desc, c.Status, c.Count)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "  running %d\n", r.Final.RunningOperators)
+
+	if r.Passed() {
+		fmt.Fprintln(w, "\nexpectations: passed")
+		return
+	}
+	fmt.Fprintln(w, "\nexpectations: failed")
+	for _, f := range r.Failures {
+		fmt.Fprintf(w, "  %s\n", f)
+	}
+}
+
+func (r *Report) addSample(sample Sample) {
+	r.Samples = append(r.Samples, sample)
+}
+
+func (r *Report) addOperator(desc, status string) {
+	r.counts[OperatorCount{Desc: desc, Status: status}]++
+}
+
+func (r *Report) finish(final Sample, stores []StoreReport) {
+	r.Final = final
+	r.Stores = stores
+	if len(r.Samples) == 0 || r.Samples[len(r.Samples)-1].Time != final.Time {
+		r.Samples = append(r.Samples, final)
+	}
+
+	for c, n := range r.counts {
+		c.Count = n
+		r.Operators = append(r.Operators, c)
+	}
+	sort.Slice(r.Operators, func(i, j int) bool {
+		if r.Operators[i].Desc != r.Operators[j].Desc {
+			return r.Operators[i].Desc < r.Operators[j].Desc
+		}
+		return r.Operators[i].Status < r.Operators[j].Status
+	})
+
+	r.LeaderConvergedAt = r.convergedAt(func(s Sample) float64 { return s.LeaderCV })
+	r.ResourceConvergedAt = r.convergedAt(func(s Sample) float64 { return s.ResourceCV })
+	r.check()
+}
+
+// convergedAt returns the time of the first sample since which the metric stays below
+// the threshold.
+func (r *Report) convergedAt(metric func(Sample) float64) *typeutil.Duration {
+	i := len(r.Samples)
+	for i > 0 && metric(r.Samples[i-1]) <= r.threshold {
+		i--
+	}
+	if i == len(r.Samples) {
+		return nil
+	}
+	at := r.Samples[i].Time
+	return &at
+}
+
+func (r *Report) check() {
+	checkConverged := func(name string, at *typeutil.Duration, within typeutil.Duration) {
+		if within.Duration == 0 {
+			return
+		}
+		if at == nil {
+			r.Failures = append(r.Failures, fmt.Sprintf("%s balance not converged", name))
+			return
+		}
+		if d := at.Duration - r.LastStoreEventAt.Duration; d > within.Duration {
+			r.Failures = append(r.Failures, fmt.Sprintf("%s balance converged in %s, expect within %s",
+				name, d, within))
+		}
+	}
+	checkConverged("leader", r.LeaderConvergedAt, r.expect.LeaderConvergedWithin)
+	checkConverged("shard", r.ResourceConvergedAt, r.expect.ResourceConvergedWithin)
+
+	if v := r.expect.MaxWriteImbalance; v > 0 && r.Final.WriteImbalance > v {
+		r.Failures = append(r.Failures, fmt.Sprintf("write imbalance %.2f, expect at most %.2f",
+			r.Final.WriteImbalance, v))
+	}
+	if v := r.expect.MaxReadImbalance; v > 0 && r.Final.ReadImbalance > v {
+		r.Failures = append(r.Failures, fmt.Sprintf("read imbalance %.2f, expect at most %.2f",
+			r.Final.ReadImbalance, v))
+	}
+	if v := r.expect.MaxCanceledOperators; v > 0 {
+		canceled := 0
+		for _, c := range r.Operators {
+			if c.Status != "Success" {
+				canceled += c.Count
+			}
+		}
+		if canceled > v {
+			r.Failures = append(r.Failures, fmt.Sprintf("%d operators canceled or timeout, expect at most %d",
+				canceled, v))
+		}
+	}
+}
+
+// sample samples the metrics of the stores serving the shards, the down and the
+// removing stores are excluded.
+func (s *Simulator) sample() Sample {
+	rc := s.sim.GetRaftCluster()
+	flows := s.containerFlows()
+	var leaders, resources, writes, reads []float64
+	for _, id := range s.stores {
+		if c := rc.GetContainer(id); !s.isAlive(id) || c == nil || !c.IsUp() {
+			continue
+		}
+		leaders = append(leaders, float64(s.mc.GetContainerLeaderCount(id)))
+		resources = append(resources, float64(s.mc.GetContainerResourceCount(id)))
+		writes = append(writes, flows[id].writeBytes)
+		reads = append(reads, flows[id].readBytes)
+	}
+
+	return Sample{
+		Time:             typeutil.NewDuration(s.now.Sub(epoch)),
+		LeaderCV:         coefficientOfVariation(leaders),
+		ResourceCV:       coefficientOfVariation(resources),
+		WriteImbalance:   imbalance(writes),
+		ReadImbalance:    imbalance(reads),
+		HotWriteLeaders:  countHotLeaders(rc.GetHotWriteResources()),
+		HotReadLeaders:   countHotLeaders(rc.GetHotReadResources()),
+		RunningOperators: len(rc.GetOperatorController().GetOperators()),
+	}
+}
+
+func (s *Simulator) storeReports() []StoreReport {
+	rc := s.sim.GetRaftCluster()
+	flows := s.containerFlows()
+	stores := make([]StoreReport, 0, len(s.stores))
+	for _, id := range s.stores {
+		state := "Up"
+		if c := rc.GetContainer(id); c != nil {
+			state = c.GetState().String()
+		}
+		if !s.isAlive(id) {
+			state = "Down"
+		}
+		stores = append(stores, StoreReport{
+			ID:         id,
+			State:      state,
+			Leaders:    s.mc.GetContainerLeaderCount(id),
+			Resources:  s.mc.GetContainerResourceCount(id),
+			Size:       s.mc.GetContainerResourceSize(id),
+			WriteBytes: flows[id].writeBytes,
+			ReadBytes:  flows[id].readBytes,
+		})
+	}
+	return stores
+}
+
+func countHotLeaders(infos *statistics.ContainerHotPeersInfos) int {
+	if infos == nil {
+		return 0
+	}
+	n := 0
+	for _, stat := range infos.AsLeader {
+		n += stat.Count
+	}
+	return n
+}
+
+// coefficientOfVariation returns the ratio of the standard deviation to the mean
+func coefficientOfVariation(values []float64) float64 {
+	mean := average(values)
+	if mean == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum/float64(len(values))) / mean
+}
+
+// imbalance returns the ratio of the max value to the mean, 1 means balanced
+func imbalance(values []float64) float64 {
+	mean := average(values)
+	if mean == 0 {
+		return 1
+	}
+	var maxValue float64
+	for _, v := range values {
+		maxValue = math.Max(maxValue, v)
+	}
+	return maxValue / mean
+}
+
+func average(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func formatConvergedAt(at *typeutil.Duration) string {
+	if at == nil {
+		return "never"
+	}
+	return at.String()
+}
@@ -0,0 +1,257 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+)
+
+const (
+	defaultTick                      = 10 * time.Second
+	defaultDuration                  = time.Hour
+	defaultSampleInterval            = time.Minute
+	defaultResourceHeartbeatInterval = time.Minute
+	defaultOperatorTimeout           = 10 * time.Minute
+	defaultSnapshotRate              = 32
+	defaultConvergenceThreshold      = 0.1
+	defaultStores                    = 3
+	defaultShards                    = 100
+	defaultShardSize                 = 96
+	defaultCapacity                  = 1024
+	defaultHotFactor                 = 10
+)
+
+// EventType the type of the scenario event
+type EventType string
+
+var (
+	// AddStores adds count new stores
+	AddStores = EventType("add-stores")
+	// StoreDown stops the heartbeats of the store, the peers on it are down
+	StoreDown = EventType("store-down")
+	// StoreUp restarts the down store
+	StoreUp = EventType("store-up")
+	// StoreOffline starts to remove the store from the cluster
+	StoreOffline = EventType("store-offline")
+	// HotRange multiplies the load of a range of the shards
+	HotRange = EventType("hot-range")
+)
+
+// Scenario describes the cluster, the load and the events to simulate, it's decoded from
+// a toml file. The schedule and replication sections are the same as the prophet config,
+// the omitted items use the default values.
+type Scenario struct {
+	Name string `toml:"name" json:"name"`
+	// Tick the step of the virtual time
+	Tick typeutil.Duration `toml:"tick" json:"tick"`
+	// Duration the virtual time to simulate
+	Duration typeutil.Duration `toml:"duration" json:"duration"`
+	// SampleInterval the interval to sample the balance and the hot spot metrics
+	SampleInterval typeutil.Duration `toml:"sample-interval" json:"sample-interval"`
+	// ResourceHeartbeatInterval the interval of the shard heartbeats, the shards also
+	// report once their peers are changed.
+	ResourceHeartbeatInterval typeutil.Duration `toml:"resource-heartbeat-interval" json:"resource-heartbeat-interval"`
+	// OperatorTimeout the operator is canceled if it's not finished in the virtual time
+	OperatorTimeout typeutil.Duration `toml:"operator-timeout" json:"operator-timeout"`
+	// SnapshotRate the MiB per second to send the snapshot to a new peer
+	SnapshotRate uint64 `toml:"snapshot-rate" json:"snapshot-rate"`
+	// ConvergenceThreshold the balance is converged if the coefficient of variation of the
+	// counts of the stores stays below it.
+	ConvergenceThreshold float64 `toml:"convergence-threshold" json:"convergence-threshold"`
+
+	Topology Topology `toml:"topology" json:"topology"`
+	Load     Load     `toml:"load" json:"load"`
+	Events   []Event  `toml:"events" json:"events"`
+	Expect   Expect   `toml:"expect" json:"expect"`
+
+	Schedule    config.ScheduleConfig    `toml:"schedule" json:"schedule"`
+	Replication config.ReplicationConfig `toml:"replication" json:"replication"`
+
+	opts *config.PersistOptions
+}
+
+// Topology the initial stores and shards, the replicas of the shards are placed on the
+// stores round-robin.
+type Topology struct {
+	Stores int `toml:"stores" json:"stores"`
+	Shards int `toml:"shards" json:"shards"`
+	// ShardSize the size of a shard in MiB
+	ShardSize uint64 `toml:"shard-size" json:"shard-size"`
+	// Capacity the capacity of a store in GiB
+	Capacity uint64 `toml:"capacity" json:"capacity"`
+}
+
+// Load the flow per second of every shard
+type Load struct {
+	WriteBytes uint64 `toml:"write-bytes" json:"write-bytes"`
+	WriteKeys  uint64 `toml:"write-keys" json:"write-keys"`
+	ReadBytes  uint64 `toml:"read-bytes" json:"read-bytes"`
+	ReadKeys   uint64 `toml:"read-keys" json:"read-keys"`
+}
+
+// Event happens at the virtual time since the start
+type Event struct {
+	At   typeutil.Duration `toml:"at" json:"at"`
+	Type EventType         `toml:"type" json:"type"`
+	// Count the count of the stores to add
+	Count int `toml:"count" json:"count,omitempty"`
+	// Store the store to down, up or offline
+	Store uint64 `toml:"store" json:"store,omitempty"`
+	// Start the position of the hot range in the shards, in [0, 1)
+	Start float64 `toml:"start" json:"start,omitempty"`
+	// Ratio the ratio of the shards in the hot range, in (0, 1]
+	Ratio float64 `toml:"ratio" json:"ratio,omitempty"`
+	// Factor the multiple of the load of the hot range
+	Factor float64 `toml:"factor" json:"factor,omitempty"`
+}
+
+// Expect the expectations checked after the simulation, the zero values are not checked.
+// The convergence durations are since the last event changed the stores.
+type Expect struct {
+	LeaderConvergedWithin   typeutil.Duration `toml:"leader-converged-within" json:"leader-converged-within"`
+	ResourceConvergedWithin typeutil.Duration `toml:"resource-converged-within" json:"resource-converged-within"`
+	// MaxWriteImbalance the max ratio of the max write bytes of the stores to the average
+	// at the end.
+	MaxWriteImbalance float64 `toml:"max-write-imbalance" json:"max-write-imbalance"`
+	// MaxReadImbalance the max ratio of the max read bytes of the stores to the average
+	// at the end.
+	MaxReadImbalance float64 `toml:"max-read-imbalance" json:"max-read-imbalance"`
+	// MaxCanceledOperators the max count of the canceled and timeout operators
+	MaxCanceledOperators int `toml:"max-canceled-operators" json:"max-canceled-operators"`
+}
+
+// LoadScenario loads the scenario from the toml file
+func LoadScenario(file string) (*Scenario, error) {
+	s := &Scenario{}
+	meta, err := toml.DecodeFile(file, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", file, err)
+	}
+	if err := s.adjust(&meta); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", file, err)
+	}
+	return s, nil
+}
+
+// ParseScenario parses the scenario from the toml content
+func ParseScenario(data string) (*Scenario, error) {
+	s := &Scenario{}
+	meta, err := toml.Decode(data, s)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.adjust(&meta); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+	return s, nil
+}
+
+func (s *Scenario) adjust(meta *toml.MetaData) error {
+	adjustDuration(&s.Tick, defaultTick)
+	adjustDuration(&s.Duration, defaultDuration)
+	adjustDuration(&s.SampleInterval, defaultSampleInterval)
+	adjustDuration(&s.ResourceHeartbeatInterval, defaultResourceHeartbeatInterval)
+	adjustDuration(&s.OperatorTimeout, defaultOperatorTimeout)
+	if s.SnapshotRate == 0 {
+		s.SnapshotRate = defaultSnapshotRate
+	}
+	if s.ConvergenceThreshold == 0 {
+		s.ConvergenceThreshold = defaultConvergenceThreshold
+	}
+	if s.Topology.Stores == 0 {
+		s.Topology.Stores = defaultStores
+	}
+	if s.Topology.Shards == 0 {
+		s.Topology.Shards = defaultShards
+	}
+	if s.Topology.ShardSize == 0 {
+		s.Topology.ShardSize = defaultShardSize
+	}
+	if s.Topology.Capacity == 0 {
+		s.Topology.Capacity = defaultCapacity
+	}
+
+	// reuse the prophet config to adjust the schedule and replication config, the
+	// undefined items in the scenario are reported as well.
+	cfg := config.NewConfig()
+	cfg.Name = "simulator"
+	cfg.Schedule = s.Schedule
+	cfg.Replication = s.Replication
+	if err := cfg.Adjust(meta, false); err != nil {
+		return err
+	}
+	s.Schedule = cfg.Schedule
+	s.Replication = cfg.Replication
+	s.opts = config.NewPersistOptions(cfg)
+
+	if s.Tick.Duration > s.Duration.Duration {
+		return fmt.Errorf("tick %s is greater than the duration %s", s.Tick, s.Duration)
+	}
+	if s.Topology.Stores < int(s.Replication.MaxReplicas) {
+		return fmt.Errorf("%d stores are not enough for %d replicas",
+			s.Topology.Stores, s.Replication.MaxReplicas)
+	}
+	for i := range s.Events {
+		if err := s.Events[i].adjust(); err != nil {
+			return fmt.Errorf("event %d: %w", i, err)
+		}
+	}
+	sort.SliceStable(s.Events, func(i, j int) bool {
+		return s.Events[i].At.Duration < s.Events[j].At.Duration
+	})
+	return nil
+}
+
+func (e *Event) adjust() error {
+	switch e.Type {
+	case AddStores:
+		if e.Count <= 0 {
+			return fmt.Errorf("missing the count of the stores to add")
+		}
+	case StoreDown, StoreUp, StoreOffline:
+		if e.Store == 0 {
+			return fmt.Errorf("missing the store of %s", e.Type)
+		}
+	case HotRange:
+		if e.Start < 0 || e.Start >= 1 {
+			return fmt.Errorf("start %f of the hot range not in [0, 1)", e.Start)
+		}
+		if e.Ratio <= 0 || e.Ratio > 1 {
+			return fmt.Errorf("ratio %f of the hot range not in (0, 1]", e.Ratio)
+		}
+		if e.Factor == 0 {
+			e.Factor = defaultHotFactor
+		}
+	default:
+		return fmt.Errorf("unknown event type %q", e.Type)
+	}
+	return nil
+}
+
+// changeStores returns true if the event changes the stores serving the shards
+func (e Event) changeStores() bool {
+	return e.Type != HotRange
+}
+
+func adjustDuration(v *typeutil.Duration, defValue time.Duration) {
+	if v.Duration == 0 {
+		v.Duration = defValue
+	}
+}
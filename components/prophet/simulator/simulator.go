@@ -0,0 +1,551 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulator simulates the scheduling of prophet offline. The stores and the
+// shards of a scenario are modeled by the mock cluster as the ground truth, and they
+// are reported to a simulated prophet by the heartbeats, the real coordinator checks
+// and schedules them by the virtual time, then the operators are applied to the mock
+// cluster step by step. A scenario of hours takes seconds, so the schedule config and
+// the schedulers can be tuned and checked in the CI.
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/cluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/mock/mockcluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+)
+
+const (
+	mb = 1 << 20
+	gb = 1 << 30
+
+	// containerHeartbeatInterval the interval of the store heartbeats
+	containerHeartbeatInterval = 10 * time.Second
+	// bytesPerKey the average size of the keys to estimate the keys of a shard
+	bytesPerKey = 100
+
+	reasonTimeout = "simulated operator timeout"
+)
+
+// epoch the start of the virtual time
+var epoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Simulator runs a scenario
+type Simulator struct {
+	scenario *Scenario
+	sim      *cluster.Simulation
+	// mc the ground truth of the stores and the shards
+	mc  *mockcluster.Cluster
+	now time.Time
+
+	nextStoreID uint64
+	stores      []uint64
+	// shards the shard ids in the key order
+	shards []uint64
+	events []Event
+	// down the time of the down stores went down
+	down map[uint64]time.Time
+	// hot the load factors of the shards in the hot ranges
+	hot     map[uint64]float64
+	running map[uint64]*runningOperator
+
+	lastContainerHeartbeats map[uint64]time.Time
+	lastResourceHeartbeats  map[uint64]time.Time
+
+	nextSample time.Time
+	report     *Report
+}
+
+// runningOperator the operator being applied to the mock cluster
+type runningOperator struct {
+	op      *operator.Operator
+	startAt time.Time
+	// step the step being executed and the time to finish it
+	step     operator.OpStep
+	finishAt time.Time
+}
+
+// flow the flow of a store per second
+type flow struct {
+	writeBytes, writeKeys float64
+	readBytes, readKeys   float64
+}
+
+// NewSimulator creates a simulator of the scenario
+func NewSimulator(scenario *Scenario) (*Simulator, error) {
+	sim, err := cluster.NewSimulation(scenario.opts, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Simulator{
+		scenario:                scenario,
+		sim:                     sim,
+		mc:                      mockcluster.NewCluster(scenario.opts),
+		now:                     epoch,
+		nextStoreID:             1,
+		events:                  append([]Event(nil), scenario.Events...),
+		down:                    make(map[uint64]time.Time),
+		hot:                     make(map[uint64]float64),
+		running:                 make(map[uint64]*runningOperator),
+		lastContainerHeartbeats: make(map[uint64]time.Time),
+		lastResourceHeartbeats:  make(map[uint64]time.Time),
+		nextSample:              epoch,
+		report:                  newReport(scenario),
+	}, nil
+}
+
+// Run runs the scenario to the end and returns the report
+func (s *Simulator) Run() (*Report, error) {
+	defer s.sim.Stop()
+
+	if err := s.bootstrap(); err != nil {
+		return nil, err
+	}
+	s.sim.Start()
+
+	end := epoch.Add(s.scenario.Duration.Duration)
+	for {
+		if err := s.applyEvents(); err != nil {
+			return nil, err
+		}
+		if err := s.executeOperators(); err != nil {
+			return nil, err
+		}
+		if err := s.heartbeat(); err != nil {
+			return nil, err
+		}
+		s.sim.Tick(s.now)
+		s.collectOperators()
+		if !s.now.Before(s.nextSample) {
+			s.nextSample = s.now.Add(s.scenario.SampleInterval.Duration)
+			s.report.addSample(s.sample())
+		}
+		s.report.Ticks++
+
+		next := s.now.Add(s.scenario.Tick.Duration)
+		if next.After(end) {
+			break
+		}
+		s.now = next
+	}
+
+	s.report.finish(s.sample(), s.storeReports())
+	return s.report, nil
+}
+
+func (s *Simulator) bootstrap() error {
+	for i := 0; i < s.scenario.Topology.Stores; i++ {
+		if err := s.addStore(); err != nil {
+			return err
+		}
+	}
+
+	rc := s.sim.GetRaftCluster()
+	n := s.scenario.Topology.Shards
+	replicas := int(s.scenario.Replication.MaxReplicas)
+	size := int64(s.scenario.Topology.ShardSize)
+	for i := 0; i < n; i++ {
+		id, err := rc.AllocID()
+		if err != nil {
+			return err
+		}
+		peers := make([]metapb.Peer, 0, replicas)
+		for j := 0; j < replicas; j++ {
+			peerID, err := rc.AllocID()
+			if err != nil {
+				return err
+			}
+			peers = append(peers, metapb.Peer{
+				ID:          peerID,
+				ContainerID: s.stores[(i+j)%len(s.stores)],
+			})
+		}
+
+		meta := &metadata.TestResource{
+			ResID:    id,
+			Start:    shardKey(i, n),
+			End:      shardKey(i+1, n),
+			ResEpoch: metapb.ResourceEpoch{ConfVer: 1, Version: 1},
+			ResPeers: peers,
+		}
+		s.mc.PutResource(core.NewCachedResource(meta, &peers[0],
+			core.SetApproximateSize(size),
+			core.SetApproximateKeys(size*mb/bytesPerKey)))
+		s.shards = append(s.shards, id)
+	}
+	return nil
+}
+
+func (s *Simulator) addStore() error {
+	id := s.nextStoreID
+	s.nextStoreID++
+
+	container := &metadata.TestContainer{
+		CID:    id,
+		CAddr:  fmt.Sprintf("store-%d", id),
+		CState: metapb.ContainerState_UP,
+	}
+	if err := s.sim.PutContainer(container.Clone()); err != nil {
+		return err
+	}
+	s.mc.PutContainer(core.NewCachedContainer(container))
+	s.stores = append(s.stores, id)
+	return nil
+}
+
+func (s *Simulator) applyEvents() error {
+	elapsed := s.now.Sub(epoch)
+	for len(s.events) > 0 && s.events[0].At.Duration <= elapsed {
+		e := s.events[0]
+		s.events = s.events[1:]
+		if err := s.applyEvent(e); err != nil {
+			return fmt.Errorf("apply event %s at %s failed: %w", e.Type, e.At, err)
+		}
+		if e.changeStores() {
+			s.report.LastStoreEventAt = e.At
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) applyEvent(e Event) error {
+	switch e.Type {
+	case AddStores:
+		for i := 0; i < e.Count; i++ {
+			if err := s.addStore(); err != nil {
+				return err
+			}
+		}
+	case StoreDown:
+		if s.mc.GetContainer(e.Store) == nil {
+			return fmt.Errorf("store %d not found", e.Store)
+		}
+		if _, ok := s.down[e.Store]; !ok {
+			s.down[e.Store] = s.now
+			s.electLeaders(e.Store)
+		}
+	case StoreUp:
+		if s.mc.GetContainer(e.Store) == nil {
+			return fmt.Errorf("store %d not found", e.Store)
+		}
+		delete(s.down, e.Store)
+	case StoreOffline:
+		return s.sim.GetRaftCluster().RemoveContainer(e.Store, false)
+	case HotRange:
+		n := len(s.shards)
+		from := int(e.Start * float64(n))
+		count := int(e.Ratio * float64(n))
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			s.hot[s.shards[(from+i)%n]] = e.Factor
+		}
+	}
+	return nil
+}
+
+// electLeaders moves the leaders on the down store to the alive voters if the quorum
+// of the shard is alive.
+func (s *Simulator) electLeaders(containerID uint64) {
+	for _, id := range s.shards {
+		res := s.mc.GetResource(id)
+		if res.GetLeader().GetContainerID() != containerID {
+			continue
+		}
+
+		var alive []metapb.Peer
+		voters := res.GetVoters()
+		for _, p := range voters {
+			if s.isAlive(p.ContainerID) {
+				alive = append(alive, p)
+			}
+		}
+		if len(alive) > len(voters)/2 {
+			s.mc.PutResource(res.Clone(core.WithLeader(&alive[0])))
+		}
+	}
+}
+
+// executeOperators applies the steps of the running operators to the mock cluster
+func (s *Simulator) executeOperators() error {
+	oc := s.sim.GetRaftCluster().GetOperatorController()
+	ops := oc.GetOperators()
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].ResourceID() < ops[j].ResourceID()
+	})
+
+	for id, r := range s.running {
+		if r.op.IsEnd() {
+			delete(s.running, id)
+		}
+	}
+	for _, op := range ops {
+		r, ok := s.running[op.ResourceID()]
+		if !ok || r.op != op {
+			r = &runningOperator{op: op, startAt: s.now}
+			s.running[op.ResourceID()] = r
+		}
+		if s.now.Sub(r.startAt) > s.scenario.OperatorTimeout.Duration {
+			oc.RemoveOperator(op, reasonTimeout)
+			continue
+		}
+		if err := s.executeOperator(oc, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeOperator applies the steps which can be finished now, the shard reports after
+// every step to drive the operator.
+func (s *Simulator) executeOperator(oc *schedule.OperatorController, r *runningOperator) error {
+	for i := 0; i < r.op.Len() && !r.op.IsEnd(); i++ {
+		res := s.mc.GetResource(r.op.ResourceID())
+		step := r.op.Check(res)
+		if step == nil {
+			// all the steps are applied, report to finish the operator
+			return s.heartbeatResource(res, true)
+		}
+		if r.step == nil || r.step.String() != step.String() {
+			r.step = step
+			r.finishAt = s.now.Add(s.stepDuration(res, step))
+		}
+		if s.now.Before(r.finishAt) || !s.canExecute(res, step) {
+			return nil
+		}
+
+		res, err := applyStep(res, step)
+		if err != nil {
+			oc.RemoveOperator(r.op, err.Error())
+			return nil
+		}
+		s.mc.PutResource(res)
+		r.step = nil
+		if err := s.heartbeatResource(res, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stepDuration returns the virtual duration to execute the step, the new peer takes the
+// time to receive the snapshot, the others are finished immediately.
+func (s *Simulator) stepDuration(res *core.CachedResource, step operator.OpStep) time.Duration {
+	if _, ok := snapshotTarget(step); ok {
+		seconds := float64(res.GetApproximateSize()) / float64(s.scenario.SnapshotRate)
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return 0
+}
+
+// canExecute returns true if the leader can propose the step, and the target of the
+// snapshot or the leader transfer is alive.
+func (s *Simulator) canExecute(res *core.CachedResource, step operator.OpStep) bool {
+	if !s.isAlive(res.GetLeader().GetContainerID()) {
+		return false
+	}
+	if target, ok := snapshotTarget(step); ok {
+		return s.isAlive(target)
+	}
+	if tl, ok := step.(operator.TransferLeader); ok {
+		return s.isAlive(tl.ToContainer)
+	}
+	return true
+}
+
+func (s *Simulator) isAlive(containerID uint64) bool {
+	_, ok := s.down[containerID]
+	return !ok
+}
+
+// collectOperators counts the finished operators
+func (s *Simulator) collectOperators() {
+	histories := s.sim.GetRaftCluster().GetOperatorController().TakeFinishedHistories()
+	for _, h := range histories {
+		status := h.Status
+		if h.Reason == reasonTimeout {
+			status = operator.OpStatusToString(operator.TIMEOUT)
+		}
+		s.report.addOperator(h.Desc, status)
+	}
+}
+
+// heartbeat reports the stores and the shards whose heartbeat interval is elapsed
+func (s *Simulator) heartbeat() error {
+	rc := s.sim.GetRaftCluster()
+	flows := s.containerFlows()
+	sending, receiving := s.snapshotCounts()
+	for _, id := range s.stores {
+		if container := rc.GetContainer(id); container == nil || container.IsTombstone() {
+			continue
+		}
+
+		last, ok := s.lastContainerHeartbeats[id]
+		if !ok {
+			last = s.now.Add(-containerHeartbeatInterval)
+		}
+		if !s.isAlive(id) {
+			s.sim.SetContainerLastHeartbeat(id, s.now.Sub(last))
+			continue
+		}
+		if s.now.Sub(last) < containerHeartbeatInterval {
+			continue
+		}
+
+		interval := s.now.Sub(last).Seconds()
+		capacity := s.scenario.Topology.Capacity * gb
+		used := uint64(s.mc.GetContainerResourceSize(id)) * mb
+		if used > capacity {
+			used = capacity
+		}
+		f := flows[id]
+		if err := s.sim.HandleContainerHeartbeat(&metapb.ContainerStats{
+			ContainerID:        id,
+			StartTime:          uint64(epoch.Unix()),
+			Interval:           &metapb.TimeInterval{Start: uint64(last.Unix()), End: uint64(s.now.Unix())},
+			Capacity:           capacity,
+			UsedSize:           used,
+			Available:          capacity - used,
+			ResourceCount:      uint64(s.mc.GetContainerResourceCount(id)),
+			SendingSnapCount:   sending[id],
+			ReceivingSnapCount: receiving[id],
+			WrittenBytes:       uint64(f.writeBytes * interval),
+			WrittenKeys:        uint64(f.writeKeys * interval),
+			ReadBytes:          uint64(f.readBytes * interval),
+			ReadKeys:           uint64(f.readKeys * interval),
+		}); err != nil {
+			return err
+		}
+		s.lastContainerHeartbeats[id] = s.now
+	}
+
+	for _, id := range s.shards {
+		if err := s.heartbeatResource(s.mc.GetResource(id), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// heartbeatResource reports the shard by the leader if the heartbeat interval is elapsed
+// or forced.
+func (s *Simulator) heartbeatResource(res *core.CachedResource, force bool) error {
+	if !s.isAlive(res.GetLeader().GetContainerID()) {
+		return nil
+	}
+
+	id := res.Meta.ID()
+	last, ok := s.lastResourceHeartbeats[id]
+	if !ok {
+		last = s.now.Add(-s.scenario.ResourceHeartbeatInterval.Duration)
+	}
+	if !force && s.now.Sub(last) < s.scenario.ResourceHeartbeatInterval.Duration {
+		return nil
+	}
+
+	var downPeers []metapb.PeerStats
+	for _, p := range res.Meta.Peers() {
+		if downAt, ok := s.down[p.ContainerID]; ok {
+			downPeers = append(downPeers, metapb.PeerStats{
+				Peer:        p,
+				DownSeconds: uint64(s.now.Sub(downAt).Seconds()),
+			})
+		}
+	}
+
+	interval := s.now.Sub(last).Seconds()
+	f := s.resourceFlow(id)
+	if err := s.sim.HandleResourceHeartbeat(res.Clone(
+		core.WithDownPeers(downPeers),
+		core.WithInterval(&metapb.TimeInterval{Start: uint64(last.Unix()), End: uint64(s.now.Unix())}),
+		core.SetWrittenBytes(uint64(f.writeBytes*interval)),
+		core.SetWrittenKeys(uint64(f.writeKeys*interval)),
+		core.SetReadBytes(uint64(f.readBytes*interval)),
+		core.SetReadKeys(uint64(f.readKeys*interval)),
+	)); err != nil {
+		return fmt.Errorf("shard %d heartbeat failed: %w", id, err)
+	}
+	s.lastResourceHeartbeats[id] = s.now
+	return nil
+}
+
+// resourceFlow returns the flow of the shard per second
+func (s *Simulator) resourceFlow(id uint64) flow {
+	factor := 1.0
+	if v, ok := s.hot[id]; ok {
+		factor = v
+	}
+	load := s.scenario.Load
+	return flow{
+		writeBytes: float64(load.WriteBytes) * factor,
+		writeKeys:  float64(load.WriteKeys) * factor,
+		readBytes:  float64(load.ReadBytes) * factor,
+		readKeys:   float64(load.ReadKeys) * factor,
+	}
+}
+
+// containerFlows returns the flows of the stores, all the peers write and the leader reads.
+func (s *Simulator) containerFlows() map[uint64]flow {
+	flows := make(map[uint64]flow)
+	for _, id := range s.shards {
+		res := s.mc.GetResource(id)
+		rf := s.resourceFlow(id)
+		for _, p := range res.Meta.Peers() {
+			f := flows[p.ContainerID]
+			f.writeBytes += rf.writeBytes
+			f.writeKeys += rf.writeKeys
+			flows[p.ContainerID] = f
+		}
+		leader := res.GetLeader().GetContainerID()
+		f := flows[leader]
+		f.readBytes += rf.readBytes
+		f.readKeys += rf.readKeys
+		flows[leader] = f
+	}
+	return flows
+}
+
+// snapshotCounts returns the snapshots being sent by the leaders and received by the
+// new peers of the running operators.
+func (s *Simulator) snapshotCounts() (sending, receiving map[uint64]uint64) {
+	sending = make(map[uint64]uint64)
+	receiving = make(map[uint64]uint64)
+	for id, r := range s.running {
+		if r.op.IsEnd() || r.step == nil {
+			continue
+		}
+		if target, ok := snapshotTarget(r.step); ok {
+			sending[s.mc.GetResource(id).GetLeader().GetContainerID()]++
+			receiving[target]++
+		}
+	}
+	return sending, receiving
+}
+
+// shardKey returns the start key of the i-th shard of n shards
+func shardKey(i, n int) []byte {
+	if i == 0 || i == n {
+		return nil
+	}
+	return []byte(fmt.Sprintf("%020d", i))
+}
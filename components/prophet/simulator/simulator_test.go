@@ -0,0 +1,204 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScenario(t *testing.T) {
+	s, err := ParseScenario(`
+name = "test"
+[[events]]
+at = "20m"
+type = "hot-range"
+ratio = 0.1
+[[events]]
+at = "10m"
+type = "add-stores"
+count = 2
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultTick, s.Tick.Duration)
+	assert.Equal(t, defaultDuration, s.Duration.Duration)
+	assert.Equal(t, defaultStores, s.Topology.Stores)
+	assert.Equal(t, uint64(3), s.Replication.MaxReplicas)
+	assert.Equal(t, 2, len(s.Events))
+	assert.Equal(t, AddStores, s.Events[0].Type)
+	assert.Equal(t, HotRange, s.Events[1].Type)
+	assert.Equal(t, float64(defaultHotFactor), s.Events[1].Factor)
+
+	for _, data := range []string{
+		`unknown = 1`,
+		`tick = "2h"`,
+		"[topology]\nstores = 2",
+		"[[events]]\ntype = \"add-stores\"",
+		"[[events]]\ntype = \"store-down\"",
+		"[[events]]\ntype = \"hot-range\"\nratio = 2.0",
+		"[[events]]\ntype = \"unknown\"",
+	} {
+		_, err := ParseScenario(data)
+		assert.Error(t, err, data)
+	}
+}
+
+func TestSimulateAddStores(t *testing.T) {
+	s, err := ParseScenario(`
+name = "add-stores"
+duration = "40m"
+[topology]
+stores = 3
+shards = 30
+[load]
+write-bytes = 128
+read-bytes = 1024
+[[events]]
+at = "5m"
+type = "add-stores"
+count = 2
+[expect]
+resource-converged-within = "30m"
+max-canceled-operators = 5
+`)
+	assert.NoError(t, err)
+	sim, err := NewSimulator(s)
+	assert.NoError(t, err)
+	r, err := sim.Run()
+	assert.NoError(t, err)
+	assert.True(t, r.Passed(), "%+v", r.Failures)
+	assert.Equal(t, 241, r.Ticks)
+	assert.Equal(t, 5, len(r.Stores))
+	for _, store := range r.Stores {
+		assert.True(t, store.Resources > 0, "store %d", store.ID)
+	}
+	assert.NotNil(t, r.ResourceConvergedAt)
+	assert.True(t, len(r.Operators) > 0)
+
+	var buf bytes.Buffer
+	r.Print(&buf)
+	assert.Contains(t, buf.String(), "balance-resource")
+	assert.Contains(t, buf.String(), "expectations: passed")
+}
+
+func TestSimulateStoreDown(t *testing.T) {
+	s, err := ParseScenario(`
+name = "store-down"
+duration = "30m"
+[topology]
+stores = 4
+shards = 20
+[[events]]
+at = "5m"
+type = "store-down"
+store = 1
+[schedule]
+max-container-down-time = "10m"
+`)
+	assert.NoError(t, err)
+	sim, err := NewSimulator(s)
+	assert.NoError(t, err)
+	r, err := sim.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, []OperatorCount{{Desc: "replace-rule-down-peer", Status: "Success", Count: 15}}, r.Operators)
+	for _, store := range r.Stores {
+		if store.ID == 1 {
+			assert.Equal(t, "Down", store.State)
+			assert.Equal(t, 0, store.Resources)
+			assert.Equal(t, 0, store.Leaders)
+		} else {
+			assert.Equal(t, 20, store.Resources, "store %d", store.ID)
+		}
+	}
+}
+
+func TestSimulateExpectFailed(t *testing.T) {
+	s, err := ParseScenario(`
+duration = "10m"
+[topology]
+stores = 3
+shards = 10
+[[events]]
+at = "1m"
+type = "add-stores"
+count = 3
+[expect]
+resource-converged-within = "1m"
+`)
+	assert.NoError(t, err)
+	sim, err := NewSimulator(s)
+	assert.NoError(t, err)
+	r, err := sim.Run()
+	assert.NoError(t, err)
+	assert.False(t, r.Passed())
+	assert.Equal(t, 1, len(r.Failures))
+}
+
+func TestApplyStep(t *testing.T) {
+	res := core.NewCachedResource(&metadata.TestResource{
+		ResID:    1,
+		ResPeers: []metapb.Peer{{ID: 1, ContainerID: 1}, {ID: 2, ContainerID: 2}, {ID: 3, ContainerID: 3}},
+		ResEpoch: metapb.ResourceEpoch{ConfVer: 1, Version: 1},
+	}, &metapb.Peer{ID: 1, ContainerID: 1})
+
+	res, err := applyStep(res, operator.AddLearner{ToContainer: 4, PeerID: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Meta.Epoch().ConfVer)
+	assert.Equal(t, 1, len(res.GetLearners()))
+
+	res, err = applyStep(res, operator.ChangePeerV2Enter{
+		PromoteLearners: []operator.PromoteLearner{{ToContainer: 4, PeerID: 4}},
+		DemoteVoters:    []operator.DemoteVoter{{ToContainer: 1, PeerID: 1}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), res.Meta.Epoch().ConfVer)
+
+	res, err = applyStep(res, operator.ChangePeerV2Leave{
+		PromoteLearners: []operator.PromoteLearner{{ToContainer: 4, PeerID: 4}},
+		DemoteVoters:    []operator.DemoteVoter{{ToContainer: 1, PeerID: 1}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), res.Meta.Epoch().ConfVer)
+	assert.NotEqual(t, uint64(1), res.GetLeader().GetContainerID())
+	p, ok := res.GetContainerVoter(4)
+	assert.True(t, ok)
+	assert.Equal(t, metapb.PeerRole_Voter, p.Role)
+
+	_, err = applyStep(res, operator.RemovePeer{FromContainer: res.GetLeader().GetContainerID()})
+	assert.Error(t, err)
+	res, err = applyStep(res, operator.RemovePeer{FromContainer: 1, PeerID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(res.Meta.Peers()))
+
+	res, err = applyStep(res, operator.TransferLeader{FromContainer: res.GetLeader().GetContainerID(), ToContainer: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), res.GetLeader().GetContainerID())
+
+	_, err = applyStep(res, operator.TransferLeader{ToContainer: 1})
+	assert.Error(t, err)
+	_, err = applyStep(res, operator.MergeResource{})
+	assert.Error(t, err)
+}
+
+func TestShardKey(t *testing.T) {
+	assert.Nil(t, shardKey(0, 3))
+	assert.Equal(t, []byte("00000000000000000002"), shardKey(2, 3))
+	assert.Nil(t, shardKey(3, 3))
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+)
+
+// applyStep applies the operator step to the shard as the raft group does, the conf
+// version is increased by every changed peer. The split and the merge are not supported,
+// the shards of the scenario keep their ranges.
+func applyStep(res *core.CachedResource, step operator.OpStep) (*core.CachedResource, error) {
+	switch s := step.(type) {
+	case operator.TransferLeader:
+		p, ok := res.GetContainerVoter(s.ToContainer)
+		if !ok {
+			return nil, fmt.Errorf("no voter on the container %d to transfer leader", s.ToContainer)
+		}
+		return res.Clone(core.WithLeader(&p)), nil
+	case operator.AddPeer:
+		return addPeer(res, metapb.Peer{ID: s.PeerID, ContainerID: s.ToContainer, Witness: s.IsWitness}), nil
+	case operator.AddLightPeer:
+		return addPeer(res, metapb.Peer{ID: s.PeerID, ContainerID: s.ToContainer, Witness: s.IsWitness}), nil
+	case operator.AddLearner:
+		return addPeer(res, metapb.Peer{ID: s.PeerID, ContainerID: s.ToContainer, Witness: s.IsWitness,
+			Role: metapb.PeerRole_Learner}), nil
+	case operator.AddLightLearner:
+		return addPeer(res, metapb.Peer{ID: s.PeerID, ContainerID: s.ToContainer, Witness: s.IsWitness,
+			Role: metapb.PeerRole_Learner}), nil
+	case operator.PromoteLearner:
+		return setRoles(res, map[uint64]metapb.PeerRole{s.PeerID: metapb.PeerRole_Voter}), nil
+	case operator.DemoteFollower:
+		return setRoles(res, map[uint64]metapb.PeerRole{s.PeerID: metapb.PeerRole_Learner}), nil
+	case operator.RemovePeer:
+		if res.GetLeader().GetContainerID() == s.FromContainer {
+			return nil, fmt.Errorf("cannot remove the leader on the container %d", s.FromContainer)
+		}
+		return res.Clone(core.WithRemoveContainerPeer(s.FromContainer), core.WithIncConfVer()), nil
+	case operator.ChangePeerV2Enter:
+		roles := make(map[uint64]metapb.PeerRole)
+		for _, pl := range s.PromoteLearners {
+			roles[pl.PeerID] = metapb.PeerRole_IncomingVoter
+		}
+		for _, dv := range s.DemoteVoters {
+			roles[dv.PeerID] = metapb.PeerRole_DemotingVoter
+		}
+		return setRoles(res, roles), nil
+	case operator.ChangePeerV2Leave:
+		roles := make(map[uint64]metapb.PeerRole)
+		for _, pl := range s.PromoteLearners {
+			roles[pl.PeerID] = metapb.PeerRole_Voter
+		}
+		for _, dv := range s.DemoteVoters {
+			roles[dv.PeerID] = metapb.PeerRole_Learner
+		}
+		res = setRoles(res, roles)
+		// the demoted leader transfers the leadership before leaving the joint state
+		if res.GetLeader().GetRole() == metapb.PeerRole_Learner {
+			voters := res.GetVoters()
+			if len(voters) == 0 {
+				return nil, fmt.Errorf("no voter to transfer leader after leaving the joint state")
+			}
+			res = res.Clone(core.WithLeader(&voters[0]))
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("step %s is not supported by the simulator", step)
+	}
+}
+
+// snapshotTarget returns the container of the new peer which receives the snapshot
+func snapshotTarget(step operator.OpStep) (uint64, bool) {
+	switch s := step.(type) {
+	case operator.AddPeer:
+		return s.ToContainer, true
+	case operator.AddLightPeer:
+		return s.ToContainer, true
+	case operator.AddLearner:
+		return s.ToContainer, true
+	case operator.AddLightLearner:
+		return s.ToContainer, true
+	}
+	return 0, false
+}
+
+func addPeer(res *core.CachedResource, peer metapb.Peer) *core.CachedResource {
+	return res.Clone(core.WithAddPeer(peer), core.WithIncConfVer())
+}
+
+// setRoles changes the roles of the peers, the conf version is increased by the count of
+// the changed peers.
+func setRoles(res *core.CachedResource, roles map[uint64]metapb.PeerRole) *core.CachedResource {
+	peers := append([]metapb.Peer(nil), res.Meta.Peers()...)
+	var leader *metapb.Peer
+	for i := range peers {
+		if role, ok := roles[peers[i].ID]; ok {
+			peers[i].Role = role
+		}
+		if peers[i].ID == res.GetLeader().GetID() {
+			p := peers[i]
+			leader = &p
+		}
+	}
+
+	opts := []core.ResourceCreateOption{core.SetPeers(peers), core.WithLeader(leader)}
+	for range roles {
+		opts = append(opts, core.WithIncConfVer())
+	}
+	return res.Clone(opts...)
+}
@@ -116,6 +116,8 @@ func (s *containerStatistics) Observe(container *core.CachedContainer, stats *Co
 	containerStatusGauge.WithLabelValues(containerAddress, id, "resource_count").Set(resCount)
 	containerStatusGauge.WithLabelValues(containerAddress, id, "leader_size").Set(leaderSize)
 	containerStatusGauge.WithLabelValues(containerAddress, id, "leader_count").Set(leaderCount)
+	containerStatusGauge.WithLabelValues(containerAddress, id, "inconsistent_resource_count").Set(float64(len(container.GetInconsistentResources())))
+	containerStatusGauge.WithLabelValues(containerAddress, id, "slow_score").Set(float64(container.GetSlowScore()))
 
 	// Container flows.
 	containerFlowStats := stats.GetRollingContainerStats(container.Meta.ID())
@@ -207,6 +209,7 @@ func (s *containerStatistics) resetContainerStatistics(containerAddress string,
 		"resource_count",
 		"leader_size",
 		"leader_count",
+		"inconsistent_resource_count",
 		"container_available",
 		"container_used",
 		"container_capacity",
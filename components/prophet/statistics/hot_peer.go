@@ -21,10 +21,18 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/util/movingaverage"
 )
 
+// the dimensions of the hot peer statistics
 const (
-	byteDim int = iota
-	keyDim
-	dimLen
+	// ByteDim the bytes rate of the peer
+	ByteDim int = iota
+	// KeyDim the keys rate of the peer
+	KeyDim
+	// QueryDim the requests rate of the peer
+	QueryDim
+	// CPUDim the cpu time in microseconds per second spent on the requests of the peer
+	CPUDim
+	// DimLen the count of the dimensions
+	DimLen
 )
 
 type dimStat struct {
@@ -47,11 +55,11 @@ func (d *dimStat) Add(delta float64, interval time.Duration) {
 	d.Rolling.Add(delta, interval)
 }
 
-func (d *dimStat) isLastAverageHot(thresholds [dimLen]float64) bool {
+func (d *dimStat) isLastAverageHot(thresholds [DimLen]float64) bool {
 	return d.LastAverage.Get() >= thresholds[d.typ]
 }
 
-func (d *dimStat) isHot(thresholds [dimLen]float64) bool {
+func (d *dimStat) isHot(thresholds [DimLen]float64) bool {
 	return d.Rolling.Get() >= thresholds[d.typ]
 }
 
@@ -77,13 +85,17 @@ type HotPeerStat struct {
 	// AntiCount used to eliminate some noise when remove resource in cache
 	AntiCount int `json:"anti_count"`
 
-	Kind     FlowKind `json:"-"`
-	ByteRate float64  `json:"flow_bytes"`
-	KeyRate  float64  `json:"flow_keys"`
+	Kind      FlowKind `json:"-"`
+	ByteRate  float64  `json:"flow_bytes"`
+	KeyRate   float64  `json:"flow_keys"`
+	QueryRate float64  `json:"flow_query"`
+	CPURate   float64  `json:"flow_cpu"`
 
 	// rolling statistics, recording some recently added records.
-	rollingByteRate *dimStat
-	rollingKeyRate  *dimStat
+	rollingByteRate  *dimStat
+	rollingKeyRate   *dimStat
+	rollingQueryRate *dimStat
+	rollingCPURate   *dimStat
 
 	// LastUpdateTime used to calculate average write
 	LastUpdateTime time.Time `json:"last_update_time"`
@@ -93,7 +105,7 @@ type HotPeerStat struct {
 	isNew                  bool
 	justTransferLeader     bool
 	interval               uint64
-	thresholds             [dimLen]float64
+	thresholds             [DimLen]float64
 	peers                  []uint64
 	lastTransferLeaderTime time.Time
 }
@@ -106,14 +118,7 @@ func (stat *HotPeerStat) ID() uint64 {
 // Less compares two HotPeerStat.Implementing TopNItem.
 func (stat *HotPeerStat) Less(k int, than TopNItem) bool {
 	rhs := than.(*HotPeerStat)
-	switch k {
-	case keyDim:
-		return stat.GetKeyRate() < rhs.GetKeyRate()
-	case byteDim:
-		fallthrough
-	default:
-		return stat.GetByteRate() < rhs.GetByteRate()
-	}
+	return stat.GetLoad(k) < rhs.GetLoad(k)
 }
 
 // IsNeedCoolDownTransferLeader use cooldown time after transfer leader to avoid unnecessary schedule
@@ -152,8 +157,41 @@ func (stat *HotPeerStat) GetKeyRate() float64 {
 	return math.Round(stat.rollingKeyRate.Get())
 }
 
+// GetQueryRate returns denoised QueryRate if possible.
+func (stat *HotPeerStat) GetQueryRate() float64 {
+	if stat.rollingQueryRate == nil {
+		return math.Round(stat.QueryRate)
+	}
+	return math.Round(stat.rollingQueryRate.Get())
+}
+
+// GetCPURate returns denoised CPURate if possible.
+func (stat *HotPeerStat) GetCPURate() float64 {
+	if stat.rollingCPURate == nil {
+		return math.Round(stat.CPURate)
+	}
+	return math.Round(stat.rollingCPURate.Get())
+}
+
+// GetLoad returns the denoised rate of the dimension, the bytes rate is returned
+// for the unknown dimension.
+func (stat *HotPeerStat) GetLoad(dim int) float64 {
+	switch dim {
+	case KeyDim:
+		return stat.GetKeyRate()
+	case QueryDim:
+		return stat.GetQueryRate()
+	case CPUDim:
+		return stat.GetCPURate()
+	case ByteDim:
+		fallthrough
+	default:
+		return stat.GetByteRate()
+	}
+}
+
 // GetThresholds returns thresholds
-func (stat *HotPeerStat) GetThresholds() [dimLen]float64 {
+func (stat *HotPeerStat) GetThresholds() [DimLen]float64 {
 	return stat.thresholds
 }
 
@@ -164,15 +202,39 @@ func (stat *HotPeerStat) Clone() *HotPeerStat {
 	ret.rollingByteRate = nil
 	ret.KeyRate = stat.GetKeyRate()
 	ret.rollingKeyRate = nil
+	ret.QueryRate = stat.GetQueryRate()
+	ret.rollingQueryRate = nil
+	ret.CPURate = stat.GetCPURate()
+	ret.rollingCPURate = nil
 	return &ret
 }
 
+func (stat *HotPeerStat) rollings() [DimLen]*dimStat {
+	return [DimLen]*dimStat{
+		ByteDim:  stat.rollingByteRate,
+		KeyDim:   stat.rollingKeyRate,
+		QueryDim: stat.rollingQueryRate,
+		CPUDim:   stat.rollingCPURate,
+	}
+}
+
+func (stat *HotPeerStat) addLoads(loads [DimLen]float64, interval time.Duration) {
+	for k, rolling := range stat.rollings() {
+		rolling.Add(loads[k], interval)
+	}
+}
+
 func (stat *HotPeerStat) isFullAndHot() bool {
-	return (stat.rollingByteRate.isFull() && stat.rollingByteRate.isLastAverageHot(stat.thresholds)) ||
-		(stat.rollingKeyRate.isFull() && stat.rollingKeyRate.isLastAverageHot(stat.thresholds))
+	for _, rolling := range stat.rollings() {
+		if rolling.isFull() && rolling.isLastAverageHot(stat.thresholds) {
+			return true
+		}
+	}
+	return false
 }
 
 func (stat *HotPeerStat) clearLastAverage() {
-	stat.rollingByteRate.clearLastAverage()
-	stat.rollingKeyRate.clearLastAverage()
+	for _, rolling := range stat.rollings() {
+		rolling.clearLastAverage()
+	}
 }
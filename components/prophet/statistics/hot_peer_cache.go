@@ -39,14 +39,18 @@ const (
 )
 
 var (
-	minHotThresholds = [2][dimLen]float64{
+	minHotThresholds = [2][DimLen]float64{
 		WriteFlow: {
-			byteDim: 1 * 1024,
-			keyDim:  32,
+			ByteDim:  1 * 1024,
+			KeyDim:   32,
+			QueryDim: 32,
+			CPUDim:   10 * 1000,
 		},
 		ReadFlow: {
-			byteDim: 8 * 1024,
-			keyDim:  128,
+			ByteDim:  8 * 1024,
+			KeyDim:   128,
+			QueryDim: 128,
+			CPUDim:   10 * 1000,
 		},
 	}
 )
@@ -95,7 +99,7 @@ func (f *hotPeerCache) Update(item *HotPeerStat) {
 	} else {
 		peers, ok := f.peersOfContainer[item.ContainerID]
 		if !ok {
-			peers = NewTopN(dimLen, TopNN, topNTTL)
+			peers = NewTopN(DimLen, TopNN, topNTTL)
 			f.peersOfContainer[item.ContainerID] = peers
 		}
 		peers.Put(item)
@@ -109,33 +113,36 @@ func (f *hotPeerCache) Update(item *HotPeerStat) {
 	}
 }
 
-func (f *hotPeerCache) collectResourceMetrics(byteRate, keyRate float64, interval uint64) {
+func (f *hotPeerCache) collectResourceMetrics(rates [DimLen]float64, interval uint64) {
 	resourceHeartbeatIntervalHist.Observe(float64(interval))
 	if interval == 0 {
 		return
 	}
 	if f.kind == ReadFlow {
-		readByteHist.Observe(byteRate)
-		readKeyHist.Observe(keyRate)
+		readByteHist.Observe(rates[ByteDim])
+		readKeyHist.Observe(rates[KeyDim])
+		readQueryHist.Observe(rates[QueryDim])
 	}
 	if f.kind == WriteFlow {
-		writeByteHist.Observe(byteRate)
-		writeKeyHist.Observe(keyRate)
+		writeByteHist.Observe(rates[ByteDim])
+		writeKeyHist.Observe(rates[KeyDim])
+		writeQueryHist.Observe(rates[QueryDim])
 	}
 }
 
 // CheckResourceFlow checks the flow information of resource.
 func (f *hotPeerCache) CheckResourceFlow(res *core.CachedResource) (ret []*HotPeerStat) {
-	bytes := float64(f.getResourceBytes(res))
-	keys := float64(f.getResourceKeys(res))
+	loads := f.getResourceLoads(res)
 
 	reportInterval := res.GetInterval()
 	interval := reportInterval.GetEnd() - reportInterval.GetStart()
 
-	byteRate := bytes / float64(interval)
-	keyRate := keys / float64(interval)
+	var rates [DimLen]float64
+	for k := 0; k < DimLen; k++ {
+		rates[k] = loads[k] / float64(interval)
+	}
 
-	f.collectResourceMetrics(byteRate, keyRate, interval)
+	f.collectResourceMetrics(rates, interval)
 
 	// old resource is in the front and new resource is in the back
 	// which ensures it will hit the cache if moving peer or transfer leader occurs with the same replica number
@@ -166,8 +173,10 @@ func (f *hotPeerCache) CheckResourceFlow(res *core.CachedResource) (ret []*HotPe
 			ContainerID:        containerID,
 			ResourceID:         res.Meta.ID(),
 			Kind:               f.kind,
-			ByteRate:           byteRate,
-			KeyRate:            keyRate,
+			ByteRate:           rates[ByteDim],
+			KeyRate:            rates[KeyDim],
+			QueryRate:          rates[QueryDim],
+			CPURate:            rates[CPUDim],
 			LastUpdateTime:     time.Now(),
 			needDelete:         isExpired,
 			isLeader:           res.GetLeader().GetContainerID() == containerID,
@@ -190,7 +199,7 @@ func (f *hotPeerCache) CheckResourceFlow(res *core.CachedResource) (ret []*HotPe
 			}
 		}
 
-		newItem = f.updateHotPeerStat(newItem, oldItem, bytes, keys, time.Duration(interval)*time.Second)
+		newItem = f.updateHotPeerStat(newItem, oldItem, loads, time.Duration(interval)*time.Second)
 		if newItem != nil {
 			ret = append(ret, newItem)
 		}
@@ -214,31 +223,33 @@ func (f *hotPeerCache) CollectMetrics(typ string) {
 		container := containerTag(containerID)
 		thresholds := f.calcHotThresholds(containerID)
 		hotCacheStatusGauge.WithLabelValues("total_length", container, typ).Set(float64(peers.Len()))
-		hotCacheStatusGauge.WithLabelValues("byte-rate-threshold", container, typ).Set(thresholds[byteDim])
-		hotCacheStatusGauge.WithLabelValues("key-rate-threshold", container, typ).Set(thresholds[keyDim])
+		hotCacheStatusGauge.WithLabelValues("byte-rate-threshold", container, typ).Set(thresholds[ByteDim])
+		hotCacheStatusGauge.WithLabelValues("key-rate-threshold", container, typ).Set(thresholds[KeyDim])
+		hotCacheStatusGauge.WithLabelValues("query-rate-threshold", container, typ).Set(thresholds[QueryDim])
+		hotCacheStatusGauge.WithLabelValues("cpu-rate-threshold", container, typ).Set(thresholds[CPUDim])
 		// for compatibility
-		hotCacheStatusGauge.WithLabelValues("hotThreshold", container, typ).Set(thresholds[byteDim])
-	}
-}
-
-func (f *hotPeerCache) getResourceBytes(res *core.CachedResource) uint64 {
-	switch f.kind {
-	case WriteFlow:
-		return res.GetBytesWritten()
-	case ReadFlow:
-		return res.GetBytesRead()
+		hotCacheStatusGauge.WithLabelValues("hotThreshold", container, typ).Set(thresholds[ByteDim])
 	}
-	return 0
 }
 
-func (f *hotPeerCache) getResourceKeys(res *core.CachedResource) uint64 {
+func (f *hotPeerCache) getResourceLoads(res *core.CachedResource) [DimLen]float64 {
 	switch f.kind {
 	case WriteFlow:
-		return res.GetKeysWritten()
+		return [DimLen]float64{
+			ByteDim:  float64(res.GetBytesWritten()),
+			KeyDim:   float64(res.GetKeysWritten()),
+			QueryDim: float64(res.GetQueryWritten()),
+			CPUDim:   float64(res.GetWriteCPUTime()),
+		}
 	case ReadFlow:
-		return res.GetKeysRead()
+		return [DimLen]float64{
+			ByteDim:  float64(res.GetBytesRead()),
+			KeyDim:   float64(res.GetKeysRead()),
+			QueryDim: float64(res.GetQueryRead()),
+			CPUDim:   float64(res.GetReadCPUTime()),
+		}
 	}
-	return 0
+	return [DimLen]float64{}
 }
 
 func (f *hotPeerCache) getOldHotPeerStat(resID, containerID uint64) *HotPeerStat {
@@ -261,17 +272,15 @@ func (f *hotPeerCache) isResourceExpired(res *core.CachedResource, containerID u
 	return false
 }
 
-func (f *hotPeerCache) calcHotThresholds(containerID uint64) [dimLen]float64 {
+func (f *hotPeerCache) calcHotThresholds(containerID uint64) [DimLen]float64 {
 	minThresholds := minHotThresholds[f.kind]
 	tn, ok := f.peersOfContainer[containerID]
 	if !ok || tn.Len() < TopNN {
 		return minThresholds
 	}
-	ret := [dimLen]float64{
-		byteDim: tn.GetTopNMin(byteDim).(*HotPeerStat).GetByteRate(),
-		keyDim:  tn.GetTopNMin(keyDim).(*HotPeerStat).GetKeyRate(),
-	}
-	for k := 0; k < dimLen; k++ {
+	var ret [DimLen]float64
+	for k := 0; k < DimLen; k++ {
+		ret[k] = tn.GetTopNMin(k).(*HotPeerStat).GetLoad(k)
 		ret[k] = math.Max(ret[k]*HotThresholdRatio, minThresholds[k])
 	}
 	return ret
@@ -370,7 +379,7 @@ func (f *hotPeerCache) getDefaultTimeMedian() *movingaverage.TimeMedian {
 	return movingaverage.NewTimeMedian(DefaultAotSize, rollingWindowsSize, ResourceHeartBeatReportInterval*time.Second)
 }
 
-func (f *hotPeerCache) updateHotPeerStat(newItem, oldItem *HotPeerStat, bytes, keys float64, interval time.Duration) *HotPeerStat {
+func (f *hotPeerCache) updateHotPeerStat(newItem, oldItem *HotPeerStat, loads [DimLen]float64, interval time.Duration) *HotPeerStat {
 	if newItem.needDelete {
 		return newItem
 	}
@@ -379,7 +388,13 @@ func (f *hotPeerCache) updateHotPeerStat(newItem, oldItem *HotPeerStat, bytes, k
 		if interval == 0 {
 			return nil
 		}
-		isHot := bytes/interval.Seconds() >= newItem.thresholds[byteDim] || keys/interval.Seconds() >= newItem.thresholds[keyDim]
+		isHot := false
+		for k := 0; k < DimLen; k++ {
+			if loads[k]/interval.Seconds() >= newItem.thresholds[k] {
+				isHot = true
+				break
+			}
+		}
 		if !isHot {
 			return nil
 		}
@@ -388,10 +403,11 @@ func (f *hotPeerCache) updateHotPeerStat(newItem, oldItem *HotPeerStat, bytes, k
 			newItem.AntiCount = hotResourceAntiCount
 		}
 		newItem.isNew = true
-		newItem.rollingByteRate = newDimStat(byteDim)
-		newItem.rollingKeyRate = newDimStat(keyDim)
-		newItem.rollingByteRate.Add(bytes, interval)
-		newItem.rollingKeyRate.Add(keys, interval)
+		newItem.rollingByteRate = newDimStat(ByteDim)
+		newItem.rollingKeyRate = newDimStat(KeyDim)
+		newItem.rollingQueryRate = newDimStat(QueryDim)
+		newItem.rollingCPURate = newDimStat(CPUDim)
+		newItem.addLoads(loads, interval)
 		if newItem.rollingKeyRate.isFull() {
 			newItem.clearLastAverage()
 		}
@@ -400,6 +416,8 @@ func (f *hotPeerCache) updateHotPeerStat(newItem, oldItem *HotPeerStat, bytes, k
 
 	newItem.rollingByteRate = oldItem.rollingByteRate
 	newItem.rollingKeyRate = oldItem.rollingKeyRate
+	newItem.rollingQueryRate = oldItem.rollingQueryRate
+	newItem.rollingCPURate = oldItem.rollingCPURate
 
 	if newItem.justTransferLeader {
 		// skip the first heartbeat flow statistic after transfer leader, because its statistics are calculated by the last leader in this store and are inaccurate
@@ -411,8 +429,7 @@ func (f *hotPeerCache) updateHotPeerStat(newItem, oldItem *HotPeerStat, bytes, k
 	}
 
 	newItem.lastTransferLeaderTime = oldItem.lastTransferLeaderTime
-	newItem.rollingByteRate.Add(bytes, interval)
-	newItem.rollingKeyRate.Add(keys, interval)
+	newItem.addLoads(loads, interval)
 
 	if !newItem.rollingKeyRate.isFull() {
 		// not update hot degree and anti count
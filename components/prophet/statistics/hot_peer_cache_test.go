@@ -55,6 +55,49 @@ func TestContainerTimeUnsync(t *testing.T) {
 	}
 }
 
+func TestQueryAndCPUFlow(t *testing.T) {
+	peers := newPeers(3,
+		func(i int) uint64 { return uint64(10000 + i) },
+		func(i int) uint64 { return uint64(i) })
+	meta := &metadata.TestResource{
+		ResID:    1000,
+		ResPeers: peers,
+		Start:    []byte(""),
+		End:      []byte(""),
+		ResEpoch: metapb.ResourceEpoch{ConfVer: 6, Version: 6},
+	}
+	interval := uint64(ResourceHeartBeatReportInterval)
+
+	// the resource is hot by requests although it reads few bytes
+	cache := newHotContainersStats(ReadFlow)
+	resource := core.NewCachedResource(meta, &peers[0],
+		core.SetReportInterval(interval),
+		core.SetReadBytes(interval),
+		core.SetReadQuery(interval*uint64(minHotThresholds[ReadFlow][QueryDim])*2))
+	res := checkAndUpdate(t, cache, resource, 1)
+	assert.Equal(t, minHotThresholds[ReadFlow][QueryDim]*2, res[0].QueryRate)
+	assert.Equal(t, res[0].GetQueryRate(), res[0].GetLoad(QueryDim))
+
+	// the resource is hot by the cpu time of the write requests
+	cache = newHotContainersStats(WriteFlow)
+	resource = core.NewCachedResource(meta, &peers[0],
+		core.SetReportInterval(interval),
+		core.SetWriteCPUTime(interval*uint64(minHotThresholds[WriteFlow][CPUDim])*2))
+	res = checkAndUpdate(t, cache, resource, 3)
+	for _, item := range res {
+		assert.Equal(t, minHotThresholds[WriteFlow][CPUDim]*2, item.CPURate)
+		assert.Equal(t, item.GetCPURate(), item.GetLoad(CPUDim))
+	}
+
+	// cold in all dimensions
+	cache = newHotContainersStats(WriteFlow)
+	resource = core.NewCachedResource(meta, &peers[0],
+		core.SetReportInterval(interval),
+		core.SetWrittenQuery(interval),
+		core.SetWriteCPUTime(interval))
+	checkAndUpdate(t, cache, resource, 0)
+}
+
 type operator int
 
 const (
@@ -216,57 +259,57 @@ func TestUpdateHotPeerStat(t *testing.T) {
 	cache := newHotContainersStats(ReadFlow)
 
 	// skip interval=0
-	newItem := &HotPeerStat{needDelete: false, thresholds: [2]float64{0.0, 0.0}}
-	newItem = cache.updateHotPeerStat(newItem, nil, 0, 0, 0)
+	newItem := &HotPeerStat{needDelete: false, thresholds: [DimLen]float64{0.0, 0.0, 0.0, 0.0}}
+	newItem = cache.updateHotPeerStat(newItem, nil, [DimLen]float64{}, 0)
 	assert.Nil(t, newItem)
 
 	// new peer, interval is larger than report interval, but no hot
-	newItem = &HotPeerStat{needDelete: false, thresholds: [2]float64{1.0, 1.0}}
-	newItem = cache.updateHotPeerStat(newItem, nil, 0, 0, 60*time.Second)
+	newItem = &HotPeerStat{needDelete: false, thresholds: [DimLen]float64{1.0, 1.0, 1.0, 1.0}}
+	newItem = cache.updateHotPeerStat(newItem, nil, [DimLen]float64{}, 60*time.Second)
 	assert.Nil(t, newItem)
 
 	// new peer, interval is less than report interval
-	newItem = &HotPeerStat{needDelete: false, thresholds: [2]float64{0.0, 0.0}}
-	newItem = cache.updateHotPeerStat(newItem, nil, 60, 60, 30*time.Second)
+	newItem = &HotPeerStat{needDelete: false, thresholds: [DimLen]float64{0.0, 0.0, 0.0, 0.0}}
+	newItem = cache.updateHotPeerStat(newItem, nil, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 30*time.Second)
 	assert.NotNil(t, newItem)
 	assert.Equal(t, 0, newItem.HotDegree)
 	assert.Equal(t, 0, newItem.AntiCount)
 	// sum of interval is less than report interval
 	oldItem := newItem
-	newItem = cache.updateHotPeerStat(newItem, oldItem, 60, 60, 10*time.Second)
+	newItem = cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 10*time.Second)
 	assert.Equal(t, 0, newItem.HotDegree)
 	assert.Equal(t, 0, newItem.AntiCount)
 	// sum of interval is larger than report interval, and hot
 	oldItem = newItem
-	newItem = cache.updateHotPeerStat(newItem, oldItem, 60, 60, 30*time.Second)
+	newItem = cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 30*time.Second)
 	assert.Equal(t, 1, newItem.HotDegree)
 	assert.Equal(t, 2, newItem.AntiCount)
 	// sum of interval is less than report interval
 	oldItem = newItem
-	newItem = cache.updateHotPeerStat(newItem, oldItem, 60, 60, 10*time.Second)
+	newItem = cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 10*time.Second)
 	assert.Equal(t, 1, newItem.HotDegree)
 	assert.Equal(t, 2, newItem.AntiCount)
 	// sum of interval is larger than report interval, and hot
 	oldItem = newItem
-	newItem = cache.updateHotPeerStat(newItem, oldItem, 60, 60, 50*time.Second)
+	newItem = cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 50*time.Second)
 	assert.Equal(t, 2, newItem.HotDegree)
 	assert.Equal(t, 2, newItem.AntiCount)
 	// sum of interval is larger than report interval, and cold
 	oldItem = newItem
-	newItem.thresholds = [2]float64{10.0, 10.0}
-	newItem = cache.updateHotPeerStat(newItem, oldItem, 60, 60, 60*time.Second)
+	newItem.thresholds = [DimLen]float64{10.0, 10.0, 10.0, 10.0}
+	newItem = cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 60*time.Second)
 	assert.Equal(t, 1, newItem.HotDegree)
 	assert.Equal(t, 1, newItem.AntiCount)
 	// sum of interval is larger than report interval, and cold
 	oldItem = newItem
-	newItem = cache.updateHotPeerStat(newItem, oldItem, 60, 60, 60*time.Second)
+	newItem = cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: 60, KeyDim: 60}, 60*time.Second)
 	assert.Equal(t, 0, newItem.HotDegree)
 	assert.Equal(t, 0, newItem.AntiCount)
 	assert.True(t, newItem.needDelete)
 }
 
 func TestThresholdWithUpdateHotPeerStat(t *testing.T) {
-	byteRate := minHotThresholds[ReadFlow][byteDim] * 2
+	byteRate := minHotThresholds[ReadFlow][ByteDim] * 2
 	expectThreshold := byteRate * HotThresholdRatio
 	testMetrics(t, 120., byteRate, expectThreshold)
 	testMetrics(t, 60., byteRate, expectThreshold)
@@ -278,7 +321,7 @@ func testMetrics(t *testing.T, interval, byteRate, expectThreshold float64) {
 	cache := newHotContainersStats(ReadFlow)
 	minThresholds := minHotThresholds[cache.kind]
 	containerID := uint64(1)
-	assert.True(t, byteRate >= minThresholds[byteDim])
+	assert.True(t, byteRate >= minThresholds[ByteDim])
 	for i := uint64(1); i < TopNN+10; i++ {
 		var oldItem *HotPeerStat
 		for {
@@ -295,14 +338,14 @@ func testMetrics(t *testing.T, interval, byteRate, expectThreshold float64) {
 			if oldItem != nil && oldItem.rollingByteRate.isHot(thresholds) == true {
 				break
 			}
-			item := cache.updateHotPeerStat(newItem, oldItem, byteRate*interval, 0, time.Duration(interval)*time.Second)
+			item := cache.updateHotPeerStat(newItem, oldItem, [DimLen]float64{ByteDim: byteRate * interval}, time.Duration(interval)*time.Second)
 			cache.Update(item)
 		}
 		thresholds := cache.calcHotThresholds(containerID)
 		if i < TopNN {
-			assert.Equal(t, minThresholds[byteDim], thresholds[byteDim])
+			assert.Equal(t, minThresholds[ByteDim], thresholds[ByteDim])
 		} else {
-			assert.Equal(t, expectThreshold, thresholds[byteDim])
+			assert.Equal(t, expectThreshold, thresholds[ByteDim])
 		}
 	}
 }
@@ -18,6 +18,8 @@ package statistics
 type HotPeersStat struct {
 	TotalBytesRate float64       `json:"total_flow_bytes"`
 	TotalKeysRate  float64       `json:"total_flow_keys"`
+	TotalQueryRate float64       `json:"total_flow_query"`
+	TotalCPURate   float64       `json:"total_flow_cpu"`
 	Count          int           `json:"resources_count"`
 	Stats          []HotPeerStat `json:"statistics"`
 }
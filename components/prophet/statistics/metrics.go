@@ -114,6 +114,22 @@ var (
 			Help:      "The distribution of resource write keys",
 			Buckets:   prometheus.ExponentialBuckets(1, 2, 18),
 		})
+	readQueryHist = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "prophet",
+			Subsystem: "scheduler",
+			Name:      "read_query_hist",
+			Help:      "The distribution of resource read requests",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18),
+		})
+	writeQueryHist = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "prophet",
+			Subsystem: "scheduler",
+			Name:      "write_query_hist",
+			Help:      "The distribution of resource write requests",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18),
+		})
 	resourceHeartbeatIntervalHist = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "prophet",
@@ -145,6 +161,8 @@ func init() {
 	prometheus.MustRegister(readKeyHist)
 	prometheus.MustRegister(writeKeyHist)
 	prometheus.MustRegister(writeByteHist)
+	prometheus.MustRegister(readQueryHist)
+	prometheus.MustRegister(writeQueryHist)
 	prometheus.MustRegister(resourceHeartbeatIntervalHist)
 	prometheus.MustRegister(containerHeartbeatIntervalHist)
 }
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/fagongzi/util/format"
 	"github.com/fagongzi/util/protoc"
@@ -120,6 +121,34 @@ type ContainerStorage interface {
 	PutContainerWeight(id uint64, leaderWeight, resourceWeight float64) error
 }
 
+// TimestampStorage timestamp storage
+type TimestampStorage interface {
+	// PutTimestamp puts the upper bound of the timestamp window to the storage
+	PutTimestamp(time.Time) error
+	// GetTimestamp returns the upper bound of the timestamp window, zero time if not exists
+	GetTimestamp() (time.Time, error)
+}
+
+// OperatorHistoryStorage operator history storage, the histories are indexed by the finish
+// time, and by the resource and the containers of the operator too.
+type OperatorHistoryStorage interface {
+	// PutOperatorHistories puts the histories of the finished operators to the storage
+	PutOperatorHistories(histories ...metapb.OperatorHistory) error
+	// LoadOperatorHistories load the histories finished in [start, end) in the order of
+	// the finish time, stop loading if do returns false
+	LoadOperatorHistories(start, end time.Time, limit int64, do func(metapb.OperatorHistory) bool) error
+	// LoadResourceOperatorHistories load the histories of the resource finished in [start, end)
+	// in the order of the finish time, stop loading if do returns false
+	LoadResourceOperatorHistories(resourceID uint64, start, end time.Time, limit int64, do func(metapb.OperatorHistory) bool) error
+	// LoadContainerOperatorHistories load the histories of the operators involved the container
+	// finished in [start, end) in the order of the finish time, stop loading if do returns false
+	LoadContainerOperatorHistories(containerID uint64, start, end time.Time, limit int64, do func(metapb.OperatorHistory) bool) error
+	// CountOperatorHistories returns the count of the histories in the storage
+	CountOperatorHistories() (uint64, error)
+	// RemoveOperatorHistories remove the histories from the storage
+	RemoveOperatorHistories(histories ...metapb.OperatorHistory) error
+}
+
 // ClusterStorage cluster storage
 type ClusterStorage interface {
 	// AlreadyBootstrapped returns the cluster was already bootstrapped
@@ -138,6 +167,8 @@ type Storage interface {
 	ResourceStorage
 	ContainerStorage
 	ClusterStorage
+	TimestampStorage
+	OperatorHistoryStorage
 
 	// KV return KV storage
 	KV() KV
@@ -158,6 +189,10 @@ type storage struct {
 	jobPath                  string
 	jobDataPath              string
 	customDataPath           string
+	timestampPath            string
+	operatorHistoryPath      string
+	resourceHistoryPath      string
+	containerHistoryPath     string
 }
 
 // NewTestStorage create test storage
@@ -182,6 +217,10 @@ func NewStorage(rootPath string, kv KV, adapter metadata.Adapter) Storage {
 		jobPath:                  fmt.Sprintf("%s/jobs", rootPath),
 		jobDataPath:              fmt.Sprintf("%s/job-data", rootPath),
 		customDataPath:           fmt.Sprintf("%s/custom", rootPath),
+		timestampPath:            fmt.Sprintf("%s/timestamp", rootPath),
+		operatorHistoryPath:      fmt.Sprintf("%s/operator-histories", rootPath),
+		resourceHistoryPath:      fmt.Sprintf("%s/resource-operator-histories", rootPath),
+		containerHistoryPath:     fmt.Sprintf("%s/container-operator-histories", rootPath),
 	}
 }
 
@@ -523,6 +562,101 @@ func (s *storage) AlreadyBootstrapped() (bool, error) {
 	return v != "", nil
 }
 
+func (s *storage) PutTimestamp(ts time.Time) error {
+	return s.kv.Save(s.timestampPath, string(format.UInt64ToString(uint64(ts.UnixNano()))))
+}
+
+func (s *storage) GetTimestamp() (time.Time, error) {
+	v, err := s.kv.Load(s.timestampPath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	ts, err := format.ParseStrUInt64(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(ts)), nil
+}
+
+func (s *storage) PutOperatorHistories(histories ...metapb.OperatorHistory) error {
+	batch := &Batch{}
+	for idx := range histories {
+		value := string(protoc.MustMarshal(&histories[idx]))
+		for _, key := range s.operatorHistoryKeys(histories[idx]) {
+			batch.SaveKeys = append(batch.SaveKeys, key)
+			batch.SaveValues = append(batch.SaveValues, value)
+		}
+	}
+	return s.kv.Batch(batch)
+}
+
+func (s *storage) LoadOperatorHistories(start, end time.Time, limit int64, do func(metapb.OperatorHistory) bool) error {
+	return s.loadOperatorHistories(s.operatorHistoryKey(start.UnixNano(), 0),
+		s.operatorHistoryKey(end.UnixNano(), 0),
+		limit,
+		func(h metapb.OperatorHistory) string { return s.operatorHistoryKey(h.FinishAt, h.ResourceID) },
+		do)
+}
+
+func (s *storage) LoadResourceOperatorHistories(resourceID uint64, start, end time.Time, limit int64, do func(metapb.OperatorHistory) bool) error {
+	return s.loadOperatorHistories(s.resourceHistoryKey(resourceID, start.UnixNano()),
+		s.resourceHistoryKey(resourceID, end.UnixNano()),
+		limit,
+		func(h metapb.OperatorHistory) string { return s.resourceHistoryKey(resourceID, h.FinishAt) },
+		do)
+}
+
+func (s *storage) LoadContainerOperatorHistories(containerID uint64, start, end time.Time, limit int64, do func(metapb.OperatorHistory) bool) error {
+	return s.loadOperatorHistories(s.containerHistoryKey(containerID, start.UnixNano(), 0),
+		s.containerHistoryKey(containerID, end.UnixNano(), 0),
+		limit,
+		func(h metapb.OperatorHistory) string { return s.containerHistoryKey(containerID, h.FinishAt, h.ResourceID) },
+		do)
+}
+
+// loadOperatorHistories loads the histories in [startKey, endKey) by pages, keyFunc returns
+// the key of the history in the scanned range.
+func (s *storage) loadOperatorHistories(startKey, endKey string, limit int64,
+	keyFunc func(metapb.OperatorHistory) string, do func(metapb.OperatorHistory) bool) error {
+	nextKey := startKey
+	for {
+		_, values, err := s.kv.LoadRange(nextKey, endKey, limit)
+		if err != nil {
+			return err
+		}
+
+		var history metapb.OperatorHistory
+		for _, v := range values {
+			history = metapb.OperatorHistory{}
+			protoc.MustUnmarshal(&history, []byte(v))
+			if !do(history) {
+				return nil
+			}
+		}
+		if int64(len(values)) < limit {
+			return nil
+		}
+		nextKey = keyFunc(history) + "\x00"
+	}
+}
+
+func (s *storage) CountOperatorHistories() (uint64, error) {
+	prefix := s.operatorHistoryPath + "/"
+	return s.kv.CountRange(prefix, util.GetPrefixRangeEnd(prefix))
+}
+
+func (s *storage) RemoveOperatorHistories(histories ...metapb.OperatorHistory) error {
+	batch := &Batch{}
+	for _, h := range histories {
+		batch.RemoveKeys = append(batch.RemoveKeys, s.operatorHistoryKeys(h)...)
+	}
+	return s.kv.Batch(batch)
+}
+
 func (s *storage) getKey(id uint64, base string) string {
 	return path.Join(base, fmt.Sprintf("%020d", id))
 }
@@ -550,6 +684,32 @@ func (s *storage) jobKey(jobType metapb.JobType) string {
 	return path.Join(s.jobPath, string(format.UInt64ToString(uint64(jobType))))
 }
 
+// operatorHistoryKey the histories are ordered by the finish time
+func (s *storage) operatorHistoryKey(finishAt int64, resourceID uint64) string {
+	return path.Join(s.operatorHistoryPath, fmt.Sprintf("%020d-%020d", finishAt, resourceID))
+}
+
+// resourceHistoryKey the histories of a resource are ordered by the finish time
+func (s *storage) resourceHistoryKey(resourceID uint64, finishAt int64) string {
+	return path.Join(s.resourceHistoryPath, fmt.Sprintf("%020d", resourceID), fmt.Sprintf("%020d", finishAt))
+}
+
+// containerHistoryKey the histories of a container are ordered by the finish time
+func (s *storage) containerHistoryKey(containerID uint64, finishAt int64, resourceID uint64) string {
+	return path.Join(s.containerHistoryPath, fmt.Sprintf("%020d", containerID), fmt.Sprintf("%020d-%020d", finishAt, resourceID))
+}
+
+// operatorHistoryKeys returns the keys of the history and its indexes
+func (s *storage) operatorHistoryKeys(h metapb.OperatorHistory) []string {
+	keys := make([]string, 0, 2+len(h.Containers))
+	keys = append(keys, s.operatorHistoryKey(h.FinishAt, h.ResourceID),
+		s.resourceHistoryKey(h.ResourceID, h.FinishAt))
+	for _, id := range h.Containers {
+		keys = append(keys, s.containerHistoryKey(id, h.FinishAt, h.ResourceID))
+	}
+	return keys
+}
+
 func (s *storage) jobDataKey(jobType metapb.JobType) string {
 	return path.Join(s.jobDataPath, string(format.UInt64ToString(uint64(jobType))))
 }
@@ -293,3 +293,67 @@ func TestBatchPutCustomData(t *testing.T) {
 		assert.Equal(t, data[i], loadedValues[i])
 	}
 }
+
+func TestPutAndLoadAndRemoveOperatorHistories(t *testing.T) {
+	stopC, port := mock.StartTestSingleEtcd(t)
+	defer close(stopC)
+
+	client := mock.NewEtcdClient(t, port)
+	defer client.Close()
+
+	e, err := election.NewElector(client)
+	assert.NoError(t, err)
+	ls := e.CreateLeadship("prophet", "node1", "node1", true, func(string) bool { return true }, func(string) bool { return true })
+	defer ls.Stop()
+
+	go ls.ElectionLoop(context.Background())
+	time.Sleep(time.Millisecond * 200)
+
+	storage := NewStorage("/root", NewEtcdKV("/root", client, ls), metadata.NewTestAdapter())
+	var histories []metapb.OperatorHistory
+	for i := 1; i <= 5; i++ {
+		histories = append(histories, metapb.OperatorHistory{ResourceID: uint64(i%2 + 1), FinishAt: int64(i * 10),
+			Containers: []uint64{uint64(i), uint64(i + 1)}})
+	}
+	assert.NoError(t, storage.PutOperatorHistories(histories...))
+	n, err := storage.CountOperatorHistories()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), n)
+
+	var loaded []int64
+	load := func(h metapb.OperatorHistory) bool {
+		loaded = append(loaded, h.FinishAt)
+		return true
+	}
+	assert.NoError(t, storage.LoadOperatorHistories(time.Unix(0, 20), time.Unix(0, 50), 1, load))
+	assert.Equal(t, []int64{20, 30, 40}, loaded)
+
+	loaded = loaded[:0]
+	assert.NoError(t, storage.LoadOperatorHistories(time.Unix(0, 0), time.Unix(0, 100), 10, func(h metapb.OperatorHistory) bool {
+		loaded = append(loaded, h.FinishAt)
+		return len(loaded) < 2
+	}))
+	assert.Equal(t, []int64{10, 20}, loaded)
+
+	// load by the index of the resource or the container
+	loaded = loaded[:0]
+	assert.NoError(t, storage.LoadResourceOperatorHistories(2, time.Unix(0, 0), time.Unix(0, 100), 1, load))
+	assert.Equal(t, []int64{10, 30, 50}, loaded)
+	loaded = loaded[:0]
+	assert.NoError(t, storage.LoadResourceOperatorHistories(1, time.Unix(0, 30), time.Unix(0, 100), 10, load))
+	assert.Equal(t, []int64{40}, loaded)
+	loaded = loaded[:0]
+	assert.NoError(t, storage.LoadContainerOperatorHistories(3, time.Unix(0, 0), time.Unix(0, 100), 1, load))
+	assert.Equal(t, []int64{20, 30}, loaded)
+
+	assert.NoError(t, storage.RemoveOperatorHistories(histories[:2]...))
+	n, err = storage.CountOperatorHistories()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), n)
+	loaded = loaded[:0]
+	assert.NoError(t, storage.LoadContainerOperatorHistories(3, time.Unix(0, 0), time.Unix(0, 100), 10, load))
+	assert.Equal(t, []int64{30}, loaded)
+	loaded = loaded[:0]
+	assert.NoError(t, storage.LoadResourceOperatorHistories(2, time.Unix(0, 0), time.Unix(0, 100), 10, load))
+	assert.Equal(t, []int64{30, 50}, loaded)
+}
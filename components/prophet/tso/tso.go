@@ -0,0 +1,195 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tso
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/election"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+const (
+	// logicalBits the bits of the logical part of the timestamp
+	logicalBits = 18
+	maxLogical  = int64(1 << logicalBits)
+	// UpdateInterval the interval to update the physical time of the timestamp
+	UpdateInterval = time.Millisecond * 50
+	// updateGuard the physical time is updated only if the clock is ahead of it at least the guard
+	updateGuard = time.Millisecond
+	// maxRetryCount max retry times to wait the physical time updated if the logical is exhausted
+	maxRetryCount = 10
+)
+
+var (
+	// ErrNotInitialized the allocator is not initialized, the prophet is not the leader
+	ErrNotInitialized = errors.New("timestamp allocator is not initialized")
+	// ErrLogicalOverflow too many timestamps allocated in the same physical time
+	ErrLogicalOverflow = errors.New("timestamp logical overflow")
+)
+
+// ComposeTS returns the hybrid timestamp, the high bits are the physical time in milliseconds,
+// and the low 18 bits are the logical counter.
+func ComposeTS(physical time.Time, logical int64) uint64 {
+	return uint64(physical.UnixNano()/int64(time.Millisecond))<<logicalBits | uint64(logical)
+}
+
+// ParseTS returns the physical time and the logical counter of the timestamp
+func ParseTS(ts uint64) (time.Time, int64) {
+	ms := int64(ts >> logicalBits)
+	return time.Unix(0, ms*int64(time.Millisecond)), int64(ts & uint64(maxLogical-1))
+}
+
+// Allocator allocates the monotonic hybrid timestamps on the prophet leader. The upper bound
+// of the physical time window is persisted in the storage before it's used, the new leader
+// always allocates timestamps after the upper bound saved by the previous leader, so the
+// timestamps are monotonic across the leader failover.
+type Allocator struct {
+	sync.Mutex
+
+	storage      storage.TimestampStorage
+	leadership   *election.Leadership
+	saveInterval time.Duration
+
+	physical  time.Time
+	logical   int64
+	lastSaved time.Time
+}
+
+// NewAllocator returns a timestamp allocator, the saveInterval is the size of the time window
+// which is saved in the storage.
+func NewAllocator(storage storage.TimestampStorage, leadership *election.Leadership, saveInterval time.Duration) *Allocator {
+	return &Allocator{
+		storage:      storage,
+		leadership:   leadership,
+		saveInterval: saveInterval,
+	}
+}
+
+// Initialize initialize the physical time after the prophet becomes the leader
+func (a *Allocator) Initialize() error {
+	last, err := a.storage.GetTimestamp()
+	if err != nil {
+		return err
+	}
+
+	next := time.Now()
+	if next.Sub(last) < updateGuard {
+		util.GetLogger().Warningf("timestamp: system time %s is behind the last saved %s",
+			next,
+			last)
+		next = last.Add(updateGuard)
+	}
+
+	save := next.Add(a.saveInterval)
+	if err := a.storage.PutTimestamp(save); err != nil {
+		return err
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	a.physical = next
+	a.logical = 0
+	a.lastSaved = save
+	util.GetLogger().Infof("timestamp: initialized at %s, saved %s",
+		next,
+		save)
+	return nil
+}
+
+// Update advance the physical time, it should be called every UpdateInterval on the leader.
+// The upper bound of the time window is saved before the physical time exceeds it.
+func (a *Allocator) Update() error {
+	a.Lock()
+	physical, logical, lastSaved := a.physical, a.logical, a.lastSaved
+	a.Unlock()
+
+	if physical.IsZero() {
+		return ErrNotInitialized
+	}
+
+	now := time.Now()
+	var next time.Time
+	if now.Sub(physical) > updateGuard {
+		next = now
+	} else if logical > maxLogical/2 {
+		// the clock is not moving forward, but the logical is nearly exhausted
+		next = physical.Add(updateGuard)
+	} else {
+		return nil
+	}
+
+	if lastSaved.Sub(next) <= updateGuard {
+		save := next.Add(a.saveInterval)
+		if err := a.storage.PutTimestamp(save); err != nil {
+			return err
+		}
+		lastSaved = save
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if a.physical.IsZero() {
+		return ErrNotInitialized
+	}
+	a.physical = next
+	a.logical = 0
+	a.lastSaved = lastSaved
+	return nil
+}
+
+// Reset reset the allocator after the prophet loses the leadership
+func (a *Allocator) Reset() {
+	a.Lock()
+	defer a.Unlock()
+	a.physical = time.Time{}
+	a.logical = 0
+	a.lastSaved = time.Time{}
+}
+
+// Generate allocates count continuous timestamps, returns the last one, the timestamps in
+// [ts-count+1, ts] are allocated.
+func (a *Allocator) Generate(count uint32) (uint64, error) {
+	if count == 0 {
+		count = 1
+	}
+
+	for i := 0; i < maxRetryCount; i++ {
+		if !a.leadership.Check() {
+			return 0, util.ErrNotLeader
+		}
+
+		a.Lock()
+		if a.physical.IsZero() {
+			a.Unlock()
+			return 0, ErrNotInitialized
+		}
+
+		if a.logical+int64(count) < maxLogical {
+			a.logical += int64(count)
+			ts := ComposeTS(a.physical, a.logical)
+			a.Unlock()
+			return ts, nil
+		}
+		a.Unlock()
+
+		util.GetLogger().Warningf("timestamp: logical overflow, wait for physical time updated")
+		time.Sleep(UpdateInterval)
+	}
+
+	return 0, ErrLogicalOverflow
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tso
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/election"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/mock"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeAndParseTS(t *testing.T) {
+	now := time.Unix(0, time.Now().UnixNano()/int64(time.Millisecond)*int64(time.Millisecond))
+	physical, logical := ParseTS(ComposeTS(now, 10))
+	assert.True(t, now.Equal(physical))
+	assert.Equal(t, int64(10), logical)
+	assert.True(t, ComposeTS(now, maxLogical-1) < ComposeTS(now.Add(time.Millisecond), 0))
+}
+
+func TestAllocator(t *testing.T) {
+	stopC, port := mock.StartTestSingleEtcd(t)
+	defer close(stopC)
+
+	client := mock.NewEtcdClient(t, port)
+	defer client.Close()
+
+	e, err := election.NewElector(client)
+	assert.NoError(t, err)
+	ls := e.CreateLeadship("prophet", "node1", "node1", true, func(string) bool { return true }, func(string) bool { return true })
+	defer ls.Stop()
+
+	go ls.ElectionLoop(context.Background())
+	time.Sleep(time.Millisecond * 200)
+
+	s := storage.NewStorage("/root", storage.NewEtcdKV("/root", client, ls), metadata.NewTestAdapter())
+	a := NewAllocator(s, ls, time.Millisecond*100)
+	_, err = a.Generate(1)
+	assert.Equal(t, ErrNotInitialized, err)
+
+	assert.NoError(t, a.Initialize())
+	last := uint64(0)
+	for i := 0; i < 10; i++ {
+		ts, err := a.Generate(10)
+		assert.NoError(t, err)
+		assert.True(t, ts > last+9)
+		last = ts
+
+		time.Sleep(UpdateInterval)
+		assert.NoError(t, a.Update())
+	}
+	saved, err := s.GetTimestamp()
+	assert.NoError(t, err)
+	physical, _ := ParseTS(last)
+	assert.True(t, saved.After(physical))
+
+	// the new leader allocates the timestamps after the saved upper bound
+	a.Reset()
+	_, err = a.Generate(1)
+	assert.Equal(t, ErrNotInitialized, err)
+	a2 := NewAllocator(s, ls, time.Millisecond*100)
+	assert.NoError(t, a2.Initialize())
+	ts, err := a2.Generate(1)
+	assert.NoError(t, err)
+	physical, _ = ParseTS(ts)
+	assert.False(t, physical.Before(saved.Truncate(time.Millisecond)))
+	assert.True(t, ts > last)
+
+	// the logical exhausted
+	ts, err = a2.Generate(uint32(maxLogical / 2))
+	assert.NoError(t, err)
+	assert.NoError(t, a2.Update())
+	ts2, err := a2.Generate(uint32(maxLogical / 2))
+	assert.NoError(t, err)
+	assert.True(t, ts2 > ts)
+}
@@ -15,6 +15,7 @@ package util
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -30,7 +31,7 @@ var GetPrefixRangeEnd = clientv3.GetPrefixRangeEnd
 
 // CheckClusterID checks etcd cluster ID, returns an error if mismatch.
 // This function will never block even quorum is not satisfied.
-func CheckClusterID(localClusterID types.ID, um types.URLsMap) error {
+func CheckClusterID(localClusterID types.ID, um types.URLsMap, tlsConfig *tls.Config) error {
 	if len(um) == 0 {
 		return nil
 	}
@@ -41,7 +42,7 @@ func CheckClusterID(localClusterID types.ID, um types.URLsMap) error {
 	}
 
 	for _, u := range peerURLs {
-		trp := &http.Transport{}
+		trp := &http.Transport{TLSClientConfig: tlsConfig}
 		remoteCluster, gerr := etcdserver.GetClusterFromRemotePeers(nil, []string{u}, trp)
 		trp.CloseIdleConnections()
 		if gerr != nil {
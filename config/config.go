@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixcube/snapshot"
 	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/transport"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/vfs"
 )
 
@@ -46,6 +47,14 @@ var (
 	defaultCompactDuration                 = time.Second * 30
 	defaultShardSplitCheckDuration         = time.Second * 30
 	defaultShardStateCheckDuration         = time.Second * 60
+	defaultConsistencyCheckDuration        = time.Minute * 10
+	defaultLoadSplitDuration               = time.Second * 10
+	defaultChangeLogGCDuration             = time.Minute
+	defaultMaxRetainedChangeLogs    uint64 = 100000
+	defaultBackupCheckDuration             = time.Second * 10
+	defaultRecoveryCheckDuration           = time.Second * 10
+	defaultSlowStoreLatencyThreshold       = time.Millisecond * 500
+	defaultQuotaCheckDuration              = time.Second * 10
 	defaultMaxEntryBytes                   = 10 * mb
 	defaultShardCapacityBytes       uint64 = uint64(96 * mb)
 	defaultMaxAllowTransferLag      uint64 = 2
@@ -81,6 +90,15 @@ type Config struct {
 	Raft RaftConfig `toml:"raft"`
 	// Worker worker config
 	Worker WorkerConfig `toml:"worker"`
+	// CDC change data capture config
+	CDC CDCConfig `toml:"cdc"`
+	// Backup backup and restore config
+	Backup BackupConfig `toml:"backup"`
+	// Quota request quota config
+	Quota QuotaConfig `toml:"quota"`
+	// TLS the tls config of the raft transport and the client rpc. The prophet uses
+	// the same config if the prophet tls is not set.
+	TLS tlsutil.Config `toml:"tls"`
 	// Prophet prophet config
 	Prophet pconfig.Config `toml:"prophet"`
 	// Storage config
@@ -132,8 +150,14 @@ func (c *Config) Adjust() {
 	(&c.Raft).adjust(uint64(c.Replication.ShardCapacityBytes))
 	c.Prophet.DataDir = path.Join(c.DataPath, defaultProphetDirName)
 	c.Prophet.ContainerHeartbeatDataProcessor = c.Customize.CustomStoreHeartbeatDataProcessor
+	if c.Prophet.TLS == (tlsutil.Config{}) {
+		c.Prophet.TLS = c.TLS
+	}
 	(&c.Prophet).Adjust(nil, false)
 	(&c.Worker).adjust()
+	(&c.CDC).adjust()
+	(&c.Backup).adjust()
+	(&c.Quota).adjust()
 
 	if c.Customize.TestShardStateAware != nil {
 		if c.Customize.CustomShardStateAwareFactory != nil {
@@ -162,6 +186,10 @@ func (c *Config) validate() {
 	if c.Storage.ForeachDataStorageFunc == nil {
 		log.Panicf("missing Config.Storage.ForeachDataStorageFunc")
 	}
+
+	if err := c.TLS.Validate(); err != nil {
+		log.Panicf("invalid Config.TLS, %+v", err)
+	}
 }
 
 // SnapshotDir returns snapshot dir
@@ -180,6 +208,16 @@ type ReplicationConfig struct {
 	AllowRemoveLeader       bool              `toml:"allow-remove-leader"`
 	ShardCapacityBytes      typeutil.ByteSize `toml:"shard-capacity-bytes"`
 	ShardSplitCheckBytes    typeutil.ByteSize `toml:"shard-split-check-bytes"`
+	// ConsistencyCheckDuration interval to check whether the replicas of the shards are consistent
+	ConsistencyCheckDuration typeutil.Duration `toml:"consistency-check-duration"`
+	DisableConsistencyCheck  bool              `toml:"disable-consistency-check"`
+	// UnsafeRecoveryCheckDuration interval to fetch the unsafe recovery tasks of the store
+	UnsafeRecoveryCheckDuration typeutil.Duration `toml:"unsafe-recovery-check-duration"`
+	// SlowStoreLatencyThreshold the raft log append or apply slower than the threshold is
+	// treated as a timeout, the slow score of the store grows with the ratio of the timeouts.
+	SlowStoreLatencyThreshold typeutil.Duration `toml:"slow-store-latency-threshold"`
+	// LoadSplit load based split config
+	LoadSplit LoadSplitConfig `toml:"load-split"`
 }
 
 func (c *ReplicationConfig) adjust() {
@@ -203,6 +241,18 @@ func (c *ReplicationConfig) adjust() {
 		c.ShardStateCheckDuration.Duration = defaultShardStateCheckDuration
 	}
 
+	if c.ConsistencyCheckDuration.Duration == 0 {
+		c.ConsistencyCheckDuration.Duration = defaultConsistencyCheckDuration
+	}
+
+	if c.UnsafeRecoveryCheckDuration.Duration == 0 {
+		c.UnsafeRecoveryCheckDuration.Duration = defaultRecoveryCheckDuration
+	}
+
+	if c.SlowStoreLatencyThreshold.Duration == 0 {
+		c.SlowStoreLatencyThreshold.Duration = defaultSlowStoreLatencyThreshold
+	}
+
 	if c.ShardCapacityBytes == 0 {
 		c.ShardCapacityBytes = typeutil.ByteSize(defaultShardCapacityBytes)
 	}
@@ -210,6 +260,26 @@ func (c *ReplicationConfig) adjust() {
 	if c.ShardSplitCheckBytes == 0 {
 		c.ShardSplitCheckBytes = c.ShardCapacityBytes * 80 / 100
 	}
+
+	(&c.LoadSplit).adjust()
+}
+
+// LoadSplitConfig load based split config. The leader samples the keys of the requests
+// in a window, if the QPS or the read and written bytes per second of the window exceed
+// the thresholds, the shard is split by a key that balances the load of the two sides.
+// The load based split of the store is disabled if both thresholds are 0, but the leader
+// still samples the requests for a window if the prophet asks to split a hot shard.
+type LoadSplitConfig struct {
+	QPSThreshold   uint64            `toml:"qps-threshold"`
+	BytesThreshold typeutil.ByteSize `toml:"bytes-threshold"`
+	// Duration the window to sample the requests
+	Duration typeutil.Duration `toml:"duration"`
+}
+
+func (c *LoadSplitConfig) adjust() {
+	if c.Duration.Duration == 0 {
+		c.Duration.Duration = defaultLoadSplitDuration
+	}
 }
 
 // SnapshotConfig snapshot config
@@ -249,6 +319,56 @@ func (c *WorkerConfig) adjust() {
 	}
 }
 
+// CDCConfig change data capture config
+type CDCConfig struct {
+	// Groups the change events of the shards in these groups are recorded when the raft
+	// logs applied, and can be subscribed by `Store.SubscribeChanges`.
+	Groups []uint64 `toml:"groups"`
+	// GCDuration interval to remove the change events which are consumed by all the subscriptions
+	GCDuration typeutil.Duration `toml:"gc-duration"`
+	// MaxRetainedLogs max raft log entries of change events retained per shard, the older
+	// events are removed even if they are not consumed.
+	MaxRetainedLogs uint64 `toml:"max-retained-logs"`
+}
+
+func (c *CDCConfig) adjust() {
+	if c.GCDuration.Duration == 0 {
+		c.GCDuration.Duration = defaultChangeLogGCDuration
+	}
+
+	if c.MaxRetainedLogs == 0 {
+		c.MaxRetainedLogs = defaultMaxRetainedChangeLogs
+	}
+}
+
+// BackupConfig backup and restore config
+type BackupConfig struct {
+	// CheckDuration interval to fetch the backup tasks of the store from the backup job
+	CheckDuration typeutil.Duration `toml:"check-duration"`
+	// RestorePath if not empty, the cluster is bootstrapped with the shards and data in the
+	// backup of the path instead of the init shards.
+	RestorePath string `toml:"restore-path"`
+}
+
+func (c *BackupConfig) adjust() {
+	if c.CheckDuration.Duration == 0 {
+		c.CheckDuration.Duration = defaultBackupCheckDuration
+	}
+}
+
+// QuotaConfig request quota config
+type QuotaConfig struct {
+	// CheckDuration interval to fetch the request quotas of the tenants and the shard groups
+	// from the request quota job
+	CheckDuration typeutil.Duration `toml:"check-duration"`
+}
+
+func (c *QuotaConfig) adjust() {
+	if c.CheckDuration.Duration == 0 {
+		c.CheckDuration.Duration = defaultQuotaCheckDuration
+	}
+}
+
 // ShardConfig shard config
 type ShardConfig struct {
 	// SplitCheckInterval interval to check shard whether need to be split or not.
@@ -276,6 +396,10 @@ type RaftConfig struct {
 	MaxEntryBytes typeutil.ByteSize `toml:"max-entry-bytes"`
 	// SendRaftBatchSize raft message sender count
 	SendRaftBatchSize uint64 `toml:"send-raft-batch-size"`
+	// LeaseReadGroups the leader of the shards in these groups serves the linearizable reads
+	// locally while the leader lease is valid, instead of ReadIndex. The lease is derived from
+	// `election-timeout-ticks` * `tick-interval`.
+	LeaseReadGroups []uint64 `toml:"lease-read-groups"`
 	// RaftLog raft log 配置
 	RaftLog RaftLogConfig `toml:"raft-log"`
 }
@@ -350,6 +474,12 @@ func (c *RaftLogConfig) adjust(shardCapacityBytes uint64) {
 type StorageConfig struct {
 	// MetaStorage used to store raft, shards and store's metadata
 	MetaStorage storage.MetadataStorage
+	// LogStorage used to store the raft logs, the raft logs are stored in the MetaStorage
+	// if not set. The wal.Storage is a segmented write-ahead log for the raft logs. The raft
+	// logs in the MetaStorage are migrated to the LogStorage at startup, and the LogStorage
+	// is closed when the store stopped. The store refuses to start without the LogStorage
+	// after the raft logs are migrated.
+	LogStorage storage.LogStorage
 	// DataStorageFactory is a storage factory  to store application's data
 	DataStorageFactory func(group uint64, shardID uint64) storage.DataStorage
 	// DataMoveFunc move data from a storage to others
@@ -366,6 +496,9 @@ type CustomizeConfig struct {
 	CustomInitShardsFactory func() []bhmetapb.Shard
 	// CustomSnapshotManagerFactory is a factory func to create a snapshot.SnapshotManager to handle snapshot by youself.
 	CustomSnapshotManagerFactory func() snapshot.SnapshotManager
+	// CustomBackupDestinationFactory is a factory func to create the snapshot.BackupDestination of the backup path,
+	// the files are written to the local directory of the path by default.
+	CustomBackupDestinationFactory func(path string) (snapshot.BackupDestination, error)
 	// CustomTransportFactory is a factory func to create a transport.Transport to handle raft rpc by youself.
 	CustomTransportFactory func() transport.Transport
 	// CustomSnapshotDataCreateFuncFactory is factory create a func which called by cube if a snapshot need to create.
@@ -23,6 +23,14 @@ type Cfg struct {
 	Interval int    `toml:"interval"`
 	Job      string `toml:"job"`
 	Instance string `toml:"instance"`
+	// HTTPAddr if not empty, the store serves the metrics at `/metrics` of the http
+	// listener, so the prometheus can scrape the metrics without the pushgateway. The
+	// `/health` and `/ready` of the store are also served by it.
+	HTTPAddr string `toml:"http-addr"`
+	// EnablePprof serves the pprof at `/debug/pprof/` of the http listener. The pprof is not
+	// authenticated, anyone who can reach the http listener can profile the process unless
+	// the tls client-cert-auth is enabled.
+	EnablePprof bool `toml:"enable-pprof"`
 }
 
 func (c Cfg) instance() string {
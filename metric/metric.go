@@ -31,11 +31,13 @@ func init() {
 	registry.MustRegister(batchGauge)
 	registry.MustRegister(storeStorageGauge)
 	registry.MustRegister(shardCountGauge)
+	registry.MustRegister(requestQuotaGauge)
 
 	registry.MustRegister(raftReadyCounter)
 	registry.MustRegister(raftMsgsCounter)
 	registry.MustRegister(raftCommandCounter)
 	registry.MustRegister(raftAdminCommandCounter)
+	registry.MustRegister(requestQuotaRejectedCounter)
 
 	registry.MustRegister(raftLogLagHistogram)
 	registry.MustRegister(raftLogAppendDurationHistogram)
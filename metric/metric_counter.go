@@ -49,6 +49,14 @@ var (
 			Name:      "command_admin_total",
 			Help:      "Total number of admin commands processed.",
 		}, []string{"type", "status"})
+
+	requestQuotaRejectedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "matrixcube",
+			Subsystem: "raftstore",
+			Name:      "request_quota_rejected_total",
+			Help:      "Total number of requests rejected by the request quotas.",
+		}, []string{"quota", "type"})
 )
 
 // IncComandCount inc the command received
@@ -126,6 +134,21 @@ func AddRaftProposalReadIndexCount(value uint64) {
 	raftMsgsCounter.WithLabelValues("read-index").Add(float64(value))
 }
 
+// AddRaftProposalReadLeaseCount add read by leader lease
+func AddRaftProposalReadLeaseCount(value uint64) {
+	raftMsgsCounter.WithLabelValues("read-lease").Add(float64(value))
+}
+
+// AddRaftProposalReadFollowerCount add read by follower
+func AddRaftProposalReadFollowerCount(value uint64) {
+	raftMsgsCounter.WithLabelValues("read-follower").Add(float64(value))
+}
+
+// AddRaftProposalReadStaleCount add read by follower with bounded staleness
+func AddRaftProposalReadStaleCount(value uint64) {
+	raftMsgsCounter.WithLabelValues("read-stale").Add(float64(value))
+}
+
 // AddRaftProposalNormalCount add normal
 func AddRaftProposalNormalCount(value uint64) {
 	raftMsgsCounter.WithLabelValues("normal").Add(float64(value))
@@ -175,3 +198,43 @@ func AddRaftAdminCommandCompactCount(value uint64) {
 func AddRaftAdminCommandCompactSucceedCount(value uint64) {
 	raftAdminCommandCounter.WithLabelValues("compact", "succeed").Add(float64(value))
 }
+
+// AddRaftAdminCommandPrepareMergeCount admin command of prepare merge
+func AddRaftAdminCommandPrepareMergeCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("prepare-merge", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandCommitMergeCount admin command of commit merge
+func AddRaftAdminCommandCommitMergeCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("commit-merge", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandCommitMergeSucceedCount admin command of commit merge succeed
+func AddRaftAdminCommandCommitMergeSucceedCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("commit-merge", "succeed").Add(float64(value))
+}
+
+// AddRaftAdminCommandRollbackMergeCount admin command of rollback merge
+func AddRaftAdminCommandRollbackMergeCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("rollback-merge", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandComputeHashCount admin command of compute hash
+func AddRaftAdminCommandComputeHashCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("compute-hash", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandVerifyHashCount admin command of verify hash
+func AddRaftAdminCommandVerifyHashCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("verify-hash", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandVerifyHashInconsistentCount admin command of verify hash found the replica is inconsistent
+func AddRaftAdminCommandVerifyHashInconsistentCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("verify-hash", "inconsistent").Add(float64(value))
+}
+
+// IncRequestQuotaRejectedCount inc the requests rejected by the quota
+func IncRequestQuotaRejectedCount(quota, tp string) {
+	requestQuotaRejectedCounter.WithLabelValues(quota, tp).Inc()
+}
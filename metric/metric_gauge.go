@@ -49,6 +49,14 @@ var (
 			Name:      "store_storage_bytes",
 			Help:      "Size of raftstore storage.",
 		}, []string{"type"})
+
+	requestQuotaGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "matrixcube",
+			Subsystem: "raftstore",
+			Name:      "request_quota_limit",
+			Help:      "Limit of the request quotas, 0 means unlimited.",
+		}, []string{"quota", "type"})
 )
 
 // SetRaftMsgQueueMetric set send raft message queue size
@@ -102,3 +110,13 @@ func SetStorageOnStore(total uint64, free uint64) {
 	storeStorageGauge.WithLabelValues("total").Set(float64(total))
 	storeStorageGauge.WithLabelValues("free").Set(float64(free))
 }
+
+// SetRequestQuotaMetric set the limit of the request quota
+func SetRequestQuotaMetric(quota, tp string, limit uint64) {
+	requestQuotaGauge.WithLabelValues(quota, tp).Set(float64(limit))
+}
+
+// RemoveRequestQuotaMetric remove the limit of the removed request quota
+func RemoveRequestQuotaMetric(quota, tp string) {
+	requestQuotaGauge.DeleteLabelValues(quota, tp)
+}
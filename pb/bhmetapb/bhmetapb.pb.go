@@ -7,10 +7,12 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	metapb "github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	rpcpb "github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -22,7 +24,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ShardsPoolCmdType shards pool cmd
 type ShardsPoolCmdType int32
@@ -50,6 +52,163 @@ func (ShardsPoolCmdType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_75f1d28c03f69d97, []int{0}
 }
 
+// BackupState the state of the backup or the shard in the backup
+type BackupState int32
+
+const (
+	BackupState_Pending   BackupState = 0
+	BackupState_Running   BackupState = 1
+	BackupState_Succeeded BackupState = 2
+	BackupState_Failed    BackupState = 3
+)
+
+var BackupState_name = map[int32]string{
+	0: "Pending",
+	1: "Running",
+	2: "Succeeded",
+	3: "Failed",
+}
+
+var BackupState_value = map[string]int32{
+	"Pending":   0,
+	"Running":   1,
+	"Succeeded": 2,
+	"Failed":    3,
+}
+
+func (x BackupState) String() string {
+	return proto.EnumName(BackupState_name, int32(x))
+}
+
+func (BackupState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{1}
+}
+
+// BackupCmdType backup cmd type
+type BackupCmdType int32
+
+const (
+	BackupCmdType_FetchBackupTasks   BackupCmdType = 0
+	BackupCmdType_CompleteBackupTask BackupCmdType = 1
+	BackupCmdType_GetBackupProgress  BackupCmdType = 2
+)
+
+var BackupCmdType_name = map[int32]string{
+	0: "FetchBackupTasks",
+	1: "CompleteBackupTask",
+	2: "GetBackupProgress",
+}
+
+var BackupCmdType_value = map[string]int32{
+	"FetchBackupTasks":   0,
+	"CompleteBackupTask": 1,
+	"GetBackupProgress":  2,
+}
+
+func (x BackupCmdType) String() string {
+	return proto.EnumName(BackupCmdType_name, int32(x))
+}
+
+func (BackupCmdType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{2}
+}
+
+// UnsafeRecoveryState the state of the shard in the unsafe recovery
+type UnsafeRecoveryState int32
+
+const (
+	// Collecting collecting the applied index of the surviving replicas
+	UnsafeRecoveryState_Collecting UnsafeRecoveryState = 0
+	// Recovering the chosen replica is forced to be the only member of the shard
+	UnsafeRecoveryState_Recovering UnsafeRecoveryState = 1
+	UnsafeRecoveryState_Recovered  UnsafeRecoveryState = 2
+	// Unrecoverable no surviving replica can be used to recover the shard
+	UnsafeRecoveryState_Unrecoverable UnsafeRecoveryState = 3
+)
+
+var UnsafeRecoveryState_name = map[int32]string{
+	0: "Collecting",
+	1: "Recovering",
+	2: "Recovered",
+	3: "Unrecoverable",
+}
+
+var UnsafeRecoveryState_value = map[string]int32{
+	"Collecting":    0,
+	"Recovering":    1,
+	"Recovered":     2,
+	"Unrecoverable": 3,
+}
+
+func (x UnsafeRecoveryState) String() string {
+	return proto.EnumName(UnsafeRecoveryState_name, int32(x))
+}
+
+func (UnsafeRecoveryState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{3}
+}
+
+// UnsafeRecoveryCmdType unsafe recovery cmd type
+type UnsafeRecoveryCmdType int32
+
+const (
+	UnsafeRecoveryCmdType_FetchUnsafeRecoveryTasks   UnsafeRecoveryCmdType = 0
+	UnsafeRecoveryCmdType_ReportUnsafeRecoveryState  UnsafeRecoveryCmdType = 1
+	UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask UnsafeRecoveryCmdType = 2
+	UnsafeRecoveryCmdType_GetUnsafeRecoveryProgress  UnsafeRecoveryCmdType = 3
+)
+
+var UnsafeRecoveryCmdType_name = map[int32]string{
+	0: "FetchUnsafeRecoveryTasks",
+	1: "ReportUnsafeRecoveryState",
+	2: "CompleteUnsafeRecoveryTask",
+	3: "GetUnsafeRecoveryProgress",
+}
+
+var UnsafeRecoveryCmdType_value = map[string]int32{
+	"FetchUnsafeRecoveryTasks":   0,
+	"ReportUnsafeRecoveryState":  1,
+	"CompleteUnsafeRecoveryTask": 2,
+	"GetUnsafeRecoveryProgress":  3,
+}
+
+func (x UnsafeRecoveryCmdType) String() string {
+	return proto.EnumName(UnsafeRecoveryCmdType_name, int32(x))
+}
+
+func (UnsafeRecoveryCmdType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{4}
+}
+
+// RequestQuotaCmdType request quota cmd type
+type RequestQuotaCmdType int32
+
+const (
+	RequestQuotaCmdType_SetRequestQuota    RequestQuotaCmdType = 0
+	RequestQuotaCmdType_RemoveRequestQuota RequestQuotaCmdType = 1
+	RequestQuotaCmdType_GetRequestQuotas   RequestQuotaCmdType = 2
+)
+
+var RequestQuotaCmdType_name = map[int32]string{
+	0: "SetRequestQuota",
+	1: "RemoveRequestQuota",
+	2: "GetRequestQuotas",
+}
+
+var RequestQuotaCmdType_value = map[string]int32{
+	"SetRequestQuota":    0,
+	"RemoveRequestQuota": 1,
+	"GetRequestQuotas":   2,
+}
+
+func (x RequestQuotaCmdType) String() string {
+	return proto.EnumName(RequestQuotaCmdType_name, int32(x))
+}
+
+func (RequestQuotaCmdType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{5}
+}
+
 // StoreIdent store ident
 type StoreIdent struct {
 	ClusterID            uint64   `protobuf:"varint,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
@@ -73,7 +232,7 @@ func (m *StoreIdent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_StoreIdent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -129,7 +288,7 @@ func (m *Cluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Cluster.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -194,7 +353,7 @@ func (m *Shard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Shard.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -322,7 +481,7 @@ func (m *Store) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Store.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -440,7 +599,7 @@ func (m *ShardsPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_ShardsPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -492,7 +651,7 @@ func (m *ShardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_ShardPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -570,7 +729,7 @@ func (m *AllocatedShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_AllocatedShard.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -634,7 +793,7 @@ func (m *ShardsPoolCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_ShardsPoolCmd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -695,7 +854,7 @@ func (m *ShardsPoolCreateCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_ShardsPoolCreateCmd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -737,7 +896,7 @@ func (m *ShardsPoolAllocCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_ShardsPoolAllocCmd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -770,879 +929,4629 @@ func (m *ShardsPoolAllocCmd) GetPurpose() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("bhmetapb.ShardsPoolCmdType", ShardsPoolCmdType_name, ShardsPoolCmdType_value)
-	proto.RegisterType((*StoreIdent)(nil), "bhmetapb.StoreIdent")
-	proto.RegisterType((*Cluster)(nil), "bhmetapb.Cluster")
-	proto.RegisterType((*Shard)(nil), "bhmetapb.Shard")
-	proto.RegisterType((*Store)(nil), "bhmetapb.Store")
-	proto.RegisterType((*ShardsPool)(nil), "bhmetapb.ShardsPool")
-	proto.RegisterMapType((map[uint64]*ShardPool)(nil), "bhmetapb.ShardsPool.PoolsEntry")
-	proto.RegisterType((*ShardPool)(nil), "bhmetapb.ShardPool")
-	proto.RegisterType((*AllocatedShard)(nil), "bhmetapb.AllocatedShard")
-	proto.RegisterType((*ShardsPoolCmd)(nil), "bhmetapb.ShardsPoolCmd")
-	proto.RegisterType((*ShardsPoolCreateCmd)(nil), "bhmetapb.ShardsPoolCreateCmd")
-	proto.RegisterType((*ShardsPoolAllocCmd)(nil), "bhmetapb.ShardsPoolAllocCmd")
+// BackupShard the backup of a shard, the data of the shard is the range snapshot
+// created after the applied index on the container reaches the read index.
+type BackupShard struct {
+	Shard                Shard       `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard"`
+	ContainerID          uint64      `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	AppliedIndex         uint64      `protobuf:"varint,3,opt,name=appliedIndex,proto3" json:"appliedIndex,omitempty"`
+	State                BackupState `protobuf:"varint,4,opt,name=state,proto3,enum=bhmetapb.BackupState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func init() { proto.RegisterFile("bhmetapb.proto", fileDescriptor_75f1d28c03f69d97) }
+func (m *BackupShard) Reset()         { *m = BackupShard{} }
+func (m *BackupShard) String() string { return proto.CompactTextString(m) }
+func (*BackupShard) ProtoMessage()    {}
+func (*BackupShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{10}
+}
+func (m *BackupShard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupShard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupShard.Merge(m, src)
+}
+func (m *BackupShard) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupShard.DiscardUnknown(m)
+}
 
-var fileDescriptor_75f1d28c03f69d97 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0xf5, 0x67, 0x6b, 0xe4, 0x38, 0xce, 0xba, 0x31, 0x16, 0xae, 0x2b, 0x0b, 0x3c, 0xb1,
-	0x69, 0x2a, 0xb5, 0x6a, 0x03, 0x14, 0xbd, 0xd9, 0x52, 0xd0, 0x18, 0x68, 0x51, 0x63, 0x9d, 0x17,
-	0x58, 0x92, 0x23, 0x69, 0x51, 0x8a, 0xcb, 0xec, 0x2e, 0x03, 0xeb, 0x09, 0x7a, 0xef, 0x6b, 0xf4,
-	0x45, 0xd2, 0x5b, 0x6e, 0xbd, 0x05, 0xad, 0x9f, 0x24, 0xd8, 0x25, 0x29, 0x51, 0xb6, 0x72, 0x11,
-	0xe6, 0x9b, 0xf9, 0x66, 0x96, 0xf3, 0xcd, 0xee, 0x08, 0x0e, 0xc3, 0xc5, 0x12, 0x0d, 0xcf, 0xc2,
-	0x61, 0xa6, 0xa4, 0x91, 0x64, 0xbf, 0xc2, 0xa7, 0xdf, 0xce, 0x85, 0x59, 0xe4, 0xe1, 0x30, 0x92,
-	0xcb, 0xd1, 0x5c, 0xce, 0xe5, 0xc8, 0x11, 0xc2, 0x7c, 0xe6, 0x90, 0x03, 0xce, 0x2a, 0x12, 0x4f,
-	0x7f, 0xad, 0xd1, 0x97, 0xdc, 0x28, 0x71, 0x2b, 0x95, 0x98, 0x8b, 0xb4, 0x04, 0x51, 0x1e, 0xe2,
-	0x28, 0x92, 0xcb, 0x4c, 0xa6, 0x98, 0x1a, 0x6d, 0x8b, 0x65, 0x0b, 0x34, 0xa3, 0x2c, 0x1c, 0x15,
-	0xe7, 0x8d, 0xea, 0x9f, 0xe1, 0x4f, 0x01, 0x6e, 0x8c, 0x54, 0x78, 0x15, 0x63, 0x6a, 0xc8, 0x19,
-	0x74, 0xa3, 0x24, 0xd7, 0x06, 0xd5, 0xd5, 0x94, 0x7a, 0x03, 0x2f, 0x68, 0xb1, 0x8d, 0x83, 0x50,
-	0xd8, 0xd3, 0x8e, 0x3b, 0xa5, 0x0d, 0x17, 0xab, 0xa0, 0x3f, 0x81, 0xbd, 0x49, 0x41, 0x23, 0x27,
-	0xd0, 0x10, 0x71, 0x91, 0x7b, 0xd9, 0xb9, 0xfb, 0x78, 0xde, 0xb8, 0x9a, 0xb2, 0x86, 0x88, 0xc9,
-	0x00, 0x7a, 0x4b, 0x7e, 0xcb, 0x30, 0x4b, 0x44, 0xc4, 0xb5, 0x2b, 0xf0, 0x98, 0xd5, 0x5d, 0xfe,
-	0xbf, 0x0d, 0x68, 0xdf, 0x2c, 0xb8, 0x8a, 0x3f, 0x5b, 0xe3, 0x0b, 0x68, 0x6b, 0xc3, 0x95, 0x71,
-	0xd9, 0x07, 0xac, 0x00, 0xe4, 0x08, 0x9a, 0x98, 0xc6, 0xb4, 0xe9, 0x7c, 0xd6, 0x24, 0xdf, 0x43,
-	0x1b, 0x33, 0x19, 0x2d, 0x68, 0x6b, 0xe0, 0x05, 0xbd, 0xf1, 0xb3, 0x61, 0xd9, 0x32, 0x43, 0x2d,
-	0x73, 0x15, 0xe1, 0x2b, 0x1b, 0xbc, 0x6c, 0xbd, 0xff, 0x78, 0xfe, 0x88, 0x15, 0x4c, 0xf2, 0x8d,
-	0x2b, 0x6d, 0x90, 0xb6, 0x07, 0x5e, 0x70, 0xf8, 0x30, 0xe5, 0xc6, 0x06, 0x59, 0xc1, 0x21, 0x01,
-	0xb4, 0x33, 0x44, 0xa5, 0x69, 0x67, 0xd0, 0x0c, 0x7a, 0xe3, 0x83, 0x8a, 0x7c, 0x8d, 0xa8, 0xaa,
-	0xb2, 0x8e, 0x40, 0x7c, 0x38, 0x88, 0x85, 0xe6, 0x61, 0x82, 0x37, 0x59, 0x22, 0x0c, 0xdd, 0x1b,
-	0x78, 0xc1, 0x3e, 0xdb, 0xf2, 0xd9, 0xae, 0xe6, 0x4a, 0xe6, 0x19, 0xdd, 0x77, 0xa2, 0x16, 0x80,
-	0x9c, 0x40, 0x27, 0x4f, 0xc5, 0xdb, 0x1c, 0x29, 0x0c, 0xbc, 0xa0, 0xcb, 0x4a, 0x44, 0xfa, 0x00,
-	0x2a, 0x4f, 0xf0, 0x17, 0x4b, 0xd2, 0xb4, 0x37, 0x68, 0x06, 0x5d, 0x56, 0xf3, 0x10, 0x02, 0xad,
-	0x98, 0x1b, 0x4e, 0x0f, 0x9c, 0x1c, 0xce, 0xf6, 0xff, 0x6c, 0x42, 0xdb, 0x4d, 0xf9, 0xb3, 0xca,
-	0x9e, 0xc2, 0xbe, 0xe2, 0x33, 0x73, 0x11, 0xc7, 0xca, 0x89, 0xdb, 0x65, 0x6b, 0x6c, 0x4f, 0x8c,
-	0x12, 0x81, 0x69, 0x11, 0x6d, 0xba, 0x68, 0xcd, 0x43, 0x9e, 0x43, 0x27, 0xe1, 0x21, 0x26, 0x9a,
-	0xb6, 0xee, 0xc9, 0xc1, 0x45, 0x25, 0x47, 0xc9, 0x20, 0x2f, 0xb6, 0x65, 0x3e, 0xa9, 0xa8, 0x13,
-	0x99, 0x1a, 0x2e, 0x52, 0x54, 0x5b, 0x3a, 0x9f, 0x41, 0xd7, 0x8d, 0xf8, 0x8d, 0x58, 0x22, 0xed,
-	0x0c, 0xbc, 0xa0, 0xc9, 0x36, 0x0e, 0xf2, 0x02, 0x9e, 0x26, 0x5c, 0x9b, 0xd7, 0xc8, 0x95, 0x09,
-	0x91, 0x17, 0xac, 0x3d, 0xc7, 0x7a, 0x18, 0xb0, 0x97, 0xf7, 0x1d, 0x2a, 0x2d, 0x64, 0xea, 0x74,
-	0xee, 0xb2, 0x0a, 0xda, 0xc8, 0x5c, 0x98, 0xd7, 0x5c, 0x2f, 0x68, 0xb7, 0x88, 0x94, 0xd0, 0x76,
-	0x1e, 0x63, 0x96, 0xc8, 0xd5, 0x35, 0x37, 0x8b, 0x72, 0x0e, 0x35, 0x0f, 0xf9, 0x0e, 0x8e, 0xb3,
-	0xc5, 0x4a, 0x8b, 0x88, 0x27, 0xc9, 0x6a, 0x8a, 0xda, 0x28, 0xb9, 0xc2, 0x98, 0xf6, 0xdc, 0x90,
-	0x77, 0x85, 0xfc, 0xbf, 0x3c, 0x00, 0x77, 0xc7, 0xf5, 0xb5, 0x94, 0x09, 0x79, 0x09, 0xed, 0x4c,
-	0xca, 0x44, 0x53, 0xcf, 0x29, 0x77, 0x3e, 0x5c, 0x2f, 0x89, 0x0d, 0x69, 0x68, 0x7f, 0xf4, 0xab,
-	0xd4, 0xa8, 0x15, 0x2b, 0xd8, 0xa7, 0xbf, 0x01, 0x6c, 0x9c, 0xf6, 0xfe, 0xff, 0x81, 0xab, 0xf2,
-	0xb9, 0x5a, 0x93, 0x7c, 0x0d, 0xed, 0x77, 0x3c, 0xc9, 0xd1, 0x8d, 0xb2, 0x37, 0x3e, 0xbe, 0x57,
-	0xd6, 0xe6, 0xb2, 0x82, 0xf1, 0x73, 0xe3, 0x27, 0xcf, 0xff, 0xc7, 0x83, 0xee, 0x3a, 0x60, 0xaf,
-	0x42, 0xc4, 0x33, 0x1e, 0x09, 0x53, 0xd5, 0x5c, 0x63, 0xfb, 0x88, 0x15, 0x4f, 0xe7, 0x78, 0xad,
-	0x70, 0x26, 0x6e, 0xcb, 0x67, 0x58, 0x77, 0x91, 0x4b, 0x78, 0xc2, 0x93, 0x44, 0x46, 0xdc, 0x60,
-	0x5c, 0xf4, 0x40, 0x9b, 0xae, 0x37, 0xba, 0xf9, 0x88, 0x8b, 0x2d, 0x02, 0xbb, 0x9f, 0x60, 0x1b,
-	0xd2, 0xf8, 0xd6, 0x3d, 0xde, 0x16, 0xb3, 0x26, 0x09, 0x6a, 0x55, 0x7f, 0x9f, 0xcd, 0x34, 0x1a,
-	0x77, 0x81, 0x5a, 0xec, 0xbe, 0xdb, 0x9f, 0xc1, 0xe1, 0x76, 0x79, 0xb7, 0xb5, 0xac, 0xb1, 0xde,
-	0x68, 0x15, 0xb4, 0xdd, 0xac, 0xd3, 0x2f, 0x4c, 0xb9, 0xd3, 0xea, 0x2e, 0x9b, 0x9b, 0xe5, 0x2a,
-	0x93, 0x1a, 0xcb, 0xf5, 0x52, 0x41, 0xff, 0x6f, 0x0f, 0x1e, 0x6f, 0x66, 0x34, 0x59, 0xc6, 0x64,
-	0x04, 0x2d, 0xb3, 0xca, 0xd0, 0x1d, 0x72, 0x38, 0xfe, 0x72, 0xd7, 0x28, 0x27, 0xcb, 0xf8, 0xcd,
-	0x2a, 0x43, 0xe6, 0x88, 0xe4, 0x25, 0x74, 0x22, 0x85, 0xf6, 0x31, 0x14, 0x63, 0xfa, 0x6a, 0x67,
-	0x8a, 0x63, 0x4c, 0x96, 0x31, 0x2b, 0xc9, 0x64, 0x0c, 0x6d, 0xf7, 0x89, 0xee, 0x8b, 0x7a, 0xe3,
-	0xb3, 0x5d, 0x59, 0x4e, 0x02, 0x9b, 0x54, 0x50, 0xfd, 0x67, 0x70, 0xbc, 0xa3, 0xa4, 0x3f, 0x05,
-	0xf2, 0x30, 0x67, 0xb3, 0x8f, 0xbc, 0xfa, 0x3e, 0xaa, 0x49, 0xd1, 0xd8, 0x92, 0xe2, 0xf9, 0x8f,
-	0xf0, 0xf4, 0x41, 0x8b, 0xe4, 0x09, 0xf4, 0x8a, 0x73, 0x5c, 0xe8, 0xe8, 0x11, 0x39, 0x04, 0x70,
-	0x27, 0x14, 0xd8, 0xbb, 0x3c, 0xfa, 0xf0, 0x7f, 0xdf, 0x7b, 0x7f, 0xd7, 0xf7, 0x3e, 0xdc, 0xf5,
-	0xbd, 0xff, 0xee, 0xfa, 0x5e, 0xd8, 0x71, 0xff, 0x48, 0x3f, 0x7c, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0xa0, 0x57, 0xc3, 0x2a, 0x07, 0x00, 0x00,
+var xxx_messageInfo_BackupShard proto.InternalMessageInfo
+
+func (m *BackupShard) GetShard() Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return Shard{}
 }
 
-func (m *StoreIdent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *BackupShard) GetContainerID() uint64 {
+	if m != nil {
+		return m.ContainerID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StoreIdent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ClusterID))
+func (m *BackupShard) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
 	}
-	if m.StoreID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.StoreID))
+	return 0
+}
+
+func (m *BackupShard) GetState() BackupState {
+	if m != nil {
+		return m.State
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return BackupState_Pending
+}
+
+// BackupProgress the progress of the backup, it's the data of the backup job
+type BackupProgress struct {
+	Path   string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	State  BackupState   `protobuf:"varint,2,opt,name=state,proto3,enum=bhmetapb.BackupState" json:"state,omitempty"`
+	Shards []BackupShard `protobuf:"bytes,3,rep,name=shards,proto3" json:"shards"`
+	Error  string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// barrier the timestamp when the backup is created, every shard is snapshotted at
+	// or after a read index taken after the barrier, so all the writes acknowledged
+	// before the barrier are in the backup.
+	Barrier              uint64   `protobuf:"varint,5,opt,name=barrier,proto3" json:"barrier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupProgress) Reset()         { *m = BackupProgress{} }
+func (m *BackupProgress) String() string { return proto.CompactTextString(m) }
+func (*BackupProgress) ProtoMessage()    {}
+func (*BackupProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{11}
+}
+func (m *BackupProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *BackupProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupProgress.Merge(m, src)
+}
+func (m *BackupProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupProgress.DiscardUnknown(m)
 }
 
-func (m *Cluster) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BackupProgress proto.InternalMessageInfo
+
+func (m *BackupProgress) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+func (m *BackupProgress) GetState() BackupState {
+	if m != nil {
+		return m.State
 	}
-	if m.MaxReplicas != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.MaxReplicas))
+	return BackupState_Pending
+}
+
+func (m *BackupProgress) GetShards() []BackupShard {
+	if m != nil {
+		return m.Shards
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *BackupProgress) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Shard) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *BackupProgress) GetBarrier() uint64 {
+	if m != nil {
+		return m.Barrier
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Shard) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+// BackupMeta the metadata of a succeeded backup
+type BackupMeta struct {
+	ClusterID            uint64                `protobuf:"varint,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	Shards               []BackupShard         `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards"`
+	PlacementRules       []rpcpb.PlacementRule `protobuf:"bytes,3,rep,name=placementRules,proto3" json:"placementRules"`
+	Barrier              uint64                `protobuf:"varint,4,opt,name=barrier,proto3" json:"barrier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BackupMeta) Reset()         { *m = BackupMeta{} }
+func (m *BackupMeta) String() string { return proto.CompactTextString(m) }
+func (*BackupMeta) ProtoMessage()    {}
+func (*BackupMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{12}
+}
+func (m *BackupMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Start) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Start)))
-		i += copy(dAtA[i:], m.Start)
+}
+func (m *BackupMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupMeta.Merge(m, src)
+}
+func (m *BackupMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupMeta proto.InternalMessageInfo
+
+func (m *BackupMeta) GetClusterID() uint64 {
+	if m != nil {
+		return m.ClusterID
 	}
-	if len(m.End) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.End)))
-		i += copy(dAtA[i:], m.End)
+	return 0
+}
+
+func (m *BackupMeta) GetShards() []BackupShard {
+	if m != nil {
+		return m.Shards
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintBhmetapb(dAtA, i, uint64(m.Epoch.Size()))
-	n1, err := m.Epoch.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *BackupMeta) GetPlacementRules() []rpcpb.PlacementRule {
+	if m != nil {
+		return m.PlacementRules
 	}
-	i += n1
-	if m.State != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+	return nil
+}
+
+func (m *BackupMeta) GetBarrier() uint64 {
+	if m != nil {
+		return m.Barrier
 	}
-	if len(m.Peers) > 0 {
-		for _, msg := range m.Peers {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return 0
+}
+
+// BackupCmd backup cmd
+type BackupCmd struct {
+	Type                 BackupCmdType `protobuf:"varint,1,opt,name=type,proto3,enum=bhmetapb.BackupCmdType" json:"type,omitempty"`
+	ContainerID          uint64        `protobuf:"varint,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Shard                BackupShard   `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard"`
+	Error                string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BackupCmd) Reset()         { *m = BackupCmd{} }
+func (m *BackupCmd) String() string { return proto.CompactTextString(m) }
+func (*BackupCmd) ProtoMessage()    {}
+func (*BackupCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{13}
+}
+func (m *BackupCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DisableSplit {
-		dAtA[i] = 0x38
-		i++
-		if m.DisableSplit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Group != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
-	}
-	if len(m.Unique) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Unique)))
-		i += copy(dAtA[i:], m.Unique)
-	}
-	if len(m.RuleGroups) > 0 {
-		for _, s := range m.RuleGroups {
-			dAtA[i] = 0x5a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *BackupCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupCmd.Merge(m, src)
+}
+func (m *BackupCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupCmd.DiscardUnknown(m)
 }
 
-func (m *Store) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BackupCmd proto.InternalMessageInfo
+
+func (m *BackupCmd) GetType() BackupCmdType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return BackupCmdType_FetchBackupTasks
 }
 
-func (m *Store) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+func (m *BackupCmd) GetContainerID() uint64 {
+	if m != nil {
+		return m.ContainerID
 	}
-	if len(m.RaftAddr) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RaftAddr)))
-		i += copy(dAtA[i:], m.RaftAddr)
+	return 0
+}
+
+func (m *BackupCmd) GetShard() BackupShard {
+	if m != nil {
+		return m.Shard
 	}
-	if len(m.ClientAddr) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.ClientAddr)))
-		i += copy(dAtA[i:], m.ClientAddr)
+	return BackupShard{}
+}
+
+func (m *BackupCmd) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Labels) > 0 {
-		for _, msg := range m.Labels {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return ""
+}
+
+// BackupTasks the shards need to backup on the container
+type BackupTasks struct {
+	Path                 string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Shards               []BackupShard `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BackupTasks) Reset()         { *m = BackupTasks{} }
+func (m *BackupTasks) String() string { return proto.CompactTextString(m) }
+func (*BackupTasks) ProtoMessage()    {}
+func (*BackupTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{14}
+}
+func (m *BackupTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
-	}
-	if m.StartTime != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.StartTime))
-	}
-	if m.LastHeartbeatTime != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.LastHeartbeatTime))
-	}
-	if len(m.Version) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
-	}
-	if len(m.GitHash) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.GitHash)))
-		i += copy(dAtA[i:], m.GitHash)
+}
+func (m *BackupTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupTasks.Merge(m, src)
+}
+func (m *BackupTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupTasks proto.InternalMessageInfo
+
+func (m *BackupTasks) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if len(m.DeployPath) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.DeployPath)))
-		i += copy(dAtA[i:], m.DeployPath)
+	return ""
+}
+
+func (m *BackupTasks) GetShards() []BackupShard {
+	if m != nil {
+		return m.Shards
 	}
-	if m.PhysicallyDestroyed {
-		dAtA[i] = 0x58
-		i++
-		if m.PhysicallyDestroyed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// UnsafeRecoveryReport the state of a surviving replica
+type UnsafeRecoveryReport struct {
+	ContainerID          uint64   `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	AppliedIndex         uint64   `protobuf:"varint,2,opt,name=appliedIndex,proto3" json:"appliedIndex,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	LastTerm             uint64   `protobuf:"varint,4,opt,name=lastTerm,proto3" json:"lastTerm,omitempty"`
+	LastIndex            uint64   `protobuf:"varint,5,opt,name=lastIndex,proto3" json:"lastIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsafeRecoveryReport) Reset()         { *m = UnsafeRecoveryReport{} }
+func (m *UnsafeRecoveryReport) String() string { return proto.CompactTextString(m) }
+func (*UnsafeRecoveryReport) ProtoMessage()    {}
+func (*UnsafeRecoveryReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{15}
+}
+func (m *UnsafeRecoveryReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsafeRecoveryReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsafeRecoveryReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *UnsafeRecoveryReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsafeRecoveryReport.Merge(m, src)
+}
+func (m *UnsafeRecoveryReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsafeRecoveryReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsafeRecoveryReport.DiscardUnknown(m)
 }
 
-func (m *ShardsPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UnsafeRecoveryReport proto.InternalMessageInfo
+
+func (m *UnsafeRecoveryReport) GetContainerID() uint64 {
+	if m != nil {
+		return m.ContainerID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ShardsPool) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for k, _ := range m.Pools {
-			dAtA[i] = 0xa
-			i++
-			v := m.Pools[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovBhmetapb(uint64(msgSize))
-			}
-			mapSize := 1 + sovBhmetapb(uint64(k)) + msgSize
-			i = encodeVarintBhmetapb(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintBhmetapb(dAtA, i, uint64(k))
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintBhmetapb(dAtA, i, uint64(v.Size()))
-				n2, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n2
-			}
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *UnsafeRecoveryReport) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ShardPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UnsafeRecoveryReport) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ShardPool) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Capacity != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Capacity))
+func (m *UnsafeRecoveryReport) GetLastTerm() uint64 {
+	if m != nil {
+		return m.LastTerm
 	}
-	if len(m.RangePrefix) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RangePrefix)))
-		i += copy(dAtA[i:], m.RangePrefix)
+	return 0
+}
+
+func (m *UnsafeRecoveryReport) GetLastIndex() uint64 {
+	if m != nil {
+		return m.LastIndex
 	}
-	if len(m.AllocatedShards) > 0 {
-		for _, msg := range m.AllocatedShards {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return 0
+}
+
+// UnsafeRecoveryShard the shard which lost the raft majority
+type UnsafeRecoveryShard struct {
+	Shard                Shard                  `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard"`
+	LostPeers            []metapb.Peer          `protobuf:"bytes,2,rep,name=lostPeers,proto3" json:"lostPeers"`
+	Reports              []UnsafeRecoveryReport `protobuf:"bytes,3,rep,name=reports,proto3" json:"reports"`
+	State                UnsafeRecoveryState    `protobuf:"varint,4,opt,name=state,proto3,enum=bhmetapb.UnsafeRecoveryState" json:"state,omitempty"`
+	ChosenContainerID    uint64                 `protobuf:"varint,5,opt,name=chosenContainerID,proto3" json:"chosenContainerID,omitempty"`
+	Error                string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UnsafeRecoveryShard) Reset()         { *m = UnsafeRecoveryShard{} }
+func (m *UnsafeRecoveryShard) String() string { return proto.CompactTextString(m) }
+func (*UnsafeRecoveryShard) ProtoMessage()    {}
+func (*UnsafeRecoveryShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{16}
+}
+func (m *UnsafeRecoveryShard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsafeRecoveryShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsafeRecoveryShard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Seq != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Seq))
-	}
-	if m.AllocatedOffset != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AllocatedOffset))
+}
+func (m *UnsafeRecoveryShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsafeRecoveryShard.Merge(m, src)
+}
+func (m *UnsafeRecoveryShard) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsafeRecoveryShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsafeRecoveryShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsafeRecoveryShard proto.InternalMessageInfo
+
+func (m *UnsafeRecoveryShard) GetShard() Shard {
+	if m != nil {
+		return m.Shard
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return Shard{}
+}
+
+func (m *UnsafeRecoveryShard) GetLostPeers() []metapb.Peer {
+	if m != nil {
+		return m.LostPeers
 	}
-	return i, nil
+	return nil
 }
 
-func (m *AllocatedShard) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UnsafeRecoveryShard) GetReports() []UnsafeRecoveryReport {
+	if m != nil {
+		return m.Reports
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AllocatedShard) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ShardID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ShardID))
+func (m *UnsafeRecoveryShard) GetState() UnsafeRecoveryState {
+	if m != nil {
+		return m.State
 	}
-	if m.AllocatedAt != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AllocatedAt))
+	return UnsafeRecoveryState_Collecting
+}
+
+func (m *UnsafeRecoveryShard) GetChosenContainerID() uint64 {
+	if m != nil {
+		return m.ChosenContainerID
 	}
-	if len(m.Purpose) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Purpose)))
-		i += copy(dAtA[i:], m.Purpose)
+	return 0
+}
+
+func (m *UnsafeRecoveryShard) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+// UnsafeRecoveryProgress the progress of the unsafe recovery, it's the data of the
+// unsafe recovery job.
+type UnsafeRecoveryProgress struct {
+	FailedContainers     []uint64              `protobuf:"varint,1,rep,packed,name=failedContainers,proto3" json:"failedContainers,omitempty"`
+	Shards               []UnsafeRecoveryShard `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards"`
+	Completed            bool                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UnsafeRecoveryProgress) Reset()         { *m = UnsafeRecoveryProgress{} }
+func (m *UnsafeRecoveryProgress) String() string { return proto.CompactTextString(m) }
+func (*UnsafeRecoveryProgress) ProtoMessage()    {}
+func (*UnsafeRecoveryProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{17}
+}
+func (m *UnsafeRecoveryProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsafeRecoveryProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsafeRecoveryProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *UnsafeRecoveryProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsafeRecoveryProgress.Merge(m, src)
+}
+func (m *UnsafeRecoveryProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsafeRecoveryProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsafeRecoveryProgress.DiscardUnknown(m)
 }
 
-func (m *ShardsPoolCmd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UnsafeRecoveryProgress proto.InternalMessageInfo
+
+func (m *UnsafeRecoveryProgress) GetFailedContainers() []uint64 {
+	if m != nil {
+		return m.FailedContainers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ShardsPoolCmd) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Type))
+func (m *UnsafeRecoveryProgress) GetShards() []UnsafeRecoveryShard {
+	if m != nil {
+		return m.Shards
 	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Create.Size()))
-		n3, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	return nil
+}
+
+func (m *UnsafeRecoveryProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	if m.Alloc != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Alloc.Size()))
-		n4, err := m.Alloc.MarshalTo(dAtA[i:])
+	return false
+}
+
+// UnsafeRecoveryCmd unsafe recovery cmd
+type UnsafeRecoveryCmd struct {
+	Type                 UnsafeRecoveryCmdType `protobuf:"varint,1,opt,name=type,proto3,enum=bhmetapb.UnsafeRecoveryCmdType" json:"type,omitempty"`
+	ShardID              uint64                `protobuf:"varint,2,opt,name=shardID,proto3" json:"shardID,omitempty"`
+	Report               UnsafeRecoveryReport  `protobuf:"bytes,3,opt,name=report,proto3" json:"report"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UnsafeRecoveryCmd) Reset()         { *m = UnsafeRecoveryCmd{} }
+func (m *UnsafeRecoveryCmd) String() string { return proto.CompactTextString(m) }
+func (*UnsafeRecoveryCmd) ProtoMessage()    {}
+func (*UnsafeRecoveryCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{18}
+}
+func (m *UnsafeRecoveryCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsafeRecoveryCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsafeRecoveryCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *UnsafeRecoveryCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsafeRecoveryCmd.Merge(m, src)
+}
+func (m *UnsafeRecoveryCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsafeRecoveryCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsafeRecoveryCmd.DiscardUnknown(m)
 }
 
-func (m *ShardsPoolCreateCmd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UnsafeRecoveryCmd proto.InternalMessageInfo
+
+func (m *UnsafeRecoveryCmd) GetType() UnsafeRecoveryCmdType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return UnsafeRecoveryCmdType_FetchUnsafeRecoveryTasks
 }
 
-func (m *ShardsPoolCreateCmd) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *UnsafeRecoveryCmd) GetShardID() uint64 {
+	if m != nil {
+		return m.ShardID
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ShardsPoolAllocCmd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UnsafeRecoveryCmd) GetReport() UnsafeRecoveryReport {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return UnsafeRecoveryReport{}
 }
 
-func (m *ShardsPoolAllocCmd) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Group != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
-	}
-	if len(m.Purpose) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Purpose)))
-		i += copy(dAtA[i:], m.Purpose)
+// UnsafeRecoveryTasks the shards need to report or recover on the container
+type UnsafeRecoveryTasks struct {
+	Shards               []UnsafeRecoveryShard `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UnsafeRecoveryTasks) Reset()         { *m = UnsafeRecoveryTasks{} }
+func (m *UnsafeRecoveryTasks) String() string { return proto.CompactTextString(m) }
+func (*UnsafeRecoveryTasks) ProtoMessage()    {}
+func (*UnsafeRecoveryTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{19}
+}
+func (m *UnsafeRecoveryTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsafeRecoveryTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsafeRecoveryTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *UnsafeRecoveryTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsafeRecoveryTasks.Merge(m, src)
+}
+func (m *UnsafeRecoveryTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsafeRecoveryTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsafeRecoveryTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsafeRecoveryTasks proto.InternalMessageInfo
+
+func (m *UnsafeRecoveryTasks) GetShards() []UnsafeRecoveryShard {
+	if m != nil {
+		return m.Shards
 	}
-	return i, nil
+	return nil
 }
 
-func encodeVarintBhmetapb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// RequestQuota the request quota of the tenant or the shard group, the quota of the
+// tenant if the tenant is set, otherwise the quota of the group. 0 means unlimited.
+type RequestQuota struct {
+	Tenant               string   `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Group                uint64   `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
+	ReadQPS              uint64   `protobuf:"varint,3,opt,name=readQPS,proto3" json:"readQPS,omitempty"`
+	WriteQPS             uint64   `protobuf:"varint,4,opt,name=writeQPS,proto3" json:"writeQPS,omitempty"`
+	ReadBytes            uint64   `protobuf:"varint,5,opt,name=readBytes,proto3" json:"readBytes,omitempty"`
+	WriteBytes           uint64   `protobuf:"varint,6,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestQuota) Reset()         { *m = RequestQuota{} }
+func (m *RequestQuota) String() string { return proto.CompactTextString(m) }
+func (*RequestQuota) ProtoMessage()    {}
+func (*RequestQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{20}
+}
+func (m *RequestQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
 }
-func (m *StoreIdent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RequestQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestQuota.Merge(m, src)
+}
+func (m *RequestQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestQuota proto.InternalMessageInfo
+
+func (m *RequestQuota) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ClusterID))
+	return ""
+}
+
+func (m *RequestQuota) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
 	}
-	if m.StoreID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.StoreID))
+	return 0
+}
+
+func (m *RequestQuota) GetReadQPS() uint64 {
+	if m != nil {
+		return m.ReadQPS
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *RequestQuota) GetWriteQPS() uint64 {
+	if m != nil {
+		return m.WriteQPS
 	}
-	return n
+	return 0
 }
 
-func (m *Cluster) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RequestQuota) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ID))
+	return 0
+}
+
+func (m *RequestQuota) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
 	}
-	if m.MaxReplicas != 0 {
-		n += 1 + sovBhmetapb(uint64(m.MaxReplicas))
+	return 0
+}
+
+// RequestQuotas the request quotas, it's the data of the request quota job
+type RequestQuotas struct {
+	Quotas               []RequestQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RequestQuotas) Reset()         { *m = RequestQuotas{} }
+func (m *RequestQuotas) String() string { return proto.CompactTextString(m) }
+func (*RequestQuotas) ProtoMessage()    {}
+func (*RequestQuotas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{21}
+}
+func (m *RequestQuotas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestQuotas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestQuotas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *RequestQuotas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestQuotas.Merge(m, src)
+}
+func (m *RequestQuotas) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestQuotas) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestQuotas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestQuotas proto.InternalMessageInfo
+
+func (m *RequestQuotas) GetQuotas() []RequestQuota {
+	if m != nil {
+		return m.Quotas
 	}
-	return n
+	return nil
 }
 
-func (m *Shard) Size() (n int) {
-	if m == nil {
-		return 0
+// RequestQuotaCmd request quota cmd
+type RequestQuotaCmd struct {
+	Type                 RequestQuotaCmdType `protobuf:"varint,1,opt,name=type,proto3,enum=bhmetapb.RequestQuotaCmdType" json:"type,omitempty"`
+	Quota                RequestQuota        `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RequestQuotaCmd) Reset()         { *m = RequestQuotaCmd{} }
+func (m *RequestQuotaCmd) String() string { return proto.CompactTextString(m) }
+func (*RequestQuotaCmd) ProtoMessage()    {}
+func (*RequestQuotaCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{22}
+}
+func (m *RequestQuotaCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestQuotaCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestQuotaCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ID))
-	}
-	l = len(m.Start)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+}
+func (m *RequestQuotaCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestQuotaCmd.Merge(m, src)
+}
+func (m *RequestQuotaCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestQuotaCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestQuotaCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestQuotaCmd proto.InternalMessageInfo
+
+func (m *RequestQuotaCmd) GetType() RequestQuotaCmdType {
+	if m != nil {
+		return m.Type
 	}
-	l = len(m.End)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	return RequestQuotaCmdType_SetRequestQuota
+}
+
+func (m *RequestQuotaCmd) GetQuota() RequestQuota {
+	if m != nil {
+		return m.Quota
 	}
-	l = m.Epoch.Size()
-	n += 1 + l + sovBhmetapb(uint64(l))
-	if m.State != 0 {
-		n += 1 + sovBhmetapb(uint64(m.State))
+	return RequestQuota{}
+}
+
+func init() {
+	proto.RegisterEnum("bhmetapb.ShardsPoolCmdType", ShardsPoolCmdType_name, ShardsPoolCmdType_value)
+	proto.RegisterEnum("bhmetapb.BackupState", BackupState_name, BackupState_value)
+	proto.RegisterEnum("bhmetapb.BackupCmdType", BackupCmdType_name, BackupCmdType_value)
+	proto.RegisterEnum("bhmetapb.UnsafeRecoveryState", UnsafeRecoveryState_name, UnsafeRecoveryState_value)
+	proto.RegisterEnum("bhmetapb.UnsafeRecoveryCmdType", UnsafeRecoveryCmdType_name, UnsafeRecoveryCmdType_value)
+	proto.RegisterEnum("bhmetapb.RequestQuotaCmdType", RequestQuotaCmdType_name, RequestQuotaCmdType_value)
+	proto.RegisterType((*StoreIdent)(nil), "bhmetapb.StoreIdent")
+	proto.RegisterType((*Cluster)(nil), "bhmetapb.Cluster")
+	proto.RegisterType((*Shard)(nil), "bhmetapb.Shard")
+	proto.RegisterType((*Store)(nil), "bhmetapb.Store")
+	proto.RegisterType((*ShardsPool)(nil), "bhmetapb.ShardsPool")
+	proto.RegisterMapType((map[uint64]*ShardPool)(nil), "bhmetapb.ShardsPool.PoolsEntry")
+	proto.RegisterType((*ShardPool)(nil), "bhmetapb.ShardPool")
+	proto.RegisterType((*AllocatedShard)(nil), "bhmetapb.AllocatedShard")
+	proto.RegisterType((*ShardsPoolCmd)(nil), "bhmetapb.ShardsPoolCmd")
+	proto.RegisterType((*ShardsPoolCreateCmd)(nil), "bhmetapb.ShardsPoolCreateCmd")
+	proto.RegisterType((*ShardsPoolAllocCmd)(nil), "bhmetapb.ShardsPoolAllocCmd")
+	proto.RegisterType((*BackupShard)(nil), "bhmetapb.BackupShard")
+	proto.RegisterType((*BackupProgress)(nil), "bhmetapb.BackupProgress")
+	proto.RegisterType((*BackupMeta)(nil), "bhmetapb.BackupMeta")
+	proto.RegisterType((*BackupCmd)(nil), "bhmetapb.BackupCmd")
+	proto.RegisterType((*BackupTasks)(nil), "bhmetapb.BackupTasks")
+	proto.RegisterType((*UnsafeRecoveryReport)(nil), "bhmetapb.UnsafeRecoveryReport")
+	proto.RegisterType((*UnsafeRecoveryShard)(nil), "bhmetapb.UnsafeRecoveryShard")
+	proto.RegisterType((*UnsafeRecoveryProgress)(nil), "bhmetapb.UnsafeRecoveryProgress")
+	proto.RegisterType((*UnsafeRecoveryCmd)(nil), "bhmetapb.UnsafeRecoveryCmd")
+	proto.RegisterType((*UnsafeRecoveryTasks)(nil), "bhmetapb.UnsafeRecoveryTasks")
+	proto.RegisterType((*RequestQuota)(nil), "bhmetapb.RequestQuota")
+	proto.RegisterType((*RequestQuotas)(nil), "bhmetapb.RequestQuotas")
+	proto.RegisterType((*RequestQuotaCmd)(nil), "bhmetapb.RequestQuotaCmd")
+}
+
+func init() { proto.RegisterFile("bhmetapb.proto", fileDescriptor_75f1d28c03f69d97) }
+
+var fileDescriptor_75f1d28c03f69d97 = []byte{
+	// 1685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x6f, 0x23, 0x49,
+	0x11, 0x4f, 0x8f, 0xff, 0x24, 0x2e, 0x27, 0x8e, 0xd3, 0xd9, 0x0d, 0x43, 0xd8, 0x4d, 0xac, 0x91,
+	0x90, 0x4c, 0x6e, 0x49, 0x6e, 0xb3, 0x77, 0x12, 0x02, 0x84, 0xb4, 0x76, 0xf6, 0xf6, 0x16, 0x71,
+	0xc2, 0xd7, 0xc9, 0x71, 0xbc, 0xb6, 0x67, 0x2a, 0xf6, 0x68, 0xc7, 0x33, 0xb3, 0x3d, 0x3d, 0x4b,
+	0xfc, 0x09, 0x78, 0x87, 0x0f, 0x00, 0x12, 0x8f, 0x70, 0xaf, 0xbc, 0xf1, 0x7e, 0x88, 0x97, 0x7b,
+	0xe3, 0x6d, 0x05, 0xf9, 0x24, 0xa8, 0xbb, 0x67, 0x3c, 0x33, 0xb6, 0x73, 0x39, 0xf6, 0xc5, 0xea,
+	0xaa, 0xfa, 0x55, 0x4d, 0x75, 0x75, 0xfd, 0xaa, 0x3b, 0x81, 0xce, 0x78, 0x3a, 0x43, 0xc9, 0xe3,
+	0xf1, 0x69, 0x2c, 0x22, 0x19, 0xd1, 0xad, 0x5c, 0x3e, 0xfc, 0xf1, 0xc4, 0x97, 0xd3, 0x74, 0x7c,
+	0xea, 0x46, 0xb3, 0xb3, 0x49, 0x34, 0x89, 0xce, 0x34, 0x60, 0x9c, 0x5e, 0x6b, 0x49, 0x0b, 0x7a,
+	0x65, 0x1c, 0x0f, 0x7f, 0x55, 0x82, 0xcf, 0xb8, 0x14, 0xfe, 0x4d, 0x24, 0xfc, 0x89, 0x1f, 0x66,
+	0x82, 0x9b, 0x8e, 0xf1, 0xcc, 0x8d, 0x66, 0x71, 0x14, 0x62, 0x28, 0x13, 0x15, 0x2c, 0x9e, 0xa2,
+	0x3c, 0x8b, 0xc7, 0x67, 0xe6, 0x7b, 0x67, 0xe5, 0x34, 0x0e, 0x7f, 0xf9, 0xde, 0xd1, 0x44, 0xec,
+	0xe6, 0xbf, 0x26, 0x96, 0x73, 0x01, 0x70, 0x29, 0x23, 0x81, 0xaf, 0x3c, 0x0c, 0x25, 0x7d, 0x04,
+	0x2d, 0x37, 0x48, 0x13, 0x89, 0xe2, 0xd5, 0x85, 0x4d, 0x7a, 0xa4, 0x5f, 0x67, 0x85, 0x82, 0xda,
+	0xb0, 0x99, 0x68, 0xec, 0x85, 0x6d, 0x69, 0x5b, 0x2e, 0x3a, 0x43, 0xd8, 0x1c, 0x1a, 0x18, 0x3d,
+	0x00, 0xcb, 0xf7, 0x8c, 0xef, 0xa0, 0x79, 0xfb, 0xee, 0xd8, 0x7a, 0x75, 0xc1, 0x2c, 0xdf, 0xa3,
+	0x3d, 0x68, 0xcf, 0xf8, 0x0d, 0xc3, 0x38, 0xf0, 0x5d, 0x9e, 0xe8, 0x00, 0x3b, 0xac, 0xac, 0x72,
+	0xfe, 0x6d, 0x41, 0xe3, 0x72, 0xca, 0x85, 0x77, 0x67, 0x8c, 0x07, 0xd0, 0x48, 0x24, 0x17, 0x52,
+	0x7b, 0x6f, 0x33, 0x23, 0xd0, 0x2e, 0xd4, 0x30, 0xf4, 0xec, 0x9a, 0xd6, 0xa9, 0x25, 0x7d, 0x0a,
+	0x0d, 0x8c, 0x23, 0x77, 0x6a, 0xd7, 0x7b, 0xa4, 0xdf, 0x3e, 0x7f, 0x78, 0x9a, 0x95, 0x8f, 0x61,
+	0x12, 0xa5, 0xc2, 0xc5, 0x17, 0xca, 0x38, 0xa8, 0x7f, 0xfd, 0xee, 0x78, 0x83, 0x19, 0x24, 0xfd,
+	0x40, 0x87, 0x96, 0x68, 0x37, 0x7a, 0xa4, 0xdf, 0x59, 0x75, 0xb9, 0x54, 0x46, 0x66, 0x30, 0xb4,
+	0x0f, 0x8d, 0x18, 0x51, 0x24, 0x76, 0xb3, 0x57, 0xeb, 0xb7, 0xcf, 0xb7, 0x73, 0xf0, 0x08, 0x51,
+	0xe4, 0x61, 0x35, 0x80, 0x3a, 0xb0, 0xed, 0xf9, 0x09, 0x1f, 0x07, 0x78, 0x19, 0x07, 0xbe, 0xb4,
+	0x37, 0x7b, 0xa4, 0xbf, 0xc5, 0x2a, 0x3a, 0xb5, 0xab, 0x89, 0x88, 0xd2, 0xd8, 0xde, 0xd2, 0x45,
+	0x35, 0x02, 0x3d, 0x80, 0x66, 0x1a, 0xfa, 0x6f, 0x52, 0xb4, 0xa1, 0x47, 0xfa, 0x2d, 0x96, 0x49,
+	0xf4, 0x08, 0x40, 0xa4, 0x01, 0xbe, 0x54, 0xa0, 0xc4, 0x6e, 0xf7, 0x6a, 0xfd, 0x16, 0x2b, 0x69,
+	0x28, 0x85, 0xba, 0xc7, 0x25, 0xb7, 0xb7, 0x75, 0x39, 0xf4, 0xda, 0xf9, 0x7d, 0x0d, 0x1a, 0xfa,
+	0x94, 0xef, 0xac, 0xec, 0x21, 0x6c, 0x09, 0x7e, 0x2d, 0x9f, 0x7b, 0x9e, 0xd0, 0xc5, 0x6d, 0xb1,
+	0x85, 0xac, 0xbe, 0xe8, 0x06, 0x3e, 0x86, 0xc6, 0x5a, 0xd3, 0xd6, 0x92, 0x86, 0x9e, 0x40, 0x33,
+	0xe0, 0x63, 0x0c, 0x12, 0xbb, 0xbe, 0x54, 0x0e, 0xee, 0xe7, 0xe5, 0xc8, 0x10, 0xf4, 0x49, 0xb5,
+	0xcc, 0x07, 0x39, 0x74, 0x18, 0x85, 0x92, 0xfb, 0x21, 0x8a, 0x4a, 0x9d, 0x1f, 0x41, 0x4b, 0x1f,
+	0xf1, 0x95, 0x3f, 0x43, 0xbb, 0xd9, 0x23, 0xfd, 0x1a, 0x2b, 0x14, 0xf4, 0x09, 0xec, 0x05, 0x3c,
+	0x91, 0x9f, 0x22, 0x17, 0x72, 0x8c, 0xdc, 0xa0, 0x36, 0x35, 0x6a, 0xd5, 0xa0, 0x9a, 0xf7, 0x2d,
+	0x8a, 0xc4, 0x8f, 0x42, 0x5d, 0xe7, 0x16, 0xcb, 0x45, 0x65, 0x99, 0xf8, 0xf2, 0x53, 0x9e, 0x4c,
+	0xed, 0x96, 0xb1, 0x64, 0xa2, 0xda, 0xb9, 0x87, 0x71, 0x10, 0xcd, 0x47, 0x5c, 0x4e, 0xb3, 0x73,
+	0x28, 0x69, 0xe8, 0x87, 0xb0, 0x1f, 0x4f, 0xe7, 0x89, 0xef, 0xf2, 0x20, 0x98, 0x5f, 0x60, 0x22,
+	0x45, 0x34, 0x47, 0xcf, 0x6e, 0xeb, 0x43, 0x5e, 0x67, 0x72, 0xfe, 0x40, 0x00, 0x74, 0x8f, 0x27,
+	0xa3, 0x28, 0x0a, 0xe8, 0xc7, 0xd0, 0x88, 0xa3, 0x28, 0x48, 0x6c, 0xa2, 0x2b, 0x77, 0x7c, 0xba,
+	0x18, 0x38, 0x05, 0xe8, 0x54, 0xfd, 0x24, 0x2f, 0x42, 0x29, 0xe6, 0xcc, 0xa0, 0x0f, 0x3f, 0x03,
+	0x28, 0x94, 0xaa, 0xff, 0x5f, 0xe3, 0x3c, 0xa3, 0xab, 0x5a, 0xd2, 0x1f, 0x41, 0xe3, 0x2d, 0x0f,
+	0x52, 0xd4, 0x47, 0xd9, 0x3e, 0xdf, 0x5f, 0x0a, 0xab, 0x7c, 0x99, 0x41, 0xfc, 0xd4, 0xfa, 0x09,
+	0x71, 0xfe, 0x49, 0xa0, 0xb5, 0x30, 0xa8, 0x56, 0x70, 0x79, 0xcc, 0x5d, 0x5f, 0xe6, 0x31, 0x17,
+	0xb2, 0x22, 0xb1, 0xe0, 0xe1, 0x04, 0x47, 0x02, 0xaf, 0xfd, 0x9b, 0x8c, 0x86, 0x65, 0x15, 0x1d,
+	0xc0, 0x2e, 0x0f, 0x82, 0xc8, 0xe5, 0x12, 0x3d, 0xb3, 0x07, 0xbb, 0xa6, 0xf7, 0x66, 0x17, 0x49,
+	0x3c, 0xaf, 0x00, 0xd8, 0xb2, 0x83, 0xda, 0x50, 0x82, 0x6f, 0x34, 0x79, 0xeb, 0x4c, 0x2d, 0x69,
+	0xbf, 0x14, 0xf5, 0xd7, 0xd7, 0xd7, 0x09, 0x4a, 0xdd, 0x40, 0x75, 0xb6, 0xac, 0x76, 0xae, 0xa1,
+	0x53, 0x0d, 0xaf, 0xa7, 0x96, 0x5a, 0x2c, 0x26, 0x5a, 0x2e, 0xaa, 0xdd, 0x2c, 0xdc, 0x9f, 0xcb,
+	0x6c, 0xa6, 0x95, 0x55, 0xca, 0x37, 0x4e, 0x45, 0x1c, 0x25, 0x98, 0x8d, 0x97, 0x5c, 0x74, 0xfe,
+	0x4a, 0x60, 0xa7, 0x38, 0xa3, 0xe1, 0xcc, 0xa3, 0x67, 0x50, 0x97, 0xf3, 0x18, 0xf5, 0x47, 0x3a,
+	0xe7, 0x3f, 0x58, 0x77, 0x94, 0xc3, 0x99, 0x77, 0x35, 0x8f, 0x91, 0x69, 0x20, 0xfd, 0x18, 0x9a,
+	0xae, 0x40, 0x45, 0x06, 0x73, 0x4c, 0x8f, 0xd7, 0xba, 0x68, 0xc4, 0x70, 0xe6, 0xb1, 0x0c, 0x4c,
+	0xcf, 0xa1, 0xa1, 0x53, 0xd4, 0x19, 0xb5, 0xcf, 0x1f, 0xad, 0xf3, 0xd2, 0x25, 0x50, 0x4e, 0x06,
+	0xea, 0x3c, 0x84, 0xfd, 0x35, 0x21, 0x9d, 0x0b, 0xa0, 0xab, 0x3e, 0xc5, 0x3c, 0x22, 0xe5, 0x79,
+	0x54, 0x2a, 0x85, 0x55, 0x2d, 0xc5, 0x57, 0x04, 0xda, 0x03, 0xee, 0xbe, 0x4e, 0x63, 0x53, 0x70,
+	0x35, 0x4a, 0xd5, 0x42, 0xfb, 0xb7, 0xcf, 0x77, 0x97, 0x12, 0xcc, 0x07, 0xa4, 0xc6, 0xa8, 0x33,
+	0x70, 0x73, 0xee, 0x2f, 0xee, 0x95, 0xb2, 0x4a, 0x8d, 0x50, 0x1e, 0xc7, 0x81, 0x8f, 0xde, 0xab,
+	0xd0, 0xc3, 0x1b, 0xbd, 0xed, 0x3a, 0xab, 0xe8, 0x8a, 0xe9, 0x5d, 0xcf, 0xa6, 0xf7, 0xe2, 0x93,
+	0x59, 0x62, 0xa5, 0xa9, 0xe2, 0xfc, 0x9d, 0x40, 0xc7, 0xa8, 0x47, 0x22, 0x9a, 0x08, 0x4c, 0xf4,
+	0xd0, 0x8c, 0x15, 0xc5, 0x89, 0xa6, 0xb8, 0x5e, 0x17, 0x31, 0xad, 0xfb, 0x63, 0xd2, 0x67, 0xd0,
+	0x4c, 0xca, 0xdd, 0xbe, 0x8a, 0x2e, 0x6d, 0x3d, 0x83, 0xaa, 0x42, 0xa3, 0x10, 0x91, 0xd0, 0x59,
+	0xb7, 0x98, 0x11, 0x54, 0xa1, 0xc7, 0x5c, 0x08, 0x1f, 0x45, 0xd6, 0xe3, 0xb9, 0xe8, 0xfc, 0x83,
+	0x00, 0x98, 0x68, 0x9f, 0xa1, 0xe4, 0xf7, 0x5c, 0xd6, 0x45, 0x46, 0xd6, 0x77, 0xcf, 0x68, 0x00,
+	0x9d, 0x38, 0xe0, 0x2e, 0xce, 0x30, 0x94, 0x2c, 0x0d, 0x30, 0xdf, 0xce, 0x83, 0x53, 0xf3, 0x66,
+	0x18, 0x95, 0x8d, 0x99, 0xef, 0x92, 0x47, 0x39, 0xff, 0x7a, 0x35, 0xff, 0xbf, 0x10, 0x68, 0x99,
+	0x6f, 0xab, 0x36, 0xfb, 0xa0, 0xc2, 0x97, 0xef, 0x2d, 0xa7, 0x57, 0xe5, 0xca, 0xfd, 0x6d, 0xf2,
+	0x34, 0xef, 0xba, 0x5a, 0x76, 0xe7, 0x7f, 0xcb, 0x76, 0xb3, 0xde, 0x5b, 0x5b, 0x7f, 0xe7, 0x37,
+	0x79, 0x37, 0x5f, 0xf1, 0xe4, 0xf5, 0xfa, 0xd6, 0x78, 0x9f, 0xda, 0x3a, 0x7f, 0x23, 0xf0, 0xe0,
+	0x8b, 0x30, 0xe1, 0xd7, 0xc8, 0xd0, 0x8d, 0xde, 0xa2, 0x98, 0x33, 0x8c, 0x23, 0x21, 0x97, 0xf7,
+	0x46, 0xee, 0xa7, 0x80, 0xb5, 0x86, 0x02, 0x8b, 0xcd, 0xd4, 0xca, 0xcd, 0x74, 0x08, 0x5b, 0xea,
+	0x2a, 0xbc, 0x42, 0x31, 0xcb, 0x4e, 0x63, 0x21, 0xab, 0xfe, 0x51, 0x6b, 0x13, 0xd2, 0xb4, 0x5a,
+	0xa1, 0x70, 0xbe, 0xb2, 0x60, 0xbf, 0x9a, 0xee, 0x7b, 0xb0, 0xfb, 0x43, 0x68, 0x05, 0x51, 0x22,
+	0x47, 0xfa, 0xb1, 0x64, 0xdd, 0xf9, 0x58, 0x2a, 0x40, 0xf4, 0x17, 0xb0, 0x29, 0x74, 0x59, 0xf2,
+	0xd6, 0x3b, 0x2a, 0x3e, 0xb0, 0xae, 0x7a, 0x59, 0x84, 0xdc, 0x89, 0x3e, 0xab, 0x4e, 0x82, 0xc7,
+	0x77, 0x79, 0x57, 0xd8, 0xfb, 0x04, 0xf6, 0xdc, 0x69, 0x94, 0x60, 0x38, 0x2c, 0x9d, 0x83, 0xa9,
+	0xc8, 0xaa, 0xa1, 0xa8, 0x74, 0xb3, 0xdc, 0x36, 0x7f, 0x22, 0x70, 0x50, 0xfd, 0xc4, 0x62, 0xba,
+	0x9c, 0x40, 0xf7, 0x9a, 0xfb, 0x01, 0x7a, 0x8b, 0x28, 0xe6, 0xc2, 0xaf, 0xb3, 0x15, 0x3d, 0xfd,
+	0xd9, 0x52, 0x6b, 0xdd, 0xbd, 0x81, 0x35, 0xf4, 0x55, 0x13, 0x21, 0x9a, 0xc5, 0x01, 0x4a, 0x34,
+	0x3c, 0xd8, 0x62, 0x85, 0xc2, 0xf9, 0x33, 0x81, 0xbd, 0x6a, 0x0c, 0x45, 0xc3, 0x67, 0x15, 0x1a,
+	0x1e, 0xdf, 0xf5, 0xb9, 0x2a, 0x1d, 0x4b, 0x77, 0xaa, 0x55, 0xbd, 0x53, 0x7f, 0x0e, 0x4d, 0x73,
+	0x14, 0x19, 0x0f, 0xbf, 0xdb, 0xf1, 0x65, 0x3e, 0x0e, 0x5b, 0xee, 0x39, 0xc3, 0xc1, 0xa2, 0x28,
+	0xe4, 0xff, 0x2e, 0x8a, 0xf3, 0x2f, 0x02, 0xdb, 0x0c, 0xdf, 0xa4, 0x98, 0xc8, 0xcf, 0xd3, 0x48,
+	0x72, 0xf5, 0xb2, 0x96, 0x18, 0xf2, 0x50, 0x66, 0x9c, 0xce, 0xa4, 0xe2, 0xde, 0xb3, 0xca, 0xf7,
+	0xde, 0x0f, 0x55, 0x43, 0x72, 0xef, 0xf3, 0xd1, 0xa5, 0xb9, 0x79, 0x06, 0xed, 0xdb, 0x77, 0xc7,
+	0x9b, 0xcc, 0xa8, 0x58, 0x6e, 0xa3, 0x7d, 0xd8, 0xfa, 0x9d, 0xf0, 0x25, 0x2a, 0x9c, 0x26, 0xda,
+	0x60, 0xfb, 0xf6, 0xdd, 0xf1, 0xd6, 0x97, 0x99, 0x8e, 0x2d, 0xac, 0xea, 0x90, 0x94, 0xd3, 0x60,
+	0x2e, 0x31, 0xc9, 0x69, 0xb7, 0x50, 0xa8, 0x27, 0xa7, 0x46, 0x1a, 0x73, 0x53, 0x9b, 0x4b, 0x1a,
+	0xe7, 0x05, 0xec, 0x94, 0x37, 0x93, 0xd0, 0x8f, 0xa0, 0xf9, 0x46, 0xaf, 0xb2, 0xda, 0x1c, 0x14,
+	0xb5, 0x29, 0x03, 0xf3, 0xa2, 0x18, 0xac, 0x73, 0x03, 0xbb, 0x65, 0xab, 0x6a, 0x84, 0xa7, 0x95,
+	0x46, 0x78, 0xbc, 0x3e, 0x4c, 0xb5, 0x0d, 0xce, 0xa1, 0xa1, 0xe3, 0x65, 0x0f, 0x98, 0x6f, 0xff,
+	0xb4, 0x81, 0x9e, 0x7c, 0x04, 0x7b, 0x2b, 0x0f, 0x22, 0xba, 0x0b, 0x6d, 0xf3, 0x2a, 0xd1, 0xa6,
+	0xee, 0x06, 0xed, 0x00, 0xe8, 0xf7, 0x88, 0x91, 0xc9, 0xc9, 0x60, 0xf1, 0xc4, 0xd0, 0x84, 0x6d,
+	0xc3, 0xe6, 0x08, 0x43, 0xcf, 0x0f, 0x27, 0xdd, 0x0d, 0x25, 0xb0, 0x34, 0x0c, 0x95, 0x40, 0xe8,
+	0x0e, 0xb4, 0x2e, 0x53, 0xd7, 0x45, 0xf4, 0xd0, 0xeb, 0x5a, 0x14, 0xa0, 0xf9, 0x89, 0xa6, 0x58,
+	0xb7, 0x76, 0x72, 0x05, 0x3b, 0x95, 0xab, 0x85, 0x3e, 0x80, 0xee, 0x27, 0x28, 0xdd, 0x69, 0x69,
+	0xdc, 0x77, 0x37, 0xe8, 0x01, 0xd0, 0x61, 0xc6, 0x99, 0xc2, 0xd0, 0x25, 0xf4, 0x21, 0xec, 0xbd,
+	0x44, 0x59, 0x7d, 0x38, 0x74, 0xad, 0x93, 0x2f, 0x57, 0xc6, 0xa4, 0xce, 0xb0, 0x03, 0x30, 0x8c,
+	0x82, 0x00, 0x5d, 0x69, 0x92, 0xec, 0x00, 0x64, 0x80, 0x45, 0x9e, 0x99, 0xac, 0xf3, 0xdc, 0x83,
+	0x9d, 0x2f, 0x42, 0x61, 0x14, 0xea, 0x2f, 0xc3, 0x6e, 0xed, 0xe4, 0x8f, 0x04, 0x1e, 0xae, 0xe5,
+	0x20, 0x7d, 0x04, 0xb6, 0xce, 0x7b, 0x0d, 0x55, 0xba, 0x1b, 0xf4, 0x31, 0x7c, 0xdf, 0x70, 0x6b,
+	0x4d, 0x5a, 0x5d, 0x42, 0x8f, 0xe0, 0x30, 0xdf, 0xde, 0xaa, 0x7f, 0xd7, 0x52, 0xee, 0x2f, 0x51,
+	0xae, 0x9f, 0x64, 0xdd, 0xda, 0xc9, 0x6f, 0x61, 0x7f, 0x4d, 0x3f, 0xd0, 0x7d, 0xd8, 0xbd, 0x44,
+	0x59, 0xb6, 0x98, 0x4a, 0x32, 0x9c, 0x45, 0x6f, 0xb1, 0xa2, 0x27, 0xaa, 0xee, 0x2f, 0xab, 0xe0,
+	0xa4, 0x6b, 0x0d, 0xba, 0xdf, 0xfc, 0xf7, 0x88, 0x7c, 0x7d, 0x7b, 0x44, 0xbe, 0xb9, 0x3d, 0x22,
+	0xff, 0xb9, 0x3d, 0x22, 0xe3, 0xa6, 0xfe, 0x17, 0xc5, 0xb3, 0xff, 0x0d, 0x00, 0x23, 0x61, 0xee,
+	0x72, 0x87, 0x11, 0x00, 0x00,
+}
+
+func (m *StoreIdent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Peers) > 0 {
-		for _, e := range m.Peers {
-			l = e.Size()
-			n += 1 + l + sovBhmetapb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *StoreIdent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreIdent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DisableSplit {
-		n += 2
+	if m.StoreID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.StoreID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Group != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Group))
+	if m.ClusterID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Unique)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Cluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RuleGroups) > 0 {
-		for _, s := range m.RuleGroups {
-			l = len(s)
-			n += 1 + l + sovBhmetapb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.MaxReplicas != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.MaxReplicas))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Store) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Shard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Shard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Shard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ID))
-	}
-	l = len(m.RaftAddr)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ClientAddr)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
-			n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.RuleGroups) > 0 {
+		for iNdEx := len(m.RuleGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RuleGroups[iNdEx])
+			copy(dAtA[i:], m.RuleGroups[iNdEx])
+			i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RuleGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if m.State != 0 {
-		n += 1 + sovBhmetapb(uint64(m.State))
+	if len(m.Unique) > 0 {
+		i -= len(m.Unique)
+		copy(dAtA[i:], m.Unique)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Unique)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.StartTime != 0 {
-		n += 1 + sovBhmetapb(uint64(m.StartTime))
+	if m.Group != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.LastHeartbeatTime != 0 {
-		n += 1 + sovBhmetapb(uint64(m.LastHeartbeatTime))
+	if m.DisableSplit {
+		i--
+		if m.DisableSplit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Peers[iNdEx].Size()
+				i -= size
+				if _, err := m.Peers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.GitHash)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.State != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.DeployPath)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhmetapb(dAtA, i, uint64(size))
 	}
-	if m.PhysicallyDestroyed {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ShardsPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for k, v := range m.Pools {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovBhmetapb(uint64(l))
-			}
-			mapEntrySize := 1 + sovBhmetapb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovBhmetapb(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Store) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ShardPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Store) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Store) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Capacity != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Capacity))
-	}
-	l = len(m.RangePrefix)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AllocatedShards) > 0 {
-		for _, e := range m.AllocatedShards {
-			l = e.Size()
-			n += 1 + l + sovBhmetapb(uint64(l))
+	if m.PhysicallyDestroyed {
+		i--
+		if m.PhysicallyDestroyed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.Seq != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Seq))
+	if len(m.DeployPath) > 0 {
+		i -= len(m.DeployPath)
+		copy(dAtA[i:], m.DeployPath)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.DeployPath)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.AllocatedOffset != 0 {
-		n += 1 + sovBhmetapb(uint64(m.AllocatedOffset))
+	if len(m.GitHash) > 0 {
+		i -= len(m.GitHash)
+		copy(dAtA[i:], m.GitHash)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.GitHash)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	if m.LastHeartbeatTime != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.LastHeartbeatTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.State != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Labels[iNdEx].Size()
+				i -= size
+				if _, err := m.Labels[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClientAddr) > 0 {
+		i -= len(m.ClientAddr)
+		copy(dAtA[i:], m.ClientAddr)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.ClientAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RaftAddr) > 0 {
+		i -= len(m.RaftAddr)
+		copy(dAtA[i:], m.RaftAddr)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RaftAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllocatedShard) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardsPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardsPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ShardID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AllocatedAt != 0 {
-		n += 1 + sovBhmetapb(uint64(m.AllocatedAt))
+	if len(m.Pools) > 0 {
+		for k := range m.Pools {
+			v := m.Pools[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintBhmetapb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintBhmetapb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.Purpose)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.AllocatedOffset != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AllocatedOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Seq != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllocatedShards) > 0 {
+		for iNdEx := len(m.AllocatedShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocatedShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RangePrefix) > 0 {
+		i -= len(m.RangePrefix)
+		copy(dAtA[i:], m.RangePrefix)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RangePrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ShardsPoolCmd) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllocatedShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocatedShard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocatedShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Type))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Alloc != nil {
-		l = m.Alloc.Size()
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.AllocatedAt != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AllocatedAt))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ShardsPoolCreateCmd) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardsPoolCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardsPoolCmd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardsPoolCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Alloc != nil {
+		{
+			size, err := m.Alloc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Create != nil {
+		{
+			size, err := m.Create.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ShardsPoolAllocCmd) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardsPoolCreateCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardsPoolCreateCmd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardsPoolCreateCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Group != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Group))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Purpose)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardsPoolAllocCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardsPoolAllocCmd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardsPoolAllocCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupShard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.State != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AppliedIndex != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Shard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Barrier != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Barrier))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.State != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Barrier != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Barrier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PlacementRules) > 0 {
+		for iNdEx := len(m.PlacementRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PlacementRules[iNdEx].Size()
+				i -= size
+				if _, err := m.PlacementRules[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupCmd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Shard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContainerID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsafeRecoveryReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeRecoveryReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsafeRecoveryReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastIndex != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.LastIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastTerm != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.LastTerm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppliedIndex != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContainerID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ContainerID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsafeRecoveryShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeRecoveryShard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsafeRecoveryShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ChosenContainerID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ChosenContainerID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.State != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LostPeers) > 0 {
+		for iNdEx := len(m.LostPeers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LostPeers[iNdEx].Size()
+				i -= size
+				if _, err := m.LostPeers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Shard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsafeRecoveryProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeRecoveryProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsafeRecoveryProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FailedContainers) > 0 {
+		dAtA9 := make([]byte, len(m.FailedContainers)*10)
+		var j8 int
+		for _, num := range m.FailedContainers {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintBhmetapb(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsafeRecoveryCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeRecoveryCmd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsafeRecoveryCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ShardID != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsafeRecoveryTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeRecoveryTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsafeRecoveryTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WriteBytes != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.WriteBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReadBytes != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WriteQPS != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.WriteQPS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadQPS != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ReadQPS))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Group != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestQuotas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestQuotas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestQuotas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestQuotaCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestQuotaCmd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestQuotaCmd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhmetapb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBhmetapb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBhmetapb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreIdent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ClusterID))
+	}
+	if m.StoreID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.StoreID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ID))
+	}
+	if m.MaxReplicas != 0 {
+		n += 1 + sovBhmetapb(uint64(m.MaxReplicas))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Shard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ID))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.DisableSplit {
+		n += 2
+	}
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	l = len(m.Unique)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.RuleGroups) > 0 {
+		for _, s := range m.RuleGroups {
+			l = len(s)
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Store) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ID))
+	}
+	l = len(m.RaftAddr)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.ClientAddr)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovBhmetapb(uint64(m.StartTime))
+	}
+	if m.LastHeartbeatTime != 0 {
+		n += 1 + sovBhmetapb(uint64(m.LastHeartbeatTime))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.GitHash)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.DeployPath)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.PhysicallyDestroyed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for k, v := range m.Pools {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovBhmetapb(uint64(l))
+			}
+			mapEntrySize := 1 + sovBhmetapb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovBhmetapb(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capacity != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Capacity))
+	}
+	l = len(m.RangePrefix)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.AllocatedShards) > 0 {
+		for _, e := range m.AllocatedShards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.Seq != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Seq))
+	}
+	if m.AllocatedOffset != 0 {
+		n += 1 + sovBhmetapb(uint64(m.AllocatedOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocatedShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ShardID))
+	}
+	if m.AllocatedAt != 0 {
+		n += 1 + sovBhmetapb(uint64(m.AllocatedAt))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Type))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Alloc != nil {
+		l = m.Alloc.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolCreateCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolAllocCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shard.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.ContainerID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ContainerID))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovBhmetapb(uint64(m.AppliedIndex))
+	}
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Barrier != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Barrier))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ClusterID))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if len(m.PlacementRules) > 0 {
+		for _, e := range m.PlacementRules {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.Barrier != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Barrier))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Type))
+	}
+	if m.ContainerID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ContainerID))
+	}
+	l = m.Shard.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnsafeRecoveryReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ContainerID))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovBhmetapb(uint64(m.AppliedIndex))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.LastTerm != 0 {
+		n += 1 + sovBhmetapb(uint64(m.LastTerm))
+	}
+	if m.LastIndex != 0 {
+		n += 1 + sovBhmetapb(uint64(m.LastIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnsafeRecoveryShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shard.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.LostPeers) > 0 {
+		for _, e := range m.LostPeers {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if m.ChosenContainerID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ChosenContainerID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnsafeRecoveryProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedContainers) > 0 {
+		l = 0
+		for _, e := range m.FailedContainers {
+			l += sovBhmetapb(uint64(e))
+		}
+		n += 1 + sovBhmetapb(uint64(l)) + l
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnsafeRecoveryCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Type))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ShardID))
+	}
+	l = m.Report.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnsafeRecoveryTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	if m.ReadQPS != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ReadQPS))
+	}
+	if m.WriteQPS != 0 {
+		n += 1 + sovBhmetapb(uint64(m.WriteQPS))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ReadBytes))
+	}
+	if m.WriteBytes != 0 {
+		n += 1 + sovBhmetapb(uint64(m.WriteBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestQuotas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestQuotaCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Type))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBhmetapb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBhmetapb(x uint64) (n int) {
+	return sovBhmetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreIdent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreIdent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreIdent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
+			}
+			m.StoreID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= metapb.ResourceState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, metapb.Peer{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSplit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSplit = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unique = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleGroups = append(m.RuleGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Store) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Store: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Store: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, metapb.Pair{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= metapb.ContainerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			m.LastHeartbeatTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeatTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicallyDestroyed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PhysicallyDestroyed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pools == nil {
+				m.Pools = make(map[uint64]*ShardPool)
+			}
+			var mapkey uint64
+			var mapvalue *ShardPool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBhmetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBhmetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBhmetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthBhmetapb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthBhmetapb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ShardPool{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBhmetapb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthBhmetapb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Pools[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangePrefix == nil {
+				m.RangePrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocatedShards = append(m.AllocatedShards, &AllocatedShard{})
+			if err := m.AllocatedShards[len(m.AllocatedShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedOffset", wireType)
+			}
+			m.AllocatedOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocatedOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocatedShard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocatedShard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAt", wireType)
+			}
+			m.AllocatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
+			if m.Purpose == nil {
+				m.Purpose = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ShardsPoolCmdType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &ShardsPoolCreateCmd{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alloc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Alloc == nil {
+				m.Alloc = &ShardsPoolAllocCmd{}
+			}
+			if err := m.Alloc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolCreateCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolCreateCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolCreateCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolAllocCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolAllocCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
+			if m.Purpose == nil {
+				m.Purpose = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovBhmetapb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozBhmetapb(x uint64) (n int) {
-	return sovBhmetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StoreIdent) Unmarshal(dAtA []byte) error {
+func (m *BackupShard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1665,17 +5574,17 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreIdent: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupShard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreIdent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupShard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.ClusterID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1685,16 +5594,30 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			m.StoreID = 0
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1704,7 +5627,45 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreID |= uint64(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= BackupState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1715,59 +5676,144 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= BackupState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Cluster) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBhmetapb
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Shards = append(m.Shards, BackupShard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1777,16 +5823,29 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Barrier", wireType)
 			}
-			m.MaxReplicas = 0
+			m.Barrier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1796,7 +5855,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxReplicas |= uint32(b&0x7F) << shift
+				m.Barrier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1826,7 +5885,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shard) Unmarshal(dAtA []byte) error {
+func (m *BackupMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1849,17 +5908,17 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shard: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
 			}
-			m.ID = 0
+			m.ClusterID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1869,16 +5928,16 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.ClusterID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1888,31 +5947,31 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
-			if m.Start == nil {
-				m.Start = []byte{}
+			m.Shards = append(m.Shards, BackupShard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementRules", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1922,31 +5981,31 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
-			if m.End == nil {
-				m.End = []byte{}
+			m.PlacementRules = append(m.PlacementRules, rpcpb.PlacementRule{})
+			if err := m.PlacementRules[len(m.PlacementRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Barrier", wireType)
 			}
-			var msglen int
+			m.Barrier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1956,49 +6015,70 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Barrier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= metapb.ResourceState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2008,31 +6088,16 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= BackupCmdType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Peers = append(m.Peers, metapb.Peer{})
-			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			var v int
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2042,17 +6107,16 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableSplit = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2062,14 +6126,28 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2090,18 +6168,72 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupTasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unique = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupTasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupTasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2129,13 +6261,13 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuleGroups = append(m.RuleGroups, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2145,24 +6277,24 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Shards = append(m.Shards, BackupShard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2190,7 +6322,7 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Store) Unmarshal(dAtA []byte) error {
+func (m *UnsafeRecoveryReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2213,17 +6345,17 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Store: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsafeRecoveryReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Store: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsafeRecoveryReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			m.ID = 0
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2233,16 +6365,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
 			}
-			var stringLen uint64
+			m.AppliedIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2252,27 +6384,14 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppliedIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2300,13 +6419,13 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientAddr = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTerm", wireType)
 			}
-			var msglen int
+			m.LastTerm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2316,31 +6435,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastTerm |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Labels = append(m.Labels, metapb.Pair{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
 			}
-			m.State = 0
+			m.LastIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2350,16 +6454,70 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= metapb.ContainerState(b&0x7F) << shift
+				m.LastIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StartTime = 0
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsafeRecoveryShard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeRecoveryShard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeRecoveryShard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2369,16 +6527,30 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
 			}
-			m.LastHeartbeatTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostPeers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2388,16 +6560,31 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeartbeatTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LostPeers = append(m.LostPeers, metapb.Peer{})
+			if err := m.LostPeers[len(m.LostPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2407,29 +6594,31 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Reports = append(m.Reports, UnsafeRecoveryReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2439,27 +6628,33 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= UnsafeRecoveryState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChosenContainerID", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChosenContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChosenContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.GitHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2487,28 +6682,8 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployPath = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhysicallyDestroyed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PhysicallyDestroyed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -2534,7 +6709,7 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPool) Unmarshal(dAtA []byte) error {
+func (m *UnsafeRecoveryProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2557,49 +6732,32 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsafeRecoveryProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsafeRecoveryProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBhmetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pools == nil {
-				m.Pools = make(map[uint64]*ShardPool)
-			}
-			var mapkey uint64
-			var mapvalue *ShardPool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+				m.FailedContainers = append(m.FailedContainers, v)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowBhmetapb
@@ -2609,29 +6767,34 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBhmetapb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if packedLen < 0 {
+					return ErrInvalidLengthBhmetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBhmetapb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedContainers) == 0 {
+					m.FailedContainers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowBhmetapb
@@ -2641,43 +6804,70 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						v |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthBhmetapb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthBhmetapb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ShardPool{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBhmetapb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthBhmetapb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.FailedContainers = append(m.FailedContainers, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedContainers", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, UnsafeRecoveryShard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Pools[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -2703,7 +6893,7 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardPool) Unmarshal(dAtA []byte) error {
+func (m *UnsafeRecoveryCmd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,17 +6916,17 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsafeRecoveryCmd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsafeRecoveryCmd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Capacity = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2746,16 +6936,16 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= uint64(b&0x7F) << shift
+				m.Type |= UnsafeRecoveryCmdType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
 			}
-			var byteLen int
+			m.ShardID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2765,29 +6955,14 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ShardID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
-			if m.RangePrefix == nil {
-				m.RangePrefix = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedShards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2807,56 +6982,17 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllocatedShards = append(m.AllocatedShards, &AllocatedShard{})
-			if err := m.AllocatedShards[len(m.AllocatedShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-			}
-			m.Seq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedOffset", wireType)
-			}
-			m.AllocatedOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AllocatedOffset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -2882,7 +7018,7 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
+func (m *UnsafeRecoveryTasks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,55 +7041,17 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocatedShard: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsafeRecoveryTasks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocatedShard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsafeRecoveryTasks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
-			}
-			m.ShardID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAt", wireType)
-			}
-			m.AllocatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AllocatedAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2963,24 +7061,24 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
-			if m.Purpose == nil {
-				m.Purpose = []byte{}
+			m.Shards = append(m.Shards, UnsafeRecoveryShard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3008,7 +7106,7 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
+func (m *RequestQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3031,17 +7129,17 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPoolCmd: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPoolCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3051,16 +7149,29 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ShardsPoolCmdType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3070,33 +7181,16 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Create == nil {
-				m.Create = &ShardsPoolCreateCmd{}
-			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alloc", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQPS", wireType)
 			}
-			var msglen int
+			m.ReadQPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3106,28 +7200,68 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ReadQPS |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBhmetapb
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteQPS", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
+			m.WriteQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteQPS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
 			}
-			if m.Alloc == nil {
-				m.Alloc = &ShardsPoolAllocCmd{}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Alloc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytes", wireType)
+			}
+			m.WriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -3153,7 +7287,7 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPoolCreateCmd) Unmarshal(dAtA []byte) error {
+func (m *RequestQuotas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3176,12 +7310,46 @@ func (m *ShardsPoolCreateCmd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPoolCreateCmd: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestQuotas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPoolCreateCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestQuotas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, RequestQuota{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -3207,7 +7375,7 @@ func (m *ShardsPoolCreateCmd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
+func (m *RequestQuotaCmd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3230,17 +7398,17 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPoolAllocCmd: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestQuotaCmd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPoolAllocCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestQuotaCmd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Group = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3250,16 +7418,16 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= uint64(b&0x7F) << shift
+				m.Type |= RequestQuotaCmdType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3269,24 +7437,23 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
-			if m.Purpose == nil {
-				m.Purpose = []byte{}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3317,6 +7484,7 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 func skipBhmetapb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -3348,10 +7516,8 @@ func skipBhmetapb(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -3372,55 +7538,30 @@ func skipBhmetapb(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthBhmetapb
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthBhmetapb
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowBhmetapb
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipBhmetapb(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthBhmetapb
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBhmetapb
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBhmetapb
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthBhmetapb = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowBhmetapb   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthBhmetapb        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBhmetapb          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBhmetapb = fmt.Errorf("proto: unexpected end of group")
 )
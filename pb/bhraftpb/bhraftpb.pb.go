@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -24,7 +25,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // PeerState the state of the shard peer
 type PeerState int32
@@ -33,18 +34,21 @@ const (
 	PeerState_Normal    PeerState = 0
 	PeerState_Applying  PeerState = 1
 	PeerState_Tombstone PeerState = 2
+	PeerState_Merging   PeerState = 3
 )
 
 var PeerState_name = map[int32]string{
 	0: "Normal",
 	1: "Applying",
 	2: "Tombstone",
+	3: "Merging",
 }
 
 var PeerState_value = map[string]int32{
 	"Normal":    0,
 	"Applying":  1,
 	"Tombstone": 2,
+	"Merging":   3,
 }
 
 func (x PeerState) String() string {
@@ -88,7 +92,7 @@ func (m *RaftMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_RaftMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -195,6 +199,7 @@ func (m *RaftMessage) GetRuleGroups() []string {
 type ShardLocalState struct {
 	State                PeerState      `protobuf:"varint,1,opt,name=state,proto3,enum=bhraftpb.PeerState" json:"state,omitempty"`
 	Shard                bhmetapb.Shard `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard"`
+	Merge                *MergeState    `protobuf:"bytes,3,opt,name=merge,proto3" json:"merge,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -214,7 +219,7 @@ func (m *ShardLocalState) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_ShardLocalState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -247,6 +252,77 @@ func (m *ShardLocalState) GetShard() bhmetapb.Shard {
 	return bhmetapb.Shard{}
 }
 
+func (m *ShardLocalState) GetMerge() *MergeState {
+	if m != nil {
+		return m.Merge
+	}
+	return nil
+}
+
+// MergeState the merge state of the source shard which is in merging
+type MergeState struct {
+	MinIndex             uint64         `protobuf:"varint,1,opt,name=minIndex,proto3" json:"minIndex,omitempty"`
+	Commit               uint64         `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Target               bhmetapb.Shard `protobuf:"bytes,3,opt,name=target,proto3" json:"target"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MergeState) Reset()         { *m = MergeState{} }
+func (m *MergeState) String() string { return proto.CompactTextString(m) }
+func (*MergeState) ProtoMessage()    {}
+func (*MergeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b31c127a72499666, []int{2}
+}
+func (m *MergeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeState.Merge(m, src)
+}
+func (m *MergeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeState proto.InternalMessageInfo
+
+func (m *MergeState) GetMinIndex() uint64 {
+	if m != nil {
+		return m.MinIndex
+	}
+	return 0
+}
+
+func (m *MergeState) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+func (m *MergeState) GetTarget() bhmetapb.Shard {
+	if m != nil {
+		return m.Target
+	}
+	return bhmetapb.Shard{}
+}
+
 // RaftLocalState raft local state about raft log
 type RaftLocalState struct {
 	HardState            raftpb.HardState `protobuf:"bytes,1,opt,name=hardState,proto3" json:"hardState"`
@@ -260,7 +336,7 @@ func (m *RaftLocalState) Reset()         { *m = RaftLocalState{} }
 func (m *RaftLocalState) String() string { return proto.CompactTextString(m) }
 func (*RaftLocalState) ProtoMessage()    {}
 func (*RaftLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{2}
+	return fileDescriptor_b31c127a72499666, []int{3}
 }
 func (m *RaftLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +346,7 @@ func (m *RaftLocalState) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_RaftLocalState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -316,7 +392,7 @@ func (m *RaftTruncatedState) Reset()         { *m = RaftTruncatedState{} }
 func (m *RaftTruncatedState) String() string { return proto.CompactTextString(m) }
 func (*RaftTruncatedState) ProtoMessage()    {}
 func (*RaftTruncatedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{3}
+	return fileDescriptor_b31c127a72499666, []int{4}
 }
 func (m *RaftTruncatedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +402,7 @@ func (m *RaftTruncatedState) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_RaftTruncatedState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -372,7 +448,7 @@ func (m *RaftApplyState) Reset()         { *m = RaftApplyState{} }
 func (m *RaftApplyState) String() string { return proto.CompactTextString(m) }
 func (*RaftApplyState) ProtoMessage()    {}
 func (*RaftApplyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{4}
+	return fileDescriptor_b31c127a72499666, []int{5}
 }
 func (m *RaftApplyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +458,7 @@ func (m *RaftApplyState) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_RaftApplyState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -431,7 +507,7 @@ func (m *SnapshotMessageHeader) Reset()         { *m = SnapshotMessageHeader{} }
 func (m *SnapshotMessageHeader) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMessageHeader) ProtoMessage()    {}
 func (*SnapshotMessageHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{5}
+	return fileDescriptor_b31c127a72499666, []int{6}
 }
 func (m *SnapshotMessageHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +517,7 @@ func (m *SnapshotMessageHeader) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_SnapshotMessageHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -512,7 +588,7 @@ func (m *SnapshotMessage) Reset()         { *m = SnapshotMessage{} }
 func (m *SnapshotMessage) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMessage) ProtoMessage()    {}
 func (*SnapshotMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{6}
+	return fileDescriptor_b31c127a72499666, []int{7}
 }
 func (m *SnapshotMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +598,7 @@ func (m *SnapshotMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_SnapshotMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -587,6 +663,7 @@ func init() {
 	proto.RegisterEnum("bhraftpb.PeerState", PeerState_name, PeerState_value)
 	proto.RegisterType((*RaftMessage)(nil), "bhraftpb.RaftMessage")
 	proto.RegisterType((*ShardLocalState)(nil), "bhraftpb.ShardLocalState")
+	proto.RegisterType((*MergeState)(nil), "bhraftpb.MergeState")
 	proto.RegisterType((*RaftLocalState)(nil), "bhraftpb.RaftLocalState")
 	proto.RegisterType((*RaftTruncatedState)(nil), "bhraftpb.RaftTruncatedState")
 	proto.RegisterType((*RaftApplyState)(nil), "bhraftpb.RaftApplyState")
@@ -597,60 +674,63 @@ func init() {
 func init() { proto.RegisterFile("bhraftpb.proto", fileDescriptor_b31c127a72499666) }
 
 var fileDescriptor_b31c127a72499666 = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6e, 0xdb, 0x38,
-	0x10, 0x0e, 0xfd, 0x17, 0x7b, 0xec, 0x75, 0xbc, 0xdc, 0x64, 0x21, 0x04, 0x81, 0x63, 0xe8, 0x21,
-	0xf0, 0xee, 0x62, 0x2d, 0x20, 0xbb, 0xfb, 0xb4, 0x48, 0x81, 0x06, 0x2d, 0x9a, 0x14, 0x69, 0x51,
-	0xc8, 0xb9, 0x00, 0x25, 0xd1, 0x12, 0x51, 0x49, 0x54, 0x29, 0x0a, 0x48, 0xfb, 0xd4, 0x8b, 0xf4,
-	0x22, 0x3d, 0x40, 0x91, 0xc7, 0x9c, 0xa0, 0x68, 0x73, 0x92, 0x82, 0x14, 0x25, 0xcb, 0xe9, 0x5f,
-	0x9e, 0xc4, 0x19, 0x7e, 0x33, 0xf3, 0xcd, 0xc7, 0x19, 0xc1, 0xd8, 0x8b, 0x04, 0x59, 0xc9, 0xcc,
-	0x5b, 0x64, 0x82, 0x4b, 0x8e, 0xfb, 0x95, 0xbd, 0x7f, 0x12, 0x32, 0x19, 0x15, 0xde, 0xc2, 0xe7,
-	0x89, 0x93, 0x10, 0x29, 0xd8, 0x15, 0x17, 0x2c, 0x64, 0xa9, 0x31, 0xfc, 0xc2, 0xa3, 0x4e, 0xe6,
-	0x39, 0x5e, 0x94, 0x50, 0x49, 0x1a, 0x87, 0x32, 0xd1, 0xfe, 0xc5, 0x3d, 0xc2, 0x7d, 0x9e, 0x64,
-	0x3c, 0xa5, 0xa9, 0xcc, 0x9d, 0x4c, 0xf0, 0x2c, 0xa2, 0x52, 0x65, 0x34, 0xf9, 0x36, 0xb2, 0xfd,
-	0xdd, 0xc8, 0x16, 0xf2, 0x90, 0x3b, 0xda, 0xed, 0x15, 0x2b, 0x6d, 0x69, 0x43, 0x9f, 0x0c, 0xfc,
-	0x28, 0xe4, 0x0b, 0x2a, 0xfd, 0x60, 0xc1, 0xb8, 0xa3, 0xbe, 0x8e, 0xea, 0xc9, 0x29, 0x1b, 0xd3,
-	0x9f, 0x12, 0x67, 0xbf, 0x6b, 0xc3, 0xd0, 0x25, 0x2b, 0xf9, 0x8c, 0xe6, 0x39, 0x09, 0x29, 0xb6,
-	0x60, 0x3b, 0x8f, 0x88, 0x08, 0xce, 0x1f, 0x59, 0x68, 0x86, 0xe6, 0x1d, 0xb7, 0x32, 0xf1, 0x2e,
-	0x74, 0x43, 0xc1, 0x8b, 0xcc, 0x6a, 0x69, 0x7f, 0x69, 0xe0, 0x23, 0xe8, 0xac, 0x04, 0x4f, 0xac,
-	0xf6, 0x0c, 0xcd, 0x87, 0xc7, 0xa3, 0x85, 0xe1, 0xfc, 0x82, 0x52, 0x71, 0xda, 0xb9, 0xfe, 0x78,
-	0xb8, 0xe5, 0xea, 0x7b, 0x6c, 0x43, 0x4b, 0x72, 0xab, 0xf3, 0x5d, 0x54, 0x4b, 0x72, 0xec, 0xc0,
-	0x76, 0x52, 0xd2, 0xb0, 0xba, 0x1a, 0xb8, 0xb3, 0x30, 0x2f, 0x63, 0xd8, 0x19, 0x6c, 0x85, 0xc2,
-	0xff, 0x03, 0x68, 0x76, 0x8f, 0x33, 0xee, 0x47, 0x56, 0x4f, 0xc7, 0xec, 0x55, 0xc9, 0x5d, 0x9a,
-	0xf3, 0x42, 0xf8, 0x54, 0x5f, 0x9a, 0xc8, 0x06, 0x1c, 0xcf, 0x60, 0xc8, 0xf2, 0x4b, 0x9e, 0x78,
-	0xb9, 0xe4, 0x29, 0xb5, 0xb6, 0x67, 0x68, 0xde, 0x77, 0x9b, 0x2e, 0xd5, 0x71, 0x2e, 0x89, 0x90,
-	0x56, 0x7f, 0x86, 0xe6, 0x23, 0xb7, 0x34, 0xf0, 0x04, 0xda, 0x34, 0x0d, 0xac, 0x81, 0xf6, 0xa9,
-	0x23, 0xb6, 0x61, 0x14, 0xb0, 0x9c, 0x78, 0x31, 0x5d, 0x66, 0x31, 0x93, 0x16, 0xe8, 0x54, 0x1b,
-	0x3e, 0xfc, 0x3b, 0xf4, 0x8a, 0x94, 0xbd, 0x2a, 0xa8, 0x35, 0x9c, 0xa1, 0xf9, 0xc0, 0x35, 0x16,
-	0x9e, 0x02, 0x88, 0x22, 0xa6, 0x4f, 0x94, 0x98, 0xb9, 0x35, 0x9a, 0xb5, 0xe7, 0x03, 0xb7, 0xe1,
-	0xb1, 0x19, 0xec, 0x2c, 0x15, 0xe7, 0x0b, 0xee, 0x93, 0x78, 0x29, 0x89, 0xa4, 0xf8, 0x0f, 0x4d,
-	0x4b, 0x52, 0xfd, 0x40, 0xe3, 0xe3, 0xdf, 0x16, 0xf5, 0x00, 0x2b, 0x3d, 0x35, 0xc6, 0x2d, 0x11,
-	0xf8, 0x2f, 0xe8, 0xea, 0x8e, 0xf5, 0x9b, 0x29, 0x3d, 0xeb, 0x11, 0xd5, 0x49, 0x8d, 0x2a, 0x25,
-	0xc6, 0xa6, 0x30, 0x56, 0x93, 0xd0, 0xa8, 0xf4, 0x1f, 0x0c, 0xd4, 0xcd, 0xb2, 0xae, 0x36, 0x3c,
-	0xfe, 0xb5, 0x7a, 0x92, 0xb3, 0xea, 0xc2, 0x24, 0x59, 0x23, 0xf1, 0x01, 0x0c, 0x62, 0x92, 0xcb,
-	0xf3, 0x34, 0xa0, 0x57, 0x66, 0x5a, 0xd6, 0x0e, 0xfb, 0x01, 0x60, 0x55, 0xe6, 0x52, 0x14, 0xa9,
-	0x4f, 0x24, 0x35, 0x31, 0xbb, 0xd0, 0x65, 0x1a, 0x5f, 0x4e, 0x5d, 0x69, 0x60, 0x0c, 0x1d, 0x49,
-	0x45, 0x62, 0x92, 0xe8, 0xb3, 0xfd, 0x16, 0x95, 0x3c, 0x1f, 0x66, 0x59, 0xfc, 0xba, 0x0c, 0xb6,
-	0x61, 0x44, 0xb2, 0x2c, 0x66, 0x34, 0x38, 0x6f, 0xe4, 0xd8, 0xf0, 0xe1, 0xa7, 0x30, 0x96, 0x1b,
-	0x25, 0x8d, 0x26, 0x07, 0x6b, 0xf9, 0xbe, 0xa6, 0x65, 0x7a, 0xbb, 0x13, 0x69, 0xbf, 0x47, 0xb0,
-	0xb7, 0x4c, 0x49, 0x96, 0x47, 0xbc, 0x5a, 0x9c, 0x33, 0x4a, 0x02, 0x2a, 0xd6, 0x82, 0xa3, 0x9f,
-	0x0b, 0x5e, 0xef, 0x4e, 0xeb, 0x5e, 0xbb, 0xd3, 0xfe, 0xe1, 0xee, 0x54, 0x4a, 0x75, 0xd6, 0x4a,
-	0xad, 0x35, 0xed, 0x36, 0x34, 0xb5, 0x3f, 0x20, 0xd8, 0xb9, 0x43, 0x1e, 0x9f, 0x40, 0x2f, 0xd2,
-	0x0d, 0x18, 0xde, 0x87, 0x6b, 0x51, 0xbe, 0xd9, 0xa7, 0x29, 0x6c, 0x82, 0x54, 0xf1, 0x80, 0x48,
-	0xa2, 0x1b, 0x19, 0xb9, 0xfa, 0xac, 0x8a, 0xaf, 0x98, 0xc8, 0xa5, 0xe6, 0xdd, 0x77, 0x4b, 0x43,
-	0x21, 0xd5, 0x24, 0x68, 0x9a, 0x7d, 0x57, 0x9f, 0xf1, 0x3e, 0xf4, 0x57, 0x2c, 0xa6, 0x4b, 0xf6,
-	0x86, 0x1a, 0xa6, 0xb5, 0xad, 0xee, 0xfc, 0x88, 0xfa, 0x2f, 0x97, 0x45, 0xa2, 0xf7, 0xbb, 0xe3,
-	0xd6, 0xf6, 0x9f, 0xff, 0xc2, 0xa0, 0x1e, 0x78, 0x0c, 0xd0, 0x7b, 0xce, 0x45, 0x42, 0xe2, 0xc9,
-	0x16, 0x1e, 0x41, 0x5f, 0x0f, 0x07, 0x4b, 0xc3, 0x09, 0xc2, 0xbf, 0xc0, 0xa0, 0x5e, 0xe9, 0x49,
-	0xeb, 0x74, 0x72, 0xf3, 0x79, 0x8a, 0xae, 0x6f, 0xa7, 0xe8, 0xe6, 0x76, 0x8a, 0x3e, 0xdd, 0x4e,
-	0x91, 0xd7, 0xd3, 0x7f, 0xc2, 0x7f, 0xbe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xd9, 0xdc, 0xe3,
-	0x09, 0x06, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6e, 0xf3, 0x44,
+	0x14, 0xad, 0xf3, 0x9f, 0x9b, 0x90, 0x86, 0xa1, 0x45, 0x56, 0x55, 0xa5, 0x91, 0x1f, 0xaa, 0x50,
+	0xd4, 0x58, 0x2a, 0xe2, 0x09, 0x15, 0xa9, 0x15, 0x88, 0x16, 0xb5, 0x08, 0x39, 0xdd, 0xc0, 0xd8,
+	0x9e, 0xd8, 0x23, 0x6c, 0x8f, 0x19, 0x8f, 0xa5, 0xc2, 0x13, 0x9b, 0xe0, 0x91, 0x8d, 0xb0, 0x00,
+	0xd4, 0xc7, 0xae, 0x00, 0x41, 0x57, 0x82, 0xe6, 0xc7, 0x3f, 0xe1, 0xfb, 0xbe, 0xaa, 0x4f, 0x9e,
+	0x73, 0xe7, 0xdc, 0x3b, 0xe7, 0xde, 0x39, 0x63, 0x98, 0xf9, 0x31, 0xc7, 0x5b, 0x91, 0xfb, 0xeb,
+	0x9c, 0x33, 0xc1, 0xd0, 0xa8, 0xc2, 0x47, 0x97, 0x11, 0x15, 0x71, 0xe9, 0xaf, 0x03, 0x96, 0xba,
+	0x29, 0x16, 0x9c, 0x3e, 0x32, 0x4e, 0x23, 0x9a, 0x19, 0x10, 0x94, 0x3e, 0x71, 0x73, 0xdf, 0xf5,
+	0xe3, 0x94, 0x08, 0xdc, 0x5a, 0xe8, 0x42, 0x47, 0x77, 0x6f, 0x48, 0x0f, 0x58, 0x9a, 0xb3, 0x8c,
+	0x64, 0xa2, 0x70, 0x73, 0xce, 0xf2, 0x98, 0x08, 0x59, 0xd1, 0xd4, 0xdb, 0xa9, 0x76, 0xde, 0xaa,
+	0x16, 0xb1, 0x88, 0xb9, 0x2a, 0xec, 0x97, 0x5b, 0x85, 0x14, 0x50, 0x2b, 0x43, 0x3f, 0x8d, 0xd8,
+	0x9a, 0x88, 0x20, 0x5c, 0x53, 0xe6, 0xca, 0xaf, 0x2b, 0x7b, 0x72, 0x75, 0x63, 0xea, 0xa3, 0x79,
+	0xce, 0x1f, 0x5d, 0x98, 0x78, 0x78, 0x2b, 0xee, 0x49, 0x51, 0xe0, 0x88, 0x20, 0x1b, 0x86, 0x45,
+	0x8c, 0x79, 0x78, 0xfb, 0x8d, 0x6d, 0x2d, 0xad, 0x55, 0xcf, 0xab, 0x20, 0x3a, 0x80, 0x7e, 0xc4,
+	0x59, 0x99, 0xdb, 0x1d, 0x15, 0xd7, 0x00, 0x9d, 0x42, 0x6f, 0xcb, 0x59, 0x6a, 0x77, 0x97, 0xd6,
+	0x6a, 0x72, 0x31, 0x5d, 0x1b, 0xcd, 0x3f, 0x12, 0xc2, 0xaf, 0x7b, 0x4f, 0x7f, 0x9f, 0xec, 0x79,
+	0x6a, 0x1f, 0x39, 0xd0, 0x11, 0xcc, 0xee, 0x7d, 0x90, 0xd5, 0x11, 0x0c, 0xb9, 0x30, 0x4c, 0xb5,
+	0x0c, 0xbb, 0xaf, 0x88, 0xfb, 0x6b, 0x73, 0x33, 0x46, 0x9d, 0xe1, 0x56, 0x2c, 0xf4, 0x15, 0x80,
+	0x52, 0xf7, 0x6d, 0xce, 0x82, 0xd8, 0x1e, 0xa8, 0x9c, 0xc3, 0xaa, 0xb8, 0x47, 0x0a, 0x56, 0xf2,
+	0x80, 0xa8, 0x4d, 0x93, 0xd9, 0xa2, 0xa3, 0x25, 0x4c, 0x68, 0xf1, 0xc0, 0x52, 0xbf, 0x10, 0x2c,
+	0x23, 0xf6, 0x70, 0x69, 0xad, 0x46, 0x5e, 0x3b, 0x24, 0x3b, 0x2e, 0x04, 0xe6, 0xc2, 0x1e, 0x2d,
+	0xad, 0xd5, 0xd4, 0xd3, 0x00, 0xcd, 0xa1, 0x4b, 0xb2, 0xd0, 0x1e, 0xab, 0x98, 0x5c, 0x22, 0x07,
+	0xa6, 0x21, 0x2d, 0xb0, 0x9f, 0x90, 0x4d, 0x9e, 0x50, 0x61, 0x83, 0x2a, 0xb5, 0x13, 0x43, 0x9f,
+	0xc2, 0xa0, 0xcc, 0xe8, 0xcf, 0x25, 0xb1, 0x27, 0x4b, 0x6b, 0x35, 0xf6, 0x0c, 0x42, 0x0b, 0x00,
+	0x5e, 0x26, 0xe4, 0x3b, 0x39, 0xcc, 0xc2, 0x9e, 0x2e, 0xbb, 0xab, 0xb1, 0xd7, 0x8a, 0x38, 0xbf,
+	0x5b, 0xb0, 0xbf, 0x91, 0xa2, 0xef, 0x58, 0x80, 0x93, 0x8d, 0xc0, 0x82, 0xa0, 0xcf, 0x94, 0x2e,
+	0x41, 0xd4, 0x0d, 0xcd, 0x2e, 0x3e, 0x59, 0xd7, 0x0e, 0x96, 0x03, 0x55, 0x1c, 0x4f, 0x33, 0xd0,
+	0xe7, 0xd0, 0x57, 0x2d, 0xdb, 0x1d, 0x33, 0xd0, 0xda, 0xa3, 0xaa, 0xa8, 0x19, 0x8b, 0xe6, 0xa0,
+	0x33, 0xe8, 0xa7, 0x84, 0x47, 0xc4, 0x5c, 0xe6, 0x41, 0x53, 0xf7, 0x5e, 0x86, 0x4d, 0x61, 0x45,
+	0x71, 0x18, 0x40, 0x13, 0x44, 0x47, 0x30, 0x4a, 0x69, 0x76, 0x9b, 0x85, 0xe4, 0xd1, 0xd8, 0xa6,
+	0xc6, 0xb2, 0xf3, 0x80, 0xa5, 0x29, 0x15, 0xc6, 0x38, 0x06, 0xa1, 0x73, 0x18, 0x08, 0xcc, 0x23,
+	0x22, 0xec, 0xee, 0x6b, 0xda, 0x0c, 0xc9, 0x21, 0x30, 0x93, 0x3e, 0x6d, 0x8d, 0xe1, 0x4b, 0x18,
+	0x4b, 0xde, 0xa6, 0x1e, 0xc5, 0xe4, 0xe2, 0xe3, 0xca, 0x30, 0x37, 0xd5, 0x86, 0xa9, 0xd2, 0x30,
+	0xd1, 0x31, 0x8c, 0x13, 0x5c, 0x08, 0x2d, 0x56, 0x4b, 0x6a, 0x02, 0xce, 0xd7, 0x80, 0xe4, 0x31,
+	0x0f, 0xbc, 0xcc, 0x02, 0x2c, 0x88, 0xc9, 0x39, 0x80, 0x3e, 0x6d, 0x35, 0xa7, 0x01, 0x42, 0xd0,
+	0x13, 0x84, 0xa7, 0xa6, 0x88, 0x5a, 0x3b, 0xbf, 0x59, 0x5a, 0xe7, 0x55, 0x9e, 0x27, 0xbf, 0xe8,
+	0x64, 0x07, 0xa6, 0x38, 0xcf, 0x13, 0x4a, 0xc2, 0xf6, 0x80, 0x76, 0x62, 0xe8, 0x7b, 0x98, 0x89,
+	0x9d, 0x23, 0xcd, 0x85, 0x1d, 0x37, 0x77, 0xf0, 0xae, 0x2c, 0xd3, 0xdb, 0xff, 0x32, 0x9d, 0x3f,
+	0x2d, 0x38, 0xdc, 0x64, 0x38, 0x2f, 0x62, 0x56, 0x3d, 0xeb, 0x1b, 0x82, 0x43, 0xc2, 0x1b, 0x37,
+	0x58, 0x6f, 0x70, 0x43, 0xf5, 0xb2, 0x3b, 0x6f, 0x7a, 0xd9, 0xdd, 0x57, 0x5f, 0x76, 0x35, 0xa9,
+	0x5e, 0x33, 0xa9, 0x66, 0xa6, 0xfd, 0xd6, 0x4c, 0x9d, 0xbf, 0xa4, 0xdf, 0x77, 0xc5, 0xa3, 0x4b,
+	0x18, 0xc4, 0xaa, 0x01, 0xa3, 0xfb, 0xa4, 0x19, 0xca, 0x7b, 0xfb, 0xac, 0x9c, 0xa3, 0x93, 0xe4,
+	0xe1, 0x21, 0x16, 0x58, 0x35, 0x32, 0xf5, 0xd4, 0x5a, 0x1e, 0xbe, 0xa5, 0xbc, 0xd0, 0xde, 0x1b,
+	0x79, 0x1a, 0x48, 0xa6, 0x74, 0x82, 0x92, 0x39, 0xf2, 0xd4, 0x5a, 0x5a, 0x7b, 0x4b, 0x13, 0xb2,
+	0xa1, 0xbf, 0x12, 0xa3, 0xb4, 0xc6, 0x72, 0x2f, 0x88, 0x49, 0xf0, 0xd3, 0xa6, 0x4c, 0xd5, 0xdf,
+	0xa7, 0xe7, 0xd5, 0xf8, 0xec, 0x0a, 0xc6, 0xf5, 0x6b, 0x44, 0x00, 0x83, 0x1f, 0x18, 0x4f, 0x71,
+	0x32, 0xdf, 0x43, 0x53, 0x18, 0x29, 0x73, 0xd0, 0x2c, 0x9a, 0x5b, 0xe8, 0x23, 0x18, 0xd7, 0x3f,
+	0x9c, 0x79, 0x07, 0x4d, 0x60, 0x28, 0x9f, 0x95, 0xdc, 0xeb, 0x5e, 0xcf, 0x9f, 0xff, 0x5d, 0x58,
+	0x4f, 0x2f, 0x0b, 0xeb, 0xf9, 0x65, 0x61, 0xfd, 0xf3, 0xb2, 0xb0, 0xfc, 0x81, 0xfa, 0x69, 0x7f,
+	0xf1, 0xdf, 0x00, 0x24, 0xaf, 0xc6, 0x4d, 0xb4, 0x06, 0x00, 0x00,
 }
 
 func (m *RaftMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -658,115 +738,126 @@ func (m *RaftMessage) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ShardID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.ShardID))
-	}
-	if m.Group != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Group))
-	}
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.From.Size()))
-	n1, err := m.From.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n1
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.To.Size()))
-	n2, err := m.To.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n2
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Message.Size()))
-	n3, err := m.Message.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.RuleGroups) > 0 {
+		for iNdEx := len(m.RuleGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RuleGroups[iNdEx])
+			copy(dAtA[i:], m.RuleGroups[iNdEx])
+			i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.RuleGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	i += n3
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.ShardEpoch.Size()))
-	n4, err := m.ShardEpoch.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Unique) > 0 {
+		i -= len(m.Unique)
+		copy(dAtA[i:], m.Unique)
+		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Unique)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	i += n4
-	if m.IsTombstone {
-		dAtA[i] = 0x38
-		i++
-		if m.IsTombstone {
+	if m.DisableSplit {
+		i--
+		if m.DisableSplit {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
-	}
-	if len(m.Start) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Start)))
-		i += copy(dAtA[i:], m.Start)
+		i--
+		dAtA[i] = 0x50
 	}
 	if len(m.End) > 0 {
-		dAtA[i] = 0x4a
-		i++
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
 		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.End)))
-		i += copy(dAtA[i:], m.End)
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.DisableSplit {
-		dAtA[i] = 0x50
-		i++
-		if m.DisableSplit {
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IsTombstone {
+		i--
+		if m.IsTombstone {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Unique) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Unique)))
-		i += copy(dAtA[i:], m.Unique)
+	{
+		size := m.ShardEpoch.Size()
+		i -= size
+		if _, err := m.ShardEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	if len(m.RuleGroups) > 0 {
-		for _, s := range m.RuleGroups {
-			dAtA[i] = 0x62
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Message.Size()
+		i -= size
+		if _, err := m.Message.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.To.Size()
+		i -= size
+		if _, err := m.To.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.From.Size()
+		i -= size
+		if _, err := m.From.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0x1a
+	if m.Group != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *ShardLocalState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -774,33 +865,100 @@ func (m *ShardLocalState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ShardLocalState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardLocalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Merge != nil {
+		{
+			size, err := m.Merge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBhraftpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Shard.Size()
+		i -= size
+		if _, err := m.Shard.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.State != 0 {
-		dAtA[i] = 0x8
-		i++
 		i = encodeVarintBhraftpb(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Shard.Size()))
-	n5, err := m.Shard.MarshalTo(dAtA[i:])
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n5
+	return dAtA[:n], nil
+}
+
+func (m *MergeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Target.Size()
+		i -= size
+		if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Commit != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Commit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinIndex != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.MinIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *RaftLocalState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -808,33 +966,41 @@ func (m *RaftLocalState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftLocalState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftLocalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.HardState.Size()))
-	n6, err := m.HardState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n6
 	if m.LastIndex != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintBhraftpb(dAtA, i, uint64(m.LastIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	{
+		size := m.HardState.Size()
+		i -= size
+		if _, err := m.HardState.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *RaftTruncatedState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -842,30 +1008,36 @@ func (m *RaftTruncatedState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftTruncatedState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftTruncatedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Term != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Index != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *RaftApplyState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -873,33 +1045,41 @@ func (m *RaftApplyState) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftApplyState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftApplyState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AppliedIndex != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.AppliedIndex))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.TruncatedState.Size()))
-	n7, err := m.TruncatedState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	{
+		size, err := m.TruncatedState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	i += n7
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x12
+	if m.AppliedIndex != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *SnapshotMessageHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -907,54 +1087,66 @@ func (m *SnapshotMessageHeader) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *SnapshotMessageHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotMessageHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Shard.Size()))
-	n8, err := m.Shard.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n8
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.From.Size()))
-	n9, err := m.From.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n9
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.To.Size()))
-	n10, err := m.To.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.Index != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x28
 	}
-	i += n10
 	if m.Term != 0 {
-		dAtA[i] = 0x20
-		i++
 		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Index))
+	{
+		size := m.To.Size()
+		i -= size
+		if _, err := m.To.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.From.Size()
+		i -= size
+		if _, err := m.From.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Shard.Size()
+		i -= size
+		if _, err := m.Shard.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *SnapshotMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -962,68 +1154,79 @@ func (m *SnapshotMessage) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *SnapshotMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Header.Size()))
-	n11, err := m.Header.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n11
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if m.CheckSum != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.CheckSum))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.First {
-		dAtA[i] = 0x18
-		i++
-		if m.First {
+	if m.FileSize != 0 {
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Last {
+		i--
+		if m.Last {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
-	}
-	if m.Last {
+		i--
 		dAtA[i] = 0x20
-		i++
-		if m.Last {
+	}
+	if m.First {
+		i--
+		if m.First {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
-	}
-	if m.FileSize != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CheckSum != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintBhraftpb(dAtA, i, uint64(m.CheckSum))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBhraftpb(dAtA, i, uint64(size))
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintBhraftpb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBhraftpb(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *RaftMessage) Size() (n int) {
 	if m == nil {
@@ -1086,6 +1289,30 @@ func (m *ShardLocalState) Size() (n int) {
 	}
 	l = m.Shard.Size()
 	n += 1 + l + sovBhraftpb(uint64(l))
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovBhraftpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinIndex != 0 {
+		n += 1 + sovBhraftpb(uint64(m.MinIndex))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovBhraftpb(uint64(m.Commit))
+	}
+	l = m.Target.Size()
+	n += 1 + l + sovBhraftpb(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1199,14 +1426,7 @@ func (m *SnapshotMessage) Size() (n int) {
 }
 
 func sovBhraftpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozBhraftpb(x uint64) (n int) {
 	return sovBhraftpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -1688,6 +1908,167 @@ func (m *ShardLocalState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merge == nil {
+				m.Merge = &MergeState{}
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhraftpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhraftpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIndex", wireType)
+			}
+			m.MinIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhraftpb(dAtA[iNdEx:])
@@ -2410,6 +2791,7 @@ func (m *SnapshotMessage) Unmarshal(dAtA []byte) error {
 func skipBhraftpb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2441,10 +2823,8 @@ func skipBhraftpb(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -2465,55 +2845,30 @@ func skipBhraftpb(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthBhraftpb
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthBhraftpb
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowBhraftpb
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipBhraftpb(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthBhraftpb
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBhraftpb
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBhraftpb
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthBhraftpb = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowBhraftpb   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthBhraftpb        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBhraftpb          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBhraftpb = fmt.Errorf("proto: unexpected end of group")
 )
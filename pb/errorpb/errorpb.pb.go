@@ -425,6 +425,63 @@ func (m *RaftEntryTooLarge) GetEntrySize() uint64 {
 	return 0
 }
 
+// QuotaExceeded the request is rejected by the request quota of the tenant or the
+// shard group, the client should back off before retrying
+type QuotaExceeded struct {
+	Tenant               string   `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Group                uint64   `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaExceeded) Reset()         { *m = QuotaExceeded{} }
+func (m *QuotaExceeded) String() string { return proto.CompactTextString(m) }
+func (*QuotaExceeded) ProtoMessage()    {}
+func (*QuotaExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_390aa86757fd1154, []int{8}
+}
+func (m *QuotaExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaExceeded.Merge(m, src)
+}
+func (m *QuotaExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaExceeded proto.InternalMessageInfo
+
+func (m *QuotaExceeded) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QuotaExceeded) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
+	}
+	return 0
+}
+
 // Error is a raft error
 type Error struct {
 	Message              string             `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -436,6 +493,7 @@ type Error struct {
 	StaleCommand         *StaleCommand      `protobuf:"bytes,7,opt,name=staleCommand,proto3" json:"staleCommand,omitempty"`
 	StoreNotMatch        *StoreNotMatch     `protobuf:"bytes,8,opt,name=storeNotMatch,proto3" json:"storeNotMatch,omitempty"`
 	RaftEntryTooLarge    *RaftEntryTooLarge `protobuf:"bytes,9,opt,name=raftEntryTooLarge,proto3" json:"raftEntryTooLarge,omitempty"`
+	QuotaExceeded        *QuotaExceeded     `protobuf:"bytes,10,opt,name=quotaExceeded,proto3" json:"quotaExceeded,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -445,7 +503,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_390aa86757fd1154, []int{8}
+	return fileDescriptor_390aa86757fd1154, []int{9}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,6 +595,13 @@ func (m *Error) GetRaftEntryTooLarge() *RaftEntryTooLarge {
 	return nil
 }
 
+func (m *Error) GetQuotaExceeded() *QuotaExceeded {
+	if m != nil {
+		return m.QuotaExceeded
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NotLeader)(nil), "errorpb.NotLeader")
 	proto.RegisterType((*StoreNotMatch)(nil), "errorpb.StoreNotMatch")
@@ -546,47 +611,51 @@ func init() {
 	proto.RegisterType((*ServerIsBusy)(nil), "errorpb.ServerIsBusy")
 	proto.RegisterType((*StaleCommand)(nil), "errorpb.StaleCommand")
 	proto.RegisterType((*RaftEntryTooLarge)(nil), "errorpb.RaftEntryTooLarge")
+	proto.RegisterType((*QuotaExceeded)(nil), "errorpb.QuotaExceeded")
 	proto.RegisterType((*Error)(nil), "errorpb.Error")
 }
 
 func init() { proto.RegisterFile("errorpb.proto", fileDescriptor_390aa86757fd1154) }
 
 var fileDescriptor_390aa86757fd1154 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x5f, 0x8f, 0xd2, 0x4e,
-	0x14, 0xdd, 0xee, 0xf2, 0xe7, 0xd7, 0x0b, 0xfc, 0x76, 0xb7, 0xfe, 0x49, 0x43, 0x0c, 0x92, 0x3e,
-	0xa1, 0x89, 0x60, 0xe0, 0xc9, 0x44, 0x1f, 0x44, 0x31, 0x92, 0x45, 0xa2, 0x83, 0x5f, 0x60, 0xda,
-	0xde, 0x6d, 0x89, 0xdb, 0x99, 0x66, 0x66, 0x50, 0xf1, 0xd1, 0x4f, 0xb7, 0x8f, 0xfb, 0x09, 0x8c,
-	0xf2, 0x49, 0x4c, 0x87, 0x52, 0xa6, 0x6c, 0x34, 0xbe, 0xcd, 0x99, 0x7b, 0xce, 0xb9, 0x9d, 0x7b,
-	0x6e, 0xa1, 0x85, 0x42, 0x70, 0x91, 0xfa, 0xfd, 0x54, 0x70, 0xc5, 0x9d, 0x7a, 0x0e, 0xdb, 0x2f,
-	0xa2, 0xa5, 0x8a, 0x57, 0x7e, 0x3f, 0xe0, 0xc9, 0x20, 0xa1, 0x4a, 0x2c, 0xbf, 0x72, 0xb1, 0x8c,
-	0x96, 0x2c, 0x07, 0xc1, 0xca, 0xc7, 0x41, 0xea, 0x0f, 0xfc, 0x38, 0x41, 0x45, 0x8d, 0xc3, 0xd6,
-	0xa7, 0x3d, 0xfb, 0x07, 0x79, 0xc0, 0x93, 0x94, 0x33, 0x64, 0x4a, 0x0e, 0x52, 0xc1, 0xd3, 0x18,
-	0x55, 0xe6, 0x98, 0xfb, 0x95, 0xdc, 0x9e, 0x18, 0x6e, 0x11, 0x8f, 0xf8, 0x40, 0x5f, 0xfb, 0xab,
-	0x4b, 0x8d, 0x34, 0xd0, 0xa7, 0x2d, 0xdd, 0xfb, 0x00, 0xf6, 0x9c, 0xab, 0x19, 0xd2, 0x10, 0x85,
-	0xe3, 0x42, 0x5d, 0xc6, 0x54, 0x84, 0xd3, 0xd7, 0xae, 0xd5, 0xb5, 0x7a, 0x15, 0xb2, 0x83, 0xce,
-	0x63, 0xa8, 0x5d, 0x69, 0x8e, 0x7b, 0xdc, 0xb5, 0x7a, 0x8d, 0x61, 0xb3, 0x9f, 0x37, 0x7d, 0x8f,
-	0x28, 0xc6, 0x95, 0xeb, 0x1f, 0x0f, 0x8f, 0x48, 0xce, 0xf0, 0x4e, 0xa1, 0xb5, 0x50, 0x5c, 0xe0,
-	0x9c, 0xab, 0x77, 0x54, 0x05, 0xb1, 0xf7, 0x08, 0x5a, 0x8b, 0xcc, 0x67, 0xce, 0xd5, 0x1b, 0xbe,
-	0x62, 0xe1, 0x9f, 0xfb, 0x78, 0x01, 0xb4, 0x2e, 0x70, 0x3d, 0xe7, 0x6a, 0xca, 0xb4, 0xc4, 0x39,
-	0x83, 0x93, 0x4f, 0xb8, 0xd6, 0xb4, 0x26, 0xc9, 0x8e, 0xa6, 0xf8, 0xb8, 0xfc, 0x91, 0x77, 0xa1,
-	0x2a, 0x15, 0x15, 0xca, 0x3d, 0xd1, 0xec, 0x2d, 0xc8, 0x1c, 0x90, 0x85, 0x6e, 0x65, 0xeb, 0x80,
-	0x2c, 0xf4, 0x5e, 0x02, 0x2c, 0x14, 0xbd, 0xc2, 0x49, 0xca, 0x83, 0xd8, 0x19, 0x81, 0xcd, 0xf0,
-	0x8b, 0xee, 0x26, 0x5d, 0xab, 0x7b, 0xd2, 0x6b, 0x0c, 0x4f, 0xfb, 0x45, 0x44, 0xfa, 0x3e, 0x7f,
-	0xe0, 0x9e, 0xe7, 0xfd, 0x0f, 0xcd, 0x05, 0x8a, 0xcf, 0x28, 0xa6, 0x72, 0xbc, 0x92, 0x6b, 0x8d,
-	0x33, 0xcb, 0x57, 0x3c, 0x49, 0x28, 0x0b, 0xbd, 0x0b, 0x38, 0x27, 0xf4, 0x52, 0x4d, 0x98, 0x12,
-	0xeb, 0x8f, 0x9c, 0xcf, 0xa8, 0x88, 0xf0, 0x2f, 0xe3, 0x7d, 0x00, 0x36, 0x66, 0xd4, 0xc5, 0xf2,
-	0x1b, 0xe6, 0xaf, 0xda, 0x5f, 0x78, 0xdf, 0x2b, 0x50, 0x9d, 0x64, 0xbb, 0x96, 0x39, 0x24, 0x28,
-	0x25, 0x8d, 0x50, 0x3b, 0xd8, 0x64, 0x07, 0x9d, 0xa7, 0x60, 0xb3, 0x5d, 0x8e, 0x79, 0x46, 0x4e,
-	0x7f, 0xb7, 0xaf, 0x45, 0xc2, 0x64, 0x4f, 0x72, 0x9e, 0x43, 0x4b, 0x9a, 0xa9, 0xe8, 0xa9, 0x35,
-	0x86, 0xf7, 0x0b, 0x55, 0x29, 0x33, 0x52, 0x26, 0x67, 0xea, 0x52, 0x50, 0x7a, 0xbe, 0xa6, 0xba,
-	0x54, 0x25, 0x07, 0xa9, 0x8e, 0x00, 0x64, 0x91, 0x80, 0x5b, 0xd5, 0xd2, 0x3b, 0xfb, 0xc6, 0x45,
-	0x89, 0x18, 0x34, 0xe7, 0x19, 0x34, 0xa5, 0x31, 0x73, 0xb7, 0xa6, 0x65, 0xf7, 0xf6, 0x32, 0xa3,
-	0x48, 0x4a, 0x54, 0x2d, 0x35, 0xe2, 0x71, 0xeb, 0x87, 0x52, 0xa3, 0x48, 0x4a, 0x54, 0x3d, 0x26,
-	0x73, 0x9b, 0xdd, 0xff, 0x0e, 0xc7, 0x64, 0x56, 0x49, 0x99, 0xec, 0xbc, 0x85, 0x73, 0x71, 0xb8,
-	0x07, 0xae, 0xad, 0x1d, 0xda, 0x85, 0xc3, 0xad, 0x4d, 0x21, 0xb7, 0x45, 0xe3, 0xb3, 0x9b, 0x5f,
-	0x9d, 0xa3, 0xeb, 0x4d, 0xc7, 0xba, 0xd9, 0x74, 0xac, 0x9f, 0x9b, 0x8e, 0xe5, 0xd7, 0xf4, 0x1f,
-	0x3c, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xe6, 0x28, 0x53, 0x97, 0x04, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x51, 0x6f, 0xd3, 0x3c,
+	0x14, 0x5d, 0xb6, 0xae, 0xfb, 0x7a, 0xd7, 0x7c, 0xdb, 0x0c, 0x4c, 0xd6, 0x84, 0xca, 0x94, 0xa7,
+	0x81, 0x44, 0x8b, 0xb6, 0x27, 0xa4, 0xed, 0x81, 0x41, 0x11, 0xd3, 0x46, 0xc5, 0x5c, 0xfe, 0x80,
+	0x93, 0xdc, 0x25, 0x15, 0x8b, 0x1d, 0x1c, 0x07, 0x56, 0x7e, 0x1d, 0x8f, 0x7b, 0xdc, 0x2f, 0x40,
+	0xd0, 0x5f, 0x82, 0xe2, 0xa6, 0xa9, 0x93, 0x09, 0xc4, 0x9b, 0x8f, 0xef, 0x39, 0xc7, 0x37, 0xf7,
+	0x9e, 0x16, 0x5c, 0x54, 0x4a, 0xaa, 0xd4, 0xef, 0xa7, 0x4a, 0x6a, 0x49, 0x36, 0x4a, 0xb8, 0x77,
+	0x12, 0x4d, 0x74, 0x9c, 0xfb, 0xfd, 0x40, 0x26, 0x83, 0x84, 0x6b, 0x35, 0xb9, 0x91, 0x6a, 0x12,
+	0x4d, 0x44, 0x09, 0x82, 0xdc, 0xc7, 0x41, 0xea, 0x0f, 0xfc, 0x38, 0x41, 0xcd, 0xad, 0xc3, 0xdc,
+	0x67, 0xef, 0xe2, 0x1f, 0xe4, 0x81, 0x4c, 0x52, 0x29, 0x50, 0xe8, 0x6c, 0x90, 0x2a, 0x99, 0xc6,
+	0xa8, 0x0b, 0xc7, 0xd2, 0xaf, 0xe6, 0xf6, 0xdc, 0x72, 0x8b, 0x64, 0x24, 0x07, 0xe6, 0xda, 0xcf,
+	0xaf, 0x0c, 0x32, 0xc0, 0x9c, 0xe6, 0x74, 0xef, 0x12, 0x3a, 0x23, 0xa9, 0x2f, 0x90, 0x87, 0xa8,
+	0x08, 0x85, 0x8d, 0x2c, 0xe6, 0x2a, 0x3c, 0x7b, 0x43, 0x9d, 0x7d, 0xe7, 0xa0, 0xc5, 0x16, 0x90,
+	0x3c, 0x83, 0xf6, 0xb5, 0xe1, 0xd0, 0xd5, 0x7d, 0xe7, 0x60, 0xf3, 0xb0, 0xdb, 0x2f, 0x1f, 0xfd,
+	0x80, 0xa8, 0x4e, 0x5b, 0xb7, 0x3f, 0x9e, 0xac, 0xb0, 0x92, 0xe1, 0x6d, 0x81, 0x3b, 0xd6, 0x52,
+	0xe1, 0x48, 0xea, 0xf7, 0x5c, 0x07, 0xb1, 0xf7, 0x14, 0xdc, 0x71, 0xe1, 0x33, 0x92, 0xfa, 0xad,
+	0xcc, 0x45, 0xf8, 0xe7, 0x77, 0xbc, 0x00, 0xdc, 0x73, 0x9c, 0x8e, 0xa4, 0x3e, 0x13, 0x46, 0x42,
+	0xb6, 0x61, 0xed, 0x13, 0x4e, 0x0d, 0xad, 0xcb, 0x8a, 0xa3, 0x2d, 0x5e, 0xad, 0x37, 0xf9, 0x10,
+	0xd6, 0x33, 0xcd, 0x95, 0xa6, 0x6b, 0x86, 0x3d, 0x07, 0x85, 0x03, 0x8a, 0x90, 0xb6, 0xe6, 0x0e,
+	0x28, 0x42, 0xef, 0x15, 0xc0, 0x58, 0xf3, 0x6b, 0x1c, 0xa6, 0x32, 0x88, 0xc9, 0x11, 0x74, 0x04,
+	0x7e, 0x35, 0xaf, 0x65, 0xd4, 0xd9, 0x5f, 0x3b, 0xd8, 0x3c, 0xdc, 0xea, 0x57, 0x2b, 0x32, 0xf7,
+	0xe5, 0x07, 0x2e, 0x79, 0xde, 0xff, 0xd0, 0x1d, 0xa3, 0xfa, 0x82, 0xea, 0x2c, 0x3b, 0xcd, 0xb3,
+	0xa9, 0xc1, 0x85, 0xe5, 0x6b, 0x99, 0x24, 0x5c, 0x84, 0xde, 0x39, 0xec, 0x30, 0x7e, 0xa5, 0x87,
+	0x42, 0xab, 0xe9, 0x47, 0x29, 0x2f, 0xb8, 0x8a, 0xf0, 0x2f, 0xe3, 0x7d, 0x0c, 0x1d, 0x2c, 0xa8,
+	0xe3, 0xc9, 0x37, 0x2c, 0xbf, 0x6a, 0x79, 0xe1, 0x9d, 0x80, 0x7b, 0x99, 0x4b, 0xcd, 0x87, 0x37,
+	0x01, 0x62, 0x88, 0x21, 0xd9, 0x85, 0xb6, 0x46, 0xc1, 0x85, 0x36, 0x3e, 0x1d, 0x56, 0xa2, 0x62,
+	0x00, 0x91, 0x92, 0x79, 0x5a, 0x5a, 0xcc, 0x81, 0xf7, 0xbd, 0x05, 0xeb, 0xc3, 0x22, 0xaa, 0x45,
+	0x03, 0x09, 0x66, 0x19, 0x8f, 0xb0, 0x14, 0x2e, 0x20, 0x79, 0x01, 0x1d, 0xb1, 0x88, 0x41, 0xb9,
+	0x62, 0xd2, 0x5f, 0xc4, 0xbd, 0x0a, 0x08, 0x5b, 0x92, 0xc8, 0x31, 0xb8, 0x99, 0xbd, 0x54, 0x33,
+	0xf4, 0xcd, 0xc3, 0xdd, 0x4a, 0x55, 0x5b, 0x39, 0xab, 0x93, 0xc9, 0x71, 0x63, 0xcf, 0xb4, 0xd5,
+	0x50, 0xd7, 0xaa, 0xac, 0x11, 0x8a, 0x23, 0x80, 0xac, 0x5a, 0x20, 0x5d, 0x37, 0xd2, 0x07, 0xcb,
+	0x87, 0xab, 0x12, 0xb3, 0x68, 0xe4, 0x25, 0x74, 0x33, 0x6b, 0x65, 0xb4, 0x6d, 0x64, 0x8f, 0x96,
+	0x32, 0xab, 0xc8, 0x6a, 0x54, 0x23, 0xb5, 0xb6, 0x4b, 0x37, 0x9a, 0x52, 0xab, 0xc8, 0x6a, 0x54,
+	0x33, 0x26, 0xfb, 0xc7, 0x40, 0xff, 0x6b, 0x8e, 0xc9, 0xae, 0xb2, 0x3a, 0x99, 0xbc, 0x83, 0x1d,
+	0xd5, 0x8c, 0x11, 0xed, 0x18, 0x87, 0xbd, 0xca, 0xe1, 0x5e, 0xd0, 0xd8, 0x7d, 0x51, 0xd1, 0xc7,
+	0x67, 0x3b, 0x43, 0x14, 0x1a, 0x7d, 0xd4, 0x12, 0xc6, 0xea, 0xe4, 0xd3, 0xed, 0xbb, 0x5f, 0xbd,
+	0x95, 0xdb, 0x59, 0xcf, 0xb9, 0x9b, 0xf5, 0x9c, 0x9f, 0xb3, 0x9e, 0xe3, 0xb7, 0xcd, 0xdf, 0xc7,
+	0xd1, 0xef, 0x01, 0x00, 0x62, 0x01, 0xfa, 0x89, 0x14, 0x05, 0x00, 0x00,
 }
 
 func (m *NotLeader) Marshal() (dAtA []byte, err error) {
@@ -820,6 +889,38 @@ func (m *RaftEntryTooLarge) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *QuotaExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaExceeded) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(len(m.Tenant)))
+		i += copy(dAtA[i:], m.Tenant)
+	}
+	if m.Group != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.Group))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -921,6 +1022,16 @@ func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n9
 	}
+	if m.QuotaExceeded != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.QuotaExceeded.Size()))
+		n10, err := m.QuotaExceeded.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1067,6 +1178,25 @@ func (m *RaftEntryTooLarge) Size() (n int) {
 	return n
 }
 
+func (m *QuotaExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovErrorpb(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovErrorpb(uint64(m.Group))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Error) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1109,6 +1239,10 @@ func (m *Error) Size() (n int) {
 		l = m.RaftEntryTooLarge.Size()
 		n += 1 + l + sovErrorpb(uint64(l))
 	}
+	if m.QuotaExceeded != nil {
+		l = m.QuotaExceeded.Size()
+		n += 1 + l + sovErrorpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1824,6 +1958,111 @@ func (m *RaftEntryTooLarge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuotaExceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErrorpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaExceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErrorpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Error) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2173,6 +2412,42 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaExceeded == nil {
+				m.QuotaExceeded = &QuotaExceeded{}
+			}
+			if err := m.QuotaExceeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErrorpb(dAtA[iNdEx:])
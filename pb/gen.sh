@@ -7,7 +7,7 @@ set -e
 
 # directories containing protos to be built
 MOD="github.com/matrixorigin/matrixcube"
-DIRS="./bhmetapb ./bhraftpb ./raftcmdpb ./errorpb"
+DIRS="./bhmetapb ./bhraftpb ./raftcmdpb ./errorpb ./txnpb ./cdcpb"
 VENDOR_DIR=$(dirname "$PWD")/vendor
 PB_DIR=$(dirname "$PWD")/pb
 PROPHET_PB_DIR=$(dirname "$PWD")/components/prophet/pb
@@ -7,12 +7,14 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	metapb "github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	bhmetapb "github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	errorpb "github.com/matrixorigin/matrixcube/pb/errorpb"
+	raftpb "go.etcd.io/etcd/raft/raftpb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,7 +26,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type CMDType int32
 
@@ -72,17 +74,23 @@ const (
 	AdminCmdType_VerifyHash     AdminCmdType = 5
 	AdminCmdType_BatchSplit     AdminCmdType = 6
 	AdminCmdType_ChangePeerV2   AdminCmdType = 7
+	AdminCmdType_PrepareMerge   AdminCmdType = 8
+	AdminCmdType_CommitMerge    AdminCmdType = 9
+	AdminCmdType_RollbackMerge  AdminCmdType = 10
 )
 
 var AdminCmdType_name = map[int32]string{
-	0: "InvalidAdmin",
-	1: "ChangePeer",
-	2: "CompactLog",
-	3: "TransferLeader",
-	4: "ComputeHash",
-	5: "VerifyHash",
-	6: "BatchSplit",
-	7: "ChangePeerV2",
+	0:  "InvalidAdmin",
+	1:  "ChangePeer",
+	2:  "CompactLog",
+	3:  "TransferLeader",
+	4:  "ComputeHash",
+	5:  "VerifyHash",
+	6:  "BatchSplit",
+	7:  "ChangePeerV2",
+	8:  "PrepareMerge",
+	9:  "CommitMerge",
+	10: "RollbackMerge",
 }
 
 var AdminCmdType_value = map[string]int32{
@@ -94,6 +102,9 @@ var AdminCmdType_value = map[string]int32{
 	"VerifyHash":     5,
 	"BatchSplit":     6,
 	"ChangePeerV2":   7,
+	"PrepareMerge":   8,
+	"CommitMerge":    9,
+	"RollbackMerge":  10,
 }
 
 func (x AdminCmdType) String() string {
@@ -106,15 +117,18 @@ func (AdminCmdType) EnumDescriptor() ([]byte, []int) {
 
 // RaftRequestHeader raft request header, it contains the shard's metadata
 type RaftRequestHeader struct {
-	ID                   []byte               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ShardID              uint64               `protobuf:"varint,2,opt,name=shardID,proto3" json:"shardID,omitempty"`
-	Peer                 metapb.Peer          `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer"`
-	Epoch                metapb.ResourceEpoch `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch"`
-	Term                 uint64               `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
-	IgnoreEpochCheck     bool                 `protobuf:"varint,7,opt,name=ignoreEpochCheck,proto3" json:"ignoreEpochCheck,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ID               []byte               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShardID          uint64               `protobuf:"varint,2,opt,name=shardID,proto3" json:"shardID,omitempty"`
+	Peer             metapb.Peer          `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer"`
+	Epoch            metapb.ResourceEpoch `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch"`
+	Term             uint64               `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
+	IgnoreEpochCheck bool                 `protobuf:"varint,7,opt,name=ignoreEpochCheck,proto3" json:"ignoreEpochCheck,omitempty"`
+	// ProposeTime the unix time in seconds when the request is proposed, all the replicas
+	// compute the expire time of the writes and check the expiration based on it.
+	ProposeTime          int64    `protobuf:"varint,8,opt,name=proposeTime,proto3" json:"proposeTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
@@ -131,7 +145,7 @@ func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_RaftRequestHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -192,6 +206,13 @@ func (m *RaftRequestHeader) GetIgnoreEpochCheck() bool {
 	return false
 }
 
+func (m *RaftRequestHeader) GetProposeTime() int64 {
+	if m != nil {
+		return m.ProposeTime
+	}
+	return 0
+}
+
 type RaftResponseHeader struct {
 	ID                   []byte        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Error                errorpb.Error `protobuf:"bytes,2,opt,name=error,proto3" json:"error"`
@@ -215,7 +236,7 @@ func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_RaftResponseHeader.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -280,7 +301,7 @@ func (m *RaftCMDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_RaftCMDRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -343,7 +364,7 @@ func (m *RaftCMDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_RaftCMDResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -392,6 +413,9 @@ type AdminRequest struct {
 	VerifyHash           *VerifyHashRequest     `protobuf:"bytes,5,opt,name=verifyHash,proto3" json:"verifyHash,omitempty"`
 	Splits               *BatchSplitRequest     `protobuf:"bytes,6,opt,name=splits,proto3" json:"splits,omitempty"`
 	ChangePeerV2         *ChangePeerV2Request   `protobuf:"bytes,7,opt,name=changePeerV2,proto3" json:"changePeerV2,omitempty"`
+	PrepareMerge         *PrepareMergeRequest   `protobuf:"bytes,8,opt,name=prepareMerge,proto3" json:"prepareMerge,omitempty"`
+	CommitMerge          *CommitMergeRequest    `protobuf:"bytes,9,opt,name=commitMerge,proto3" json:"commitMerge,omitempty"`
+	RollbackMerge        *RollbackMergeRequest  `protobuf:"bytes,10,opt,name=rollbackMerge,proto3" json:"rollbackMerge,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -411,7 +435,7 @@ func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_AdminRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -479,6 +503,27 @@ func (m *AdminRequest) GetChangePeerV2() *ChangePeerV2Request {
 	return nil
 }
 
+func (m *AdminRequest) GetPrepareMerge() *PrepareMergeRequest {
+	if m != nil {
+		return m.PrepareMerge
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetCommitMerge() *CommitMergeRequest {
+	if m != nil {
+		return m.CommitMerge
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetRollbackMerge() *RollbackMergeRequest {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
+}
+
 // AdminResponse admin response
 type AdminResponse struct {
 	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmdType,proto3,enum=raftcmdpb.AdminCmdType" json:"cmdType,omitempty"`
@@ -488,6 +533,9 @@ type AdminResponse struct {
 	VerifyHash           *VerifyHashResponse     `protobuf:"bytes,5,opt,name=verifyHash,proto3" json:"verifyHash,omitempty"`
 	Splits               *BatchSplitResponse     `protobuf:"bytes,9,opt,name=splits,proto3" json:"splits,omitempty"`
 	ChangePeerV2         *ChangePeerV2Response   `protobuf:"bytes,10,opt,name=changePeerV2,proto3" json:"changePeerV2,omitempty"`
+	PrepareMerge         *PrepareMergeResponse   `protobuf:"bytes,11,opt,name=prepareMerge,proto3" json:"prepareMerge,omitempty"`
+	CommitMerge          *CommitMergeResponse    `protobuf:"bytes,12,opt,name=commitMerge,proto3" json:"commitMerge,omitempty"`
+	RollbackMerge        *RollbackMergeResponse  `protobuf:"bytes,13,opt,name=rollbackMerge,proto3" json:"rollbackMerge,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -507,7 +555,7 @@ func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_AdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -575,6 +623,27 @@ func (m *AdminResponse) GetChangePeerV2() *ChangePeerV2Response {
 	return nil
 }
 
+func (m *AdminResponse) GetPrepareMerge() *PrepareMergeResponse {
+	if m != nil {
+		return m.PrepareMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetCommitMerge() *CommitMergeResponse {
+	if m != nil {
+		return m.CommitMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetRollbackMerge() *RollbackMergeResponse {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
+}
+
 // Request request
 type Request struct {
 	ID                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -590,6 +659,8 @@ type Request struct {
 	AllowFollower        bool     `protobuf:"varint,11,opt,name=allowFollower,proto3" json:"allowFollower,omitempty"`
 	LastBroadcast        bool     `protobuf:"varint,12,opt,name=lastBroadcast,proto3" json:"lastBroadcast,omitempty"`
 	IgnoreEpochCheck     bool     `protobuf:"varint,13,opt,name=ignoreEpochCheck,proto3" json:"ignoreEpochCheck,omitempty"`
+	MaxStalenessMS       uint64   `protobuf:"varint,14,opt,name=maxStalenessMS,proto3" json:"maxStalenessMS,omitempty"`
+	Tenant               string   `protobuf:"bytes,15,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -609,7 +680,7 @@ func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -719,6 +790,20 @@ func (m *Request) GetIgnoreEpochCheck() bool {
 	return false
 }
 
+func (m *Request) GetMaxStalenessMS() uint64 {
+	if m != nil {
+		return m.MaxStalenessMS
+	}
+	return 0
+}
+
+func (m *Request) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
 // Response response
 type Response struct {
 	ID                   []byte        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -749,7 +834,7 @@ func (m *Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -854,7 +939,7 @@ func (m *ChangePeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_ChangePeerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -909,7 +994,7 @@ func (m *ChangePeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_ChangePeerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -958,7 +1043,7 @@ func (m *CompactLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_CompactLogRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1012,7 +1097,7 @@ func (m *CompactLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CompactLogResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1053,7 +1138,7 @@ func (m *TransferLeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_TransferLeaderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1099,7 +1184,7 @@ func (m *TransferLeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return xxx_messageInfo_TransferLeaderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1141,7 +1226,7 @@ func (m *VerifyHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_VerifyHashRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1201,7 +1286,7 @@ func (m *VerifyHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_VerifyHashResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1249,7 +1334,7 @@ func (m *SplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_SplitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1313,7 +1398,7 @@ func (m *BatchSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_BatchSplitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1367,7 +1452,7 @@ func (m *BatchSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_BatchSplitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1414,7 +1499,7 @@ func (m *ChangePeerV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_ChangePeerV2Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1461,7 +1546,7 @@ func (m *ChangePeerV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_ChangePeerV2Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1487,6 +1572,297 @@ func (m *ChangePeerV2Response) GetShard() *bhmetapb.Shard {
 	return nil
 }
 
+// PrepareMergeRequest is proposed by the source shard, after the log is applied,
+// the source shard will stop serving writes and wait the target shard to merge it.
+type PrepareMergeRequest struct {
+	// minIndex is the min matched index of all source peers, the logs in
+	// (minIndex, commit] will be sent to target shard to catch up.
+	MinIndex             uint64         `protobuf:"varint,1,opt,name=minIndex,proto3" json:"minIndex,omitempty"`
+	Target               bhmetapb.Shard `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PrepareMergeRequest) Reset()         { *m = PrepareMergeRequest{} }
+func (m *PrepareMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeRequest) ProtoMessage()    {}
+func (*PrepareMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{21}
+}
+func (m *PrepareMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepareMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepareMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrepareMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeRequest.Merge(m, src)
+}
+func (m *PrepareMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepareMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepareMergeRequest proto.InternalMessageInfo
+
+func (m *PrepareMergeRequest) GetMinIndex() uint64 {
+	if m != nil {
+		return m.MinIndex
+	}
+	return 0
+}
+
+func (m *PrepareMergeRequest) GetTarget() bhmetapb.Shard {
+	if m != nil {
+		return m.Target
+	}
+	return bhmetapb.Shard{}
+}
+
+type PrepareMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrepareMergeResponse) Reset()         { *m = PrepareMergeResponse{} }
+func (m *PrepareMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeResponse) ProtoMessage()    {}
+func (*PrepareMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{22}
+}
+func (m *PrepareMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepareMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepareMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrepareMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeResponse.Merge(m, src)
+}
+func (m *PrepareMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepareMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepareMergeResponse proto.InternalMessageInfo
+
+// CommitMergeRequest is proposed by the target shard, contains the source shard and
+// the logs of the source shard used to catch up the lagging source peers.
+type CommitMergeRequest struct {
+	Source bhmetapb.Shard `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
+	// commit is the index of the PrepareMerge log of the source shard
+	Commit               uint64         `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Entries              []raftpb.Entry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CommitMergeRequest) Reset()         { *m = CommitMergeRequest{} }
+func (m *CommitMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeRequest) ProtoMessage()    {}
+func (*CommitMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{23}
+}
+func (m *CommitMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeRequest.Merge(m, src)
+}
+func (m *CommitMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitMergeRequest proto.InternalMessageInfo
+
+func (m *CommitMergeRequest) GetSource() bhmetapb.Shard {
+	if m != nil {
+		return m.Source
+	}
+	return bhmetapb.Shard{}
+}
+
+func (m *CommitMergeRequest) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+func (m *CommitMergeRequest) GetEntries() []raftpb.Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type CommitMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitMergeResponse) Reset()         { *m = CommitMergeResponse{} }
+func (m *CommitMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeResponse) ProtoMessage()    {}
+func (*CommitMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{24}
+}
+func (m *CommitMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeResponse.Merge(m, src)
+}
+func (m *CommitMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitMergeResponse proto.InternalMessageInfo
+
+// RollbackMergeRequest is proposed by the source shard, if the target shard refused
+// to merge the source shard.
+type RollbackMergeRequest struct {
+	Commit               uint64   `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackMergeRequest) Reset()         { *m = RollbackMergeRequest{} }
+func (m *RollbackMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeRequest) ProtoMessage()    {}
+func (*RollbackMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{25}
+}
+func (m *RollbackMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeRequest.Merge(m, src)
+}
+func (m *RollbackMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMergeRequest proto.InternalMessageInfo
+
+func (m *RollbackMergeRequest) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+type RollbackMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackMergeResponse) Reset()         { *m = RollbackMergeResponse{} }
+func (m *RollbackMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeResponse) ProtoMessage()    {}
+func (*RollbackMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{26}
+}
+func (m *RollbackMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeResponse.Merge(m, src)
+}
+func (m *RollbackMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMergeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("raftcmdpb.CMDType", CMDType_name, CMDType_value)
 	proto.RegisterEnum("raftcmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
@@ -1511,105 +1887,130 @@ func init() {
 	proto.RegisterType((*BatchSplitResponse)(nil), "raftcmdpb.BatchSplitResponse")
 	proto.RegisterType((*ChangePeerV2Request)(nil), "raftcmdpb.ChangePeerV2Request")
 	proto.RegisterType((*ChangePeerV2Response)(nil), "raftcmdpb.ChangePeerV2Response")
+	proto.RegisterType((*PrepareMergeRequest)(nil), "raftcmdpb.PrepareMergeRequest")
+	proto.RegisterType((*PrepareMergeResponse)(nil), "raftcmdpb.PrepareMergeResponse")
+	proto.RegisterType((*CommitMergeRequest)(nil), "raftcmdpb.CommitMergeRequest")
+	proto.RegisterType((*CommitMergeResponse)(nil), "raftcmdpb.CommitMergeResponse")
+	proto.RegisterType((*RollbackMergeRequest)(nil), "raftcmdpb.RollbackMergeRequest")
+	proto.RegisterType((*RollbackMergeResponse)(nil), "raftcmdpb.RollbackMergeResponse")
 }
 
 func init() { proto.RegisterFile("raftcmdpb.proto", fileDescriptor_c4d8ad5550754569) }
 
 var fileDescriptor_c4d8ad5550754569 = []byte{
-	// 1380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x45, 0xfd, 0x79, 0x44, 0xdb, 0xf4, 0xc6, 0x71, 0xd9, 0xa0, 0xb6, 0x55, 0xa2, 0x2d,
-	0x0c, 0xb7, 0xb1, 0x11, 0x35, 0x6d, 0x51, 0x24, 0x6e, 0x61, 0x49, 0x29, 0x22, 0x34, 0x01, 0x02,
-	0x2a, 0x48, 0xd0, 0x23, 0x45, 0xae, 0x25, 0x36, 0x12, 0xc9, 0x2e, 0x57, 0x4e, 0xdc, 0x6b, 0x1f,
-	0xa2, 0x8f, 0x93, 0x5b, 0x90, 0x4b, 0x81, 0x3c, 0x81, 0x91, 0xfa, 0x49, 0x8a, 0xfd, 0x21, 0xb9,
-	0x34, 0x25, 0x3b, 0xe8, 0xc5, 0xe2, 0xfc, 0xed, 0xce, 0xcc, 0x37, 0x3b, 0x33, 0x86, 0x75, 0xe2,
-	0x9e, 0x50, 0x6f, 0xe6, 0xc7, 0xa3, 0x83, 0x98, 0x44, 0x34, 0x42, 0x2b, 0x19, 0xe3, 0xf6, 0xd1,
-	0x38, 0xa0, 0x93, 0xf9, 0xe8, 0xc0, 0x8b, 0x66, 0x87, 0x33, 0x97, 0x92, 0xe0, 0x75, 0x44, 0x82,
-	0x71, 0x10, 0x4a, 0xc2, 0x9b, 0x8f, 0xf0, 0x61, 0x3c, 0x3a, 0x1c, 0x4d, 0x66, 0x98, 0xba, 0xca,
-	0x87, 0x38, 0xe9, 0xf6, 0xfd, 0x8f, 0x33, 0xc7, 0x84, 0x44, 0x24, 0xff, 0x95, 0xc6, 0x8f, 0x3f,
-	0xc2, 0xd8, 0x8b, 0x66, 0x71, 0x14, 0xe2, 0x90, 0x26, 0x87, 0x31, 0x89, 0xe2, 0x09, 0xa6, 0xec,
-	0x3c, 0xe9, 0x4c, 0xc1, 0x95, 0x3b, 0xca, 0x69, 0xe3, 0x68, 0x1c, 0x1d, 0x72, 0xf6, 0x68, 0x7e,
-	0xc2, 0x29, 0x4e, 0xf0, 0x2f, 0xa1, 0x6e, 0x7f, 0xd0, 0x60, 0xc3, 0x71, 0x4f, 0xa8, 0x83, 0xff,
-	0x98, 0xe3, 0x84, 0x3e, 0xc2, 0xae, 0x8f, 0x09, 0xda, 0x82, 0x4a, 0xe0, 0x5b, 0x5a, 0x5b, 0xdb,
-	0x33, 0xba, 0xf5, 0x8b, 0xf3, 0xdd, 0xca, 0xa0, 0xef, 0x54, 0x02, 0x1f, 0x59, 0xd0, 0x48, 0x26,
-	0x2e, 0xf1, 0x07, 0x7d, 0xab, 0xd2, 0xd6, 0xf6, 0xaa, 0x4e, 0x4a, 0xa2, 0xaf, 0xa0, 0x1a, 0x63,
-	0x4c, 0x2c, 0xbd, 0xad, 0xed, 0xb5, 0x3a, 0xc6, 0x81, 0xf4, 0xe9, 0x29, 0xc6, 0xa4, 0x5b, 0x7d,
-	0x77, 0xbe, 0x7b, 0xc3, 0xe1, 0x72, 0x74, 0x17, 0x6a, 0x38, 0x8e, 0xbc, 0x89, 0x55, 0xe3, 0x8a,
-	0xb7, 0x52, 0x45, 0x07, 0x27, 0xd1, 0x9c, 0x78, 0xf8, 0x21, 0x13, 0x4a, 0x0b, 0xa1, 0x89, 0x10,
-	0x54, 0x29, 0x26, 0x33, 0xab, 0xce, 0x6f, 0xe4, 0xdf, 0x68, 0x1f, 0xcc, 0x60, 0x1c, 0x46, 0x44,
-	0xe8, 0xf7, 0x26, 0xd8, 0x7b, 0x69, 0x35, 0xda, 0xda, 0x5e, 0xd3, 0x29, 0xf1, 0xed, 0x3f, 0x01,
-	0x89, 0x08, 0x93, 0x38, 0x0a, 0x13, 0x7c, 0x4d, 0x88, 0xfb, 0x50, 0xe3, 0xf0, 0xf0, 0x00, 0x5b,
-	0x9d, 0xb5, 0x83, 0x14, 0xac, 0x87, 0xec, 0x37, 0xf3, 0x8c, 0x11, 0xa8, 0x0d, 0x2d, 0x6f, 0x4e,
-	0x08, 0x0e, 0xe9, 0x33, 0xe6, 0xa0, 0xce, 0x1d, 0x54, 0x59, 0xf6, 0x1b, 0x0d, 0xd6, 0xd8, 0xe5,
-	0xbd, 0x27, 0x7d, 0x99, 0x61, 0x74, 0x0f, 0xea, 0x13, 0xee, 0x02, 0xbf, 0xbc, 0xd5, 0xf9, 0xec,
-	0x20, 0xaf, 0xcb, 0x12, 0x12, 0x8e, 0xd4, 0x45, 0xf7, 0xa0, 0x49, 0x84, 0x20, 0xb1, 0x2a, 0x6d,
-	0x7d, 0xaf, 0xd5, 0x41, 0xaa, 0x9d, 0x10, 0x71, 0xef, 0x34, 0x27, 0xd3, 0x44, 0xc7, 0x60, 0xb8,
-	0xfe, 0x2c, 0x08, 0xa5, 0x5c, 0xa2, 0xf3, 0x89, 0x62, 0x79, 0xac, 0x88, 0xa5, 0x79, 0xc1, 0xc4,
-	0xfe, 0x47, 0x83, 0xf5, 0x2c, 0x02, 0x91, 0x41, 0x74, 0xff, 0x52, 0x08, 0xdb, 0xa5, 0x10, 0xd4,
-	0x54, 0xcb, 0x63, 0xd3, 0x48, 0x7e, 0x80, 0x15, 0x22, 0xe5, 0x69, 0x28, 0x37, 0x0b, 0xa1, 0x08,
-	0x99, 0xb4, 0xca, 0x75, 0x51, 0x1f, 0x56, 0xa5, 0x67, 0x82, 0x23, 0xa3, 0xb1, 0xca, 0xd1, 0x14,
-	0x4e, 0x28, 0x1a, 0xd9, 0x6f, 0x74, 0x30, 0xd4, 0xa0, 0xd1, 0x5d, 0x68, 0x78, 0x33, 0xff, 0xd9,
-	0x59, 0x8c, 0x79, 0x34, 0x6b, 0xe5, 0xf4, 0xf4, 0x84, 0xd8, 0x49, 0xf5, 0xd0, 0x03, 0x00, 0x6f,
-	0xe2, 0x86, 0x63, 0xcc, 0xca, 0x5b, 0x16, 0x8a, 0x0a, 0x63, 0x2f, 0x13, 0xca, 0x4b, 0x1c, 0x45,
-	0x9f, 0x5b, 0x47, 0xb3, 0xd8, 0xf5, 0xe8, 0xe3, 0x68, 0x2c, 0x83, 0x28, 0x58, 0x67, 0xc2, 0xdc,
-	0x3a, 0x63, 0xa1, 0x47, 0xb0, 0x46, 0x89, 0x1b, 0x26, 0x27, 0x98, 0x3c, 0x16, 0x18, 0x54, 0xf9,
-	0x09, 0x6d, 0xe5, 0x84, 0x67, 0x05, 0x85, 0xf4, 0x94, 0x4b, 0x76, 0xcc, 0x8f, 0x53, 0x4c, 0x82,
-	0x93, 0xb3, 0x47, 0x6e, 0x92, 0xbe, 0x47, 0xd5, 0x8f, 0xe7, 0x99, 0x30, 0xf3, 0x23, 0xd7, 0x67,
-	0x65, 0x9c, 0xc4, 0xd3, 0x80, 0x26, 0xfc, 0x5d, 0x16, 0x2d, 0xbb, 0x2e, 0xf5, 0x26, 0x43, 0x26,
-	0x4d, 0x2d, 0xa5, 0x2e, 0xea, 0x82, 0x91, 0x67, 0xe2, 0x79, 0x87, 0xbf, 0xd9, 0x56, 0x67, 0x67,
-	0x61, 0xee, 0x9e, 0x77, 0x52, 0xeb, 0x82, 0x8d, 0xfd, 0x56, 0x87, 0xd5, 0x02, 0xd0, 0xff, 0x07,
-	0xc2, 0xa3, 0x05, 0x10, 0x6e, 0x2f, 0x81, 0x50, 0xdc, 0x52, 0xc0, 0xf0, 0x68, 0x01, 0x86, 0xdb,
-	0x4b, 0x30, 0xcc, 0xcc, 0x73, 0x10, 0x07, 0x4b, 0x40, 0xfc, 0xfc, 0x0a, 0x10, 0xe5, 0x31, 0x97,
-	0x51, 0x3c, 0x5a, 0x80, 0xe2, 0xf6, 0x12, 0x14, 0x53, 0x4f, 0x14, 0x18, 0xbf, 0xcb, 0x60, 0x5c,
-	0x29, 0x99, 0xaa, 0x30, 0x4a, 0xd3, 0x14, 0xc7, 0xde, 0x25, 0x1c, 0x81, 0x1b, 0xef, 0x2e, 0xc5,
-	0x51, 0x9a, 0x17, 0x81, 0xfc, 0x4b, 0x87, 0x46, 0xfa, 0x0a, 0x97, 0xb5, 0xe3, 0x4d, 0xa8, 0x8d,
-	0x49, 0x34, 0x8f, 0xe5, 0xbc, 0x11, 0x04, 0x9b, 0x36, 0x94, 0xa1, 0xad, 0x73, 0xb4, 0xd5, 0x4e,
-	0xd8, 0x7b, 0xd2, 0xe7, 0x40, 0x73, 0x39, 0xda, 0x01, 0xf0, 0xe6, 0x09, 0xc5, 0x33, 0x5e, 0x1b,
-	0x55, 0x7e, 0x84, 0xc2, 0x41, 0x26, 0xe8, 0x2f, 0xf1, 0x19, 0xcf, 0x9a, 0xe1, 0xb0, 0x4f, 0xc6,
-	0xf1, 0x66, 0x3e, 0xaf, 0x69, 0xc3, 0x61, 0x9f, 0xe8, 0x53, 0xd0, 0x93, 0xc0, 0xe7, 0x95, 0xaa,
-	0x77, 0x1b, 0x17, 0xe7, 0xbb, 0xfa, 0x70, 0xd0, 0x77, 0x18, 0x8f, 0x89, 0xe2, 0xc0, 0xb7, 0x9a,
-	0xb9, 0xe8, 0x29, 0x13, 0xc5, 0x81, 0x8f, 0xb6, 0xa0, 0x9e, 0xd0, 0x28, 0x3e, 0xa6, 0x3c, 0xaf,
-	0xba, 0x23, 0x29, 0x36, 0x41, 0x69, 0x34, 0x64, 0x43, 0x93, 0xe7, 0xac, 0xea, 0xa4, 0x24, 0xfa,
-	0x02, 0x56, 0xdd, 0xe9, 0x34, 0x7a, 0xf5, 0x4b, 0xc4, 0xfe, 0x62, 0x62, 0xb5, 0xf8, 0x3c, 0x2b,
-	0x32, 0x99, 0xd6, 0xd4, 0x4d, 0x68, 0x97, 0x44, 0xae, 0xef, 0xb9, 0x09, 0xb5, 0x0c, 0xa1, 0x55,
-	0x60, 0x2e, 0x1c, 0x8f, 0xab, 0x4b, 0xc6, 0xe3, 0xdb, 0x0a, 0x34, 0xb3, 0x97, 0xb4, 0x0c, 0x86,
-	0x34, 0xe1, 0x95, 0x6b, 0x12, 0xbe, 0x09, 0xb5, 0x53, 0x77, 0x3a, 0x17, 0xc8, 0x18, 0x8e, 0x20,
-	0xd0, 0x4f, 0xb0, 0x2a, 0x56, 0x9a, 0x74, 0x0e, 0x89, 0x6a, 0x5f, 0x3e, 0xc1, 0x8a, 0xea, 0x29,
-	0x04, 0xb5, 0xe5, 0x10, 0xd4, 0x17, 0x40, 0x90, 0x4d, 0xf2, 0xc6, 0xf5, 0x93, 0xfc, 0x1b, 0xd8,
-	0xf0, 0xa2, 0x90, 0x06, 0xe1, 0x1c, 0xe7, 0xa9, 0x6d, 0xf2, 0x8c, 0x95, 0x05, 0x2c, 0xca, 0x84,
-	0xba, 0x53, 0xcc, 0xb1, 0x6d, 0x3a, 0x82, 0xb0, 0x13, 0xd8, 0x28, 0x35, 0x7e, 0xf4, 0x7d, 0xda,
-	0x67, 0x94, 0xee, 0xb4, 0x95, 0x2e, 0x3d, 0xb9, 0x3a, 0x4f, 0xa1, 0xa2, 0x99, 0xed, 0x53, 0x95,
-	0xab, 0xf7, 0x29, 0xfb, 0x18, 0x50, 0xb9, 0x55, 0xa1, 0xaf, 0xa1, 0xc6, 0x17, 0x33, 0x39, 0x9f,
-	0xd7, 0x0f, 0xb2, 0x7d, 0x95, 0xd7, 0x5a, 0x1a, 0x3b, 0xd7, 0xb1, 0x7f, 0x83, 0x8d, 0xd2, 0xc8,
-	0x41, 0x36, 0x18, 0xb2, 0x5f, 0x0d, 0x42, 0x1f, 0xbf, 0xe6, 0x07, 0x55, 0x9d, 0x02, 0x8f, 0xaf,
-	0x3f, 0x82, 0xe6, 0xeb, 0x4f, 0x45, 0xae, 0x3f, 0x39, 0xcb, 0xde, 0x04, 0x54, 0xee, 0x84, 0xf6,
-	0xcf, 0x70, 0x6b, 0xe1, 0x84, 0xca, 0x82, 0xd6, 0xae, 0x09, 0xda, 0x82, 0xad, 0xc5, 0xdd, 0xd1,
-	0x7e, 0x01, 0x1b, 0xa5, 0xb1, 0xc5, 0xe0, 0x0a, 0x94, 0x20, 0x04, 0xc1, 0xd6, 0xca, 0x09, 0x6b,
-	0x99, 0x15, 0x5e, 0xa9, 0xfc, 0x9b, 0xbd, 0x4e, 0x86, 0x36, 0x7e, 0x4d, 0x65, 0x01, 0xa7, 0x24,
-	0x8b, 0xa4, 0xdc, 0x49, 0xed, 0xdf, 0xc1, 0x50, 0xc7, 0x1c, 0xba, 0x0d, 0x4d, 0xde, 0x20, 0x7f,
-	0xc5, 0x67, 0xe2, 0x11, 0x39, 0x19, 0xcd, 0x7a, 0x51, 0x88, 0x5f, 0x0d, 0x0b, 0xeb, 0xb3, 0xc2,
-	0x91, 0x72, 0x16, 0xeb, 0xa0, 0x9f, 0x58, 0x7a, 0x5b, 0x97, 0x72, 0xc9, 0xb1, 0x63, 0xd8, 0x28,
-	0xcd, 0x55, 0xf4, 0xa3, 0xb2, 0x16, 0x6a, 0x7c, 0x97, 0x52, 0x47, 0x9f, 0xaa, 0x2a, 0x13, 0x98,
-	0xef, 0x86, 0x6d, 0x68, 0x91, 0x60, 0x3c, 0xa1, 0x7d, 0x4c, 0x82, 0x53, 0xf1, 0xb2, 0x9b, 0x8e,
-	0xca, 0xb2, 0x7b, 0x80, 0xca, 0x23, 0x00, 0xdd, 0x81, 0x3a, 0xaf, 0x9b, 0xf4, 0xc2, 0x25, 0xc5,
-	0x25, 0x95, 0xec, 0x21, 0xdc, 0x5c, 0x30, 0xd2, 0xd1, 0x03, 0x68, 0x88, 0x6a, 0x4f, 0x8f, 0xb9,
-	0x72, 0x7f, 0x92, 0x67, 0xa6, 0x26, 0xf6, 0x11, 0x6c, 0x2e, 0x9a, 0x2f, 0xe8, 0xcb, 0xab, 0xeb,
-	0x5e, 0x56, 0xfc, 0x7e, 0x1f, 0x1a, 0xb2, 0x6d, 0xa1, 0x16, 0x34, 0x06, 0xe1, 0xa9, 0x3b, 0x0d,
-	0x7c, 0xf3, 0x06, 0x5a, 0x85, 0x15, 0xb6, 0xbe, 0xf2, 0xfe, 0x60, 0x6a, 0xa8, 0x09, 0xd5, 0x61,
-	0xe8, 0xc6, 0x66, 0x05, 0xad, 0x40, 0xed, 0x05, 0x09, 0x28, 0x36, 0x75, 0xc6, 0x74, 0xb0, 0xeb,
-	0x9b, 0xd5, 0xfd, 0xbf, 0x35, 0xb9, 0x49, 0xca, 0xe5, 0x02, 0x99, 0x60, 0xc8, 0xb3, 0x38, 0xdb,
-	0xbc, 0x81, 0xd6, 0x00, 0x72, 0x3f, 0x4d, 0x8d, 0xd3, 0xd9, 0x7b, 0x30, 0x2b, 0x08, 0xc1, 0x5a,
-	0xb1, 0x90, 0x4d, 0x1d, 0xad, 0x43, 0x8b, 0xe9, 0xcc, 0x29, 0x66, 0xa5, 0x66, 0x56, 0x99, 0x51,
-	0x5e, 0x7a, 0x66, 0x8d, 0xd1, 0x39, 0x2c, 0x66, 0x9d, 0x5d, 0xab, 0x26, 0xc3, 0x6c, 0x74, 0xcd,
-	0xf7, 0xff, 0xee, 0x68, 0xef, 0x2e, 0x76, 0xb4, 0xf7, 0x17, 0x3b, 0xda, 0x87, 0x8b, 0x1d, 0x6d,
-	0x54, 0xe7, 0xff, 0xed, 0x7d, 0xfb, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xfe, 0xa5, 0x8f,
-	0x04, 0x0f, 0x00, 0x00,
+	// 1688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcb, 0x6f, 0xdb, 0xb0,
+	0x19, 0xaf, 0x2c, 0xbf, 0xf2, 0xf9, 0x11, 0x85, 0x79, 0x54, 0x2b, 0x96, 0xc4, 0x13, 0xb6, 0x22,
+	0xc8, 0x56, 0x07, 0xf5, 0xba, 0x0d, 0x5b, 0x9b, 0x75, 0xb1, 0x9d, 0xa2, 0xc1, 0x1a, 0xa0, 0x90,
+	0x83, 0x16, 0xbb, 0x4d, 0x96, 0x18, 0x5b, 0xab, 0xf5, 0x18, 0x45, 0xa7, 0xc9, 0x8e, 0x3b, 0x6e,
+	0xe7, 0xfd, 0x3d, 0xbb, 0x0d, 0xbd, 0x14, 0xe8, 0x6d, 0xb7, 0x60, 0xcb, 0x5f, 0x32, 0x90, 0xa2,
+	0x64, 0xca, 0x92, 0x93, 0x62, 0x17, 0x4b, 0xdf, 0x93, 0xfc, 0xf8, 0xfd, 0x48, 0xfe, 0x64, 0x58,
+	0x27, 0xd6, 0x25, 0xb5, 0x3d, 0x27, 0x1c, 0x77, 0x43, 0x12, 0xd0, 0x00, 0xad, 0xa5, 0x8a, 0x27,
+	0xc7, 0x13, 0x97, 0x4e, 0xe7, 0xe3, 0xae, 0x1d, 0x78, 0x47, 0x9e, 0x45, 0x89, 0x7b, 0x1d, 0x10,
+	0x77, 0xe2, 0xfa, 0x42, 0xb0, 0xe7, 0x63, 0x7c, 0x14, 0x8e, 0x8f, 0xc6, 0x53, 0x0f, 0x53, 0x4b,
+	0x7a, 0x89, 0x33, 0x3d, 0x79, 0xf9, 0x7d, 0xe1, 0x98, 0x90, 0x80, 0x2c, 0x9e, 0x22, 0xf8, 0xdd,
+	0x77, 0x04, 0xdb, 0x81, 0x17, 0x06, 0x3e, 0xf6, 0x69, 0x74, 0x14, 0x92, 0x20, 0x9c, 0x62, 0xca,
+	0xf2, 0x89, 0xc9, 0x64, 0xa6, 0xf2, 0x4c, 0xca, 0x36, 0x09, 0x26, 0xc1, 0x11, 0x57, 0x8f, 0xe7,
+	0x97, 0x5c, 0xe2, 0x02, 0x7f, 0x13, 0xee, 0x4f, 0x27, 0x41, 0x17, 0x53, 0xdb, 0xe9, 0xba, 0xc1,
+	0x11, 0x7b, 0x1e, 0xb1, 0x35, 0xe1, 0x3f, 0xe1, 0x98, 0x3f, 0x62, 0x3f, 0xe3, 0xaf, 0x25, 0xd8,
+	0x30, 0xad, 0x4b, 0x6a, 0xe2, 0x3f, 0xcf, 0x71, 0x44, 0xdf, 0x62, 0xcb, 0xc1, 0x04, 0xed, 0x40,
+	0xc9, 0x75, 0x74, 0xa5, 0xa3, 0x1c, 0x34, 0xfb, 0xd5, 0xbb, 0xdb, 0xfd, 0xd2, 0xd9, 0xd0, 0x2c,
+	0xb9, 0x0e, 0xd2, 0xa1, 0x16, 0x4d, 0x2d, 0xe2, 0x9c, 0x0d, 0xf5, 0x52, 0x47, 0x39, 0x28, 0x9b,
+	0x89, 0x88, 0x9e, 0x42, 0x39, 0xc4, 0x98, 0xe8, 0x6a, 0x47, 0x39, 0x68, 0xf4, 0x9a, 0x5d, 0x31,
+	0xf7, 0xf7, 0x18, 0x93, 0x7e, 0xf9, 0xcb, 0xed, 0xfe, 0x23, 0x93, 0xdb, 0xd1, 0x73, 0xa8, 0xe0,
+	0x30, 0xb0, 0xa7, 0x7a, 0x85, 0x3b, 0x6e, 0x27, 0x8e, 0x26, 0x8e, 0x82, 0x39, 0xb1, 0xf1, 0x29,
+	0x33, 0x8a, 0x88, 0xd8, 0x13, 0x21, 0x28, 0x53, 0x4c, 0x3c, 0xbd, 0xca, 0x47, 0xe4, 0xef, 0xe8,
+	0x10, 0x34, 0x77, 0xe2, 0x07, 0x24, 0xf6, 0x1f, 0x4c, 0xb1, 0xfd, 0x49, 0xaf, 0x75, 0x94, 0x83,
+	0xba, 0x99, 0xd3, 0xa3, 0x0e, 0x34, 0xd8, 0xda, 0x06, 0x11, 0xbe, 0x70, 0x3d, 0xac, 0xd7, 0x3b,
+	0xca, 0x81, 0x6a, 0xca, 0x2a, 0xe3, 0x2f, 0x80, 0xe2, 0x35, 0x88, 0xc2, 0xc0, 0x8f, 0xf0, 0x03,
+	0x8b, 0x70, 0x08, 0x15, 0xde, 0x68, 0xbe, 0x04, 0x8d, 0x5e, 0xbb, 0x9b, 0xb4, 0xfd, 0x94, 0x3d,
+	0xd3, 0xb9, 0x33, 0x81, 0x8d, 0x6d, 0xcf, 0x09, 0xc1, 0x3e, 0xbd, 0x60, 0x25, 0xa8, 0xbc, 0x04,
+	0x59, 0x65, 0xfc, 0x53, 0x81, 0x36, 0x1b, 0x7c, 0x70, 0x3e, 0x14, 0x3d, 0x40, 0x2f, 0xa0, 0x3a,
+	0xe5, 0x53, 0xe0, 0x83, 0x37, 0x7a, 0x3f, 0xec, 0x2e, 0x10, 0x9e, 0xeb, 0x95, 0x29, 0x7c, 0xd1,
+	0x0b, 0xa8, 0x93, 0xd8, 0x10, 0xe9, 0xa5, 0x8e, 0x7a, 0xd0, 0xe8, 0x21, 0x39, 0x2e, 0x36, 0xf1,
+	0xd9, 0x29, 0x66, 0xea, 0x89, 0x4e, 0xa0, 0x69, 0x39, 0x9e, 0xeb, 0x0b, 0xbb, 0xe8, 0xdf, 0x63,
+	0x29, 0xf2, 0x44, 0x32, 0x8b, 0xf0, 0x4c, 0x88, 0xf1, 0x55, 0x81, 0xf5, 0xb4, 0x82, 0x78, 0x05,
+	0xd1, 0xcb, 0xa5, 0x12, 0x76, 0x73, 0x25, 0xc8, 0x4b, 0x2d, 0xd2, 0x26, 0x95, 0xfc, 0x0a, 0xd6,
+	0x88, 0xb0, 0x27, 0xa5, 0x6c, 0x66, 0x4a, 0x89, 0x6d, 0x22, 0x6a, 0xe1, 0x8b, 0x86, 0xd0, 0x12,
+	0x33, 0x8b, 0x35, 0xa2, 0x1a, 0x3d, 0x5f, 0x4d, 0x26, 0x43, 0x36, 0xc8, 0xf8, 0x7b, 0x05, 0x9a,
+	0x72, 0xd1, 0xe8, 0x39, 0xd4, 0x6c, 0xcf, 0xb9, 0xb8, 0x09, 0x31, 0xaf, 0xa6, 0x9d, 0x5f, 0x9e,
+	0x41, 0x6c, 0x36, 0x13, 0x3f, 0xf4, 0x0a, 0xc0, 0x9e, 0x5a, 0xfe, 0x04, 0xb3, 0x0d, 0xa0, 0x97,
+	0x72, 0x6d, 0x1c, 0xa4, 0x46, 0x31, 0x88, 0x29, 0xf9, 0xf3, 0xe8, 0xc0, 0x0b, 0x2d, 0x9b, 0xbe,
+	0x0b, 0x26, 0xba, 0x9a, 0x8f, 0x4e, 0x8d, 0x8b, 0xe8, 0x54, 0x85, 0xde, 0x42, 0x9b, 0x12, 0xcb,
+	0x8f, 0x2e, 0x31, 0x79, 0x17, 0xf7, 0xa0, 0xcc, 0x33, 0x74, 0xa4, 0x0c, 0x17, 0x19, 0x87, 0x24,
+	0xcb, 0x52, 0x1c, 0x9b, 0xc7, 0x15, 0x26, 0xee, 0xe5, 0xcd, 0x5b, 0x2b, 0x4a, 0x76, 0xac, 0x3c,
+	0x8f, 0x0f, 0xa9, 0x31, 0x9d, 0xc7, 0xc2, 0x9f, 0xc1, 0x38, 0x0a, 0x67, 0x2e, 0x8d, 0xf4, 0x6a,
+	0x2e, 0xb2, 0x6f, 0x51, 0x7b, 0x3a, 0x62, 0xd6, 0x24, 0x52, 0xf8, 0xa2, 0x3e, 0x34, 0x17, 0x2b,
+	0xf1, 0xa1, 0xc7, 0x77, 0x75, 0xa3, 0xb7, 0x57, 0xb8, 0x76, 0x1f, 0x7a, 0x49, 0x74, 0x26, 0x86,
+	0xe5, 0x08, 0x09, 0x0e, 0x2d, 0x82, 0xcf, 0x31, 0x99, 0xc4, 0x5b, 0x3e, 0x9b, 0xe3, 0xbd, 0x64,
+	0x4e, 0x73, 0xc8, 0x31, 0xe8, 0x35, 0x34, 0xec, 0xc0, 0xf3, 0x5c, 0x1a, 0xa7, 0x58, 0xcb, 0xc1,
+	0x78, 0xb0, 0xb0, 0x26, 0x19, 0xe4, 0x08, 0x74, 0x0a, 0x2d, 0x12, 0xcc, 0x66, 0x63, 0xcb, 0xfe,
+	0x14, 0xa7, 0x00, 0x9e, 0x62, 0x5f, 0x46, 0xb2, 0x6c, 0x4f, 0x92, 0x64, 0xa3, 0x8c, 0x7f, 0x54,
+	0xa0, 0x95, 0x01, 0xed, 0xff, 0x03, 0xc7, 0xe3, 0x02, 0x38, 0xee, 0xae, 0x80, 0x63, 0x3c, 0x4a,
+	0x06, 0x8f, 0xc7, 0x05, 0x78, 0xdc, 0x5d, 0x81, 0xc7, 0x34, 0x3c, 0xd5, 0xa1, 0xb3, 0x15, 0x80,
+	0xfc, 0xd1, 0x3d, 0x80, 0x14, 0x69, 0x96, 0x11, 0x79, 0x5c, 0x80, 0xc8, 0xdd, 0x15, 0x88, 0x4c,
+	0x66, 0xb2, 0x08, 0x40, 0xbf, 0x48, 0x21, 0x99, 0xef, 0xa7, 0x0c, 0x49, 0x11, 0x9a, 0x60, 0x72,
+	0xb0, 0x84, 0xc9, 0x7c, 0x27, 0xb3, 0x98, 0x14, 0xe1, 0x59, 0x50, 0x0e, 0x96, 0x40, 0xd9, 0xc8,
+	0x25, 0xc9, 0x82, 0x32, 0x49, 0x92, 0x41, 0xe5, 0xef, 0xb2, 0xa8, 0x6c, 0xe6, 0x37, 0x87, 0x8c,
+	0x4a, 0x91, 0x22, 0x03, 0xcb, 0x37, 0xcb, 0xb0, 0x6c, 0xe5, 0x0e, 0x87, 0x25, 0x58, 0x8a, 0x2c,
+	0x4b, 0xb8, 0xfc, 0xb7, 0x0a, 0xb5, 0xe4, 0x80, 0x5c, 0x75, 0x53, 0x6e, 0x41, 0x65, 0x42, 0x82,
+	0x79, 0x28, 0xc8, 0x42, 0x2c, 0x30, 0xaa, 0x40, 0x19, 0x78, 0x55, 0x0e, 0x5e, 0xf9, 0x92, 0x1a,
+	0x9c, 0x0f, 0x39, 0x6e, 0xb9, 0x1d, 0xed, 0x01, 0xd8, 0xf3, 0x88, 0x62, 0x8f, 0x43, 0xbd, 0xcc,
+	0x53, 0x48, 0x1a, 0xa4, 0x81, 0xfa, 0x09, 0xdf, 0x70, 0x10, 0x34, 0x4d, 0xf6, 0xca, 0x34, 0xb6,
+	0xe7, 0xf0, 0xe3, 0xa6, 0x69, 0xb2, 0x57, 0xf4, 0x03, 0x50, 0x23, 0xd7, 0xe1, 0x87, 0x88, 0xda,
+	0xaf, 0xdd, 0xdd, 0xee, 0xab, 0xa3, 0xb3, 0xa1, 0xc9, 0x74, 0xcc, 0x14, 0xba, 0x8e, 0x5e, 0x5f,
+	0x98, 0xde, 0x33, 0x53, 0xe8, 0x3a, 0x68, 0x07, 0xaa, 0x11, 0x0d, 0xc2, 0x13, 0xca, 0x61, 0xa2,
+	0x9a, 0x42, 0x62, 0xf4, 0x87, 0x06, 0x23, 0xc6, 0x78, 0x38, 0x04, 0xca, 0x66, 0x22, 0xa2, 0x1f,
+	0x43, 0xcb, 0x9a, 0xcd, 0x82, 0xcf, 0x6f, 0x02, 0xf6, 0x8b, 0x09, 0xef, 0x6e, 0xdd, 0xcc, 0x2a,
+	0x99, 0xd7, 0xcc, 0x8a, 0x68, 0x9f, 0x04, 0x96, 0x63, 0x5b, 0x11, 0xe5, 0xfd, 0xab, 0x9b, 0x59,
+	0x65, 0x21, 0xb7, 0x69, 0xad, 0xe0, 0x36, 0xbf, 0x81, 0xb6, 0x67, 0x5d, 0x8f, 0xa8, 0x35, 0xc3,
+	0x3e, 0x8e, 0xa2, 0xf3, 0x91, 0xde, 0x66, 0x13, 0xeb, 0xa3, 0xbb, 0xdb, 0xfd, 0xf6, 0x79, 0xc6,
+	0x62, 0x2e, 0x79, 0xb2, 0x2a, 0x29, 0xf6, 0x2d, 0x9f, 0xea, 0xeb, 0x1d, 0xe5, 0x60, 0xcd, 0x14,
+	0x92, 0xf1, 0xaf, 0x12, 0xd4, 0xd3, 0xc3, 0x66, 0x55, 0x6b, 0x93, 0x26, 0x96, 0x1e, 0x68, 0xe2,
+	0x16, 0x54, 0xae, 0xac, 0xd9, 0x3c, 0xee, 0x76, 0xd3, 0x8c, 0x05, 0xf4, 0x5b, 0x68, 0xc5, 0x5c,
+	0x38, 0xa1, 0x1d, 0xf1, 0x81, 0xb0, 0x9a, 0xb0, 0x64, 0xdd, 0x93, 0xb6, 0x56, 0x56, 0xb7, 0xb5,
+	0x5a, 0xd0, 0xd6, 0x94, 0xb8, 0xd5, 0x1e, 0x26, 0x6e, 0x3f, 0x83, 0x0d, 0x3b, 0xf0, 0xa9, 0xeb,
+	0xcf, 0xf1, 0xa2, 0x5d, 0x75, 0xde, 0x85, 0xbc, 0x81, 0x55, 0x19, 0xb1, 0x95, 0xe5, 0x78, 0xa9,
+	0x9b, 0xb1, 0x60, 0x44, 0xb0, 0x91, 0xbb, 0xe7, 0xd1, 0x2f, 0x93, 0xa3, 0x58, 0x3a, 0xc0, 0x77,
+	0x12, 0x16, 0xbc, 0x70, 0xe7, 0x4b, 0x28, 0x79, 0xa6, 0x04, 0xbb, 0x74, 0x3f, 0xc1, 0x36, 0x4e,
+	0x00, 0xe5, 0x4f, 0x73, 0xf4, 0x53, 0xa8, 0x70, 0xa6, 0x2e, 0xe8, 0xd8, 0x7a, 0x37, 0xfd, 0xd0,
+	0xe1, 0xf8, 0x4d, 0x6a, 0xe7, 0x3e, 0xc6, 0x1f, 0x60, 0x23, 0xc7, 0x30, 0x90, 0x01, 0x4d, 0x71,
+	0xa4, 0x9f, 0xf9, 0x0e, 0xbe, 0xe6, 0x89, 0xca, 0x66, 0x46, 0xc7, 0xd9, 0x6e, 0x2c, 0x73, 0xb6,
+	0x5b, 0x12, 0x6c, 0x77, 0xa1, 0x32, 0xb6, 0x00, 0xe5, 0x2f, 0x0b, 0xe3, 0x35, 0x6c, 0x17, 0x12,
+	0x92, 0xb4, 0x68, 0xe5, 0x81, 0xa2, 0x75, 0xd8, 0x29, 0xbe, 0x40, 0x8c, 0x8f, 0xb0, 0x91, 0x63,
+	0x29, 0xac, 0x5d, 0xae, 0x54, 0x44, 0x2c, 0xb0, 0xef, 0x8c, 0x29, 0xbb, 0x55, 0x4a, 0x1c, 0xa9,
+	0xfc, 0x9d, 0xed, 0x78, 0xd6, 0x6d, 0x7c, 0x4d, 0x05, 0x80, 0x13, 0x91, 0x55, 0x92, 0xbf, 0x6c,
+	0x8c, 0x3f, 0x41, 0x53, 0x66, 0x35, 0xe8, 0x09, 0xd4, 0xf9, 0x1d, 0xf2, 0x7b, 0x7c, 0x13, 0x6f,
+	0x22, 0x33, 0x95, 0xd9, 0xf9, 0xe6, 0xe3, 0xcf, 0xa3, 0xcc, 0xf7, 0x94, 0xa4, 0x11, 0x76, 0x56,
+	0xeb, 0xd9, 0x30, 0xd2, 0xd5, 0x8e, 0x2a, 0xec, 0x42, 0x63, 0x84, 0xb0, 0x91, 0xa3, 0x51, 0xe8,
+	0xd7, 0xd2, 0x57, 0x80, 0xc2, 0xa9, 0xb3, 0xcc, 0x0e, 0x64, 0x57, 0xb1, 0x80, 0xa9, 0x3b, 0xeb,
+	0x1e, 0x71, 0x27, 0x53, 0x3a, 0xc4, 0xc4, 0xbd, 0x8a, 0x77, 0x76, 0xdd, 0x94, 0x55, 0xc6, 0x00,
+	0x50, 0xfe, 0x96, 0x44, 0xcf, 0xa0, 0xca, 0x71, 0x93, 0x0c, 0xb8, 0x02, 0x5c, 0xc2, 0xc9, 0x18,
+	0xc1, 0x66, 0x01, 0x83, 0x43, 0xaf, 0xa0, 0x16, 0xa3, 0x3d, 0x49, 0x73, 0x2f, 0x5d, 0x16, 0x39,
+	0x93, 0x10, 0xe3, 0x18, 0xb6, 0x8a, 0xae, 0x60, 0xf4, 0x93, 0xfb, 0x71, 0x9f, 0x20, 0xfe, 0x8f,
+	0xb0, 0x59, 0xc0, 0x08, 0x59, 0xf7, 0x3c, 0xd7, 0x97, 0xf1, 0x9e, 0xca, 0xac, 0x6a, 0x6a, 0x91,
+	0x09, 0xa6, 0x7a, 0xa9, 0x30, 0x75, 0x52, 0x75, 0xec, 0x64, 0xec, 0xc0, 0x56, 0xd1, 0xf5, 0x6e,
+	0xfc, 0x4d, 0xe1, 0x3b, 0x62, 0x89, 0x49, 0xf2, 0x35, 0xe5, 0xdf, 0xc3, 0xf7, 0x6f, 0x58, 0xe1,
+	0xc4, 0x8e, 0xf2, 0xf8, 0x8e, 0x17, 0x30, 0x12, 0x12, 0x7a, 0x06, 0x35, 0xec, 0x53, 0xe2, 0xe2,
+	0x18, 0x3f, 0x8d, 0x5e, 0xab, 0x1b, 0xff, 0x05, 0xd0, 0x3d, 0xf5, 0x29, 0xb9, 0x49, 0x56, 0x51,
+	0xf8, 0x18, 0xdb, 0xb0, 0x59, 0xc0, 0x1f, 0x8c, 0x2e, 0x6c, 0x15, 0x31, 0x55, 0x69, 0x54, 0x45,
+	0x1e, 0xd5, 0x78, 0x0c, 0xdb, 0x85, 0x14, 0xe2, 0x70, 0x08, 0x35, 0x71, 0x3b, 0xa0, 0x06, 0xd4,
+	0xce, 0xfc, 0x2b, 0x6b, 0xe6, 0x3a, 0xda, 0x23, 0xd4, 0x82, 0x35, 0xf6, 0x51, 0xc8, 0x8f, 0x61,
+	0x4d, 0x41, 0x75, 0x28, 0x8f, 0x7c, 0x2b, 0xd4, 0x4a, 0x68, 0x0d, 0x2a, 0x1f, 0x89, 0x4b, 0xb1,
+	0xa6, 0x32, 0xa5, 0x89, 0x2d, 0x47, 0x2b, 0x1f, 0x7e, 0x55, 0xa0, 0x29, 0xd3, 0x5c, 0xa4, 0x41,
+	0x53, 0xe4, 0xe2, 0x6a, 0xed, 0x11, 0x6a, 0x03, 0x2c, 0xe0, 0xa0, 0x29, 0x5c, 0x4e, 0x8f, 0x1d,
+	0xad, 0x84, 0x10, 0xb4, 0xb3, 0xe7, 0x85, 0xa6, 0xa2, 0x75, 0x68, 0x30, 0x9f, 0x39, 0xc5, 0x6c,
+	0x47, 0x6b, 0x65, 0x16, 0xb4, 0xd8, 0xe1, 0x5a, 0x85, 0xc9, 0x0b, 0xf4, 0x6b, 0x55, 0x36, 0xac,
+	0x8c, 0x39, 0xad, 0xc6, 0x34, 0x72, 0x93, 0xb5, 0xba, 0x48, 0x9a, 0xac, 0xa8, 0xb6, 0x86, 0x36,
+	0xa0, 0x95, 0x59, 0x1b, 0x0d, 0xfa, 0xda, 0xb7, 0xff, 0xee, 0x29, 0x5f, 0xee, 0xf6, 0x94, 0x6f,
+	0x77, 0x7b, 0xca, 0x7f, 0xee, 0xf6, 0x94, 0x71, 0x95, 0xff, 0x37, 0xf3, 0xf3, 0xff, 0x0d, 0x00,
+	0x4a, 0x41, 0xc9, 0xa0, 0xda, 0x12, 0x00, 0x00,
 }
 
 func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1617,102 +2018,127 @@ func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftRequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if m.ShardID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ShardID))
-	}
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Peer.Size()))
-	n1, err := m.Peer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n1
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Epoch.Size()))
-	n2, err := m.Epoch.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n2
-	if m.Term != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Term))
+	if m.ProposeTime != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ProposeTime))
+		i--
+		dAtA[i] = 0x40
 	}
 	if m.IgnoreEpochCheck {
-		dAtA[i] = 0x38
-		i++
+		i--
 		if m.IgnoreEpochCheck {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Term != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x30
 	}
-	return i, nil
-}
-
-func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Peer.Size()
+		i -= size
+		if _, err := m.Peer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ShardID != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
 	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Error.Size()))
-	n3, err := m.Error.MarshalTo(dAtA[i:])
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftResponseHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	i += n3
 	if m.CurrentTerm != 0 {
-		dAtA[i] = 0x18
-		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CurrentTerm))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	{
+		size := m.Error.Size()
+		i -= size
+		if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *RaftCMDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1720,52 +2146,64 @@ func (m *RaftCMDRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftCMDRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftCMDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Header.Size()))
-		n4, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Requests) > 0 {
-		for _, msg := range m.Requests {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRaftcmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.AdminRequest != nil {
+		{
+			size, err := m.AdminRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-	}
-	if m.AdminRequest != nil {
+		i--
 		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
-		n5, err := m.AdminRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i += n5
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *RaftCMDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1773,52 +2211,64 @@ func (m *RaftCMDResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *RaftCMDResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftCMDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Header.Size()))
-		n6, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Responses) > 0 {
-		for _, msg := range m.Responses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRaftcmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
+	if m.AdminResponse != nil {
+		{
+			size, err := m.AdminResponse.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i += n
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-	}
-	if m.AdminResponse != nil {
+		i--
 		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
-		n7, err := m.AdminResponse.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i += n7
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1826,85 +2276,139 @@ func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CmdType))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChangePeer != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n8, err := m.ChangePeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.RollbackMerge != nil {
+		{
+			size, err := m.RollbackMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n8
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.CompactLog != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n9, err := m.CompactLog.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.CommitMerge != nil {
+		{
+			size, err := m.CommitMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n9
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n10, err := m.TransferLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.PrepareMerge != nil {
+		{
+			size, err := m.PrepareMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n10
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.VerifyHash != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.VerifyHash.Size()))
-		n11, err := m.VerifyHash.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.ChangePeerV2 != nil {
+		{
+			size, err := m.ChangePeerV2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n11
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.Splits != nil {
+		{
+			size, err := m.Splits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n12, err := m.Splits.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	}
+	if m.VerifyHash != nil {
+		{
+			size, err := m.VerifyHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n12
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ChangePeerV2 != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
-		n13, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.TransferLeader != nil {
+		{
+			size, err := m.TransferLeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n13
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.CompactLog != nil {
+		{
+			size, err := m.CompactLog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangePeer != nil {
+		{
+			size, err := m.ChangePeer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	if m.CmdType != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CmdType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1912,85 +2416,139 @@ func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CmdType))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChangePeer != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n14, err := m.ChangePeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.RollbackMerge != nil {
+		{
+			size, err := m.RollbackMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n14
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.CompactLog != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n15, err := m.CompactLog.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.CommitMerge != nil {
+		{
+			size, err := m.CommitMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n15
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n16, err := m.TransferLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.PrepareMerge != nil {
+		{
+			size, err := m.PrepareMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n16
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.VerifyHash != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.VerifyHash.Size()))
-		n17, err := m.VerifyHash.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.ChangePeerV2 != nil {
+		{
+			size, err := m.ChangePeerV2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n17
+		i--
+		dAtA[i] = 0x52
 	}
 	if m.Splits != nil {
+		{
+			size, err := m.Splits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n18, err := m.Splits.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	}
+	if m.VerifyHash != nil {
+		{
+			size, err := m.VerifyHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n18
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ChangePeerV2 != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
-		n19, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if m.TransferLeader != nil {
+		{
+			size, err := m.TransferLeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i += n19
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.CompactLog != nil {
+		{
+			size, err := m.CompactLog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangePeer != nil {
+		{
+			size, err := m.ChangePeer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CmdType != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CmdType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -1998,103 +2556,124 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if m.Group != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Group))
-	}
-	if m.Type != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Type))
-	}
-	if m.CustemType != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CustemType))
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Cmd) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Cmd)))
-		i += copy(dAtA[i:], m.Cmd)
-	}
-	if m.SID != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.SID))
-	}
-	if m.PID != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.PID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StopAt != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.StopAt))
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.ToShard != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ToShard))
+	if m.MaxStalenessMS != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.MaxStalenessMS))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.AllowFollower {
-		dAtA[i] = 0x58
-		i++
-		if m.AllowFollower {
+	if m.IgnoreEpochCheck {
+		i--
+		if m.IgnoreEpochCheck {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
+		i--
+		dAtA[i] = 0x68
 	}
 	if m.LastBroadcast {
-		dAtA[i] = 0x60
-		i++
+		i--
 		if m.LastBroadcast {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.IgnoreEpochCheck {
-		dAtA[i] = 0x68
-		i++
-		if m.IgnoreEpochCheck {
+	if m.AllowFollower {
+		i--
+		if m.AllowFollower {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ToShard != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ToShard))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StopAt != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.StopAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PID != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.PID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SID != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.SID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Cmd) > 0 {
+		i -= len(m.Cmd)
+		copy(dAtA[i:], m.Cmd)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Cmd)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CustemType != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CustemType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Group != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2102,85 +2681,97 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Type))
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	if m.ContinueBroadcast {
+		i--
+		if m.ContinueBroadcast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.OriginRequest != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.OriginRequest.Size()))
-		n20, err := m.OriginRequest.MarshalTo(dAtA[i:])
-		if err != nil {
+	{
+		size := m.Error.Size()
+		i -= size
+		if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i += n20
-	}
-	if m.SID != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.SID))
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x3a
 	if m.PID != 0 {
-		dAtA[i] = 0x30
-		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.PID))
+		i--
+		dAtA[i] = 0x30
 	}
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Error.Size()))
-	n21, err := m.Error.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.SID != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.SID))
+		i--
+		dAtA[i] = 0x28
 	}
-	i += n21
-	if m.ContinueBroadcast {
-		dAtA[i] = 0x40
-		i++
-		if m.ContinueBroadcast {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.OriginRequest != nil {
+		{
+			size, err := m.OriginRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 		}
-		i++
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Stale {
-		dAtA[i] = 0x48
-		i++
-		if m.Stale {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2188,33 +2779,41 @@ func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangeType))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Peer.Size()))
-	n22, err := m.Peer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	{
+		size := m.Peer.Size()
+		i -= size
+		if _, err := m.Peer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 	}
-	i += n22
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x12
+	if m.ChangeType != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangeType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2222,28 +2821,36 @@ func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Shard.Size()))
-	n23, err := m.Shard.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n23
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	{
+		size := m.Shard.Size()
+		i -= size
+		if _, err := m.Shard.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2251,30 +2858,36 @@ func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CompactLogRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CompactIndex != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CompactIndex))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.CompactTerm != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CompactTerm))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.CompactIndex != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CompactIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2282,20 +2895,26 @@ func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2303,28 +2922,36 @@ func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Peer.Size()))
-	n24, err := m.Peer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n24
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Peer.Size()
+		i -= size
+		if _, err := m.Peer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 	}
-	return i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2332,20 +2959,26 @@ func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *VerifyHashRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2353,37 +2986,45 @@ func (m *VerifyHashRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *VerifyHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Index))
-	}
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Context) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Context)))
-		i += copy(dAtA[i:], m.Context)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *VerifyHashResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2391,20 +3032,26 @@ func (m *VerifyHashResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *VerifyHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2412,48 +3059,56 @@ func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SplitKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.SplitKey)))
-		i += copy(dAtA[i:], m.SplitKey)
-	}
-	if m.NewShardID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.NewShardID))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.NewPeerIDs) > 0 {
-		dAtA26 := make([]byte, len(m.NewPeerIDs)*10)
-		var j25 int
+		dAtA32 := make([]byte, len(m.NewPeerIDs)*10)
+		var j31 int
 		for _, num := range m.NewPeerIDs {
 			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j25++
+				j31++
 			}
-			dAtA26[j25] = uint8(num)
-			j25++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(j31))
+		i--
 		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(j25))
-		i += copy(dAtA[i:], dAtA26[:j25])
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.NewShardID != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.NewShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SplitKey) > 0 {
+		i -= len(m.SplitKey)
+		copy(dAtA[i:], m.SplitKey)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.SplitKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *BatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2461,42 +3116,50 @@ func (m *BatchSplitRequest) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *BatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, msg := range m.Requests {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRaftcmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.RightDerive {
-		dAtA[i] = 0x10
-		i++
+		i--
 		if m.RightDerive {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *BatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2504,32 +3167,40 @@ func (m *BatchSplitResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *BatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Shards) > 0 {
-		for _, msg := range m.Shards {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRaftcmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Shards[iNdEx].Size()
+				i -= size
+				if _, err := m.Shards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ChangePeerV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2537,32 +3208,40 @@ func (m *ChangePeerV2Request) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ChangePeerV2Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePeerV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Changes) > 0 {
-		for _, msg := range m.Changes {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRaftcmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ChangePeerV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2570,34 +3249,255 @@ func (m *ChangePeerV2Response) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ChangePeerV2Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePeerV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if m.Shard != nil {
+		{
+			size := m.Shard.Size()
+			i -= size
+			if _, err := m.Shard.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Shard.Size()))
-		n27, err := m.Shard.MarshalTo(dAtA[i:])
-		if err != nil {
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepareMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepareMergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Target.Size()
+		i -= size
+		if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinIndex != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.MinIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepareMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepareMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitMergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Entries[iNdEx].Size()
+				i -= size
+				if _, err := m.Entries[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Commit != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Commit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Source.Size()
+		i -= size
+		if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i += n27
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackMergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Commit != 0 {
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Commit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintRaftcmdpb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRaftcmdpb(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *RaftRequestHeader) Size() (n int) {
 	if m == nil {
@@ -2622,6 +3522,9 @@ func (m *RaftRequestHeader) Size() (n int) {
 	if m.IgnoreEpochCheck {
 		n += 2
 	}
+	if m.ProposeTime != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.ProposeTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2734,6 +3637,18 @@ func (m *AdminRequest) Size() (n int) {
 		l = m.ChangePeerV2.Size()
 		n += 1 + l + sovRaftcmdpb(uint64(l))
 	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2773,6 +3688,18 @@ func (m *AdminResponse) Size() (n int) {
 		l = m.ChangePeerV2.Size()
 		n += 1 + l + sovRaftcmdpb(uint64(l))
 	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2827,6 +3754,13 @@ func (m *Request) Size() (n int) {
 	if m.IgnoreEpochCheck {
 		n += 2
 	}
+	if m.MaxStalenessMS != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.MaxStalenessMS))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3095,40 +4029,124 @@ func (m *ChangePeerV2Response) Size() (n int) {
 	return n
 }
 
-func sovRaftcmdpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *PrepareMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinIndex != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.MinIndex))
+	}
+	l = m.Target.Size()
+	n += 1 + l + sovRaftcmdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
-func sozRaftcmdpb(x uint64) (n int) {
-	return sovRaftcmdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PrepareMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftcmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *CommitMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovRaftcmdpb(uint64(l))
+	if m.Commit != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Commit))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRaftcmdpb(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRaftcmdpb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRaftcmdpb(x uint64) (n int) {
+	return sovRaftcmdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: RaftRequestHeader: wiretype end group for non-group")
@@ -3192,7 +4210,880 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreEpochCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreEpochCheck = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeTime", wireType)
+			}
+			m.ProposeTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposeTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftResponseHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftResponseHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTerm", wireType)
+			}
+			m.CurrentTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTerm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftCMDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftCMDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftCMDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RaftRequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &Request{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdminRequest == nil {
+				m.AdminRequest = &AdminRequest{}
+			}
+			if err := m.AdminRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftCMDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftCMDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftCMDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RaftResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &Response{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdminResponse == nil {
+				m.AdminResponse = &AdminResponse{}
+			}
+			if err := m.AdminResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= AdminCmdType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeerRequest{}
+			}
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactLog == nil {
+				m.CompactLog = &CompactLogRequest{}
+			}
+			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeaderRequest{}
+			}
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerifyHash == nil {
+				m.VerifyHash = &VerifyHashRequest{}
+			}
+			if err := m.VerifyHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Splits == nil {
+				m.Splits = &BatchSplitRequest{}
+			}
+			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeerV2 == nil {
+				m.ChangePeerV2 = &ChangePeerV2Request{}
+			}
+			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3219,13 +5110,16 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeRequest{}
+			}
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,15 +5146,18 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeRequest{}
+			}
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
 			}
-			m.Term = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3270,31 +5167,28 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Term |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreEpochCheck", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
 			}
-			m.IgnoreEpochCheck = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeRequest{}
+			}
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -3320,7 +5214,7 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3343,17 +5237,17 @@ func (m *RaftResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
 			}
-			var byteLen int
+			m.CmdType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3363,29 +5257,14 @@ func (m *RaftResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CmdType |= AdminCmdType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3412,15 +5291,18 @@ func (m *RaftResponseHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeerResponse{}
+			}
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTerm", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
 			}
-			m.CurrentTerm = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3430,68 +5312,31 @@ func (m *RaftResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTerm |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftCMDRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftcmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.CompactLog == nil {
+				m.CompactLog = &CompactLogResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftCMDRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftCMDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3518,16 +5363,16 @@ func (m *RaftCMDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RaftRequestHeader{}
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeaderResponse{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyHash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3554,14 +5399,16 @@ func (m *RaftCMDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &Request{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VerifyHash == nil {
+				m.VerifyHash = &VerifyHashResponse{}
+			}
+			if err := m.VerifyHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3588,70 +5435,52 @@ func (m *RaftCMDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdminRequest == nil {
-				m.AdminRequest = &AdminRequest{}
+			if m.Splits == nil {
+				m.Splits = &BatchSplitResponse{}
 			}
-			if err := m.AdminRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftCMDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftcmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ChangePeerV2 == nil {
+				m.ChangePeerV2 = &ChangePeerV2Response{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftCMDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftCMDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3678,16 +5507,16 @@ func (m *RaftCMDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RaftResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeResponse{}
+			}
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3714,14 +5543,16 @@ func (m *RaftCMDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, &Response{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeResponse{}
+			}
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3748,10 +5579,10 @@ func (m *RaftCMDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdminResponse == nil {
-				m.AdminResponse = &AdminResponse{}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeResponse{}
 			}
-			if err := m.AdminResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3780,7 +5611,7 @@ func (m *RaftCMDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminRequest) Unmarshal(dAtA []byte) error {
+func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3803,17 +5634,17 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.CmdType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3823,16 +5654,31 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= AdminCmdType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3842,33 +5688,16 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeerRequest{}
-			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3878,33 +5707,16 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= CMDType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CompactLog == nil {
-				m.CompactLog = &CompactLogRequest{}
-			}
-			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustemType", wireType)
 			}
-			var msglen int
+			m.CustemType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3914,33 +5726,16 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CustemType |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeaderRequest{}
-			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifyHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3950,33 +5745,31 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerifyHash == nil {
-				m.VerifyHash = &VerifyHashRequest{}
-			}
-			if err := m.VerifyHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -3986,33 +5779,31 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Splits == nil {
-				m.Splits = &BatchSplitRequest{}
-			}
-			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Cmd = append(m.Cmd[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cmd == nil {
+				m.Cmd = []byte{}
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
 			}
-			var msglen int
+			m.SID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4022,87 +5813,35 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangePeerV2 == nil {
-				m.ChangePeerV2 = &ChangePeerV2Request{}
-			}
-			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AdminResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftcmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AdminResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopAt", wireType)
 			}
-			m.CmdType = 0
+			m.StopAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4112,16 +5851,16 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= AdminCmdType(b&0x7F) << shift
+				m.StopAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToShard", wireType)
 			}
-			var msglen int
+			m.ToShard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4131,33 +5870,16 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ToShard |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeerResponse{}
-			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowFollower", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4167,33 +5889,17 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CompactLog == nil {
-				m.CompactLog = &CompactLogResponse{}
-			}
-			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			m.AllowFollower = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBroadcast", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4203,33 +5909,17 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeaderResponse{}
-			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifyHash", wireType)
+			m.LastBroadcast = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreEpochCheck", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4239,33 +5929,17 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VerifyHash == nil {
-				m.VerifyHash = &VerifyHashResponse{}
-			}
-			if err := m.VerifyHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			m.IgnoreEpochCheck = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessMS", wireType)
 			}
-			var msglen int
+			m.MaxStalenessMS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4275,33 +5949,16 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxStalenessMS |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Splits == nil {
-				m.Splits = &BatchSplitResponse{}
-			}
-			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4311,27 +5968,23 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeerV2 == nil {
-				m.ChangePeerV2 = &ChangePeerV2Response{}
-			}
-			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4358,7 +6011,7 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4381,10 +6034,10 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4422,25 +6075,6 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
@@ -4459,28 +6093,9 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustemType", wireType)
-			}
-			m.CustemType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CustemType |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4507,16 +6122,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginRequest", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4526,69 +6141,33 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd[:0], dAtA[iNdEx:postIndex]...)
-			if m.Cmd == nil {
-				m.Cmd = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
-			}
-			m.SID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
-			}
-			m.PID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.OriginRequest == nil {
+				m.OriginRequest = &Request{}
 			}
-		case 9:
+			if err := m.OriginRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
 			}
-			m.StopAt = 0
+			m.SID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4598,16 +6177,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StopAt |= int64(b&0x7F) << shift
+				m.SID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToShard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
 			}
-			m.ToShard = 0
+			m.PID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4617,16 +6196,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToShard |= uint64(b&0x7F) << shift
+				m.PID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowFollower", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4636,15 +6215,28 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowFollower = bool(v != 0)
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBroadcast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueBroadcast", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4661,10 +6253,10 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LastBroadcast = bool(v != 0)
-		case 13:
+			m.ContinueBroadcast = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreEpochCheck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4681,7 +6273,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IgnoreEpochCheck = bool(v != 0)
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -4707,7 +6299,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4730,51 +6322,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
 			}
-			m.Type = 0
+			m.ChangeType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4784,16 +6342,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= CMDType(b&0x7F) << shift
+				m.ChangeType |= metapb.ChangePeerType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4803,29 +6361,82 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangePeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangePeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4852,37 +6463,69 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OriginRequest == nil {
-				m.OriginRequest = &Request{}
-			}
-			if err := m.OriginRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
 			}
-		case 6:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactIndex", wireType)
 			}
-			m.PID = 0
+			m.CompactIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4892,16 +6535,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PID |= int64(b&0x7F) << shift
+				m.CompactIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactTerm", wireType)
 			}
-			var msglen int
+			m.CompactTerm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4911,65 +6554,65 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CompactTerm |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueBroadcast", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
 			}
-			m.ContinueBroadcast = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Stale = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -4995,7 +6638,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
+func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5018,32 +6661,13 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
-			}
-			m.ChangeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChangeType |= metapb.ChangePeerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
@@ -5101,7 +6725,7 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5124,17 +6748,90 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -5144,23 +6841,58 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
+			if m.Context == nil {
+				m.Context = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5188,7 +6920,7 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
+func (m *VerifyHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5211,50 +6943,12 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactLogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactIndex", wireType)
-			}
-			m.CompactIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompactIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactTerm", wireType)
-			}
-			m.CompactTerm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompactTerm |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5280,7 +6974,7 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
+func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5303,12 +6997,141 @@ func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactLogResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewShardID", wireType)
+			}
+			m.NewShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewShardID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftcmdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIDs = append(m.NewPeerIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftcmdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRaftcmdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRaftcmdpb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewPeerIDs) == 0 {
+					m.NewPeerIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftcmdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIDs = append(m.NewPeerIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIDs", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5334,7 +7157,7 @@ func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5357,15 +7180,15 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5392,10 +7215,31 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, SplitRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightDerive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RightDerive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5421,7 +7265,7 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5431,25 +7275,59 @@ func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRaftcmdpb
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Shards = append(m.Shards, bhmetapb.Shard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5475,7 +7353,7 @@ func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyHashRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerV2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5498,70 +7376,17 @@ func (m *VerifyHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerV2Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -5571,24 +7396,24 @@ func (m *VerifyHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
-			if m.Context == nil {
-				m.Context = []byte{}
+			m.Changes = append(m.Changes, ChangePeerRequest{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5616,7 +7441,7 @@ func (m *VerifyHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyHashResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5639,12 +7464,48 @@ func (m *VerifyHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shard == nil {
+				m.Shard = &bhmetapb.Shard{}
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5670,7 +7531,7 @@ func (m *VerifyHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRequest) Unmarshal(dAtA []byte) error {
+func (m *PrepareMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5693,51 +7554,17 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewShardID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIndex", wireType)
 			}
-			m.NewShardID = 0
+			m.MinIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -5747,87 +7574,44 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewShardID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftcmdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIDs = append(m.NewPeerIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftcmdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRaftcmdpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRaftcmdpb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.NewPeerIDs) == 0 {
-					m.NewPeerIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRaftcmdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIDs = append(m.NewPeerIDs, v)
+				m.MinIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIDs", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5853,7 +7637,7 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *PrepareMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5876,15 +7660,69 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMergeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMergeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5911,16 +7749,15 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, SplitRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RightDerive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var v int
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -5930,12 +7767,45 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Commit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RightDerive = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, raftpb.Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5961,7 +7831,7 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5984,46 +7854,12 @@ func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Shards = append(m.Shards, bhmetapb.Shard{})
-			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -6049,7 +7885,7 @@ func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerV2Request) Unmarshal(dAtA []byte) error {
+func (m *RollbackMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6072,17 +7908,17 @@ func (m *ChangePeerV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -6092,26 +7928,11 @@ func (m *ChangePeerV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Commit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Changes = append(m.Changes, ChangePeerRequest{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -6137,7 +7958,7 @@ func (m *ChangePeerV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerV2Response) Unmarshal(dAtA []byte) error {
+func (m *RollbackMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6160,48 +7981,12 @@ func (m *ChangePeerV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftcmdpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Shard == nil {
-				m.Shard = &bhmetapb.Shard{}
-			}
-			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -6230,6 +8015,7 @@ func (m *ChangePeerV2Response) Unmarshal(dAtA []byte) error {
 func skipRaftcmdpb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6261,10 +8047,8 @@ func skipRaftcmdpb(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -6285,55 +8069,30 @@ func skipRaftcmdpb(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthRaftcmdpb
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthRaftcmdpb
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowRaftcmdpb
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipRaftcmdpb(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthRaftcmdpb
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRaftcmdpb
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRaftcmdpb
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthRaftcmdpb = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowRaftcmdpb   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthRaftcmdpb        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRaftcmdpb          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRaftcmdpb = fmt.Errorf("proto: unexpected end of group")
 )
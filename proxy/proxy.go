@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/raftstore"
 	"github.com/matrixorigin/matrixcube/util"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 )
 
 var (
@@ -35,6 +36,8 @@ var (
 var (
 	// ErrTimeout timeout error
 	ErrTimeout = errors.New("exec timeout")
+	// ErrQuotaExceeded the request is rejected by the request quota of the tenant or the shard group
+	ErrQuotaExceeded = errors.New("request quota exceeded")
 )
 
 var (
@@ -91,11 +94,40 @@ type shardsProxy struct {
 }
 
 func (p *shardsProxy) Dispatch(req *raftcmdpb.Request) error {
-	shard, to := p.router.SelectShard(req.Group, req.Key)
-	return p.DispatchTo(req, shard, to)
+	if err := p.checkQuota(req); err != nil {
+		return err
+	}
+
+	return p.dispatch(req)
 }
 
 func (p *shardsProxy) DispatchTo(req *raftcmdpb.Request, shard uint64, to string) error {
+	if err := p.checkQuota(req); err != nil {
+		return err
+	}
+
+	return p.dispatchTo(req, shard, to)
+}
+
+// checkQuota checks the QPS quotas of the request once when the request is received,
+// the retried requests are not limited again.
+func (p *shardsProxy) checkQuota(req *raftcmdpb.Request) error {
+	if p.store != nil && p.store.CheckRequestQuota(req) != nil {
+		return ErrQuotaExceeded
+	}
+
+	return nil
+}
+
+func (p *shardsProxy) dispatch(req *raftcmdpb.Request) error {
+	shard, to := p.router.SelectShard(req.Group, req.Key)
+	if req.AllowFollower {
+		to = p.router.NearestPeerStore(shard, p.local).ClientAddr
+	}
+	return p.dispatchTo(req, shard, to)
+}
+
+func (p *shardsProxy) dispatchTo(req *raftcmdpb.Request, shard uint64, to string) error {
 	// No leader, retry after a leader tick
 	if to == "" {
 		if logger.DebugEnabled() {
@@ -132,7 +164,8 @@ func (p *shardsProxy) forwardToBackend(req *raftcmdpb.Request, leader string) er
 
 func (p *shardsProxy) onLocalResp(header *raftcmdpb.RaftResponseHeader, rsp *raftcmdpb.Response) {
 	if header != nil {
-		if header.Error.RaftEntryTooLarge == nil {
+		if header.Error.RaftEntryTooLarge == nil &&
+			header.Error.QuotaExceeded == nil {
 			rsp.Type = raftcmdpb.CMDType_RaftError
 		} else {
 			rsp.Type = raftcmdpb.CMDType_Invalid
@@ -146,6 +179,11 @@ func (p *shardsProxy) onLocalResp(header *raftcmdpb.RaftResponseHeader, rsp *raf
 }
 
 func (p *shardsProxy) done(rsp *raftcmdpb.Response) {
+	if rsp.Error.QuotaExceeded != nil {
+		p.errorDoneCB(rsp.OriginRequest, ErrQuotaExceeded)
+		return
+	}
+
 	if rsp.Type == raftcmdpb.CMDType_Invalid && rsp.Error.Message != "" {
 		p.errorDoneCB(rsp.OriginRequest, errors.New(rsp.Error.String()))
 		return
@@ -178,18 +216,18 @@ func (p *shardsProxy) retryWithRaftError(req *raftcmdpb.Request, err string, lat
 func (p *shardsProxy) doRetry(arg interface{}) {
 	req := arg.(raftcmdpb.Request)
 	if req.ToShard == 0 {
-		p.Dispatch(&req)
+		p.dispatch(&req)
 		return
 	}
 
 	to := ""
 	if req.AllowFollower {
-		to = p.router.RandomPeerStore(req.ToShard).ClientAddr
+		to = p.router.NearestPeerStore(req.ToShard, p.local).ClientAddr
 	} else {
 		to = p.router.LeaderPeerStore(req.ToShard).ClientAddr
 	}
 
-	p.DispatchTo(&req, req.ToShard, to)
+	p.dispatchTo(&req, req.ToShard, to)
 }
 
 func (p *shardsProxy) getConn(addr string) (*backend, error) {
@@ -211,8 +249,13 @@ func (p *shardsProxy) getConnLocked(addr string) *backend {
 
 func (p *shardsProxy) createConn(addr string) *backend {
 	encoder, decoder := p.store.CreateRPCCliendSideCodec()
-	bc := newBackend(p, addr,
-		goetty.NewIOSession(goetty.WithCodec(encoder, decoder)))
+	var conn goetty.IOSession
+	if tls := p.store.TLS(); tls != nil {
+		conn = tlsutil.NewIOSession(tls, tlsutil.SessionOptions{Encoder: encoder, Decoder: decoder})
+	} else {
+		conn = goetty.NewIOSession(goetty.WithCodec(encoder, decoder))
+	}
+	bc := newBackend(p, addr, conn)
 
 	old, loaded := p.backends.LoadOrStore(addr, bc)
 	if loaded {
@@ -15,6 +15,7 @@ package raftstore
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/fagongzi/util/uuid"
@@ -51,7 +52,8 @@ func (q *readIndexQueue) push(c cmd) {
 	q.reads = append(q.reads, c)
 }
 
-func (q *readIndexQueue) ready(state raft.ReadState) {
+// ready marks the read is ready, and returns the time when the ReadIndex is sent
+func (q *readIndexQueue) ready(state raft.ReadState) time.Time {
 	if !bytes.Equal(state.RequestCtx, q.reads[q.readyToRead].getUUID()) {
 		logger.Fatalf("shard %d apply read failed, uuid not match",
 			q.shardID)
@@ -61,9 +63,11 @@ func (q *readIndexQueue) ready(state raft.ReadState) {
 		if bytes.Equal(state.RequestCtx, q.reads[idx].getUUID()) {
 			q.reads[idx].readIndexCommittedIndex = state.Index
 			q.readyToRead++
-			return
+			return q.reads[idx].readIndexTime
 		}
 	}
+
+	return time.Time{}
 }
 
 func (q *readIndexQueue) doReadLEAppliedIndex(appliedIndex uint64, pr *peerReplica) {
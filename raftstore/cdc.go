@@ -0,0 +1,728 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/pb/cdcpb"
+	"github.com/matrixorigin/matrixcube/util"
+)
+
+var (
+	// ErrCDCNotEnabled the change events of the group are not recorded, see `CDCConfig.Groups`
+	ErrCDCNotEnabled = errors.New("cdc is not enabled for the group")
+	// ErrChangeLogCompacted the change events after the checkpoint of the subscription were
+	// removed, the subscription can not be resumed.
+	ErrChangeLogCompacted = errors.New("change log compacted")
+	// ErrSubscriptionExists the subscription with the same name is in use
+	ErrSubscriptionExists = errors.New("subscription already exists")
+	// ErrSubscriptionClosed the subscription was closed
+	ErrSubscriptionClosed = errors.New("subscription closed")
+)
+
+var (
+	maxChangeEventsPerPoll = 1024
+	changeWaitTimeout      = time.Second
+)
+
+// ChangeSubscription a subscription of the change events of a key range on the local store.
+//
+// The change events of a shard are ordered by the raft log index, and the events of a key are
+// ordered even if the key moved to other shard by split or merge. The events of the different
+// keys in the different shards are not ordered. Only the shards which have replicas on the local
+// store are subscribed, and the events are delivered at least once, so the consumer should be
+// idempotent. A new subscription starts from the latest applied raft log of the shards, the
+// consumer can scan the data before subscribing if the existing keys are needed.
+//
+// ChangeSubscription is not safe for concurrent use.
+type ChangeSubscription interface {
+	// Next returns the next change events, it blocks until any events are available or
+	// the ctx is done.
+	Next(ctx context.Context) ([]cdcpb.Event, error)
+	// Commit persists the checkpoint of the events returned by Next, the subscription with
+	// the same name is resumed after these events, even if the store restarted.
+	Commit() error
+	// Close closes the subscription, the committed checkpoint is kept.
+	Close() error
+	// Unsubscribe closes the subscription and removes the checkpoint, the change events
+	// are no longer retained for the subscription.
+	Unsubscribe() error
+}
+
+// changeFeed records the change events of the shards when the raft log entries applied, and
+// serves the subscriptions.
+//
+// The events of a raft log entry are saved in the same write batch with the apply state, keyed
+// by the shard id and the log index. So every replica has the same change log regardless of the
+// leadership, and the events are neither lost nor duplicated when the store restarts. The change
+// log of a shard is kept after the shard destroyed, until it is consumed by all the subscriptions.
+//
+// The split and merge are recorded in the change log too, they are used to hand over the key
+// range between the shards in order:
+//  1. Split: the new shards are subscribed after the split event consumed.
+//  2. Merge: the events of the target shard after the merge are blocked until the change log of
+//     the source shard consumed.
+type changeFeed struct {
+	store  *store
+	groups map[uint64]struct{}
+	// compactLock prevents the gc removes the change events which are in reading
+	compactLock sync.RWMutex
+
+	sync.Mutex
+	changedC chan struct{}
+	subs     map[string]*changeSubscription
+}
+
+func newChangeFeed(s *store) *changeFeed {
+	f := &changeFeed{
+		store:    s,
+		groups:   make(map[uint64]struct{}),
+		changedC: make(chan struct{}),
+		subs:     make(map[string]*changeSubscription),
+	}
+	for _, g := range s.cfg.CDC.Groups {
+		f.groups[g] = struct{}{}
+	}
+	return f
+}
+
+func (f *changeFeed) enabled(group uint64) bool {
+	_, ok := f.groups[group]
+	return ok
+}
+
+// notify wakes up the subscriptions which are waiting for the new events
+func (f *changeFeed) notify() {
+	f.Lock()
+	close(f.changedC)
+	f.changedC = make(chan struct{})
+	f.Unlock()
+}
+
+func (f *changeFeed) changed() <-chan struct{} {
+	f.Lock()
+	defer f.Unlock()
+	return f.changedC
+}
+
+func (f *changeFeed) subscribe(name string, group uint64, start, end []byte) (ChangeSubscription, error) {
+	if !f.enabled(group) {
+		return nil, ErrCDCNotEnabled
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	if _, ok := f.subs[name]; ok {
+		return nil, ErrSubscriptionExists
+	}
+
+	sub := &changeSubscription{
+		feed:     f,
+		name:     name,
+		group:    group,
+		start:    start,
+		end:      end,
+		cursors:  make(map[uint64]*changeCursor),
+		finished: make(map[uint64]struct{}),
+		closeC:   make(chan struct{}),
+	}
+
+	cp, err := f.loadCheckpoint(name)
+	if err != nil {
+		return nil, err
+	}
+	if cp != nil {
+		if cp.Group != group || !bytes.Equal(cp.Start, start) || !bytes.Equal(cp.End, end) {
+			return nil, fmt.Errorf("subscription %s was subscribed with group %d [%+v, %+v)",
+				name,
+				cp.Group,
+				cp.Start,
+				cp.End)
+		}
+
+		for _, s := range cp.Shards {
+			sub.cursors[s.ShardID] = &changeCursor{index: s.Index, waitFor: s.WaitFor, strict: true}
+		}
+	}
+
+	if err := sub.discover(); err != nil {
+		return nil, err
+	}
+
+	f.subs[name] = sub
+	return sub, nil
+}
+
+func (f *changeFeed) unregister(name string) {
+	f.Lock()
+	delete(f.subs, name)
+	f.Unlock()
+}
+
+func (f *changeFeed) loadCheckpoint(name string) (*cdcpb.Checkpoint, error) {
+	v, err := f.store.MetadataStorage().Get(getChangeCheckpointKey(name))
+	if err != nil || len(v) == 0 {
+		return nil, err
+	}
+
+	cp := &cdcpb.Checkpoint{}
+	protoc.MustUnmarshal(cp, v)
+	return cp, nil
+}
+
+// truncatedIndex returns the index that the change events before or equal to it were removed
+func (f *changeFeed) truncatedIndex(shardID uint64) (uint64, error) {
+	v, err := f.store.MetadataStorage().Get(getChangeLogKey(shardID, 0))
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(v), nil
+}
+
+func (f *changeFeed) setTruncatedIndex(shardID, index uint64, wb *util.WriteBatch) error {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, index)
+	if wb != nil {
+		return wb.Set(getChangeLogKey(shardID, 0), v)
+	}
+
+	return f.store.MetadataStorage().Set(getChangeLogKey(shardID, 0), v)
+}
+
+// isShardAlive returns false if the shard was removed from the local store, no more change
+// events will be appended to its change log.
+func (f *changeFeed) isShardAlive(shardID uint64) (bool, error) {
+	v, err := f.store.MetadataStorage().Get(getShardLocaleStateKey(shardID))
+	if err != nil || len(v) == 0 {
+		return false, err
+	}
+
+	state := &bhraftpb.ShardLocalState{}
+	protoc.MustUnmarshal(state, v)
+	return state.State != bhraftpb.PeerState_Tombstone, nil
+}
+
+// scan scans the change log of the shard from the giving index until the handler returns false
+func (f *changeFeed) scan(shardID, from uint64, handler func(index uint64, batch *cdcpb.EventBatch) bool) error {
+	if from == 0 {
+		from = 1
+	}
+
+	return f.store.MetadataStorage().Scan(getChangeLogKey(shardID, from),
+		getChangeLogKey(shardID, math.MaxUint64), func(key, value []byte) (bool, error) {
+			_, index, err := decodeChangeLogKey(key)
+			if err != nil {
+				return false, err
+			}
+
+			batch := &cdcpb.EventBatch{}
+			protoc.MustUnmarshal(batch, value)
+			return handler(index, batch), nil
+		}, false)
+}
+
+// pendingSplits returns the shards which are split from the subscribed shards, but the split
+// events are not consumed yet. These shards can't be subscribed before the split events.
+func (f *changeFeed) pendingSplits(positions map[uint64]uint64) (map[uint64]struct{}, error) {
+	pending := make(map[uint64]struct{})
+	var queue []uint64
+	for id := range positions {
+		queue = append(queue, id)
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		err := f.scan(id, positions[id]+1, func(index uint64, batch *cdcpb.EventBatch) bool {
+			for _, e := range batch.Events {
+				if e.Type != cdcpb.Split {
+					continue
+				}
+
+				for _, r := range e.Shards {
+					if _, ok := pending[r.ID]; ok || r.ID == id {
+						continue
+					}
+					if _, ok := positions[r.ID]; ok {
+						continue
+					}
+
+					pending[r.ID] = struct{}{}
+					queue = append(queue, r.ID)
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pending, nil
+}
+
+// gc removes the change events which are consumed by all the subscriptions or exceed the
+// max retained logs, and the change logs of the removed shards which are not subscribed.
+func (f *changeFeed) gc() {
+	if len(f.groups) == 0 {
+		return
+	}
+
+	retained, err := f.retainedIndexes()
+	if err != nil {
+		logger.Errorf("load change subscriptions failed with %+v", err)
+		return
+	}
+
+	f.compactLock.Lock()
+	defer f.compactLock.Unlock()
+
+	id := uint64(0)
+	for {
+		key, _, err := f.store.MetadataStorage().Seek(getChangeLogKey(id, 0))
+		if err != nil {
+			logger.Errorf("seek change log failed with %+v", err)
+			return
+		}
+		if len(key) == 0 || !bytes.HasPrefix(key, changeLogPrefixKey) {
+			return
+		}
+
+		id, _, err = decodeChangeLogKey(key)
+		if err != nil {
+			logger.Errorf("decode change log key failed with %+v", err)
+			return
+		}
+
+		index, ok := retained[id]
+		if err := f.gcShard(id, index, ok); err != nil {
+			logger.Errorf("shard %d gc change log failed with %+v",
+				id,
+				err)
+		}
+
+		if id == math.MaxUint64 {
+			return
+		}
+		id++
+	}
+}
+
+func (f *changeFeed) gcShard(id uint64, consumed uint64, subscribed bool) error {
+	alive, err := f.isShardAlive(id)
+	if err != nil {
+		return err
+	}
+
+	if !alive && !subscribed {
+		logger.Infof("shard %d change log removed", id)
+		return f.store.MetadataStorage().RangeDelete(getChangeLogKey(id, 0), getChangeLogKey(id+1, 0))
+	}
+
+	truncated, err := f.truncatedIndex(id)
+	if err != nil {
+		return err
+	}
+
+	target := truncated
+	if subscribed && consumed > target {
+		target = consumed
+	}
+
+	if alive {
+		v, err := f.store.MetadataStorage().Get(getRaftApplyStateKey(id))
+		if err != nil {
+			return err
+		}
+		if len(v) > 0 {
+			state := &bhraftpb.RaftApplyState{}
+			protoc.MustUnmarshal(state, v)
+			max := f.store.cfg.CDC.MaxRetainedLogs
+			if state.AppliedIndex > max && state.AppliedIndex-max > target {
+				target = state.AppliedIndex - max
+			}
+		}
+	}
+
+	if target <= truncated {
+		return nil
+	}
+
+	err = f.store.MetadataStorage().RangeDelete(getChangeLogKey(id, truncated+1), getChangeLogKey(id, target+1))
+	if err != nil {
+		return err
+	}
+
+	logger.Debugf("shard %d change log truncated to %d", id, target)
+	return f.setTruncatedIndex(id, target, nil)
+}
+
+// retainedIndexes returns the min consumed index of the shards by all the subscriptions, both
+// the committed checkpoints and the active subscriptions are considered.
+func (f *changeFeed) retainedIndexes() (map[uint64]uint64, error) {
+	var all []map[uint64]uint64
+	err := f.store.MetadataStorage().PrefixScan(changeCheckpointPrefixKey, func(key, value []byte) (bool, error) {
+		cp := &cdcpb.Checkpoint{}
+		protoc.MustUnmarshal(cp, value)
+		positions := make(map[uint64]uint64)
+		for _, s := range cp.Shards {
+			positions[s.ShardID] = s.Index
+		}
+		all = append(all, positions)
+		return true, nil
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	for _, sub := range f.subs {
+		all = append(all, sub.positions())
+	}
+	f.Unlock()
+
+	retained := make(map[uint64]uint64)
+	keep := func(id, index uint64) {
+		if v, ok := retained[id]; !ok || index < v {
+			retained[id] = index
+		}
+	}
+	for _, positions := range all {
+		pending, err := f.pendingSplits(positions)
+		if err != nil {
+			return nil, err
+		}
+
+		for id, index := range positions {
+			keep(id, index)
+		}
+		for id := range pending {
+			keep(id, 0)
+		}
+	}
+	return retained, nil
+}
+
+type changeCursor struct {
+	// index the last consumed log index
+	index uint64
+	// waitFor the merged source shard, the events after the merge are blocked until the
+	// change log of the source shard consumed.
+	waitFor uint64
+	// strict the cursor is resumed from a checkpoint or created by a split, the subscription
+	// fails if the events after the index were removed.
+	strict bool
+}
+
+type changeSubscription struct {
+	feed  *changeFeed
+	name  string
+	group uint64
+	start []byte
+	end   []byte
+
+	sync.Mutex
+	cursors map[uint64]*changeCursor
+	// finished the shards which are no longer subscribed, they are left the key range or
+	// removed from the local store.
+	finished  map[uint64]struct{}
+	closeOnce sync.Once
+	closeC    chan struct{}
+}
+
+func (sub *changeSubscription) Next(ctx context.Context) ([]cdcpb.Event, error) {
+	for {
+		select {
+		case <-sub.closeC:
+			return nil, ErrSubscriptionClosed
+		default:
+		}
+
+		changedC := sub.feed.changed()
+		events, err := sub.poll()
+		if err != nil || len(events) > 0 {
+			return events, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-sub.closeC:
+			return nil, ErrSubscriptionClosed
+		case <-changedC:
+		case <-time.After(changeWaitTimeout):
+		}
+	}
+}
+
+func (sub *changeSubscription) Commit() error {
+	sub.Lock()
+	cp := &cdcpb.Checkpoint{
+		Group: sub.group,
+		Start: sub.start,
+		End:   sub.end,
+	}
+	for _, id := range sub.shardIDs() {
+		c := sub.cursors[id]
+		cp.Shards = append(cp.Shards, cdcpb.ShardCheckpoint{
+			ShardID: id,
+			Index:   c.index,
+			WaitFor: c.waitFor,
+		})
+	}
+	sub.Unlock()
+
+	return sub.feed.store.MetadataStorage().Set(getChangeCheckpointKey(sub.name), protoc.MustMarshal(cp))
+}
+
+func (sub *changeSubscription) Close() error {
+	sub.closeOnce.Do(func() {
+		close(sub.closeC)
+		sub.feed.unregister(sub.name)
+	})
+	return nil
+}
+
+func (sub *changeSubscription) Unsubscribe() error {
+	sub.Close()
+	return sub.feed.store.MetadataStorage().Delete(getChangeCheckpointKey(sub.name))
+}
+
+func (sub *changeSubscription) positions() map[uint64]uint64 {
+	sub.Lock()
+	defer sub.Unlock()
+
+	positions := make(map[uint64]uint64, len(sub.cursors))
+	for id, c := range sub.cursors {
+		positions[id] = c.index
+	}
+	return positions
+}
+
+func (sub *changeSubscription) shardIDs() []uint64 {
+	ids := make([]uint64, 0, len(sub.cursors))
+	for id := range sub.cursors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (sub *changeSubscription) contains(key []byte) bool {
+	return bytes.Compare(key, sub.start) >= 0 &&
+		(len(sub.end) == 0 || bytes.Compare(key, sub.end) < 0)
+}
+
+func (sub *changeSubscription) overlaps(start, end []byte) bool {
+	return (len(sub.end) == 0 || bytes.Compare(start, sub.end) < 0) &&
+		(len(end) == 0 || bytes.Compare(sub.start, end) < 0)
+}
+
+// discover subscribes the local shards which overlap the key range, except the shards which
+// are waiting for the split events of the subscribed shards.
+func (sub *changeSubscription) discover() error {
+	positions := make(map[uint64]uint64, len(sub.cursors))
+	for id, c := range sub.cursors {
+		positions[id] = c.index
+	}
+	pending, err := sub.feed.pendingSplits(positions)
+	if err != nil {
+		return err
+	}
+
+	value, ok := sub.feed.store.keyRanges.Load(sub.group)
+	if !ok {
+		return nil
+	}
+
+	var shards []uint64
+	value.(*util.ShardTree).Ascend(func(shard *bhmetapb.Shard) bool {
+		if sub.overlaps(shard.Start, shard.End) {
+			shards = append(shards, shard.ID)
+		}
+		return true
+	})
+
+	for _, id := range shards {
+		if _, ok := sub.cursors[id]; ok {
+			continue
+		}
+		if _, ok := sub.finished[id]; ok {
+			continue
+		}
+		if _, ok := pending[id]; ok {
+			continue
+		}
+
+		alive, err := sub.feed.isShardAlive(id)
+		if err != nil {
+			return err
+		}
+		if !alive {
+			continue
+		}
+
+		c, err := sub.newCursor(id)
+		if err != nil {
+			return err
+		}
+		sub.cursors[id] = c
+	}
+	return nil
+}
+
+// newCursor returns the cursor to subscribe a new shard. If the shard merged a subscribed
+// shard, starts from the merge, otherwise starts from the latest applied index.
+func (sub *changeSubscription) newCursor(id uint64) (*changeCursor, error) {
+	var merged *changeCursor
+	err := sub.feed.scan(id, 0, func(index uint64, batch *cdcpb.EventBatch) bool {
+		for _, e := range batch.Events {
+			if e.Type != cdcpb.Merge {
+				continue
+			}
+
+			for _, r := range e.Shards {
+				if _, ok := sub.finished[r.ID]; ok {
+					merged = &changeCursor{index: index, strict: true}
+				} else if _, ok := sub.cursors[r.ID]; ok {
+					merged = &changeCursor{index: index, waitFor: r.ID, strict: true}
+				}
+			}
+		}
+		return true
+	})
+	if err != nil || merged != nil {
+		return merged, err
+	}
+
+	v, err := sub.feed.store.MetadataStorage().Get(getRaftApplyStateKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	state := &bhraftpb.RaftApplyState{}
+	if len(v) > 0 {
+		protoc.MustUnmarshal(state, v)
+	}
+	return &changeCursor{index: state.AppliedIndex}, nil
+}
+
+func (sub *changeSubscription) poll() ([]cdcpb.Event, error) {
+	sub.Lock()
+	defer sub.Unlock()
+
+	sub.feed.compactLock.RLock()
+	defer sub.feed.compactLock.RUnlock()
+
+	var events []cdcpb.Event
+	rediscover := false
+	for _, id := range sub.shardIDs() {
+		if len(events) >= maxChangeEventsPerPoll {
+			break
+		}
+
+		c := sub.cursors[id]
+		if c.waitFor != 0 {
+			if _, ok := sub.cursors[c.waitFor]; ok {
+				continue
+			}
+			c.waitFor = 0
+		}
+
+		// check the state before reading, the events appended before the shard removed
+		// must be read.
+		alive, err := sub.feed.isShardAlive(id)
+		if err != nil {
+			return nil, err
+		}
+
+		truncated, err := sub.feed.truncatedIndex(id)
+		if err != nil {
+			return nil, err
+		}
+		if c.index < truncated {
+			if c.strict {
+				return nil, fmt.Errorf("%w: shard %d consumed %d, truncated %d",
+					ErrChangeLogCompacted,
+					id,
+					c.index,
+					truncated)
+			}
+			c.index = truncated
+		}
+
+		read := false
+		leave := false
+		err = sub.feed.scan(id, c.index+1, func(index uint64, batch *cdcpb.EventBatch) bool {
+			read = true
+			for _, e := range batch.Events {
+				switch e.Type {
+				case cdcpb.Put, cdcpb.Delete:
+					if sub.contains(e.Key) {
+						events = append(events, e)
+					}
+				case cdcpb.Split:
+					for _, r := range e.Shards {
+						if r.ID == id {
+							leave = !sub.overlaps(r.Start, r.End)
+						} else if _, ok := sub.cursors[r.ID]; !ok && sub.overlaps(r.Start, r.End) {
+							sub.cursors[r.ID] = &changeCursor{strict: true}
+						}
+					}
+				case cdcpb.Merge:
+					for _, r := range e.Shards {
+						if _, ok := sub.cursors[r.ID]; ok {
+							c.waitFor = r.ID
+						}
+					}
+				}
+			}
+
+			c.index = index
+			return !leave && c.waitFor == 0 && len(events) < maxChangeEventsPerPoll
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if leave || (!read && !alive) {
+			delete(sub.cursors, id)
+			sub.finished[id] = struct{}{}
+			rediscover = true
+		}
+	}
+
+	if rediscover {
+		if err := sub.discover(); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
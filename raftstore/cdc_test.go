@@ -0,0 +1,123 @@
+package raftstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/cdcpb"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeSubscription(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		DisableScheduleTestCluster,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.CDC.Groups = []uint64{0}
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	s := c.GetStore(0)
+	_, err := s.SubscribeChanges("s1", 1, nil, nil)
+	assert.Equal(t, ErrCDCNotEnabled, err)
+
+	sub, err := s.SubscribeChanges("s1", 0, []byte("key1"), []byte("key3"))
+	assert.NoError(t, err)
+	_, err = s.SubscribeChanges("s1", 0, []byte("key1"), []byte("key3"))
+	assert.Equal(t, ErrSubscriptionExists, err)
+
+	sendTestWrites(t, s, "key1", "key2", "key3")
+	events := nextTestChanges(t, sub, 2)
+	assert.Equal(t, "key1", string(events[0].Key))
+	assert.Equal(t, "key2", string(events[1].Key))
+	assert.Equal(t, cdcpb.Put, events[0].Type)
+	assert.True(t, events[1].Index > events[0].Index)
+	assert.NoError(t, sub.Commit())
+	assert.NoError(t, sub.Close())
+
+	// resume from the checkpoint
+	sendTestWrites(t, s, "key2")
+	sub, err = s.SubscribeChanges("s1", 0, []byte("key1"), []byte("key3"))
+	assert.NoError(t, err)
+	events = nextTestChanges(t, sub, 1)
+	assert.Equal(t, "key2", string(events[0].Key))
+	assert.NoError(t, sub.Commit())
+
+	// the consumed change events are removed
+	st := s.(*store)
+	st.changes.gc()
+	truncated, err := st.changes.truncatedIndex(events[0].ShardID)
+	assert.NoError(t, err)
+	assert.Equal(t, events[0].Index, truncated)
+
+	assert.NoError(t, sub.Unsubscribe())
+	_, err = sub.Next(context.Background())
+	assert.Equal(t, ErrSubscriptionClosed, err)
+	cp, err := st.changes.loadCheckpoint("s1")
+	assert.NoError(t, err)
+	assert.Nil(t, cp)
+}
+
+func TestChangeSubscriptionWithSplit(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.CDC.Groups = []uint64{0}
+			cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+			cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitShardByCount(t, 1, time.Second*10)
+	s := c.GetStore(0)
+	sub, err := s.SubscribeChanges("s1", 0, nil, nil)
+	assert.NoError(t, err)
+	defer sub.Close()
+
+	sendTestWrites(t, s, "key1", "key2", "key3")
+	c.WaitShardByCount(t, 2, time.Second*10)
+	sendTestWrites(t, s, "key1", "key2", "key3")
+
+	events := nextTestChanges(t, sub, 6)
+	shards := make(map[string][]uint64)
+	for _, e := range events {
+		shards[string(e.Key)] = append(shards[string(e.Key)], e.ShardID)
+	}
+	for _, key := range []string{"key1", "key2", "key3"} {
+		assert.Equal(t, 2, len(shards[key]), key)
+	}
+	assert.NotEqual(t, shards["key3"][0], shards["key3"][1])
+}
+
+func sendTestWrites(t *testing.T, s Store, keys ...string) {
+	for _, key := range keys {
+		req := createTestWriteReq(fmt.Sprintf("w-%s-%d", key, time.Now().UnixNano()), key, "value")
+		_, err := sendTestReqs(s, time.Second*10, nil, nil, req)
+		assert.NoError(t, err)
+	}
+}
+
+func nextTestChanges(t *testing.T, sub ChangeSubscription, n int) []cdcpb.Event {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	var events []cdcpb.Event
+	for len(events) < n {
+		values, err := sub.Next(ctx)
+		if !assert.NoError(t, err) {
+			break
+		}
+		events = append(events, values...)
+	}
+	return events
+}
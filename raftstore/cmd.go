@@ -14,6 +14,8 @@
 package raftstore
 
 import (
+	"time"
+
 	"github.com/fagongzi/util/uuid"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/pb"
@@ -25,6 +27,7 @@ type cmd struct {
 	req                     *raftcmdpb.RaftCMDRequest
 	cb                      func(*raftcmdpb.RaftCMDResponse)
 	readIndexCommittedIndex uint64
+	readIndexTime           time.Time
 	term                    uint64
 	tp                      int
 	size                    int
@@ -35,7 +38,9 @@ func (c *cmd) isFull(n, max int) bool {
 }
 
 func (c *cmd) canAppend(req *raftcmdpb.Request) bool {
-	return c.req.Header.IgnoreEpochCheck == req.IgnoreEpochCheck
+	return c.req.Header.IgnoreEpochCheck == req.IgnoreEpochCheck &&
+		c.req.Requests[0].AllowFollower == req.AllowFollower &&
+		c.req.Requests[0].MaxStalenessMS == req.MaxStalenessMS
 }
 
 func newCMD(req *raftcmdpb.RaftCMDRequest, cb func(*raftcmdpb.RaftCMDResponse), tp int, size int) cmd {
@@ -86,6 +91,21 @@ func respStoreNotMatch(err error, req *raftcmdpb.Request, cb func(*raftcmdpb.Raf
 	cb(rsp)
 }
 
+func respQuotaExceeded(err *errorpb.QuotaExceeded, req *raftcmdpb.Request, cb func(*raftcmdpb.RaftCMDResponse)) {
+	rsp := errorPbResp(&errorpb.Error{
+		Message:       errQuotaExceeded.Error(),
+		QuotaExceeded: err,
+	}, uuid.NewV4().Bytes(), 0)
+
+	resp := pb.AcquireResponse()
+	resp.ID = req.ID
+	resp.SID = req.SID
+	resp.PID = req.PID
+	resp.OriginRequest = req
+	rsp.Responses = append(rsp.Responses, resp)
+	cb(rsp)
+}
+
 func (c *cmd) resp(resp *raftcmdpb.RaftCMDResponse) {
 	if c.cb != nil {
 		if len(c.req.Requests) > 0 {
@@ -0,0 +1,46 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"runtime"
+	"syscall"
+)
+
+// rusageThread is the RUSAGE_THREAD of getrusage(2), which is not defined in syscall
+const rusageThread = 1
+
+// measureCPUTime returns the microseconds of the cpu time spent by fn. The goroutine is
+// locked to the current thread while running fn, so the cpu time of the thread is only
+// consumed by fn. The time waiting for the io or the locks is not counted.
+func measureCPUTime(fn func()) uint64 {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	start := threadCPUTime()
+	fn()
+	end := threadCPUTime()
+	if end < start {
+		return 0
+	}
+	return end - start
+}
+
+func threadCPUTime() uint64 {
+	var ru syscall.Rusage
+	if err := syscall.Getrusage(rusageThread, &ru); err != nil {
+		return 0
+	}
+	return uint64((ru.Utime.Nano() + ru.Stime.Nano()) / 1000)
+}
@@ -0,0 +1,36 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeasureCPUTime(t *testing.T) {
+	// the sleeping time is not counted
+	assert.True(t, measureCPUTime(func() { time.Sleep(time.Millisecond * 200) }) < 100000)
+
+	n := 0
+	busy := measureCPUTime(func() {
+		start := time.Now()
+		for time.Since(start) < time.Millisecond*200 {
+			n++
+		}
+	})
+	assert.True(t, n > 0)
+	assert.True(t, busy > 0)
+}
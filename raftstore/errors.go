@@ -32,6 +32,9 @@ var (
 	errLargeRaftEntrySize = errors.New("raft entry is too large")
 	errKeyNotInShard      = errors.New("key not in shard")
 	errStoreNotMatch      = errors.New("store not match")
+	errShardMerging       = errors.New("shard is merging")
+	errMergeSourceMissing = errors.New("merge source shard is not ready")
+	errQuotaExceeded      = errors.New("request quota exceeded")
 
 	infoStaleCMD  = new(errorpb.StaleCommand)
 	storeNotMatch = new(errorpb.StoreNotMatch)
@@ -76,6 +79,15 @@ func errorStaleCMDResp(uuid []byte, currentTerm uint64) *raftcmdpb.RaftCMDRespon
 	return resp
 }
 
+// errorMergeSourceMissingResp the target shard can't merge the source shard now, the
+// source shard is not created or not caught up on the store, the merge can be retried.
+func errorMergeSourceMissingResp(uuid []byte, currentTerm uint64, err error) *raftcmdpb.RaftCMDResponse {
+	resp := errorBaseResp(uuid, currentTerm)
+	resp.Header.Error.Message = err.Error()
+	resp.Header.Error.ServerIsBusy = &errorpb.ServerIsBusy{}
+	return resp
+}
+
 func errorStaleEpochResp(uuid []byte, currentTerm uint64, newShards ...bhmetapb.Shard) *raftcmdpb.RaftCMDResponse {
 	resp := errorBaseResp(uuid, currentTerm)
 
@@ -0,0 +1,94 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"time"
+
+	"go.etcd.io/etcd/raft"
+)
+
+// followerReadQueue holds the reads served by a follower. The follower gets the
+// committed index of the leader by the ReadIndex, and serves the reads after the
+// applied index catches up.
+type followerReadQueue struct {
+	shardID uint64
+	reads   []cmd
+	// safeTime is the time when the latest finished ReadIndex is sent, the data
+	// of the follower is not older than it.
+	safeTime time.Time
+}
+
+func (q *followerReadQueue) push(c cmd) {
+	q.reads = append(q.reads, c)
+}
+
+// ready returns true if the read state belongs to a follower read. Unlike the
+// leader, the read states may be out of order because the ReadIndex messages
+// can be dropped by the network or the leader.
+func (q *followerReadQueue) ready(state raft.ReadState) bool {
+	for idx := range q.reads {
+		if bytes.Equal(state.RequestCtx, q.reads[idx].getUUID()) {
+			q.reads[idx].readIndexCommittedIndex = state.Index
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *followerReadQueue) doReadLEAppliedIndex(appliedIndex uint64, pr *peerReplica) {
+	if len(q.reads) == 0 {
+		return
+	}
+
+	newCmds := q.reads[:0] // avoid alloc new slice
+	for _, c := range q.reads {
+		if c.readIndexCommittedIndex > 0 && c.readIndexCommittedIndex <= appliedIndex {
+			pr.doExecReadCmd(c)
+			if c.readIndexTime.After(q.safeTime) {
+				q.safeTime = c.readIndexTime
+			}
+		} else {
+			newCmds = append(newCmds, c)
+		}
+	}
+
+	q.reads = newCmds
+}
+
+// expire responds the reads which are waiting for the ReadIndex too long with
+// the stale error, the client will retry them.
+func (q *followerReadQueue) expire(now time.Time, timeout time.Duration, term uint64) {
+	if len(q.reads) == 0 {
+		return
+	}
+
+	newCmds := q.reads[:0] // avoid alloc new slice
+	for _, c := range q.reads {
+		if c.readIndexCommittedIndex == 0 && now.Sub(c.readIndexTime) > timeout {
+			c.resp(errorStaleCMDResp(c.getUUID(), term))
+		} else {
+			newCmds = append(newCmds, c)
+		}
+	}
+
+	q.reads = newCmds
+}
+
+// fresh returns true if the data of the follower is no older than the staleness.
+func (q *followerReadQueue) fresh(now time.Time, staleness time.Duration) bool {
+	return !q.safeTime.IsZero() && now.Sub(q.safeTime) <= staleness
+}
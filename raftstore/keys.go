@@ -53,6 +53,10 @@ var (
 
 var storeIdentKey = []byte{localPrefix, 0x01}
 
+// logStorageKey is set if the raft logs are saved in the configured LogStorage instead of
+// the MetadataStorage, see migrateRaftLogs.
+var logStorageKey = []byte{localPrefix, 0x06}
+
 var (
 	// We save two types shard data in DB, for raft and other meta data.
 	// When the store starts, we should iterate all shard meta data to
@@ -64,6 +68,13 @@ var (
 	metaPrefixKey      = []byte{localPrefix, metaPrefix}
 	metaMinKey         = []byte{localPrefix, metaPrefix}
 	metaMaxKey         = []byte{localPrefix, metaPrefix + 1}
+
+	// The change events of the shards and the checkpoints of the change subscriptions,
+	// see cdc.go.
+	changeLogPrefix           byte = 0x04
+	changeLogPrefixKey             = []byte{localPrefix, changeLogPrefix}
+	changeCheckpointPrefix    byte = 0x05
+	changeCheckpointPrefixKey      = []byte{localPrefix, changeCheckpointPrefix}
 )
 
 // GetStoreIdentKey return key of StoreIdent
@@ -166,6 +177,36 @@ func getIDKey(shardID uint64, suffix byte, extraCap int, extra uint64) []byte {
 	return data
 }
 
+func getChangeLogKey(shardID uint64, logIndex uint64) []byte {
+	buf := acquireBuf()
+	buf.Write(changeLogPrefixKey)
+	buf.WriteInt64(int64(shardID))
+	buf.WriteInt64(int64(logIndex))
+	_, data, _ := buf.ReadBytes(buf.Readable())
+
+	releaseBuf(buf)
+	return data
+}
+
+func decodeChangeLogKey(key []byte) (uint64, uint64, error) {
+	prefixLen := len(changeLogPrefixKey)
+	if len(key) != prefixLen+16 || !bytes.HasPrefix(key, changeLogPrefixKey) {
+		return 0, 0, fmt.Errorf("key<%v> is not a valid change log key", key)
+	}
+
+	return binary.BigEndian.Uint64(key[prefixLen:]), binary.BigEndian.Uint64(key[prefixLen+8:]), nil
+}
+
+func getChangeCheckpointKey(name string) []byte {
+	buf := acquireBuf()
+	buf.Write(changeCheckpointPrefixKey)
+	buf.WriteString(name)
+	_, data, _ := buf.ReadBytes(buf.Readable())
+
+	releaseBuf(buf)
+	return data
+}
+
 func getDataKey0(group uint64, key []byte, buf *buf.ByteBuf) []byte {
 	buf.Write(dataPrefixKey)
 	buf.WriteUInt64(group)
@@ -0,0 +1,68 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+)
+
+// leaderLease is the lease of the leader, the leader can serve the linearizable reads
+// locally while the lease is valid.
+//
+// With CheckQuorum enabled, a follower will not vote for other peers within the election
+// timeout after it received the heartbeat from the leader. So once a heartbeat sent at time
+// t is acknowledged by the quorum, no other leader can be elected before t + election
+// timeout. The heartbeats of ReadIndex are used to renew the lease.
+type leaderLease struct {
+	maxLease time.Duration
+	term     uint64
+	expireAt time.Time
+}
+
+func newLeaderLease(cfg config.RaftConfig) *leaderLease {
+	// the follower counts the election timeout by ticks, the first tick maybe fired
+	// immediately after the heartbeat received.
+	ticks := cfg.ElectionTimeoutTicks - 1
+	if ticks < 1 {
+		ticks = 1
+	}
+
+	return &leaderLease{
+		maxLease: time.Duration(ticks) * cfg.TickInterval.Duration,
+	}
+}
+
+// renew renew the lease by a heartbeat which sent at the giving time and acknowledged
+// by the quorum in the giving term.
+func (l *leaderLease) renew(term uint64, sentAt time.Time) {
+	expireAt := sentAt.Add(l.maxLease)
+	if term != l.term || expireAt.After(l.expireAt) {
+		l.term = term
+		l.expireAt = expireAt
+	}
+}
+
+// inLease returns true if the lease of the term is valid at the giving time
+func (l *leaderLease) inLease(term uint64, now time.Time) bool {
+	return l.term == term && now.Before(l.expireAt)
+}
+
+// expire expire the lease, e.g. the leadership will be transferred to other peer,
+// the target peer will campaign immediately without waiting for the election timeout.
+func (l *leaderLease) expire() {
+	l.term = 0
+	l.expireAt = time.Time{}
+}
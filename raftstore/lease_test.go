@@ -0,0 +1,40 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderLease(t *testing.T) {
+	l := newLeaderLease(config.RaftConfig{
+		TickInterval:         typeutil.NewDuration(time.Millisecond * 100),
+		ElectionTimeoutTicks: 10,
+	})
+	assert.Equal(t, time.Millisecond*900, l.maxLease)
+
+	now := time.Now()
+	assert.False(t, l.inLease(1, now))
+
+	l.renew(1, now)
+	assert.True(t, l.inLease(1, now))
+	assert.True(t, l.inLease(1, now.Add(time.Millisecond*899)))
+	assert.False(t, l.inLease(1, now.Add(time.Millisecond*900)))
+	assert.False(t, l.inLease(2, now))
+
+	// an older heartbeat cannot shorten the lease
+	l.renew(1, now.Add(-time.Millisecond*100))
+	assert.True(t, l.inLease(1, now.Add(time.Millisecond*899)))
+
+	// a new term always resets the lease
+	l.renew(2, now.Add(-time.Millisecond*100))
+	assert.False(t, l.inLease(1, now))
+	assert.True(t, l.inLease(2, now))
+	assert.False(t, l.inLease(2, now.Add(time.Millisecond*800)))
+
+	l.expire()
+	assert.False(t, l.inLease(2, now))
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+)
+
+var (
+	// maxLoadSamples max sampled request keys in a load split window
+	maxLoadSamples = 256
+)
+
+type loadSample struct {
+	key    []byte
+	weight uint64
+}
+
+// loadSplitter samples the keys of the requests served by the leader, and finds
+// the split key which balances the load of the two sides.
+type loadSplitter struct {
+	start    time.Time
+	requests uint64
+	bytes    uint64
+	samples  []loadSample
+}
+
+func loadSplitEnabled(cfg config.LoadSplitConfig) bool {
+	return cfg.QPSThreshold > 0 || cfg.BytesThreshold > 0
+}
+
+// add adds a request with the read or written bytes returned by the command handler.
+// The keys are sampled by the reservoir sampling, the weight of the sample is the
+// bytes plus 1, so the requests without bytes are also counted.
+func (s *loadSplitter) add(key []byte, bytes uint64) {
+	s.requests++
+	s.bytes += bytes
+
+	idx := len(s.samples)
+	if idx < maxLoadSamples {
+		s.samples = append(s.samples, loadSample{})
+	} else if idx = rand.Intn(int(s.requests)); idx >= maxLoadSamples {
+		return
+	}
+
+	s.samples[idx].key = append(s.samples[idx].key[:0], key...)
+	s.samples[idx].weight = bytes + 1
+}
+
+// isHot returns whether the load of the window exceeds the thresholds, and true if
+// the window is finished.
+func (s *loadSplitter) isHot(now time.Time, cfg config.LoadSplitConfig) (bool, bool) {
+	if s.start.IsZero() {
+		s.start = now
+		return false, false
+	}
+
+	elapsed := now.Sub(s.start)
+	if elapsed < cfg.Duration.Duration {
+		return false, false
+	}
+
+	seconds := elapsed.Seconds()
+	qps := float64(s.requests) / seconds
+	bytesRate := float64(s.bytes) / seconds
+	return (cfg.QPSThreshold > 0 && qps >= float64(cfg.QPSThreshold)) ||
+		(cfg.BytesThreshold > 0 && bytesRate >= float64(cfg.BytesThreshold)), true
+}
+
+// splitKey returns the sampled key in [start, end) which balances the weight of the
+// two sides best, returns nil if all the samples have the same key.
+func (s *loadSplitter) splitKey(start, end []byte) []byte {
+	var samples []loadSample
+	total := uint64(0)
+	for _, sample := range s.samples {
+		if bytes.Compare(sample.key, start) >= 0 &&
+			bytes.Compare(sample.key, end) < 0 {
+			samples = append(samples, sample)
+			total += sample.weight
+		}
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		return bytes.Compare(samples[i].key, samples[j].key) < 0
+	})
+
+	best := -1
+	bestDiff := total
+	left := uint64(0)
+	for idx, sample := range samples {
+		if idx > 0 && !bytes.Equal(sample.key, samples[idx-1].key) {
+			right := total - left
+			diff := right - left
+			if left > right {
+				diff = left - right
+			}
+
+			if diff < bestDiff {
+				best = idx
+				bestDiff = diff
+			}
+		}
+		left += sample.weight
+	}
+
+	if best < 0 {
+		return nil
+	}
+	return append([]byte(nil), samples[best].key...)
+}
+
+func (s *loadSplitter) reset(now time.Time) {
+	s.start = now
+	s.requests = 0
+	s.bytes = 0
+	s.samples = s.samples[:0]
+}
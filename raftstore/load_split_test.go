@@ -0,0 +1,130 @@
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSplitterSplitKey(t *testing.T) {
+	s := &loadSplitter{}
+	assert.Nil(t, s.splitKey(nil, []byte("z")))
+
+	for i := 0; i < 10; i++ {
+		s.add([]byte("a"), 0)
+	}
+	assert.Nil(t, s.splitKey(nil, []byte("z")))
+
+	for i := 0; i < 10; i++ {
+		s.add([]byte("b"), 0)
+		s.add([]byte("c"), 0)
+	}
+	assert.Equal(t, []byte("b"), s.splitKey(nil, []byte("z")))
+	// out of range keys are ignored
+	assert.Equal(t, []byte("c"), s.splitKey([]byte("b"), []byte("z")))
+	assert.Nil(t, s.splitKey([]byte("b"), []byte("c")))
+
+	// weighted by bytes
+	s.reset(time.Now())
+	s.add([]byte("a"), 100)
+	s.add([]byte("b"), 0)
+	s.add([]byte("c"), 0)
+	assert.Equal(t, []byte("b"), s.splitKey(nil, []byte("z")))
+}
+
+func TestLoadSplitterIsHot(t *testing.T) {
+	cfg := config.LoadSplitConfig{QPSThreshold: 10, Duration: typeutil.NewDuration(time.Second)}
+	now := time.Now()
+	s := &loadSplitter{}
+	hot, finished := s.isHot(now, cfg)
+	assert.False(t, hot)
+	assert.False(t, finished)
+
+	for i := 0; i < 10; i++ {
+		s.add([]byte("a"), 0)
+	}
+	hot, finished = s.isHot(now.Add(time.Millisecond*500), cfg)
+	assert.False(t, hot)
+	assert.False(t, finished)
+
+	hot, finished = s.isHot(now.Add(time.Second), cfg)
+	assert.True(t, hot)
+	assert.True(t, finished)
+
+	hot, finished = s.isHot(now.Add(time.Second*2), cfg)
+	assert.False(t, hot)
+	assert.True(t, finished)
+
+	s.reset(now)
+	for i := 0; i < maxLoadSamples*2; i++ {
+		s.add([]byte("a"), 0)
+	}
+	assert.Equal(t, maxLoadSamples, len(s.samples))
+	assert.Equal(t, uint64(maxLoadSamples*2), s.requests)
+}
+
+func TestLoadSplit(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.LoadSplit.QPSThreshold = 10
+			cfg.Replication.LoadSplit.Duration = typeutil.NewDuration(time.Second)
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitShardByCount(t, 1, time.Second*10)
+	s := c.GetStore(0)
+	timeout := time.After(time.Second * 10)
+	for i := 0; c.GetPRCount(0) < 2; i++ {
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait load split timeout")
+		default:
+		}
+
+		req := createTestWriteReq(fmt.Sprintf("w%d", i), fmt.Sprintf("key%d", i%4), "v")
+		_, err := sendTestReqs(s, time.Second*10, nil, nil, req)
+		assert.NoError(t, err)
+	}
+}
+
+func TestLoadSplitRequestedByProphet(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.LoadSplit.Duration = typeutil.NewDuration(time.Second)
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitShardByCount(t, 1, time.Second*10)
+	s := c.GetStore(0)
+	shard := c.GetShardByIndex(0)
+	pr := s.(*store).getPR(shard.ID, false)
+	assert.False(t, pr.sampleLoad())
+
+	// the load split of the store is disabled, the leader samples the requests after
+	// the prophet asks to split the shard by the load.
+	pr.addAction(action{epoch: shard.Epoch, actionType: checkLoadSplitAction})
+	timeout := time.After(time.Second * 10)
+	for i := 0; c.GetPRCount(0) < 2; i++ {
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait load split timeout")
+		default:
+		}
+
+		req := createTestWriteReq(fmt.Sprintf("w%d", i), fmt.Sprintf("key%d", i%4), "v")
+		_, err := sendTestReqs(s, time.Second*10, nil, nil, req)
+		assert.NoError(t, err)
+	}
+	assert.False(t, pr.sampleLoad())
+}
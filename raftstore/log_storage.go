@@ -0,0 +1,266 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/matrixorigin/matrixcube/util"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+const (
+	// migrateRaftLogBatch the max number of the entries appended to the LogStorage at once
+	// by the migration
+	migrateRaftLogBatch = 1024
+)
+
+// kvLogStorage is the default LogStorage, the raft logs are saved in the MetadataStorage
+// with the raft state.
+type kvLogStorage struct {
+	storage storage.MetadataStorage
+}
+
+func newKVLogStorage(storage storage.MetadataStorage) storage.LogStorage {
+	return &kvLogStorage{storage: storage}
+}
+
+func (s *kvLogStorage) Close() error {
+	return nil
+}
+
+func (s *kvLogStorage) Append(shardID uint64, prevLastIndex uint64, entries []raftpb.Entry, wb *util.WriteBatch, sync bool) error {
+	for _, e := range entries {
+		err := wb.Set(getRaftLogKey(shardID, e.Index), protoc.MustMarshal(&e))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Delete any previously appended log entries which never committed.
+	lastIndex := entries[len(entries)-1].Index
+	for index := lastIndex + 1; index < prevLastIndex+1; index++ {
+		err := wb.Delete(getRaftLogKey(shardID, index))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *kvLogStorage) Entries(shardID uint64, low, high, maxSize uint64) ([]raftpb.Entry, error) {
+	var ents []raftpb.Entry
+	startKey := getRaftLogKey(shardID, low)
+
+	if low+1 == high {
+		// If election happens in inactive shards, they will just try
+		// to fetch one empty log.
+		v, err := s.storage.Get(startKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(v) == 0 {
+			return nil, raft.ErrUnavailable
+		}
+
+		e := raftpb.Entry{}
+		protoc.MustUnmarshal(&e, v)
+		if e.Index != low {
+			return nil, raft.ErrUnavailable
+		}
+
+		ents = append(ents, e)
+		return ents, nil
+	}
+
+	var totalSize uint64
+	nextIndex := low
+	exceededMaxSize := false
+
+	endKey := getRaftLogKey(shardID, high)
+	err := s.storage.Scan(startKey, endKey, func(key, value []byte) (bool, error) {
+		e := raftpb.Entry{}
+		protoc.MustUnmarshal(&e, value)
+
+		// May meet gap or has been compacted.
+		if e.Index != nextIndex {
+			return false, nil
+		}
+
+		nextIndex++
+		totalSize += uint64(len(value))
+
+		exceededMaxSize = totalSize > maxSize
+		if !exceededMaxSize || len(ents) == 0 {
+			ents = append(ents, e)
+		}
+
+		return !exceededMaxSize, nil
+	}, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// If we get the correct number of entries the total size exceeds max_size, returns.
+	if len(ents) == int(high-low) || exceededMaxSize {
+		return ents, nil
+	}
+
+	return nil, raft.ErrUnavailable
+}
+
+func (s *kvLogStorage) Term(shardID uint64, index uint64) (uint64, error) {
+	ents, err := s.Entries(shardID, index, index+1, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return ents[0].Term, nil
+}
+
+func (s *kvLogStorage) FirstIndex(shardID uint64) (uint64, error) {
+	startKey := getRaftLogKey(shardID, 0)
+	key, _, err := s.storage.Seek(startKey)
+	if err != nil {
+		return 0, err
+	}
+
+	// no raft log of the shard
+	if len(key) != len(startKey) || !bytes.HasPrefix(key, startKey[:len(startKey)-8]) {
+		return 0, nil
+	}
+
+	return getRaftLogIndex(key)
+}
+
+func (s *kvLogStorage) Compact(shardID uint64, index uint64) error {
+	firstIndex, err := s.FirstIndex(shardID)
+	if err != nil {
+		return err
+	}
+
+	if firstIndex == 0 || firstIndex >= index {
+		return nil
+	}
+
+	wb := util.NewWriteBatch()
+	for i := firstIndex; i < index; i++ {
+		err := wb.Delete(getRaftLogKey(shardID, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.storage.Write(wb, false)
+}
+
+// Remove the raft logs are already removed with the metadata of the shard by
+// the store.clearMeta.
+func (s *kvLogStorage) Remove(shardID uint64) error {
+	return nil
+}
+
+// migrateRaftLogs moves the raft logs saved in the MetadataStorage by the kvLogStorage to
+// the configured LogStorage, so the existing data can be started with the LogStorage. The
+// raft logs are removed from the MetadataStorage after they are durable in the LogStorage,
+// the migration is done again if the store crashed before the removal. The logStorageKey is
+// saved with the removal, the store which ran with the LogStorage refuses to start with the
+// kvLogStorage, the raft logs in the LogStorage can't be migrated back.
+func (s *store) migrateRaftLogs() error {
+	v, err := s.MetadataStorage().Get(logStorageKey)
+	if err != nil {
+		return err
+	}
+	migrated := len(v) > 0
+
+	if _, ok := s.logStorage.(*kvLogStorage); ok {
+		if migrated {
+			return fmt.Errorf("the raft logs are saved in the log storage, the LogStorage must be configured")
+		}
+		return nil
+	}
+
+	var shardID uint64
+	var entries []raftpb.Entry
+	shards, count := 0, 0
+	wb := util.NewWriteBatch()
+	flush := func() error {
+		if len(entries) == 0 {
+			return nil
+		}
+
+		err := s.logStorage.Append(shardID, 0, entries, wb, true)
+		entries = nil
+		return err
+	}
+
+	start := raftPrefixKey
+	end := []byte{localPrefix, raftPrefix + 1}
+	err = s.MetadataStorage().Scan(start, end, func(key, value []byte) (bool, error) {
+		if len(key) != len(raftPrefixKey)+8*2+1 ||
+			key[len(raftPrefixKey)+8] != raftLogSuffix {
+			return true, nil
+		}
+
+		id := binary.BigEndian.Uint64(key[len(raftPrefixKey):])
+		if id != shardID || len(entries) >= migrateRaftLogBatch {
+			if err := flush(); err != nil {
+				return false, err
+			}
+			if id != shardID {
+				shards++
+				shardID = id
+			}
+		}
+
+		e := raftpb.Entry{}
+		protoc.MustUnmarshal(&e, value)
+		entries = append(entries, e)
+		count++
+		return true, wb.Delete(key)
+	}, false)
+	if err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if count == 0 && migrated {
+		return nil
+	}
+
+	// The entries are synced by the LogStorage before they are removed from the
+	// MetadataStorage, the two fsyncs can't be merged, otherwise the raft logs are lost
+	// if the removal is durable but the appended entries are not.
+	if err := wb.Set(logStorageKey, []byte{1}); err != nil {
+		return err
+	}
+	if err := s.MetadataStorage().Write(wb, true); err != nil {
+		return err
+	}
+
+	logger.Infof("%d raft logs of %d shards are migrated to the log storage",
+		count,
+		shards)
+	return nil
+}
@@ -0,0 +1,146 @@
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/storage/mem"
+	"github.com/matrixorigin/matrixcube/util"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/matrixorigin/matrixcube/vfs"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+func TestKVLogStorage(t *testing.T) {
+	fs := vfs.GetTestFS()
+	ms := mem.NewStorage(fs)
+	defer ms.Close()
+	s := newKVLogStorage(ms)
+
+	var entries []raftpb.Entry
+	for i := uint64(1); i <= 10; i++ {
+		entries = append(entries, raftpb.Entry{Index: i, Term: 1, Data: []byte("data")})
+	}
+	wb := util.NewWriteBatch()
+	assert.NoError(t, s.Append(1, 0, entries, wb, true))
+	assert.NoError(t, s.Append(2, 0, entries[:1], wb, true))
+	assert.NoError(t, ms.Write(wb, true))
+
+	ents, err := s.Entries(1, 1, 11, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, ents)
+
+	// the uncommitted entries replaced by the new leader
+	wb.Reset()
+	assert.NoError(t, s.Append(1, 10, []raftpb.Entry{{Index: 6, Term: 2}}, wb, true))
+	assert.NoError(t, ms.Write(wb, true))
+	term, err := s.Term(1, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), term)
+	_, err = s.Term(1, 7)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	first, err := s.FirstIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), first)
+	assert.NoError(t, s.Compact(1, 5))
+	first, err = s.FirstIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), first)
+
+	first, err = s.FirstIndex(3)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), first)
+}
+
+func TestWALLogStorage(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		DiskTestCluster,
+		WithTestClusterUseWAL(),
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Raft.RaftLog.CompactDuration = typeutil.NewDuration(time.Millisecond * 100)
+			cfg.Raft.RaftLog.CompactThreshold = 1
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	var keys []string
+	for i := 0; i < 10; i++ {
+		keys = append(keys, fmt.Sprintf("key%d", i))
+	}
+	sendTestWrites(t, c.stores[0], keys...)
+
+	// the raft logs are compacted
+	shardID := c.GetShardByIndex(0).ID
+	timeout := time.After(time.Second * 10)
+	for {
+		first, err := c.stores[0].logStorage.FirstIndex(shardID)
+		assert.NoError(t, err)
+		if first > raftInitLogIndex+1 {
+			break
+		}
+
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait raft log compact timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+
+	c.Restart()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	sendTestWrites(t, c.stores[0], "key10")
+	for _, key := range append(keys, "key10") {
+		resps, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil, createTestReadReq(key, key))
+		assert.NoError(t, err)
+		assert.Equal(t, "value", string(resps[key].Responses[0].Value))
+	}
+}
+
+func TestMigrateRaftLogsToWAL(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		DiskTestCluster,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler)
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	sendTestWrites(t, c.stores[0], "key1")
+
+	// restart the store with the wal, the raft logs in the MetaStorage are migrated
+	c.initOpts = append(c.initOpts, WithTestClusterUseWAL())
+	c.Restart()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shardID := c.GetShardByIndex(0).ID
+	first, err := c.stores[0].logStorage.FirstIndex(shardID)
+	assert.NoError(t, err)
+	assert.True(t, first > 0)
+	first, err = newKVLogStorage(c.stores[0].MetadataStorage()).FirstIndex(shardID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), first)
+
+	sendTestWrites(t, c.stores[0], "key2")
+	for _, key := range []string{"key1", "key2"} {
+		resps, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil, createTestReadReq(key, key))
+		assert.NoError(t, err)
+		assert.Equal(t, "value", string(resps[key].Responses[0].Value))
+	}
+
+	// the store can't be started without the wal after the raft logs are migrated
+	s := &store{cfg: c.stores[0].cfg, logStorage: newKVLogStorage(c.stores[0].MetadataStorage())}
+	assert.Error(t, s.migrateRaftLogs())
+}
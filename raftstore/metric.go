@@ -24,6 +24,11 @@ type applyMetrics struct {
 	deleteKeysHint uint64
 	writtenBytes   uint64
 	writtenKeys    uint64
+	writtenQuery   uint64
+	// writeCPUTime the microseconds of the cpu time spent on applying the write requests
+	writeCPUTime uint64
+	// sampledWrites the written keys and bytes for the load based split
+	sampledWrites []loadSample
 
 	admin raftAdminMetrics
 }
@@ -127,6 +132,9 @@ func (m *raftMessageMetrics) flush() {
 type raftProposeMetrics struct {
 	readLocal      uint64
 	readIndex      uint64
+	readLease      uint64
+	readFollower   uint64
+	readStale      uint64
 	normal         uint64
 	transferLeader uint64
 	confChange     uint64
@@ -143,6 +151,21 @@ func (m *raftProposeMetrics) flush() {
 		m.readIndex = 0
 	}
 
+	if m.readLease > 0 {
+		metric.AddRaftProposalReadLeaseCount(m.readLease)
+		m.readLease = 0
+	}
+
+	if m.readFollower > 0 {
+		metric.AddRaftProposalReadFollowerCount(m.readFollower)
+		m.readFollower = 0
+	}
+
+	if m.readStale > 0 {
+		metric.AddRaftProposalReadStaleCount(m.readStale)
+		m.readStale = 0
+	}
+
 	if m.normal > 0 {
 		metric.AddRaftProposalNormalCount(m.normal)
 		m.normal = 0
@@ -160,9 +183,14 @@ func (m *raftProposeMetrics) flush() {
 }
 
 type raftAdminMetrics struct {
-	confChange uint64
-	split      uint64
-	compact    uint64
+	confChange    uint64
+	split         uint64
+	compact       uint64
+	prepareMerge  uint64
+	commitMerge   uint64
+	rollbackMerge uint64
+	computeHash   uint64
+	verifyHash    uint64
 
 	confChangeReject uint64
 
@@ -171,6 +199,7 @@ type raftAdminMetrics struct {
 	removePeerSucceed uint64
 	splitSucceed      uint64
 	compactSucceed    uint64
+	mergeSucceed      uint64
 }
 
 func (m *raftAdminMetrics) incBy(by raftAdminMetrics) {
@@ -183,6 +212,12 @@ func (m *raftAdminMetrics) incBy(by raftAdminMetrics) {
 	m.splitSucceed += by.splitSucceed
 	m.compact += by.compact
 	m.compactSucceed += by.compactSucceed
+	m.prepareMerge += by.prepareMerge
+	m.commitMerge += by.commitMerge
+	m.mergeSucceed += by.mergeSucceed
+	m.rollbackMerge += by.rollbackMerge
+	m.computeHash += by.computeHash
+	m.verifyHash += by.verifyHash
 }
 
 func (m *raftAdminMetrics) flush() {
@@ -216,4 +251,30 @@ func (m *raftAdminMetrics) flush() {
 		metric.AddRaftAdminCommandCompactSucceedCount(m.compactSucceed)
 		m.compactSucceed = 0
 	}
+
+	if m.prepareMerge > 0 {
+		metric.AddRaftAdminCommandPrepareMergeCount(m.prepareMerge)
+		m.prepareMerge = 0
+	}
+	if m.commitMerge > 0 {
+		metric.AddRaftAdminCommandCommitMergeCount(m.commitMerge)
+		m.commitMerge = 0
+	}
+	if m.mergeSucceed > 0 {
+		metric.AddRaftAdminCommandCommitMergeSucceedCount(m.mergeSucceed)
+		m.mergeSucceed = 0
+	}
+	if m.rollbackMerge > 0 {
+		metric.AddRaftAdminCommandRollbackMergeCount(m.rollbackMerge)
+		m.rollbackMerge = 0
+	}
+
+	if m.computeHash > 0 {
+		metric.AddRaftAdminCommandComputeHashCount(m.computeHash)
+		m.computeHash = 0
+	}
+	if m.verifyHash > 0 {
+		metric.AddRaftAdminCommandVerifyHashCount(m.verifyHash)
+		m.verifyHash = 0
+	}
 }
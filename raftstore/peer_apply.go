@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/fagongzi/goetty/buf"
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/pb/cdcpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/util"
@@ -49,6 +51,8 @@ func (pr *peerReplica) doRegistrationJob(delegate *applyDelegate) error {
 		old.term = delegate.term
 		old.applyState = delegate.applyState
 		old.appliedIndexTerm = delegate.appliedIndexTerm
+		old.mergeState = delegate.mergeState
+		old.pendingEntries = nil
 		old.clearAllCommandsAsStale()
 	}
 
@@ -72,40 +76,7 @@ func (s *store) doDestroy(shardID uint64, tombstone bool) {
 }
 
 func (pr *peerReplica) doCompactRaftLog(shardID, startIndex, endIndex uint64) error {
-	firstIndex := startIndex
-
-	if firstIndex == 0 {
-		startKey := getRaftLogKey(shardID, 0)
-		firstIndex = endIndex
-		key, _, err := pr.store.MetadataStorage().Seek(startKey)
-		if err != nil {
-			return err
-		}
-
-		if len(key) > 0 {
-			firstIndex, err = getRaftLogIndex(key)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	if firstIndex >= endIndex {
-		logger.Infof("shard %d no need to gc raft log",
-			shardID)
-		return nil
-	}
-
-	wb := util.NewWriteBatch()
-	for index := firstIndex; index < endIndex; index++ {
-		key := getRaftLogKey(shardID, index)
-		err := wb.Delete(key)
-		if err != nil {
-			return err
-		}
-	}
-
-	err := pr.store.MetadataStorage().Write(wb, false)
+	err := pr.store.logStorage.Compact(shardID, endIndex)
 	if err == nil {
 		logger.Debugf("shard %d raft log gc complete, entriesCount=<%d>",
 			shardID,
@@ -143,6 +114,10 @@ func (pr *peerReplica) doApplyingSnapshotJob() error {
 
 	wb := util.NewWriteBatch()
 	pr.store.updatePeerState(pr.ps.shard, bhraftpb.PeerState_Normal, wb)
+	if pr.store.changes.enabled(pr.ps.shard.Group) {
+		// the change events before the snapshot are not on the local store
+		pr.store.changes.setTruncatedIndex(pr.shardID, pr.ps.raftApplyState.TruncatedState.Index, wb)
+	}
 	err = pr.store.MetadataStorage().Write(wb, true)
 	if err != nil {
 		logger.Fatalf("shard %d apply snap update peer state failed with %+v",
@@ -173,6 +148,9 @@ func (pr *peerReplica) doApplyCommittedEntries(shardID uint64, term uint64, comm
 	}
 
 	delegate := value.(*applyDelegate)
+	delegate.applyLock.Lock()
+	defer delegate.applyLock.Unlock()
+
 	delegate.term = term
 	delegate.applyCommittedEntries(commitedEntries)
 
@@ -184,6 +162,29 @@ func (pr *peerReplica) doApplyCommittedEntries(shardID uint64, term uint64, comm
 	return nil
 }
 
+// doResumeApply applies the entries which are waiting for the source shard of a CommitMerge.
+func (pr *peerReplica) doResumeApply() error {
+	value, ok := pr.store.delegates.Load(pr.shardID)
+	if !ok {
+		return nil
+	}
+
+	delegate := value.(*applyDelegate)
+	delegate.applyLock.Lock()
+	defer delegate.applyLock.Unlock()
+
+	entries := delegate.pendingEntries
+	delegate.pendingEntries = nil
+	delegate.applyEntries(entries)
+
+	if delegate.isPendingRemove() {
+		delegate.destroy()
+		pr.store.delegates.Delete(delegate.shard.ID)
+	}
+
+	return nil
+}
+
 type asyncApplyResult struct {
 	shardID          uint64
 	appliedIndexTerm uint64
@@ -197,11 +198,16 @@ func (res *asyncApplyResult) hasSplitExecResult() bool {
 }
 
 type execResult struct {
-	adminType    raftcmdpb.AdminCmdType
-	changePeer   *changePeer
-	splitResult  *splitResult
-	raftGCResult *raftGCResult
-	needSyncData bool
+	adminType           raftcmdpb.AdminCmdType
+	changePeer          *changePeer
+	splitResult         *splitResult
+	raftGCResult        *raftGCResult
+	prepareMergeResult  *prepareMergeResult
+	commitMergeResult   *commitMergeResult
+	rollbackMergeResult *rollbackMergeResult
+	computeHashResult   *computeHashResult
+	verifyHashResult    *verifyHashResult
+	needSyncData        bool
 }
 
 type changePeer struct {
@@ -216,6 +222,30 @@ type splitResult struct {
 	shards  []bhmetapb.Shard
 }
 
+type prepareMergeResult struct {
+	shard bhmetapb.Shard
+	state bhraftpb.MergeState
+}
+
+type commitMergeResult struct {
+	source bhmetapb.Shard
+	target bhmetapb.Shard
+}
+
+type rollbackMergeResult struct {
+	shard bhmetapb.Shard
+}
+
+type computeHashResult struct {
+	index   uint64
+	compute func() (uint32, error)
+}
+
+type verifyHashResult struct {
+	index uint64
+	hash  []byte
+}
+
 type raftGCResult struct {
 	state      bhraftpb.RaftTruncatedState
 	firstIndex uint64
@@ -228,12 +258,18 @@ type applyContext struct {
 	attrs      map[string]interface{}
 	buf        *buf.ByteBuf
 	applyState bhraftpb.RaftApplyState
+	changes    cdcpb.EventBatch
 	req        *raftcmdpb.RaftCMDRequest
 	index      uint64
 	term       uint64
 	offset     int
 	batchSize  int
 	metrics    applyMetrics
+	// waitMergeSource is true if the entry is a CommitMerge and the source shard is not
+	// ready on the store, the entry is not applied and retried later.
+	waitMergeSource bool
+	// mergeSource is the source shard of the CommitMerge which is caught up
+	mergeSource *applyDelegate
 }
 
 func newApplyContext(pr *peerReplica) *applyContext {
@@ -253,12 +289,56 @@ func (ctx *applyContext) reset() {
 		delete(ctx.attrs, key)
 	}
 	ctx.applyState = bhraftpb.RaftApplyState{}
+	ctx.changes.Events = ctx.changes.Events[:0]
 	ctx.req = nil
 	ctx.index = 0
 	ctx.term = 0
 	ctx.offset = 0
 	ctx.batchSize = 0
 	ctx.metrics = applyMetrics{}
+	ctx.waitMergeSource = false
+	ctx.mergeSource = nil
+}
+
+// addWriteEvents records the writes in the data write batch from the offset as change events
+func (ctx *applyContext) addWriteEvents(shardID uint64, offset int) {
+	wb := ctx.dataWB
+	for i := offset; i < len(wb.Ops); i++ {
+		key := wb.Keys[i]
+		if len(key) < DataPrefixSize || key[0] != dataPrefix {
+			continue
+		}
+
+		event := cdcpb.Event{
+			ShardID: shardID,
+			Index:   ctx.index,
+			Key:     DecodeDataKey(key),
+		}
+		if wb.Ops[i] == util.OpDelete {
+			event.Type = cdcpb.Delete
+		} else {
+			event.Type = cdcpb.Put
+			event.Value = wb.Values[i]
+		}
+		ctx.changes.Events = append(ctx.changes.Events, event)
+	}
+}
+
+// addShardEvent records the split or merge as a change event
+func (ctx *applyContext) addShardEvent(shardID uint64, eventType cdcpb.EventType, shards ...bhmetapb.Shard) {
+	event := cdcpb.Event{
+		ShardID: shardID,
+		Index:   ctx.index,
+		Type:    eventType,
+	}
+	for _, shard := range shards {
+		event.Shards = append(event.Shards, cdcpb.ShardRange{
+			ID:    shard.ID,
+			Start: shard.Start,
+			End:   shard.End,
+		})
+	}
+	ctx.changes.Events = append(ctx.changes.Events, event)
 }
 
 func (ctx *applyContext) WriteBatch() *util.WriteBatch {
@@ -306,6 +386,14 @@ type applyDelegate struct {
 	pendingCMDs          []cmd
 	pendingChangePeerCMD cmd
 	ctx                  *applyContext
+	// mergeState is not nil if the shard is the source shard of a merge, the logs after
+	// PrepareMerge can't be applied except RollbackMerge.
+	mergeState *bhraftpb.MergeState
+	// applyLock is used to apply the source shard's logs by the target shard's CommitMerge.
+	applyLock sync.Mutex
+	// pendingEntries are the committed entries from a CommitMerge which waits for the source
+	// shard to be ready on the store, they are applied in order after the source is ready.
+	pendingEntries []raftpb.Entry
 
 	// sync data after exec admin requests.
 	// Before restart we applied index is `100`, If `Customize.CustomAdjustInitAppliedIndexFactory` is set,
@@ -315,6 +403,12 @@ type applyDelegate struct {
 	syncData bool
 }
 
+// isWitness returns true if the replica of the shard on the current store is a witness.
+func (d *applyDelegate) isWitness() bool {
+	p := findPeer(&d.shard, d.store.Meta().ID)
+	return p != nil && p.Witness
+}
+
 func (d *applyDelegate) clearAllCommandsAsStale() {
 	for _, c := range d.pendingCMDs {
 		d.notifyStaleCMD(c)
@@ -392,10 +486,24 @@ func (d *applyDelegate) applyCommittedEntries(commitedEntries []raftpb.Entry) {
 		return
 	}
 
+	// A CommitMerge is waiting for the source shard, the following entries must be
+	// applied after it.
+	if len(d.pendingEntries) > 0 {
+		d.pendingEntries = append(d.pendingEntries, commitedEntries...)
+		return
+	}
+
 	start := time.Now()
+	op := d.store.slowScore.begin(start)
+	d.applyEntries(commitedEntries)
+	metric.ObserveRaftLogApplyDuration(start)
+	d.store.slowScore.record(op)
+}
+
+func (d *applyDelegate) applyEntries(commitedEntries []raftpb.Entry) {
 	req := pb.AcquireRaftCMDRequest()
 
-	for _, entry := range commitedEntries {
+	for idx, entry := range commitedEntries {
 		if d.isPendingRemove() {
 			// This peer is about to be destroyed, skip everything.
 			break
@@ -429,6 +537,11 @@ func (d *applyDelegate) applyCommittedEntries(commitedEntries []raftpb.Entry) {
 			result = d.applyConfChange(&entry)
 		}
 
+		if d.ctx.waitMergeSource {
+			d.waitMergeSource(commitedEntries[idx:])
+			break
+		}
+
 		asyncResult := asyncApplyResult{}
 		asyncResult.shardID = d.shard.ID
 		asyncResult.appliedIndexTerm = d.appliedIndexTerm
@@ -447,8 +560,30 @@ func (d *applyDelegate) applyCommittedEntries(commitedEntries []raftpb.Entry) {
 
 	// only release RaftCMDRequest. Header and Requests fields is pb created in Unmarshal
 	pb.ReleaseRaftCMDRequest(req)
+}
 
-	metric.ObserveRaftLogApplyDuration(start)
+// mergeSourceRetryInterval is the interval to retry the CommitMerge which waits for the
+// source shard.
+const mergeSourceRetryInterval = time.Millisecond * 100
+
+// waitMergeSource keeps the entries from the CommitMerge which waits for the source shard
+// to be ready on the store, and retries to apply them later.
+func (d *applyDelegate) waitMergeSource(entries []raftpb.Entry) {
+	d.pendingEntries = append([]raftpb.Entry(nil), entries...)
+
+	shardID := d.shard.ID
+	util.DefaultTimeoutWheel().Schedule(mergeSourceRetryInterval, func(interface{}) {
+		pr := d.store.getPR(shardID, false)
+		if pr == nil {
+			return
+		}
+
+		if err := pr.startResumeApplyJob(); err != nil {
+			logger.Errorf("shard %d add resume apply job failed with %+v",
+				shardID,
+				err)
+		}
+	}, nil)
 }
 
 func (d *applyDelegate) applyEntry(entry *raftpb.Entry) *execResult {
@@ -521,6 +656,13 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 			d.shard.ID)
 	}
 
+	// The committed CommitMerge must be applied by every replica, it waits until the source
+	// shard is ready on the store, never refused.
+	if !d.mergeSourceReady(d.ctx.req) {
+		d.ctx.waitMergeSource = true
+		return nil
+	}
+
 	c, ok := d.findCB(d.ctx)
 	if d.isPendingRemove() {
 		logger.Fatalf("shard %d apply raft comand can not pending remove",
@@ -535,14 +677,23 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 
 	if !d.checkEpoch(d.ctx.req) {
 		resp = errorStaleEpochResp(d.ctx.req.Header.ID, d.term, d.shard)
+	} else if !d.checkMerging(d.ctx.req) {
+		resp = errorOtherCMDResp(errShardMerging)
 	} else {
 		if d.ctx.req.AdminRequest != nil {
 			resp, result, err = d.execAdminRequest(d.ctx)
 			if err != nil {
 				resp = errorStaleEpochResp(d.ctx.req.Header.ID, d.term, d.shard)
 			}
+		} else if d.isWitness() {
+			// the witness stores no data, the write requests are only replicated
+			resp = pb.AcquireRaftCMDResponse()
 		} else {
-			writeBytes, diffBytes, resp = d.execWriteRequest(d.ctx)
+			// the expire time of the writes is based on the propose time, not the apply time
+			d.ctx.dataWB.Now = d.ctx.req.Header.ProposeTime
+			d.ctx.metrics.writeCPUTime += measureCPUTime(func() {
+				writeBytes, diffBytes, resp = d.execWriteRequest(d.ctx)
+			})
 		}
 	}
 
@@ -571,6 +722,11 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 		}
 	}
 
+	hasChanges := len(d.ctx.changes.Events) > 0
+	if hasChanges {
+		d.ctx.raftWB.Set(getChangeLogKey(d.shard.ID, d.ctx.index), protoc.MustMarshal(&d.ctx.changes))
+	}
+
 	ds := d.store.DataStorageByGroup(d.shard.Group, d.shard.ID)
 	if kv, ok := ds.(storage.KVStorage); ok {
 		err = kv.Write(d.ctx.dataWB, true)
@@ -595,6 +751,10 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 			err)
 	}
 
+	if hasChanges {
+		d.store.changes.notify()
+	}
+
 	d.applyState = d.ctx.applyState
 	d.term = d.ctx.term
 
@@ -635,8 +795,27 @@ func (d *applyDelegate) checkEpoch(req *raftcmdpb.RaftCMDRequest) bool {
 	return checkEpoch(d.shard, req)
 }
 
+// checkMerging returns false if the shard is in merging and the request is not allowed
+func (d *applyDelegate) checkMerging(req *raftcmdpb.RaftCMDRequest) bool {
+	if d.mergeState == nil {
+		return true
+	}
+
+	return isRollbackMergeCMD(req)
+}
+
 func isChangePeerCMD(req *raftcmdpb.RaftCMDRequest) bool {
 	return nil != req.AdminRequest &&
 		(req.AdminRequest.CmdType == raftcmdpb.AdminCmdType_ChangePeer ||
 			req.AdminRequest.CmdType == raftcmdpb.AdminCmdType_ChangePeerV2)
 }
+
+func isCommitMergeCMD(req *raftcmdpb.RaftCMDRequest) bool {
+	return nil != req.AdminRequest &&
+		req.AdminRequest.CmdType == raftcmdpb.AdminCmdType_CommitMerge
+}
+
+func isRollbackMergeCMD(req *raftcmdpb.RaftCMDRequest) bool {
+	return nil != req.AdminRequest &&
+		req.AdminRequest.CmdType == raftcmdpb.AdminCmdType_RollbackMerge
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/pb/cdcpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"go.etcd.io/etcd/raft/raftpb"
 )
 
 func (d *applyDelegate) execAdminRequest(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
@@ -51,6 +53,24 @@ func (d *applyDelegate) execAdminRequest(ctx *applyContext) (*raftcmdpb.RaftCMDR
 		return resp, result, err
 	case raftcmdpb.AdminCmdType_CompactLog:
 		return d.doExecCompactRaftLog(ctx)
+	case raftcmdpb.AdminCmdType_PrepareMerge:
+		resp, result, err := d.doExecPrepareMerge(ctx)
+		if result != nil {
+			result.needSyncData = true
+		}
+		return resp, result, err
+	case raftcmdpb.AdminCmdType_CommitMerge:
+		resp, result, err := d.doExecCommitMerge(ctx)
+		if result != nil {
+			result.needSyncData = true
+		}
+		return resp, result, err
+	case raftcmdpb.AdminCmdType_RollbackMerge:
+		return d.doExecRollbackMerge(ctx)
+	case raftcmdpb.AdminCmdType_ComputeHash:
+		return d.doExecComputeHash(ctx)
+	case raftcmdpb.AdminCmdType_VerifyHash:
+		return d.doExecVerifyHash(ctx)
 	}
 
 	return nil, nil, nil
@@ -409,6 +429,10 @@ func (d *applyDelegate) doExecSplit(ctx *applyContext) (*raftcmdpb.RaftCMDRespon
 		}
 	}
 
+	if d.store.changes.enabled(derived.Group) {
+		ctx.addShardEvent(d.shard.ID, cdcpb.Split, append([]bhmetapb.Shard{derived}, shards...)...)
+	}
+
 	d.shard = derived
 	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_BatchSplit, &raftcmdpb.BatchSplitResponse{
 		Shards: shards,
@@ -459,11 +483,330 @@ func (d *applyDelegate) doExecCompactRaftLog(ctx *applyContext) (*raftcmdpb.Raft
 	return rsp, result, nil
 }
 
+func (d *applyDelegate) doExecPrepareMerge(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	ctx.metrics.admin.prepareMerge++
+
+	req := ctx.req.AdminRequest.PrepareMerge
+	if req.Target.ID == 0 || req.Target.ID == d.shard.ID {
+		return nil, nil, fmt.Errorf("invalid merge target shard %+v", req.Target)
+	}
+
+	if req.Target.Group != d.shard.Group {
+		return nil, nil, fmt.Errorf("merge target shard %d group %d not match source group %d",
+			req.Target.ID,
+			req.Target.Group,
+			d.shard.Group)
+	}
+
+	res := bhmetapb.Shard{}
+	protoc.MustUnmarshal(&res, protoc.MustMarshal(&d.shard))
+	// The version and conf version are both changed, so all proposals proposed
+	// before the PrepareMerge will be rejected by epoch check.
+	res.Epoch.Version++
+	res.Epoch.ConfVer++
+
+	state := bhraftpb.MergeState{
+		MinIndex: req.MinIndex,
+		Commit:   ctx.index,
+		Target:   req.Target,
+	}
+	d.store.updateMergeState(res, state, ctx.raftWB)
+	d.shard = res
+	d.mergeState = &state
+
+	logger.Infof("shard %d prepare merge to shard %d at index %d, min index %d",
+		d.shard.ID,
+		req.Target.ID,
+		state.Commit,
+		state.MinIndex)
+
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_PrepareMerge, &raftcmdpb.PrepareMergeResponse{})
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_PrepareMerge,
+		prepareMergeResult: &prepareMergeResult{
+			shard: res,
+			state: state,
+		},
+	}
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecCommitMerge(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	ctx.metrics.admin.commitMerge++
+
+	req := ctx.req.AdminRequest.CommitMerge
+	source := req.Source
+	if source.Group != d.shard.Group {
+		return nil, nil, fmt.Errorf("merge source shard %d group %d not match target group %d",
+			source.ID,
+			source.Group,
+			d.shard.Group)
+	}
+
+	// The CommitMerge maybe proposed more than once, the first one is already merged
+	// the source shard.
+	if shardContains(d.shard, source) {
+		logger.Infof("shard %d already merged source shard %d",
+			d.shard.ID,
+			source.ID)
+		rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_CommitMerge, &raftcmdpb.CommitMergeResponse{})
+		return rsp, nil, nil
+	}
+
+	res := bhmetapb.Shard{}
+	protoc.MustUnmarshal(&res, protoc.MustMarshal(&d.shard))
+	if len(source.End) > 0 && bytes.Equal(source.End, res.Start) {
+		res.Start = source.Start
+	} else if len(res.End) > 0 && bytes.Equal(source.Start, res.End) {
+		res.End = source.End
+	} else {
+		return nil, nil, fmt.Errorf("merge source shard %d [%+v, %+v) is not adjacent to target shard %d [%+v, %+v)",
+			source.ID,
+			source.Start,
+			source.End,
+			res.ID,
+			res.Start,
+			res.End)
+	}
+
+	// The source shard is already caught up by mergeSourceReady before the CommitMerge is
+	// executed.
+	delegate := ctx.mergeSource
+	if delegate == nil || delegate.shard.ID != source.ID {
+		logger.Fatalf("shard %d commit merge missing source shard %d",
+			d.shard.ID,
+			source.ID)
+	}
+	source = delegate.shard
+
+	if source.Epoch.Version > res.Epoch.Version {
+		res.Epoch.Version = source.Epoch.Version
+	}
+	res.Epoch.Version++
+
+	err := d.store.DataStorageByGroup(res.Group, res.ID).MergeShardData(source, res)
+	if err != nil {
+		logger.Fatalf("shard %d commit merge source shard %d data failed with %+v",
+			d.shard.ID,
+			source.ID,
+			err)
+	}
+
+	d.store.updatePeerState(res, bhraftpb.PeerState_Normal, ctx.raftWB)
+	d.store.updateMergedState(source, res, ctx.raftWB)
+	if d.store.changes.enabled(res.Group) {
+		ctx.addShardEvent(res.ID, cdcpb.Merge, source)
+	}
+	d.shard = res
+
+	logger.Infof("shard %d merge source shard %d completed, new shard %+v",
+		d.shard.ID,
+		source.ID,
+		res)
+
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_CommitMerge, &raftcmdpb.CommitMergeResponse{})
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_CommitMerge,
+		commitMergeResult: &commitMergeResult{
+			source: source,
+			target: res,
+		},
+	}
+	ctx.metrics.admin.mergeSucceed++
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecRollbackMerge(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	ctx.metrics.admin.rollbackMerge++
+
+	req := ctx.req.AdminRequest.RollbackMerge
+	if d.mergeState == nil {
+		return nil, nil, errors.New("shard is not in merging")
+	}
+
+	if d.mergeState.Commit != req.Commit {
+		return nil, nil, fmt.Errorf("rollback merge commit %d not match merge state commit %d",
+			req.Commit,
+			d.mergeState.Commit)
+	}
+
+	res := bhmetapb.Shard{}
+	protoc.MustUnmarshal(&res, protoc.MustMarshal(&d.shard))
+	res.Epoch.Version++
+
+	d.store.updatePeerState(res, bhraftpb.PeerState_Normal, ctx.raftWB)
+	d.shard = res
+	d.mergeState = nil
+
+	logger.Infof("shard %d rollback merge at commit %d",
+		d.shard.ID,
+		req.Commit)
+
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_RollbackMerge, &raftcmdpb.RollbackMergeResponse{})
+	result := &execResult{
+		adminType:           raftcmdpb.AdminCmdType_RollbackMerge,
+		rollbackMergeResult: &rollbackMergeResult{shard: res},
+	}
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecComputeHash(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	ctx.metrics.admin.computeHash++
+
+	// The witness has no data to compute the hash, the following VerifyHash is skipped.
+	if d.isWitness() {
+		return newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_ComputeHash, nil), nil, nil
+	}
+
+	// All the logs before the ComputeHash are already written to the data storage,
+	// so every replica takes the view of the same data. Only the view is taken in the
+	// apply path, the hash is computed by the consistency check worker. The expiration
+	// is checked at the propose time in the log, not the local time of the replica.
+	compute, err := d.store.DataStorageByGroup(d.shard.Group, d.shard.ID).Checksum(encStartKey(&d.shard),
+		encEndKey(&d.shard), ctx.req.Header.ProposeTime)
+	if err != nil {
+		logger.Errorf("shard %d compute hash at index %d failed with %+v",
+			d.shard.ID,
+			ctx.index,
+			err)
+		return nil, nil, err
+	}
+
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_ComputeHash, nil)
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_ComputeHash,
+		computeHashResult: &computeHashResult{
+			index:   ctx.index,
+			compute: compute,
+		},
+	}
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecVerifyHash(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	ctx.metrics.admin.verifyHash++
+
+	req := ctx.req.AdminRequest.VerifyHash
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_VerifyHash, &raftcmdpb.VerifyHashResponse{})
+	if d.isWitness() {
+		return rsp, nil, nil
+	}
+
+	// The leader's hash is compared with the local hash after the local hash is computed
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_VerifyHash,
+		verifyHashResult: &verifyHashResult{
+			index: req.Index,
+			hash:  req.Hash,
+		},
+	}
+	return rsp, result, nil
+}
+
+// mergeSourceReady returns true if the request is not a CommitMerge, or the CommitMerge
+// can be executed. The source shard maybe not created or still catching up the logs on
+// this store, the CommitMerge waits until the source shard logs are caught up.
+func (d *applyDelegate) mergeSourceReady(req *raftcmdpb.RaftCMDRequest) bool {
+	if !isCommitMergeCMD(req) ||
+		!d.checkEpoch(req) ||
+		!d.checkMerging(req) {
+		return true
+	}
+
+	// the CommitMerge is refused by doExecCommitMerge, the source shard is not needed
+	source := req.AdminRequest.CommitMerge.Source
+	adjacent := (len(source.End) > 0 && bytes.Equal(source.End, d.shard.Start)) ||
+		(len(d.shard.End) > 0 && bytes.Equal(source.Start, d.shard.End))
+	if source.Group != d.shard.Group || shardContains(d.shard, source) || !adjacent {
+		return true
+	}
+
+	value, ok := d.store.delegates.Load(source.ID)
+	if !ok {
+		logger.Warningf("shard %d commit merge wait for the missing source shard %d",
+			d.shard.ID,
+			source.ID)
+		return false
+	}
+
+	delegate := value.(*applyDelegate)
+	err := delegate.catchUpMergeLogs(req.AdminRequest.CommitMerge)
+	if err != nil {
+		logger.Warningf("shard %d commit merge wait for source shard %d to catch up logs, %+v",
+			d.shard.ID,
+			source.ID,
+			err)
+		return false
+	}
+
+	d.ctx.mergeSource = delegate
+	return true
+}
+
+// catchUpMergeLogs apply the source shard logs until the PrepareMerge log, and mark the
+// source shard as removed, the logs after the PrepareMerge will be never applied.
+func (d *applyDelegate) catchUpMergeLogs(req *raftcmdpb.CommitMergeRequest) error {
+	d.applyLock.Lock()
+	defer d.applyLock.Unlock()
+
+	applied := d.applyState.AppliedIndex
+	if applied < req.Commit {
+		first := req.Commit + 1
+		if len(req.Entries) > 0 {
+			first = req.Entries[0].Index
+		}
+
+		// The logs in (applied, first) are not included in the CommitMerge request,
+		// but they must exist in the local raft log.
+		var entries []raftpb.Entry
+		for index := applied + 1; index < first; index++ {
+			e, err := d.ps.loadLogEntry(index)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, e)
+		}
+		for _, e := range req.Entries {
+			if e.Index > applied && e.Index <= req.Commit {
+				entries = append(entries, e)
+			}
+		}
+
+		logger.Infof("shard %d catch up %d logs from %d to %d for merge",
+			d.shard.ID,
+			len(entries),
+			applied+1,
+			req.Commit)
+		d.applyEntries(entries)
+	}
+
+	if d.applyState.AppliedIndex < req.Commit {
+		return fmt.Errorf("shard %d catch up logs failed, applied %d, expect %d",
+			d.shard.ID,
+			d.applyState.AppliedIndex,
+			req.Commit)
+	}
+
+	if d.mergeState == nil {
+		return fmt.Errorf("shard %d is not in merging after catch up logs", d.shard.ID)
+	}
+
+	d.setPendingRemove()
+	return nil
+}
+
 func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raftcmdpb.RaftCMDResponse) {
 	writeBytes := uint64(0)
 	diffBytes := int64(0)
 	resp := pb.AcquireRaftCMDResponse()
 
+	recordChanges := d.store.changes.enabled(d.shard.Group)
+	sampleWrites := loadSplitEnabled(d.store.cfg.Replication.LoadSplit)
+	if !sampleWrites {
+		if pr := d.store.getPR(d.shard.ID, true); pr != nil {
+			sampleWrites = pr.sampleLoad()
+		}
+	}
 	ctx.batchSize = len(ctx.req.Requests)
 	for idx, req := range ctx.req.Requests {
 		if logger.DebugEnabled() {
@@ -471,7 +814,11 @@ func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raf
 		}
 		ctx.offset = idx
 		if h, ok := d.store.writeHandlers[req.CustemType]; ok {
+			offset := len(ctx.dataWB.Ops)
 			written, diff, rsp := h(d.shard, req, ctx)
+			if recordChanges {
+				ctx.addWriteEvents(d.shard.ID, offset)
+			}
 			if rsp.Stale {
 				rsp.Error.Message = errStaleCMD.Error()
 				rsp.Error.StaleCommand = infoStaleCMD
@@ -482,6 +829,10 @@ func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raf
 			resp.Responses = append(resp.Responses, rsp)
 			writeBytes += written
 			diffBytes += diff
+			if sampleWrites {
+				ctx.metrics.sampledWrites = append(ctx.metrics.sampledWrites,
+					loadSample{key: append([]byte(nil), req.Key...), weight: written})
+			}
 		} else {
 			logger.Fatalf("%s missing write handle func for type %d, registers %+v",
 				hex.EncodeToString(req.ID),
@@ -490,5 +841,7 @@ func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raf
 		}
 		ctx.metrics.writtenKeys++
 	}
+	// the requests in a batch are counted as one query
+	ctx.metrics.writtenQuery++
 	return writeBytes, diffBytes, resp
 }
@@ -1,12 +1,16 @@
 package raftstore
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/storage/mem"
 	"github.com/matrixorigin/matrixcube/util/leaktest"
 	"github.com/stretchr/testify/assert"
@@ -163,3 +167,302 @@ func TestSyncData(t *testing.T) {
 	c.WaitLeadersByCount(t, 2, time.Second*10)
 	assert.True(t, c.dataStorages[0].(*mem.Storage).SyncCount > changedCount)
 }
+
+func TestMergeShard(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		DisableScheduleTestCluster,
+		DiskTestCluster,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+			cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	// split to 2 shards [nil, key2), [key2, nil)
+	resps, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value11"),
+		createTestWriteReq("w2", "key2", "value22"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resps))
+	c.WaitLeadersByCount(t, 2, time.Second*10)
+	c.stores[0].cfg.Replication.DisableShardSplit = true
+
+	target := c.GetShardByIndex(0)
+	source := c.GetShardByIndex(1)
+	assert.Equal(t, "key2", string(target.End))
+	assert.Equal(t, "key2", string(source.Start))
+
+	pr := c.stores[0].getPR(source.ID, true)
+	assert.NotNil(t, pr)
+	pr.addAction(action{
+		actionType:  prepareMergeAction,
+		epoch:       pr.ps.shard.Epoch,
+		mergeTarget: c.stores[0].getPR(target.ID, false).ps.shard,
+	})
+
+	c.WaitRemovedByShardID(t, source.ID, time.Second*10)
+	c.CheckShardCount(t, 1)
+	c.CheckShardRange(t, 0, nil, nil)
+	assert.True(t, c.GetShardByIndex(0).Epoch.Version > target.Epoch.Version)
+
+	// the data of the source shard is owned by the target shard
+	resps, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestReadReq("r1", "key1"),
+		createTestReadReq("r2", "key2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value11", string(resps["r1"].Responses[0].Value))
+	assert.Equal(t, "value22", string(resps["r2"].Responses[0].Value))
+
+	c.Restart()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	resps, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestReadReq("r3", "key2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value22", string(resps["r3"].Responses[0].Value))
+
+	// the tombstone state of the source shard is removed by the cleanup
+	value, err := c.stores[0].MetadataStorage().Get(getShardLocaleStateKey(source.ID))
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+}
+
+func TestCommitMergeMissingSource(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		DisableScheduleTestCluster,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+			cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	// split to 2 shards [nil, key2), [key2, nil)
+	_, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value11"),
+		createTestWriteReq("w2", "key2", "value22"))
+	assert.NoError(t, err)
+	c.WaitLeadersByCount(t, 2, time.Second*10)
+	c.stores[0].cfg.Replication.DisableShardSplit = true
+
+	target := c.GetShardByIndex(0)
+	respC := make(chan *raftcmdpb.RaftCMDResponse, 1)
+	pr := c.stores[0].getPR(target.ID, true)
+	assert.NoError(t, pr.onAdminWithCB(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_CommitMerge,
+		CommitMerge: &raftcmdpb.CommitMergeRequest{
+			Source: bhmetapb.Shard{ID: 10000, Group: target.Group, Start: []byte("key2")},
+			Commit: 10,
+		},
+	}, func(resp *raftcmdpb.RaftCMDResponse) {
+		respC <- resp
+	}))
+
+	// the source shard is not on the proposer, the merge is refused by a retryable error
+	// before it is proposed
+	select {
+	case resp := <-respC:
+		assert.NotNil(t, resp.Header.Error.ServerIsBusy)
+	case <-time.After(time.Second * 10):
+		assert.FailNow(t, "wait commit merge response timeout")
+	}
+	c.CheckShardCount(t, 2)
+	c.CheckShardRange(t, 0, nil, []byte("key2"))
+
+	// the committed CommitMerge waits for the missing source shard instead of refusing it
+	value, ok := c.stores[0].delegates.Load(target.ID)
+	assert.True(t, ok)
+	delegate := value.(*applyDelegate)
+	delegate.applyLock.Lock()
+	defer delegate.applyLock.Unlock()
+	assert.False(t, delegate.mergeSourceReady(&raftcmdpb.RaftCMDRequest{
+		Header: &raftcmdpb.RaftRequestHeader{ShardID: target.ID, Epoch: delegate.shard.Epoch},
+		AdminRequest: &raftcmdpb.AdminRequest{
+			CmdType: raftcmdpb.AdminCmdType_CommitMerge,
+			CommitMerge: &raftcmdpb.CommitMergeRequest{
+				Source: bhmetapb.Shard{ID: 10000, Group: target.Group, Start: []byte("key2")},
+				Commit: 10,
+			},
+		},
+	}))
+}
+
+func TestConsistencyCheck(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewTestClusterStore(t,
+		DisableScheduleTestCluster,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.DisableConsistencyCheck = true
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCounts(t, [3]int{1, 1, 1}, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	resps, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value11"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	shard := c.GetShardByIndex(0)
+	leader := c.GetShardLeaderStore(shard.ID).(*store)
+
+	// write a key to a follower directly, make it inconsistent with the leader
+	follower := -1
+	for idx, s := range c.stores {
+		if s != leader {
+			follower = idx
+			break
+		}
+	}
+	assert.NoError(t, c.dataStorages[follower].(storage.KVStorage).Set(EncodeDataKey(shard.Group, []byte("key2")), []byte("value22")))
+
+	leader.getPR(shard.ID, false).addAction(action{actionType: checkConsistencyAction})
+
+	timeoutC := time.After(time.Second * 10)
+	for {
+		select {
+		case <-timeoutC:
+			assert.FailNow(t, "wait inconsistent replica timeout")
+		default:
+		}
+
+		if atomic.LoadUint64(&c.stores[follower].getPR(shard.ID, false).inconsistentIndex) > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	for idx, s := range c.stores {
+		if idx != follower {
+			assert.Equal(t, uint64(0), atomic.LoadUint64(&s.getPR(shard.ID, false).inconsistentIndex))
+		}
+	}
+}
+
+func TestHashCheck(t *testing.T) {
+	var c hashCheck
+	_, _, ok := c.update(10, []byte("h1"), true)
+	assert.False(t, ok)
+	local, leader, ok := c.update(10, []byte("h2"), false)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("h2"), local)
+	assert.Equal(t, []byte("h1"), leader)
+
+	// a newer check resets the hashes, and the older one is ignored
+	_, _, ok = c.update(20, []byte("h1"), false)
+	assert.False(t, ok)
+	_, _, ok = c.update(10, []byte("h1"), true)
+	assert.False(t, ok)
+	local, leader, ok = c.update(20, []byte("h1"), true)
+	assert.True(t, ok)
+	assert.Equal(t, local, leader)
+}
+
+func TestWitnessReplica(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Prophet.Replication.MaxReplicas = 2
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	// 2 voters and 1 witness
+	assert.NoError(t, c.GetProphet().GetClient().PutPlacementRule(rpcpb.PlacementRule{
+		GroupID: "prophet",
+		ID:      "witness",
+		Role:    rpcpb.Witness,
+		Count:   1,
+	}))
+
+	id := c.GetShardByIndex(0).ID
+	witness := -1
+	timeoutC := time.After(time.Second * 30)
+	for witness < 0 {
+		select {
+		case <-timeoutC:
+			assert.FailNow(t, "wait witness timeout")
+		default:
+			for idx, s := range c.stores {
+				if pr := s.getPR(id, false); pr != nil && pr.peer.Witness && len(pr.ps.shard.Peers) == 3 {
+					witness = idx
+				}
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+	}
+
+	leader := c.GetShardLeaderStore(id)
+	assert.NotNil(t, leader)
+	assert.NotEqual(t, c.stores[witness], leader)
+	resps, err := sendTestReqs(leader, time.Second*10, nil, nil, createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	// the witness replicates the log but never applies the write
+	pr := c.stores[witness].getPR(id, false)
+	shard := pr.ps.shard
+	for pr.ps.getAppliedIndex() < leader.(*store).getPR(id, false).ps.getAppliedIndex() {
+		time.Sleep(time.Millisecond * 10)
+	}
+	value, err := c.dataStorages[witness].(storage.KVStorage).Get(EncodeDataKey(shard.Group, []byte("key1")))
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+
+	// the witness rejects the reads
+	r := createTestReadReq("r1", "key1")
+	r.AllowFollower = true
+	resps, err = sendTestReqs(c.stores[witness], time.Second*10, nil, nil, r)
+	assert.NoError(t, err)
+	assert.NotNil(t, resps["r1"].Header.Error.NotLeader)
+
+	// the witness may be elected after the leader stopped, but it transfers the leadership
+	// to the other voter once the voter is up to date
+	stopped := -1
+	for idx, s := range c.stores {
+		if s == leader {
+			stopped = idx
+			c.StopNode(idx)
+		}
+	}
+	timeoutC = time.After(time.Second * 30)
+	for {
+		elected := false
+		for idx, s := range c.stores {
+			if idx == stopped {
+				continue
+			}
+
+			if pr := s.getPR(id, false); pr != nil && pr.isLeader() && idx != witness {
+				elected = true
+			}
+		}
+		if elected {
+			break
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNow(t, "wait new leader timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
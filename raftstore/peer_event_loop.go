@@ -14,12 +14,15 @@
 package raftstore
 
 import (
+	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/util"
 	"go.etcd.io/etcd/raft"
@@ -32,20 +35,32 @@ const (
 )
 
 type action struct {
-	actionType actionType
-	splitKeys  [][]byte
-	splitIDs   []rpcpb.SplitID
-	epoch      metapb.ResourceEpoch
+	actionType  actionType
+	splitKeys   [][]byte
+	splitIDs    []rpcpb.SplitID
+	epoch       metapb.ResourceEpoch
+	mergeTarget bhmetapb.Shard
+	backup      backupTask
+	peerID      uint64
 }
 
 type actionType int
 
 const (
-	checkCompactAction = actionType(0)
-	doCampaignAction   = actionType(1)
-	checkSplitAction   = actionType(2)
-	doSplitAction      = actionType(3)
-	heartbeatAction    = actionType(4)
+	checkCompactAction          = actionType(0)
+	doCampaignAction            = actionType(1)
+	checkSplitAction            = actionType(2)
+	doSplitAction               = actionType(3)
+	heartbeatAction             = actionType(4)
+	prepareMergeAction          = actionType(5)
+	commitMergeAction           = actionType(6)
+	rollbackMergeAction         = actionType(7)
+	checkConsistencyAction      = actionType(8)
+	checkLoadSplitAction        = actionType(9)
+	unsafeRecoveryAction        = actionType(10)
+	backupAction                = actionType(11)
+	unsafeRecoveryDestroyAction = actionType(12)
+	unsafeRecoveryReportAction  = actionType(13)
 )
 
 func (pr *peerReplica) addRequest(req reqCtx) error {
@@ -105,8 +120,13 @@ func (pr *peerReplica) step(msg raftpb.Message) {
 }
 
 func (pr *peerReplica) onAdmin(req *raftcmdpb.AdminRequest) error {
+	return pr.onAdminWithCB(req, nil)
+}
+
+func (pr *peerReplica) onAdminWithCB(req *raftcmdpb.AdminRequest, cb func(*raftcmdpb.RaftCMDResponse)) error {
 	r := reqCtx{}
 	r.admin = req
+	r.cb = cb
 	return pr.addRequest(r)
 }
 
@@ -229,6 +249,24 @@ func (pr *peerReplica) handleAction(items []interface{}) {
 			}
 		case heartbeatAction:
 			pr.doHeartbeat()
+		case prepareMergeAction:
+			pr.doPrepareMerge(a.mergeTarget, a.epoch)
+		case commitMergeAction:
+			pr.doCommitMerge()
+		case rollbackMergeAction:
+			pr.doRollbackMerge()
+		case checkConsistencyAction:
+			pr.doCheckConsistency()
+		case checkLoadSplitAction:
+			pr.doCheckLoadSplit(a.epoch)
+		case unsafeRecoveryAction:
+			pr.doUnsafeRecovery(a.epoch)
+		case backupAction:
+			pr.doBackup(a.backup)
+		case unsafeRecoveryDestroyAction:
+			pr.doUnsafeRecoveryDestroy(a.peerID)
+		case unsafeRecoveryReportAction:
+			pr.doUnsafeRecoveryReport()
 		}
 	}
 
@@ -295,6 +333,11 @@ func (pr *peerReplica) handleTick(items []interface{}) {
 				pr.rn.Tick()
 			}
 		}
+
+		if n > 0 {
+			pr.followerReads.expire(time.Now(), pr.followerReadTimeout(), pr.getCurrentTerm())
+			pr.maybeTransferLeaderFromWitness()
+		}
 	}
 }
 
@@ -356,6 +399,70 @@ func (pr *peerReplica) doCheckSplit() {
 	pr.sizeDiffHint = 0
 }
 
+// maybeSplitByLoad splits the shard by the sampled request keys if the load of
+// the finished window exceeds the thresholds, or the prophet asked for it.
+func (pr *peerReplica) maybeSplitByLoad() {
+	now := time.Now()
+	hot, finished := pr.loadSplitter.isHot(now, pr.store.cfg.Replication.LoadSplit)
+	if !finished {
+		return
+	}
+
+	// the prophet already found the shard hot if it requested the load split
+	requested := atomic.CompareAndSwapUint32(&pr.loadSplitRequested, 1, 0)
+	if (hot || requested) &&
+		!pr.store.cfg.Replication.DisableShardSplit &&
+		pr.canSplitByLoad() {
+		pr.splitByLoad(pr.ps.shard.Epoch)
+	}
+	pr.loadSplitter.reset(now)
+}
+
+// doCheckLoadSplit handles the load split requested by the prophet. If there are no
+// samples, e.g. the load split of the store is disabled, the leader samples the
+// requests for a window and splits the shard at the end of the window.
+func (pr *peerReplica) doCheckLoadSplit(epoch metapb.ResourceEpoch) {
+	if !pr.canSplitByLoad() {
+		return
+	}
+
+	if pr.splitByLoad(epoch) {
+		return
+	}
+
+	if atomic.CompareAndSwapUint32(&pr.loadSplitRequested, 0, 1) {
+		logger.Infof("shard %d has no load split key, sample the requests for %s",
+			pr.shardID,
+			pr.store.cfg.Replication.LoadSplit.Duration.Duration)
+		pr.loadSplitter.reset(time.Now())
+	}
+}
+
+func (pr *peerReplica) canSplitByLoad() bool {
+	return pr.isLeader() &&
+		pr.supportSplit() &&
+		pr.ps.mergeState == nil
+}
+
+// splitByLoad starts the split job with the sampled split key, returns false if
+// there is no split key.
+func (pr *peerReplica) splitByLoad(epoch metapb.ResourceEpoch) bool {
+	shard := pr.ps.shard
+	splitKey := pr.loadSplitter.splitKey(encStartKey(&shard), encEndKey(&shard))
+	if len(splitKey) == 0 {
+		logger.Infof("shard %d has no load split key", pr.shardID)
+		return false
+	}
+
+	err := pr.startLoadSplitJob(epoch, splitKey)
+	if err != nil {
+		logger.Errorf("shard %d add load split job failed with %+v",
+			pr.shardID,
+			err)
+	}
+	return true
+}
+
 func (pr *peerReplica) doSplit(splitKeys [][]byte, splitIDs []rpcpb.SplitID, epoch metapb.ResourceEpoch) {
 	if !pr.isLeader() {
 		return
@@ -385,7 +492,148 @@ func (pr *peerReplica) doSplit(splitKeys [][]byte, splitIDs []rpcpb.SplitID, epo
 	pr.onAdmin(req)
 }
 
+func (pr *peerReplica) doPrepareMerge(target bhmetapb.Shard, epoch metapb.ResourceEpoch) {
+	if !pr.isLeader() {
+		return
+	}
+
+	if pr.ps.mergeState != nil {
+		logger.Infof("shard %d is already in merging to shard %d",
+			pr.shardID,
+			pr.ps.mergeState.Target.ID)
+		return
+	}
+
+	current := pr.ps.shard
+	if current.Epoch.Version != epoch.Version ||
+		current.Epoch.ConfVer != epoch.ConfVer {
+		logger.Infof("shard %d epoch changed, skip merge, current=<%+v> merge=<%+v>",
+			pr.shardID,
+			current.Epoch,
+			epoch)
+		return
+	}
+
+	if pr.rn.PendingConfIndex() > pr.ps.getAppliedIndex() {
+		logger.Infof("shard %d has pending conf change, skip merge",
+			pr.shardID)
+		return
+	}
+
+	// All logs after min index will be sent to the target shard to catch up
+	// the lagging source peers.
+	var minIndex uint64
+	for _, p := range pr.rn.Status().Progress {
+		if minIndex == 0 || p.Match < minIndex {
+			minIndex = p.Match
+		}
+	}
+	if minIndex < pr.ps.getTruncatedIndex() {
+		logger.Infof("shard %d some peers are lagging, skip merge, min index %d, truncated index %d",
+			pr.shardID,
+			minIndex,
+			pr.ps.getTruncatedIndex())
+		return
+	}
+
+	logger.Infof("shard %d propose prepare merge to shard %d, min index %d",
+		pr.shardID,
+		target.ID,
+		minIndex)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_PrepareMerge,
+		PrepareMerge: &raftcmdpb.PrepareMergeRequest{
+			MinIndex: minIndex,
+			Target:   target,
+		},
+	})
+}
+
+// doCommitMerge propose CommitMerge to the target shard, only the peer which on the
+// same store with the target shard leader can propose it.
+func (pr *peerReplica) doCommitMerge() {
+	state := pr.ps.mergeState
+	if state == nil {
+		return
+	}
+
+	target := pr.store.getPR(state.Target.ID, true)
+	if target == nil {
+		return
+	}
+
+	entries, err := pr.ps.Entries(state.MinIndex+1, state.Commit+1, math.MaxUint64)
+	if err != nil {
+		logger.Errorf("shard %d load merge logs in [%d, %d] failed with %+v",
+			pr.shardID,
+			state.MinIndex+1,
+			state.Commit,
+			err)
+		return
+	}
+
+	commit := state.Commit
+	target.onAdminWithCB(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_CommitMerge,
+		CommitMerge: &raftcmdpb.CommitMergeRequest{
+			Source:  pr.ps.shard,
+			Commit:  commit,
+			Entries: entries,
+		},
+	}, func(resp *raftcmdpb.RaftCMDResponse) {
+		// the target shard refused to merge, or the source shard is not ready on the target
+		// store, rollback, prophet will retry the merge later.
+		if resp.Header != nil &&
+			(resp.Header.Error.StaleEpoch != nil || resp.Header.Error.ServerIsBusy != nil) {
+			logger.Errorf("shard %d commit merge to shard %d failed with %s, rollback",
+				pr.shardID,
+				target.shardID,
+				resp.Header.Error.Message)
+			pr.addAction(action{actionType: rollbackMergeAction})
+		}
+	})
+}
+
+func (pr *peerReplica) doRollbackMerge() {
+	if !pr.isLeader() {
+		return
+	}
+
+	state := pr.ps.mergeState
+	if state == nil {
+		return
+	}
+
+	logger.Infof("shard %d propose rollback merge at commit %d",
+		pr.shardID,
+		state.Commit)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_RollbackMerge,
+		RollbackMerge: &raftcmdpb.RollbackMergeRequest{
+			Commit: state.Commit,
+		},
+	})
+}
+
+func (pr *peerReplica) doCheckConsistency() {
+	if !pr.isLeader() || pr.ps.mergeState != nil {
+		return
+	}
+
+	logger.Infof("shard %d propose compute hash to check consistency",
+		pr.shardID)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_ComputeHash,
+	})
+}
+
 func (pr *peerReplica) doCheckCompact() {
+	// The logs after min index will be sent to the target shard, so we can't
+	// compact them until the merge completed or rollbacked.
+	if pr.ps.mergeState != nil {
+		return
+	}
+
 	// Leader will replicate the compact log command to followers,
 	// If we use current replicated_index (like 10) as the compact index,
 	// when we replicate this log, the newest replicated_index will be 11,
@@ -523,6 +771,10 @@ func (pr *peerReplica) doHeartbeat() {
 	req.Stats.WrittenKeys = pr.writtenKeys
 	req.Stats.ReadBytes = pr.readBytes
 	req.Stats.ReadKeys = pr.readKeys
+	req.Stats.WrittenQuery = pr.writtenQuery
+	req.Stats.ReadQuery = pr.readQuery
+	req.Stats.WriteCPUTime = pr.writeCPUTime
+	req.Stats.ReadCPUTime = pr.readCPUTime
 	req.Stats.ApproximateKeys = pr.approximateKeys
 	req.Stats.ApproximateSize = pr.approximateSize
 	req.Stats.Interval = &metapb.TimeInterval{
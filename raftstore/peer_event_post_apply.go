@@ -14,6 +14,10 @@
 package raftstore
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
@@ -74,6 +78,8 @@ func (pr *peerReplica) doPostApply(result asyncApplyResult) {
 
 	pr.writtenBytes += result.metrics.writtenBytes
 	pr.writtenKeys += result.metrics.writtenKeys
+	pr.writtenQuery += result.metrics.writtenQuery
+	pr.writeCPUTime += result.metrics.writeCPUTime
 
 	if result.hasSplitExecResult() {
 		pr.deleteKeysHint = result.metrics.deleteKeysHint
@@ -83,6 +89,13 @@ func (pr *peerReplica) doPostApply(result asyncApplyResult) {
 		pr.sizeDiffHint += result.metrics.sizeDiffHint
 	}
 
+	if len(result.metrics.sampledWrites) > 0 && pr.isLeader() {
+		for _, sample := range result.metrics.sampledWrites {
+			pr.loadSplitter.add(sample.key, sample.weight)
+		}
+		pr.maybeSplitByLoad()
+	}
+
 	pr.maybeExecRead()
 }
 
@@ -94,6 +107,16 @@ func (pr *peerReplica) doPostApplyResult(result asyncApplyResult) {
 		pr.doApplySplit(result.result.splitResult)
 	case raftcmdpb.AdminCmdType_CompactLog:
 		pr.doApplyCompactRaftLog(result.result.raftGCResult)
+	case raftcmdpb.AdminCmdType_PrepareMerge:
+		pr.doApplyPrepareMerge(result.result.prepareMergeResult)
+	case raftcmdpb.AdminCmdType_CommitMerge:
+		pr.doApplyCommitMerge(result.result.commitMergeResult)
+	case raftcmdpb.AdminCmdType_RollbackMerge:
+		pr.doApplyRollbackMerge(result.result.rollbackMergeResult)
+	case raftcmdpb.AdminCmdType_ComputeHash:
+		pr.doApplyComputeHash(result.result.computeHashResult)
+	case raftcmdpb.AdminCmdType_VerifyHash:
+		pr.doApplyVerifyHash(result.result.verifyHashResult)
 	}
 }
 
@@ -195,6 +218,8 @@ func (pr *peerReplica) doApplySplit(result *splitResult) {
 	pr.sizeDiffHint = 0
 	pr.approximateKeys = 0
 	pr.approximateSize = 0
+	pr.loadSplitter.reset(time.Now())
+	atomic.StoreUint32(&pr.loadSplitRequested, 0)
 	pr.store.updateShardKeyRange(result.derived)
 
 	if pr.isLeader() {
@@ -272,6 +297,135 @@ func (pr *peerReplica) doApplySplit(result *splitResult) {
 		result.shards)
 }
 
+func (pr *peerReplica) doApplyPrepareMerge(result *prepareMergeResult) {
+	logger.Infof("shard %d update to %+v by post apply prepare merge, merge state %+v",
+		pr.shardID,
+		result.shard,
+		result.state)
+
+	pr.ps.shard = result.shard
+	state := result.state
+	pr.ps.mergeState = &state
+
+	if pr.isLeader() {
+		pr.addAction(action{actionType: heartbeatAction})
+	}
+	pr.addAction(action{actionType: commitMergeAction})
+}
+
+func (pr *peerReplica) doApplyCommitMerge(result *commitMergeResult) {
+	logger.Infof("shard %d update to %+v by post apply commit merge, source shard %d",
+		pr.shardID,
+		result.target,
+		result.source.ID)
+
+	pr.ps.shard = result.target
+
+	if value, ok := pr.store.delegates.Load(result.source.ID); ok {
+		delegate := value.(*applyDelegate)
+		delegate.applyLock.Lock()
+		pr.store.delegates.Delete(result.source.ID)
+		delegate.destroy()
+		delegate.applyLock.Unlock()
+	}
+
+	if source := pr.store.getPR(result.source.ID, false); source != nil {
+		source.ps.shard.State = metapb.ResourceState_Removed
+		source.mustDestroyMerged(result.target)
+	}
+	pr.store.updateShardKeyRange(result.target)
+
+	if pr.isLeader() {
+		pr.approximateSize = 0
+		pr.approximateKeys = 0
+		pr.addAction(action{actionType: heartbeatAction})
+	}
+
+	if pr.store.aware != nil {
+		pr.store.aware.Merged(pr.ps.shard)
+	}
+}
+
+func (pr *peerReplica) doApplyRollbackMerge(result *rollbackMergeResult) {
+	logger.Infof("shard %d update to %+v by post apply rollback merge",
+		pr.shardID,
+		result.shard)
+
+	pr.ps.shard = result.shard
+	pr.ps.mergeState = nil
+
+	if pr.isLeader() {
+		pr.addAction(action{actionType: heartbeatAction})
+	}
+}
+
+func (pr *peerReplica) doApplyComputeHash(result *computeHashResult) {
+	err := pr.store.addConsistencyCheckJob(func() error {
+		sum, err := result.compute()
+		if err != nil {
+			logger.Errorf("shard %d compute hash at index %d failed with %+v",
+				pr.shardID,
+				result.index,
+				err)
+			return err
+		}
+
+		hash := make([]byte, 4)
+		binary.BigEndian.PutUint32(hash, sum)
+		logger.Infof("shard %d compute hash %s at index %d",
+			pr.shardID,
+			hex.EncodeToString(hash),
+			result.index)
+
+		// All replicas compute the hash at the same index, the leader sends its hash
+		// to the followers to verify.
+		if pr.isLeader() {
+			pr.onAdmin(&raftcmdpb.AdminRequest{
+				CmdType: raftcmdpb.AdminCmdType_VerifyHash,
+				VerifyHash: &raftcmdpb.VerifyHashRequest{
+					Index: result.index,
+					Hash:  hash,
+				},
+			})
+		}
+		pr.checkHash(result.index, hash, false)
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("shard %d add compute hash job failed with %+v",
+			pr.shardID,
+			err)
+		// release the data view
+		result.compute()
+	}
+}
+
+func (pr *peerReplica) doApplyVerifyHash(result *verifyHashResult) {
+	pr.checkHash(result.index, result.hash, true)
+}
+
+// checkHash sets the local or the leader hash at the index, and compares them if both
+// are ready. The inconsistent shard will be reported to prophet by the store heartbeat.
+func (pr *peerReplica) checkHash(index uint64, hash []byte, leader bool) {
+	local, leaderHash, ok := pr.hashes.update(index, hash, leader)
+	if !ok {
+		return
+	}
+
+	if bytes.Equal(local, leaderHash) {
+		atomic.StoreUint64(&pr.inconsistentIndex, 0)
+		return
+	}
+
+	metric.AddRaftAdminCommandVerifyHashInconsistentCount(1)
+	logger.Errorf("shard %d is inconsistent at index %d, leader hash %s, local hash %s",
+		pr.shardID,
+		index,
+		hex.EncodeToString(leaderHash),
+		hex.EncodeToString(local))
+	atomic.StoreUint64(&pr.inconsistentIndex, index)
+}
+
 func (pr *peerReplica) doApplyCompactRaftLog(result *raftGCResult) {
 	total := pr.ps.lastReadyIndex - result.firstIndex
 	remain := pr.ps.lastReadyIndex - result.state.Index - 1
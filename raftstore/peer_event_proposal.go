@@ -17,6 +17,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/fagongzi/util/protoc"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
@@ -54,6 +55,7 @@ const (
 	proposeNormal         = requestPolicy(2)
 	proposeTransferLeader = requestPolicy(3)
 	proposeChange         = requestPolicy(4)
+	readFollower          = requestPolicy(5)
 )
 
 func (pr *peerReplica) handleRequest(items []interface{}) {
@@ -80,6 +82,11 @@ func (pr *peerReplica) handleRequest(items []interface{}) {
 					}
 					continue
 				}
+
+				if err := pr.store.quotas.admitBytes(req.req); err != nil {
+					respQuotaExceeded(err, req.req, req.cb)
+					continue
+				}
 			}
 
 			if logger.DebugEnabled() && req.req != nil {
@@ -125,12 +132,44 @@ func (pr *peerReplica) propose(c cmd) {
 		return
 	}
 
+	// the source shard of merge can only serve read and rollback merge
+	if pr.ps.mergeState != nil &&
+		policy != readIndex &&
+		policy != readLocal &&
+		policy != readFollower &&
+		!isRollbackMergeCMD(c.req) {
+		c.respOtherError(errShardMerging)
+		return
+	}
+
+	// the committed CommitMerge waits until the source shard is ready on every replica, so
+	// the source shard must be ready on the proposer, otherwise the merge is refused and
+	// the source shard rollbacks the merge.
+	if isCommitMergeCMD(c.req) {
+		if err := pr.checkMergeSource(c.req.AdminRequest.CommitMerge); err != nil {
+			c.resp(errorMergeSourceMissingResp(c.req.Header.ID, pr.getCurrentTerm(), err))
+			return
+		}
+	}
+
+	// the witness has no data, the reads and writes are served by the other replicas
+	if pr.peer.Witness && c.req.AdminRequest == nil {
+		var target metapb.Peer
+		if !pr.isLeader() {
+			target, _ = pr.store.getPeer(pr.getLeaderPeerID())
+		}
+		c.respNotLeader(pr.shardID, target)
+		return
+	}
+
 	doPropose := false
 	switch policy {
 	case readIndex:
 		pr.execReadIndex(c)
 	case readLocal:
 		pr.doExecReadCmd(c)
+	case readFollower:
+		pr.execFollowerRead(c)
 	case proposeNormal:
 		doPropose = pr.proposeNormal(c)
 	case proposeTransferLeader:
@@ -159,9 +198,17 @@ func (pr *peerReplica) execReadIndex(c cmd) {
 		return
 	}
 
+	now := time.Now()
+	if pr.canLeaseRead(now) {
+		pr.doExecReadCmd(c)
+		pr.metrics.propose.readLease++
+		return
+	}
+
 	lastPendingReadCount := pr.pendingReadCount()
 	lastReadyReadCount := pr.readyReadCount()
 
+	c.readIndexTime = now
 	pr.rn.ReadIndex(c.getUUID())
 
 	pendingReadCount := pr.pendingReadCount()
@@ -178,6 +225,50 @@ func (pr *peerReplica) execReadIndex(c cmd) {
 	pr.metrics.propose.readIndex++
 }
 
+// execFollowerRead serves the read on a follower. The read is served immediately
+// if the data of the follower is fresh enough for the bounded staleness of the
+// request, otherwise it is served after the applied index catches up the
+// committed index which is returned by the leader.
+func (pr *peerReplica) execFollowerRead(c cmd) {
+	now := time.Now()
+	staleness := time.Duration(c.req.Requests[0].MaxStalenessMS) * time.Millisecond
+	if staleness > 0 && pr.followerReads.fresh(now, staleness) {
+		pr.doExecReadCmd(c)
+		pr.metrics.propose.readStale++
+		return
+	}
+
+	// raft drops the ReadIndex silently if there is no leader
+	if pr.getLeaderPeerID() == 0 {
+		c.respNotLeader(pr.shardID, metapb.Peer{})
+		return
+	}
+
+	c.readIndexTime = now
+	pr.rn.ReadIndex(c.getUUID())
+	pr.followerReads.push(c)
+	pr.metrics.propose.readFollower++
+}
+
+// followerReadTimeout returns the max time to wait for the ReadIndex response
+// from the leader
+func (pr *peerReplica) followerReadTimeout() time.Duration {
+	return time.Duration(pr.store.cfg.Raft.ElectionTimeoutTicks) * pr.store.cfg.Raft.TickInterval.Duration
+}
+
+// canLeaseRead returns true if the leader can serve the read locally by the lease,
+// all the committed logs must be applied before read.
+func (pr *peerReplica) canLeaseRead(now time.Time) bool {
+	if pr.lease == nil ||
+		!pr.readyToHandleRead() ||
+		pr.isTransferringLeader() ||
+		!pr.lease.inLease(pr.getCurrentTerm(), now) {
+		return false
+	}
+
+	return pr.ps.raftApplyState.AppliedIndex >= pr.rn.BasicStatus().Commit
+}
+
 func (pr *peerReplica) proposeNormal(c cmd) bool {
 	if !pr.isLeader() {
 		target, _ := pr.store.getPeer(pr.getLeaderPeerID())
@@ -185,6 +276,8 @@ func (pr *peerReplica) proposeNormal(c cmd) bool {
 		return false
 	}
 
+	// all the replicas use the propose time in the log as the current time when applying
+	c.req.Header.ProposeTime = time.Now().Unix()
 	data := protoc.MustMarshal(c.req)
 	size := len(data)
 	metric.ObserveProposalBytes(int64(size))
@@ -331,6 +424,11 @@ func (pr *peerReplica) doTransferLeader(peer metapb.Peer) {
 		pr.shardID,
 		peer.ID)
 
+	// The target peer will campaign without waiting for the election timeout
+	if pr.lease != nil {
+		pr.lease.expire()
+	}
+
 	// Broadcast heartbeat to make sure followers commit the entries immediately.
 	// It's only necessary to ping the target peer, but ping all for simplicity.
 	pr.rn.Ping()
@@ -339,7 +437,67 @@ func (pr *peerReplica) doTransferLeader(peer metapb.Peer) {
 	pr.metrics.propose.transferLeader++
 }
 
+// checkMergeSource checks the source shard of the CommitMerge is on the store and applied
+// the PrepareMerge.
+func (pr *peerReplica) checkMergeSource(req *raftcmdpb.CommitMergeRequest) error {
+	source := pr.store.getPR(req.Source.ID, false)
+	if source == nil {
+		return fmt.Errorf("%w: shard %d is not on the store", errMergeSourceMissing, req.Source.ID)
+	}
+
+	if applied := source.ps.getAppliedIndex(); applied < req.Commit || source.ps.mergeState == nil {
+		return fmt.Errorf("%w: shard %d applied %d, expect %d",
+			errMergeSourceMissing,
+			req.Source.ID,
+			applied,
+			req.Commit)
+	}
+
+	if _, ok := pr.store.delegates.Load(req.Source.ID); !ok {
+		return fmt.Errorf("%w: shard %d missing delegate", errMergeSourceMissing, req.Source.ID)
+	}
+
+	return nil
+}
+
+// maybeTransferLeaderFromWitness transfers the leadership to the most up-to-date
+// voter if the witness becomes the leader, the witness can't serve any request.
+// The witness campaigns as the other voters, so the shard is still available if
+// only the witness and a lagging voter are alive. The witness leader catches the
+// voter up by the raft logs, and transfers the leadership once the voter is up
+// to date, see isTransferLeaderAllowed.
+func (pr *peerReplica) maybeTransferLeaderFromWitness() {
+	if !pr.peer.Witness || !pr.isLeader() {
+		return
+	}
+
+	status := pr.rn.Status()
+	if status.LeadTransferee != 0 {
+		return
+	}
+
+	var target metapb.Peer
+	var match uint64
+	for _, p := range pr.ps.shard.Peers {
+		if p.ID == pr.peer.ID || p.Witness || p.Role != metapb.PeerRole_Voter {
+			continue
+		}
+		if progress, ok := status.Progress[p.ID]; ok && (target.ID == 0 || progress.Match > match) {
+			target, match = p, progress.Match
+		}
+	}
+
+	if target.ID != 0 && pr.isTransferLeaderAllowed(target) {
+		pr.doTransferLeader(target)
+	}
+}
+
 func (pr *peerReplica) isTransferLeaderAllowed(newLeaderPeer metapb.Peer) bool {
+	// the witness can't serve any request
+	if p, ok := pr.getPeerByID(newLeaderPeer.ID); ok && p.Witness {
+		return false
+	}
+
 	status := pr.rn.Status()
 	if _, ok := status.Progress[newLeaderPeer.ID]; !ok {
 		return false
@@ -512,5 +670,9 @@ func (pr *peerReplica) getHandlePolicy(req *raftcmdpb.RaftCMDRequest) (requestPo
 		return readLocal, nil
 	}
 
+	if req.Requests[0].AllowFollower && !pr.isLeader() {
+		return readFollower, nil
+	}
+
 	return readIndex, nil
 }
@@ -1,13 +1,16 @@
 package raftstore
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/matrixorigin/matrixcube/command"
+	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/transport"
 	"github.com/matrixorigin/matrixcube/util/leaktest"
 	"github.com/stretchr/testify/assert"
 )
@@ -73,3 +76,159 @@ func TestIssue90(t *testing.T) {
 	assert.Nil(t, resps["r2"].Header)
 	assert.Equal(t, "2", string(resps["r2"].Responses[0].Value))
 }
+
+func TestLeaseRead(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		DisableScheduleTestCluster,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Raft.LeaseReadGroups = []uint64{0}
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	id := c.GetShardByIndex(0).ID
+	s := c.GetShardLeaderStore(id)
+	assert.NotNil(t, s)
+
+	pr := s.(*store).getPR(id, true)
+	assert.NotNil(t, pr)
+	assert.NotNil(t, pr.lease)
+
+	for i := 0; i < 3; i++ {
+		w := createTestWriteReq(fmt.Sprintf("w%d", i), "key1", fmt.Sprintf("%d", i))
+		r := createTestReadReq(fmt.Sprintf("r%d", i), "key1")
+		resps, err := sendTestReqs(s, time.Second*10, nil, nil, w)
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", string(resps[string(w.ID)].Responses[0].Value))
+
+		resps, err = sendTestReqs(s, time.Second*10, nil, nil, r)
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d", i), string(resps[string(r.ID)].Responses[0].Value))
+	}
+}
+
+func TestLeaseReadDuringTransferLeader(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		DisableScheduleTestCluster,
+		WithTestClusterFaults(transport.NewFaults()),
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Raft.LeaseReadGroups = []uint64{0}
+			// the transfer is aborted after the election timeout
+			cfg.Raft.ElectionTimeoutTicks = 30
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	id := c.GetShardByIndex(0).ID
+	s := c.GetShardLeaderStore(id)
+	assert.NotNil(t, s)
+
+	pr := s.(*store).getPR(id, true)
+	assert.NotNil(t, pr)
+
+	w := createTestWriteReq("w1", "key1", "1")
+	resps, err := sendTestReqs(s, time.Second*10, nil, nil, w)
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	// partition a follower, so the transfer to it is pending until aborted
+	follower := -1
+	c.EveryStore(func(i int, store Store) {
+		if store != s && follower < 0 {
+			follower = i
+		}
+	})
+	fpr := c.GetStore(follower).(*store).getPR(id, false)
+	assert.NotNil(t, fpr)
+	target := fpr.peer
+	var others []int
+	c.EveryStore(func(i int, store Store) {
+		if i != follower {
+			others = append(others, i)
+		}
+	})
+	partition := c.PartitionNodes([]int{follower}, others)
+	defer c.HealPartition(partition)
+
+	assert.NoError(t, pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType:        raftcmdpb.AdminCmdType_TransferLeader,
+		TransferLeader: &raftcmdpb.TransferLeaderRequest{Peer: target},
+	}))
+	timeout := time.After(time.Second * 2)
+	for !pr.isTransferringLeader() {
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait transfer leader timeout")
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+
+	// the reads are served by the ReadIndex and never renew the lease
+	for i := 0; i < 3; i++ {
+		r := createTestReadReq(fmt.Sprintf("r%d", i), "key1")
+		resps, err = sendTestReqs(s, time.Second*10, nil, nil, r)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", string(resps[string(r.ID)].Responses[0].Value))
+	}
+	assert.True(t, pr.isTransferringLeader())
+	assert.False(t, pr.lease.inLease(pr.getCurrentTerm(), time.Now()))
+}
+
+func TestFollowerRead(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		DisableScheduleTestCluster)
+	c.Start()
+	defer c.Stop()
+
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	c.WaitShardByCount(t, 1, time.Second*10)
+	id := c.GetShardByIndex(0).ID
+	leader := c.GetShardLeaderStore(id)
+	assert.NotNil(t, leader)
+
+	var follower Store
+	c.EveryStore(func(i int, s Store) {
+		if s != leader {
+			follower = s
+		}
+	})
+
+	w := createTestWriteReq("w1", "key1", "1")
+	resps, err := sendTestReqs(leader, time.Second*10, nil, nil, w)
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	// the follower must wait the write applied
+	r := createTestReadReq("r1", "key1")
+	r.AllowFollower = true
+	resps, err = sendTestReqs(follower, time.Second*10, nil, nil, r)
+	assert.NoError(t, err)
+	assert.Nil(t, resps["r1"].Header)
+	assert.Equal(t, "1", string(resps["r1"].Responses[0].Value))
+
+	// bounded staleness read is served by the follower immediately
+	w = createTestWriteReq("w2", "key1", "2")
+	resps, err = sendTestReqs(leader, time.Second*10, nil, nil, w)
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w2"].Responses[0].Value))
+
+	r = createTestReadReq("r2", "key1")
+	r.AllowFollower = true
+	r.MaxStalenessMS = 60000
+	resps, err = sendTestReqs(follower, time.Second*10, nil, nil, r)
+	assert.NoError(t, err)
+	assert.Nil(t, resps["r2"].Header)
+	assert.Contains(t, []string{"1", "2"}, string(resps["r2"].Responses[0].Value))
+}
@@ -116,6 +116,7 @@ func (pr *peerReplica) handleReady() {
 
 func (pr *peerReplica) handleRaftReadyAppend(ctx *readyContext, rd *raft.Ready) {
 	start := time.Now()
+	op := pr.store.slowScore.begin(start)
 
 	// If we become leader, send heartbeat to pd
 	if rd.SoftState != nil {
@@ -158,7 +159,18 @@ func (pr *peerReplica) handleRaftReadyAppend(ctx *readyContext, rd *raft.Ready)
 			err)
 	}
 
+	// The raft logs before the snapshot are useless after the snapshot state saved
+	if !raft.IsEmptySnap(rd.Snapshot) {
+		err := pr.store.logStorage.Compact(pr.shardID, rd.Snapshot.Metadata.Index+1)
+		if err != nil {
+			logger.Fatalf("shard %d compact raft log after snapshot failed with %+v",
+				pr.shardID,
+				err)
+		}
+	}
+
 	metric.ObserveRaftLogAppendDuration(start)
+	pr.store.slowScore.record(op)
 }
 
 func (pr *peerReplica) handleAppendSnapshot(ctx *readyContext, rd *raft.Ready) {
@@ -247,28 +259,17 @@ func (pr *peerReplica) doAppendEntries(ctx *readyContext, entries []raftpb.Entry
 	lastIndex := entries[c-1].Index
 	lastTerm := entries[c-1].Term
 
-	for _, e := range entries {
-		d := protoc.MustMarshal(&e)
-		err := ctx.wb.Set(getRaftLogKey(pr.shardID, e.Index), d)
-		if err != nil {
-			logger.Fatalf("shard %d append entry <%s> failed with %+v",
-				pr.shardID,
-				e.String(),
-				err)
-			return err
-		}
-	}
-
-	// Delete any previously appended log entries which never committed.
-	for index := lastIndex + 1; index < prevLastIndex+1; index++ {
-		err := ctx.wb.Delete(getRaftLogKey(pr.shardID, index))
-		if err != nil {
-			logger.Fatalf("shard %d delete any previously appended log entries %d failed with %+v",
-				pr.shardID,
-				index,
-				err)
-			return err
-		}
+	// The entries must be durable before the raft state is written, or added to
+	// the write batch with the raft state.
+	err := pr.store.logStorage.Append(pr.shardID, prevLastIndex, entries, ctx.wb,
+		!pr.store.cfg.Raft.RaftLog.DisableSync)
+	if err != nil {
+		logger.Fatalf("shard %d append entries [%d, %d] failed with %+v",
+			pr.shardID,
+			entries[0].Index,
+			lastIndex,
+			err)
+		return err
 	}
 
 	ctx.raftState.LastIndex = lastIndex
@@ -425,16 +426,24 @@ func (pr *peerReplica) applyCommittedEntries(rd *raft.Ready, result *applySnapRe
 }
 
 func (pr *peerReplica) doApplyReads(rd *raft.Ready) {
-	if pr.readyToHandleRead() {
-		for _, state := range rd.ReadStates {
-			pr.pendingReads.ready(state)
+	readyToHandleRead := pr.readyToHandleRead() && pr.isLeader()
+	for _, state := range rd.ReadStates {
+		if pr.followerReads.ready(state) {
+			continue
 		}
 
-		if len(rd.ReadStates) > 0 {
-			pr.maybeExecRead()
+		if readyToHandleRead {
+			sentAt := pr.pendingReads.ready(state)
+			if pr.lease != nil && !sentAt.IsZero() && !pr.isTransferringLeader() {
+				pr.lease.renew(pr.getCurrentTerm(), sentAt)
+			}
 		}
 	}
 
+	if len(rd.ReadStates) > 0 {
+		pr.maybeExecRead()
+	}
+
 	// Note that only after handle read_states can we identify what requests are
 	// actually stale.
 	if rd.SoftState != nil {
@@ -45,6 +45,7 @@ func (pr *peerReplica) startRegistrationJob(from string) {
 		applyState:       pr.ps.raftApplyState,
 		appliedIndexTerm: pr.ps.appliedIndexTerm,
 		ctx:              newApplyContext(pr),
+		mergeState:       pr.ps.mergeState,
 		syncData: pr.store.cfg.Customize.CustomAdjustInitAppliedIndexFactory != nil &&
 			pr.store.cfg.Customize.CustomAdjustInitAppliedIndexFactory(pr.ps.shard.Group) != nil,
 	}
@@ -78,6 +79,13 @@ func (pr *peerReplica) startApplyCommittedEntriesJob(shardID uint64, term uint64
 	return err
 }
 
+func (pr *peerReplica) startResumeApplyJob() error {
+	err := pr.store.addApplyJob(pr.applyWorker, "doResumeApply", func() error {
+		return pr.doResumeApply()
+	}, nil)
+	return err
+}
+
 func (pr *peerReplica) startCompactRaftLogJob(shardID, startIndex, endIndex uint64) error {
 	err := pr.store.addApplyJob(pr.applyWorker, "doCompactRaftLog", func() error {
 		return pr.doCompactRaftLog(shardID, startIndex, endIndex)
@@ -107,6 +115,15 @@ func (pr *peerReplica) startProposeJob(c cmd, isConfChange bool) error {
 	return err
 }
 
+func (pr *peerReplica) startLoadSplitJob(epoch metapb.ResourceEpoch, splitKey []byte) error {
+	logger.Infof("shard %d start load split job with split key %+v",
+		pr.ps.shard.ID,
+		splitKey)
+	return pr.store.addSplitJob(func() error {
+		return pr.doAskSplit(epoch, [][]byte{splitKey})
+	})
+}
+
 func (pr *peerReplica) startSplitCheckJob() error {
 	shard := pr.ps.shard
 	epoch := shard.Epoch
@@ -307,6 +324,10 @@ func (pr *peerReplica) doSplitCheck(epoch metapb.ResourceEpoch, startKey, endKey
 		size,
 		splitKeys)
 
+	return pr.doAskSplit(epoch, splitKeys)
+}
+
+func (pr *peerReplica) doAskSplit(epoch metapb.ResourceEpoch, splitKeys [][]byte) error {
 	current := pr.ps.shard
 	if current.Epoch.Version != epoch.Version {
 		logger.Infof("shard %d epoch changed, need re-check later, current=<%+v> split=<%+v>",
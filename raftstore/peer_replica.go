@@ -115,6 +115,10 @@ type peerReplica struct {
 
 	batch        *proposeBatch
 	pendingReads *readIndexQueue
+	// followerReads holds the reads served by this peer as a follower
+	followerReads *followerReadQueue
+	// lease is not nil if the shard group enables the lease read
+	lease        *leaderLease
 	ctx          context.Context
 	cancel       context.CancelFunc
 	items        []interface{}
@@ -126,16 +130,29 @@ type peerReplica struct {
 	requests     *task.Queue
 	actions      *task.Queue
 
-	writtenKeys     uint64
-	writtenBytes    uint64
-	readKeys        uint64
-	readBytes       uint64
-	sizeDiffHint    uint64
-	raftLogSizeHint uint64
-	deleteKeysHint  uint64
+	writtenKeys  uint64
+	writtenBytes uint64
+	readKeys     uint64
+	readBytes    uint64
+	writtenQuery uint64
+	readQuery    uint64
+	writeCPUTime uint64
+	readCPUTime  uint64
+	loadSplitter loadSplitter
+	// loadSplitRequested is set if the prophet asks to split the shard by the load
+	// but there are no samples, the requests are sampled for a window even if the
+	// load split of the store is disabled.
+	loadSplitRequested uint32
+	sizeDiffHint       uint64
+	raftLogSizeHint    uint64
+	deleteKeysHint     uint64
 	// TODO: setting on split check
 	approximateSize uint64
 	approximateKeys uint64
+	// inconsistentIndex is the index of the last VerifyHash which found the replica
+	// is inconsistent with the leader, 0 means consistent. Accessed atomically.
+	inconsistentIndex uint64
+	hashes            hashCheck
 
 	metrics  localMetrics
 	stopOnce sync.Once
@@ -198,6 +215,12 @@ func newPeerReplica(store *store, shard *bhmetapb.Shard, peer metapb.Peer) (*pee
 			break
 		}
 	}
+	for _, g := range store.cfg.Raft.LeaseReadGroups {
+		if shard.Group == g {
+			pr.lease = newLeaderLease(store.cfg.Raft)
+			break
+		}
+	}
 	pr.batch = newBatch(pr)
 
 	c := getRaftConfig(peer.ID, ps.getAppliedIndex(), ps, store.cfg)
@@ -222,9 +245,17 @@ func newPeerReplica(store *store, shard *bhmetapb.Shard, peer metapb.Peer) (*pee
 	pr.pendingReads = &readIndexQueue{
 		shardID: shard.ID,
 	}
+	pr.followerReads = &followerReadQueue{
+		shardID: shard.ID,
+	}
 
 	// If this shard has only one peer and I am the one, campaign directly.
-	if len(shard.Peers) == 1 && shard.Peers[0].ContainerID == store.meta.meta.ID {
+	// The witness never campaigns, it has no data to serve as the leader.
+	if peer.Witness {
+		logger.Infof("shard %d peer %d is a witness, skip campaign",
+			pr.shardID,
+			peer.ID)
+	} else if len(shard.Peers) == 1 && shard.Peers[0].ContainerID == store.meta.meta.ID {
 		logger.Infof("shard %d try to campaign leader, because only self",
 			pr.shardID)
 
@@ -271,6 +302,13 @@ func (pr *peerReplica) isLeader() bool {
 	return pr.getLeaderPeerID() == pr.peer.ID
 }
 
+// isTransferringLeader returns true if the leader is transferring the leadership. The
+// transferee campaigns by MsgTimeoutNow which bypasses the CheckQuorum, so the lease
+// must not be used or renewed until the transfer is finished or aborted.
+func (pr *peerReplica) isTransferringLeader() bool {
+	return pr.rn.BasicStatus().LeadTransferee != 0
+}
+
 func (pr *peerReplica) getLeaderPeerID() uint64 {
 	return atomic.LoadUint64(&pr.leaderID)
 }
@@ -285,6 +323,10 @@ func (pr *peerReplica) maybeCampaign() (bool, error) {
 		return false, nil
 	}
 
+	if pr.peer.Witness {
+		return false, nil
+	}
+
 	err := pr.rn.Campaign()
 	if err != nil {
 		return false, err
@@ -294,9 +336,20 @@ func (pr *peerReplica) maybeCampaign() (bool, error) {
 }
 
 func (pr *peerReplica) mustDestroy() {
+	pr.destroy(true, nil)
+}
+
+// mustDestroyMerged destroy the source shard which is merged into the target shard,
+// the data of the source shard is owned by the target shard, so we keep it. The target
+// is saved in the tombstone state, so the cleanup after restart keeps the data too.
+func (pr *peerReplica) mustDestroyMerged(target bhmetapb.Shard) {
+	pr.destroy(false, &target)
+}
+
+func (pr *peerReplica) destroy(clearData bool, mergedTo *bhmetapb.Shard) {
 	if pr.ps.isApplyingSnapshot() {
 		util.DefaultTimeoutWheel().Schedule(time.Second*30, func(interface{}) {
-			pr.mustDestroy()
+			pr.destroy(clearData, mergedTo)
 		}, nil)
 		logger.Infof("shard %d is applying snapshot, retry destory later", pr.shardID)
 		return
@@ -316,7 +369,11 @@ func (pr *peerReplica) mustDestroy() {
 
 	wb := util.NewWriteBatch()
 	pr.store.clearMeta(pr.shardID, wb)
-	pr.store.updatePeerState(pr.ps.shard, bhraftpb.PeerState_Tombstone, wb)
+	if mergedTo != nil {
+		pr.store.updateMergedState(pr.ps.shard, *mergedTo, wb)
+	} else {
+		pr.store.updatePeerState(pr.ps.shard, bhraftpb.PeerState_Tombstone, wb)
+	}
 	err := pr.store.MetadataStorage().Write(wb, false)
 	if err != nil {
 		logger.Fatal("shard %d do destroy failed with %+v",
@@ -324,7 +381,14 @@ func (pr *peerReplica) mustDestroy() {
 			err)
 	}
 
-	if pr.ps.isInitialized() {
+	err = pr.store.logStorage.Remove(pr.shardID)
+	if err != nil {
+		logger.Fatalf("shard %d remove raft log failed with %+v",
+			pr.shardID,
+			err)
+	}
+
+	if clearData && pr.ps.isInitialized() {
 		err := pr.store.startClearDataJob(pr.ps.shard)
 		if err != nil {
 			logger.Fatal("shard %d do destroy failed with %+v",
@@ -359,6 +423,7 @@ func (pr *peerReplica) stopEventLoop() {
 }
 
 func (pr *peerReplica) maybeExecRead() {
+	pr.followerReads.doReadLEAppliedIndex(pr.ps.raftApplyState.AppliedIndex, pr)
 	if pr.readyToHandleRead() {
 		pr.pendingReads.doReadLEAppliedIndex(pr.ps.raftApplyState.AppliedIndex, pr)
 	}
@@ -369,28 +434,46 @@ func (pr *peerReplica) doExecReadCmd(c cmd) {
 
 	pr.readCtx.reset()
 	pr.readCtx.batchSize = len(c.req.Requests)
-	for idx, req := range c.req.Requests {
-		if logger.DebugEnabled() {
-			logger.Debugf("%s exec", hex.EncodeToString(req.ID))
-		}
-		pr.readKeys++
-		pr.readCtx.offset = idx
-		if h, ok := pr.store.readHandlers[req.CustemType]; ok {
-			rsp, readBytes := h(pr.ps.shard, req, pr.readCtx)
-			resp.Responses = append(resp.Responses, rsp)
-			pr.readBytes += readBytes
+	sampled := pr.isLeader() && pr.sampleLoad()
+	// the requests in a batch are counted as one query
+	pr.readQuery++
+	pr.readCPUTime += measureCPUTime(func() {
+		for idx, req := range c.req.Requests {
 			if logger.DebugEnabled() {
-				logger.Debugf("%s exec completed", hex.EncodeToString(req.ID))
+				logger.Debugf("%s exec", hex.EncodeToString(req.ID))
+			}
+			pr.readKeys++
+			pr.readCtx.offset = idx
+			if h, ok := pr.store.readHandlers[req.CustemType]; ok {
+				rsp, readBytes := h(pr.ps.shard, req, pr.readCtx)
+				resp.Responses = append(resp.Responses, rsp)
+				pr.readBytes += readBytes
+				pr.store.quotas.takeReadBytes(req, readBytes)
+				if sampled {
+					pr.loadSplitter.add(req.Key, readBytes)
+				}
+				if logger.DebugEnabled() {
+					logger.Debugf("%s exec completed", hex.EncodeToString(req.ID))
+				}
+			} else {
+				logger.Fatalf("%s missing read handle func for type %d, registers %+v",
+					hex.EncodeToString(req.ID),
+					req.CustemType,
+					pr.store.readHandlers)
 			}
-		} else {
-			logger.Fatalf("%s missing read handle func for type %d, registers %+v",
-				hex.EncodeToString(req.ID),
-				req.CustemType,
-				pr.store.readHandlers)
 		}
-	}
+	})
 
 	c.resp(resp)
+	if sampled {
+		pr.maybeSplitByLoad()
+	}
+}
+
+// sampleLoad returns true if the requests need to be sampled for the load split.
+func (pr *peerReplica) sampleLoad() bool {
+	return loadSplitEnabled(pr.store.cfg.Replication.LoadSplit) ||
+		atomic.LoadUint32(&pr.loadSplitRequested) == 1
 }
 
 func (pr *peerReplica) supportSplit() bool {
@@ -502,3 +585,39 @@ func getRaftConfig(id, appliedIndex uint64, ps *peerStorage, cfg *config.Config)
 		PreVote:         cfg.Raft.EnablePreVote,
 	}
 }
+
+// hashCheck is the local hash and the leader hash of the last ComputeHash. The local hash
+// is computed by the consistency check worker, and the leader hash is received by the
+// VerifyHash, they are compared when both are ready.
+type hashCheck struct {
+	sync.Mutex
+	index      uint64
+	hash       []byte
+	leaderHash []byte
+}
+
+// update sets the local or the leader hash at the index, returns both hashes if they are
+// ready at the index. A hash at a newer index resets the check.
+func (c *hashCheck) update(index uint64, hash []byte, leader bool) ([]byte, []byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if index < c.index {
+		return nil, nil, false
+	}
+	if index > c.index {
+		c.index = index
+		c.hash = nil
+		c.leaderHash = nil
+	}
+
+	if leader {
+		c.leaderHash = hash
+	} else {
+		c.hash = hash
+	}
+	if c.hash == nil || c.leaderHash == nil {
+		return nil, nil, false
+	}
+	return c.hash, c.leaderHash, true
+}
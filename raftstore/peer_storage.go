@@ -19,7 +19,6 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"github.com/fagongzi/util/protoc"
 	"github.com/fagongzi/util/task"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
@@ -50,6 +49,8 @@ type peerStorage struct {
 	lastCompactIndex uint64
 	raftLocalState   bhraftpb.RaftLocalState
 	raftApplyState   bhraftpb.RaftApplyState
+	// mergeState is not nil if the shard is in merging
+	mergeState *bhraftpb.MergeState
 
 	genSnapJob       *task.Job
 	applySnapJob     *task.Job
@@ -165,24 +166,16 @@ func (ps *peerStorage) initLastTerm() error {
 		return nil
 	}
 
-	v, err := ps.store.MetadataStorage().Get(getRaftLogKey(ps.shard.ID, lastIndex))
-	if err != nil {
-		return err
-	}
-
-	if nil == v {
+	term, err := ps.store.logStorage.Term(ps.shard.ID, lastIndex)
+	if err == raft.ErrUnavailable {
 		return fmt.Errorf("shard %d entry at index<%d> doesn't exist, may lose data",
 			ps.shard.ID,
 			lastIndex)
-	}
-
-	s := &raftpb.Entry{}
-	err = s.Unmarshal(v)
-	if err != nil {
+	} else if err != nil {
 		return err
 	}
 
-	ps.lastTerm = s.Term
+	ps.lastTerm = term
 	return nil
 }
 
@@ -234,6 +227,31 @@ func (ps *peerStorage) isInitialized() bool {
 	return len(ps.shard.Peers) != 0
 }
 
+// forceSingleMember forces the peer to be the only voter of the shard, it's used by the
+// unsafe recovery when the majority of the replicas are lost. The ConfVer is increased
+// by the number of the removed peers, so the new shard is newer than the shard on any
+// removed replica. The shard local state is persisted, so the raft group starts with
+// the single member conf state after restart.
+func (ps *peerStorage) forceSingleMember(peer metapb.Peer) (bhmetapb.Shard, error) {
+	shard := ps.shard
+	peer.Role = metapb.PeerRole_Voter
+	shard.Epoch.ConfVer += uint64(len(shard.Peers))
+	shard.Peers = []metapb.Peer{peer}
+
+	if err := ps.store.updatePeerState(shard, bhraftpb.PeerState_Normal, nil); err != nil {
+		return shard, err
+	}
+
+	ps.shard = shard
+	return shard, nil
+}
+
+// isWitness returns true if the replica of the shard on the current store is a witness.
+func (ps *peerStorage) isWitness() bool {
+	p := findPeer(&ps.shard, ps.store.Meta().ID)
+	return p != nil && p.Witness
+}
+
 func (ps *peerStorage) isApplyingSnapshot() bool {
 	return ps.applySnapJob != nil && ps.applySnapJob.IsNotComplete()
 }
@@ -264,22 +282,21 @@ func (ps *peerStorage) checkRange(low, high uint64) error {
 }
 
 func (ps *peerStorage) loadLogEntry(index uint64) (raftpb.Entry, error) {
-	key := getRaftLogKey(ps.shard.ID, index)
-	v, err := ps.store.MetadataStorage().Get(key)
-	if err != nil {
+	ents, err := ps.store.logStorage.Entries(ps.shard.ID, index, index+1, 0)
+	if err == raft.ErrUnavailable {
+		logger.Errorf("shard %d entry %d not found",
+			ps.shard.ID,
+			index)
+		return emptyEntry, fmt.Errorf("log entry at %d not found", index)
+	} else if err != nil {
 		logger.Errorf("shard %d load entry failed at %d with %+v",
 			ps.shard.ID,
 			index,
 			err)
 		return emptyEntry, err
-	} else if len(v) == 0 {
-		logger.Errorf("shard %d entry %d not found",
-			ps.shard.ID,
-			index)
-		return emptyEntry, fmt.Errorf("log entry at %d not found", index)
 	}
 
-	return ps.unmarshal(v, index)
+	return ents[0], nil
 }
 
 func (ps *peerStorage) loadShardLocalState(job *task.Job) (*bhraftpb.ShardLocalState, error) {
@@ -304,6 +321,11 @@ func (ps *peerStorage) applySnapshot(job *task.Job) error {
 		Index: ps.raftApplyState.TruncatedState.Index,
 	}
 
+	// the witness stores no data, only the raft state of the snapshot is applied.
+	if ps.isWitness() {
+		return ps.store.snapshotManager.CleanSnap(snap)
+	}
+
 	return ps.store.snapshotManager.Apply(snap)
 }
 
@@ -326,19 +348,6 @@ func (ps *peerStorage) loadRaftApplyState() (*bhraftpb.RaftApplyState, error) {
 	return applyState, err
 }
 
-func (ps *peerStorage) unmarshal(v []byte, expectIndex uint64) (raftpb.Entry, error) {
-	e := raftpb.Entry{}
-	protoc.MustUnmarshal(&e, v)
-	if e.Index != expectIndex {
-		logger.Fatalf("shard %d raft log index not match, logIndex %d expect %d",
-			ps.shard.ID,
-			e.Index,
-			expectIndex)
-	}
-
-	return e, nil
-}
-
 func compactRaftLog(shardID uint64, state *bhraftpb.RaftApplyState, compactIndex, compactTerm uint64) error {
 	logger.Debugf("shard %d compact log entries to index %d",
 		shardID,
@@ -423,64 +432,7 @@ func (ps *peerStorage) Entries(low, high, maxSize uint64) ([]raftpb.Entry, error
 		return ents, nil
 	}
 
-	var totalSize uint64
-	nextIndex := low
-	exceededMaxSize := false
-
-	startKey := getRaftLogKey(ps.shard.ID, low)
-
-	if low+1 == high {
-		// If election happens in inactive shards, they will just try
-		// to fetch one empty log.
-		v, err := ps.store.MetadataStorage().Get(startKey)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(v) == 0 {
-			return nil, raft.ErrUnavailable
-		}
-
-		e, err := ps.unmarshal(v, low)
-		if err != nil {
-			return nil, err
-		}
-
-		ents = append(ents, e)
-		return ents, nil
-	}
-
-	endKey := getRaftLogKey(ps.shard.ID, high)
-	err = ps.store.MetadataStorage().Scan(startKey, endKey, func(key, value []byte) (bool, error) {
-		e := raftpb.Entry{}
-		protoc.MustUnmarshal(&e, value)
-
-		// May meet gap or has been compacted.
-		if e.Index != nextIndex {
-			return false, nil
-		}
-
-		nextIndex++
-		totalSize += uint64(len(value))
-
-		exceededMaxSize = totalSize > maxSize
-		if !exceededMaxSize || len(ents) == 0 {
-			ents = append(ents, e)
-		}
-
-		return !exceededMaxSize, nil
-	}, false)
-
-	if err != nil {
-		return nil, err
-	}
-
-	// If we get the correct number of entries the total size exceeds max_size, returns.
-	if len(ents) == int(high-low) || exceededMaxSize {
-		return ents, nil
-	}
-
-	return nil, raft.ErrUnavailable
+	return ps.store.logStorage.Entries(ps.shard.ID, low, high, maxSize)
 }
 
 func (ps *peerStorage) Term(idx uint64) (uint64, error) {
@@ -502,22 +454,7 @@ func (ps *peerStorage) Term(idx uint64) (uint64, error) {
 		return ps.lastTerm, nil
 	}
 
-	key := getRaftLogKey(ps.shard.ID, idx)
-	v, err := ps.store.MetadataStorage().Get(key)
-	if err != nil {
-		return 0, err
-	}
-
-	if v == nil {
-		return 0, raft.ErrUnavailable
-	}
-
-	e, err := ps.unmarshal(v, idx)
-	if err != nil {
-		return 0, err
-	}
-
-	return e.Term, nil
+	return ps.store.logStorage.Term(ps.shard.ID, idx)
 }
 
 func (ps *peerStorage) LastIndex() (uint64, error) {
@@ -529,6 +466,13 @@ func (ps *peerStorage) FirstIndex() (uint64, error) {
 }
 
 func (ps *peerStorage) Snapshot() (raftpb.Snapshot, error) {
+	// the witness has no data to generate the snapshot
+	if ps.isWitness() {
+		logger.Warningf("shard %d is a witness, refuse to generate snapshot",
+			ps.shard.ID)
+		return raftpb.Snapshot{}, raft.ErrSnapshotTemporarilyUnavailable
+	}
+
 	if ps.isGeneratingSnap() {
 		return raftpb.Snapshot{}, raft.ErrSnapshotTemporarilyUnavailable
 	}
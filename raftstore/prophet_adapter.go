@@ -16,6 +16,7 @@ package raftstore
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/fagongzi/util/protoc"
@@ -238,7 +239,9 @@ func (ca *containerAdapter) Clone() metadata.Container {
 type prophetAdapter struct {
 }
 
-func newProphetAdapter() metadata.Adapter {
+// NewProphetAdapter returns the prophet adapter of the shards and stores, the tools which talk
+// to prophet can use it to decode the metadata.
+func NewProphetAdapter() metadata.Adapter {
 	return &prophetAdapter{}
 }
 
@@ -321,10 +324,15 @@ func (s *store) doStoreHeartbeat(last time.Time) {
 		}
 
 		stats.ResourceCount++
+		if atomic.LoadUint64(&pr.inconsistentIndex) > 0 {
+			stats.InconsistentResources = append(stats.InconsistentResources, pr.shardID)
+		}
 		return true
 	})
 	stats.ReceivingSnapCount = s.snapshotManager.ReceiveSnapCount()
 	stats.SendingSnapCount = s.trans.SendingSnapshotCount()
+	stats.SentSnapBytes = atomic.SwapUint64(&s.snapStats.sentBytes, 0)
+	stats.ReceivedSnapBytes = atomic.SwapUint64(&s.snapStats.receivedBytes, 0)
 	stats.StartTime = uint64(s.Meta().StartTime)
 
 	s.cfg.Storage.ForeachDataStorageFunc(func(db storage.DataStorage) {
@@ -335,6 +343,8 @@ func (s *store) doStoreHeartbeat(last time.Time) {
 		stats.ReadBytes += st.ReadBytes
 	})
 
+	stats.SlowScore = s.slowScore.tick()
+
 	// TODO: is busy
 	stats.IsBusy = false
 	stats.Interval = &metapb.TimeInterval{
@@ -349,9 +359,12 @@ func (s *store) doStoreHeartbeat(last time.Time) {
 
 	rsp, err := s.pd.GetClient().ContainerHeartbeat(rpcpb.ContainerHeartbeatReq{Stats: stats, Data: data})
 	if err != nil {
+		atomic.StoreUint32(&s.prophetConnected, 0)
 		logger.Errorf("send store heartbeat failed with %+v", err)
 		return
 	}
+	atomic.StoreUint32(&s.prophetConnected, 1)
+	s.prophetJobs.Store(rsp.Jobs)
 	if s.cfg.Customize.CustomStoreHeartbeatDataProcessor != nil {
 		err := s.cfg.Customize.CustomStoreHeartbeatDataProcessor.HandleHeartbeatRsp(rsp.Data)
 		if err != nil {
@@ -360,6 +373,18 @@ func (s *store) doStoreHeartbeat(last time.Time) {
 	}
 }
 
+// hasProphetJob returns true if the job is created in the prophet
+func (s *store) hasProphetJob(jobType metapb.JobType) bool {
+	if v := s.prophetJobs.Load(); v != nil {
+		for _, t := range v.([]metapb.JobType) {
+			if t == jobType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (s *store) startHandleResourceHeartbeat() {
 	c, err := s.pd.GetClient().GetResourceHeartbeatRspNotifier()
 	if err != nil {
@@ -403,7 +428,7 @@ func (s *store) doResourceHeartbeatRsp(rsp rpcpb.ResourceHeartbeatRsp) {
 	} else if rsp.TransferLeader != nil {
 		pr.onAdmin(newTransferLeaderAdminReq(rsp))
 	} else if rsp.SplitResource != nil {
-		// currently, pd only support use keys to splits
+		// pd splits the shard by the given keys, or by the load of the shard
 		switch rsp.SplitResource.Policy {
 		case metapb.CheckPolicy_USEKEY:
 			splitIDs, err := pr.store.pd.GetClient().AskBatchSplit(NewResourceAdapterWithShard(pr.ps.shard),
@@ -420,7 +445,24 @@ func (s *store) doResourceHeartbeatRsp(rsp rpcpb.ResourceHeartbeatRsp) {
 				splitKeys:  rsp.SplitResource.Keys,
 				splitIDs:   splitIDs,
 			})
+		case metapb.CheckPolicy_LOAD:
+			// the hot shard is split by the sampled request keys
+			pr.addAction(action{
+				epoch:      rsp.ResourceEpoch,
+				actionType: checkLoadSplitAction,
+			})
 		}
+	} else if rsp.Merge != nil {
+		target := bhmetapb.Shard{}
+		protoc.MustUnmarshal(&target, rsp.Merge.Target)
+		logger.Infof("shard-%d merge to shard %d",
+			rsp.ResourceID,
+			target.ID)
+		pr.addAction(action{
+			epoch:       rsp.ResourceEpoch,
+			actionType:  prepareMergeAction,
+			mergeTarget: target,
+		})
 	}
 }
 
@@ -43,6 +43,9 @@ type Router interface {
 	LeaderPeerStore(shardID uint64) bhmetapb.Store
 	// RandomPeerStore return random peer store
 	RandomPeerStore(shardID uint64) bhmetapb.Store
+	// NearestPeerStore returns the peer store nearest to the given store. The given store
+	// itself is preferred, then the stores with the most same labels.
+	NearestPeerStore(shardID uint64, store bhmetapb.Store) bhmetapb.Store
 
 	// GetShardStats returns the runtime stats info of the shard
 	GetShardStats(id uint64) *metapb.ResourceStats
@@ -161,6 +164,41 @@ func (r *defaultRouter) RandomPeerStore(shardID uint64) bhmetapb.Store {
 	return bhmetapb.Store{}
 }
 
+func (r *defaultRouter) NearestPeerStore(shardID uint64, store bhmetapb.Store) bhmetapb.Store {
+	value, ok := r.shards.Load(shardID)
+	if !ok {
+		return bhmetapb.Store{}
+	}
+
+	shard := value.(bhmetapb.Shard)
+	max := -1
+	var nearest []uint64
+	for _, p := range shard.Peers {
+		// the witness has no data to serve the reads
+		if p.Witness {
+			continue
+		}
+
+		if p.ContainerID == store.ID {
+			return r.mustGetStore(p.ContainerID)
+		}
+
+		n := sameLabels(store.Labels, r.mustGetStore(p.ContainerID).Labels)
+		if n > max {
+			max = n
+			nearest = nearest[:0]
+		}
+		if n == max {
+			nearest = append(nearest, p.ContainerID)
+		}
+	}
+
+	if len(nearest) == 0 {
+		return bhmetapb.Store{}
+	}
+	return r.mustGetStore(nearest[int(r.getOp(shardID).next())%len(nearest)])
+}
+
 func (r *defaultRouter) GetShardStats(id uint64) *metapb.ResourceStats {
 	if v, ok := r.shardStats.Load(id); ok {
 		return v.(*metapb.ResourceStats)
@@ -177,19 +215,43 @@ func (r *defaultRouter) GetStoreStats(id uint64) *metapb.ContainerStats {
 	return nil
 }
 
+// selectStore selects the store of a replica of the shard by round robin, the witness
+// is skipped since it has no data to serve the reads.
 func (r *defaultRouter) selectStore(shard *bhmetapb.Shard) uint64 {
-	var ops *op
-	if v, ok := r.opts.Load(shard.ID); ok {
-		ops = v.(*op)
-	} else {
-		ops = &op{}
-		v, exists := r.opts.LoadOrStore(shard.ID, ops)
-		if exists {
-			ops = v.(*op)
+	peers := make([]metapb.Peer, 0, len(shard.Peers))
+	for _, p := range shard.Peers {
+		if !p.Witness {
+			peers = append(peers, p)
 		}
 	}
+	if len(peers) == 0 {
+		peers = shard.Peers
+	}
 
-	return shard.Peers[int(ops.next())%len(shard.Peers)].ContainerID
+	return peers[int(r.getOp(shard.ID).next())%len(peers)].ContainerID
+}
+
+func (r *defaultRouter) getOp(shardID uint64) *op {
+	if v, ok := r.opts.Load(shardID); ok {
+		return v.(*op)
+	}
+
+	v, _ := r.opts.LoadOrStore(shardID, &op{})
+	return v.(*op)
+}
+
+// sameLabels returns the number of the labels with the same key and value
+func sameLabels(labels, others []metapb.Pair) int {
+	n := 0
+	for _, label := range labels {
+		for _, other := range others {
+			if label.Key == other.Key && label.Value == other.Value {
+				n++
+				break
+			}
+		}
+	}
+	return n
 }
 
 func (r *defaultRouter) searchShard(group uint64, key []byte) bhmetapb.Shard {
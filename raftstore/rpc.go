@@ -33,7 +33,11 @@ func newRPC(store *store) *defaultRPC {
 	}
 
 	encoder, decoder := length.NewWithSize(rc, rc, 0, 0, 0, int(store.cfg.Raft.MaxEntryBytes)*2)
-	app, err := goetty.NewTCPApplication(store.cfg.ClientAddr, rpc.onMessage,
+	listener, err := store.tls.Listen(store.cfg.ClientAddr)
+	if err != nil {
+		logger.Fatalf("create rpc failed with %+v", err)
+	}
+	app, err := goetty.NewApplication(listener, rpc.onMessage,
 		goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder),
 			goetty.WithEnableAsyncWrite(16),
 			goetty.WithLogger(logger),
@@ -76,7 +80,8 @@ func (rpc *defaultRPC) onMessage(rs goetty.IOSession, value interface{}, seq uin
 func (rpc *defaultRPC) onResp(header *raftcmdpb.RaftResponseHeader, rsp *raftcmdpb.Response) {
 	if rs, _ := rpc.app.GetSession(uint64(rsp.PID)); rs != nil {
 		if header != nil {
-			if header.Error.RaftEntryTooLarge == nil {
+			if header.Error.RaftEntryTooLarge == nil &&
+				header.Error.QuotaExceeded == nil {
 				rsp.Type = raftcmdpb.CMDType_RaftError
 			} else {
 				rsp.Type = raftcmdpb.CMDType_Invalid
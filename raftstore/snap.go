@@ -17,9 +17,9 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
 	"path"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fagongzi/goetty"
@@ -116,6 +116,36 @@ func newDefaultSnapshotManager(s *store) snapshot.SnapshotManager {
 	return m
 }
 
+// snapshotStats the snapshot bytes sent and received since the last store heartbeat
+type snapshotStats struct {
+	sentBytes     uint64
+	receivedBytes uint64
+}
+
+// statsSnapshotManager counts the snapshot bytes sent and received by the snapshot
+// manager, the prophet estimates the snapshot throughput of the store by them.
+type statsSnapshotManager struct {
+	snapshot.SnapshotManager
+
+	stats *snapshotStats
+}
+
+func (m *statsSnapshotManager) WriteTo(msg *bhraftpb.SnapshotMessage, conn goetty.IOSession) (uint64, error) {
+	written, err := m.SnapshotManager.WriteTo(msg, conn)
+	if err == nil {
+		atomic.AddUint64(&m.stats.sentBytes, written)
+	}
+	return written, err
+}
+
+func (m *statsSnapshotManager) ReceiveSnapData(msg *bhraftpb.SnapshotMessage) error {
+	err := m.SnapshotManager.ReceiveSnapData(msg)
+	if err == nil {
+		atomic.AddUint64(&m.stats.receivedBytes, uint64(len(msg.Data)))
+	}
+	return err
+}
+
 func formatKey(msg *bhraftpb.SnapshotMessage) string {
 	return fmt.Sprintf("%d_%d_%d", msg.Header.Shard.ID, msg.Header.Term, msg.Header.Index)
 }
@@ -133,11 +163,13 @@ func (m *defaultSnapshotManager) getPathOfSnapKey(msg *bhraftpb.SnapshotMessage)
 	return fmt.Sprintf("%s/%s", m.dir, formatKey(msg))
 }
 
-func (m *defaultSnapshotManager) getPathOfSnapKeyGZ(msg *bhraftpb.SnapshotMessage) string {
-	return fmt.Sprintf("%s.gz", m.getPathOfSnapKey(msg))
+// getPathOfSnapKeyTar returns the path of the snapshot archive. The snapshot files are
+// archived without compression, and sent to the other stores in chunks.
+func (m *defaultSnapshotManager) getPathOfSnapKeyTar(msg *bhraftpb.SnapshotMessage) string {
+	return fmt.Sprintf("%s.tar", m.getPathOfSnapKey(msg))
 }
 
-func (m *defaultSnapshotManager) getTmpPathOfSnapKeyGZ(msg *bhraftpb.SnapshotMessage) string {
+func (m *defaultSnapshotManager) getTmpPathOfSnapKeyTar(msg *bhraftpb.SnapshotMessage) string {
 	return fmt.Sprintf("%s.tmp", m.getPathOfSnapKey(msg))
 }
 
@@ -165,13 +197,13 @@ func (m *defaultSnapshotManager) Deregister(msg *bhraftpb.SnapshotMessage, step
 
 func (m *defaultSnapshotManager) Create(msg *bhraftpb.SnapshotMessage) error {
 	path := m.getPathOfSnapKey(msg)
-	gzPath := m.getPathOfSnapKeyGZ(msg)
+	tarPath := m.getPathOfSnapKeyTar(msg)
 	start := encStartKey(&msg.Header.Shard)
 	end := encEndKey(&msg.Header.Shard)
 	db := m.s.DataStorageByGroup(msg.Header.Shard.Group, msg.Header.Shard.ID)
 	fs := m.s.cfg.FS
 
-	if !exist(fs, gzPath) {
+	if !exist(fs, tarPath) {
 		if !exist(fs, path) {
 			err := db.CreateSnapshot(path, start, end)
 			if err != nil {
@@ -187,13 +219,13 @@ func (m *defaultSnapshotManager) Create(msg *bhraftpb.SnapshotMessage) error {
 				}
 			}
 		}
-		err := util.GZIP(fs, path)
+		err := util.Tar(fs, path)
 		if err != nil {
 			return err
 		}
 	}
 
-	info, err := fs.Stat(fmt.Sprintf("%s.gz", path))
+	info, err := fs.Stat(tarPath)
 	if err != nil {
 		return err
 	}
@@ -203,13 +235,13 @@ func (m *defaultSnapshotManager) Create(msg *bhraftpb.SnapshotMessage) error {
 }
 
 func (m *defaultSnapshotManager) Exists(msg *bhraftpb.SnapshotMessage) bool {
-	file := m.getPathOfSnapKeyGZ(msg)
+	file := m.getPathOfSnapKeyTar(msg)
 	fs := m.s.cfg.FS
 	return exist(fs, file)
 }
 
 func (m *defaultSnapshotManager) WriteTo(msg *bhraftpb.SnapshotMessage, conn goetty.IOSession) (uint64, error) {
-	file := m.getPathOfSnapKeyGZ(msg)
+	file := m.getPathOfSnapKeyTar(msg)
 
 	if !m.Exists(msg) {
 		return 0, fmt.Errorf("missing snapshot file: %s", file)
@@ -275,7 +307,7 @@ func (m *defaultSnapshotManager) CleanSnap(msg *bhraftpb.SnapshotMessage) error
 	var err error
 
 	fs := m.s.cfg.FS
-	tmpFile := m.getTmpPathOfSnapKeyGZ(msg)
+	tmpFile := m.getTmpPathOfSnapKeyTar(msg)
 	if exist(fs, tmpFile) {
 		logger.Infof("shard %d delete exists snap tmp file %s, header is %s",
 			msg.Header.Shard.ID,
@@ -288,9 +320,9 @@ func (m *defaultSnapshotManager) CleanSnap(msg *bhraftpb.SnapshotMessage) error
 		return err
 	}
 
-	file := m.getPathOfSnapKeyGZ(msg)
+	file := m.getPathOfSnapKeyTar(msg)
 	if exist(fs, file) {
-		logger.Infof("shard %d delete exists snap gz file %s, header is %s",
+		logger.Infof("shard %d delete exists snap tar file %s, header is %s",
 			msg.Header.Shard.ID,
 			file,
 			msg.Header.String())
@@ -329,7 +361,7 @@ func (m *defaultSnapshotManager) ReceiveSnapData(msg *bhraftpb.SnapshotMessage)
 	}
 
 	fs := m.s.cfg.FS
-	file := m.getTmpPathOfSnapKeyGZ(msg)
+	file := m.getTmpPathOfSnapKeyTar(msg)
 	if exist(fs, file) {
 		f, err = fs.OpenForAppend(file)
 		if err != nil {
@@ -372,19 +404,21 @@ func (m *defaultSnapshotManager) ReceiveSnapData(msg *bhraftpb.SnapshotMessage)
 }
 
 func (m *defaultSnapshotManager) Apply(msg *bhraftpb.SnapshotMessage) error {
-	file := m.getPathOfSnapKeyGZ(msg)
+	file := m.getPathOfSnapKeyTar(msg)
 	if !m.Exists(msg) {
 		return fmt.Errorf("missing snapshot file, path=%s", file)
 	}
 
 	defer m.CleanSnap(msg)
 
-	err := util.UnGZIP(m.s.cfg.FS, file, m.dir)
+	// the snapshots received from the previous versions are compressed by gzip,
+	// UnTar supports both of them.
+	err := util.UnTar(m.s.cfg.FS, file, m.dir)
 	if err != nil {
 		return err
 	}
 	dir := m.getPathOfSnapKey(msg)
-	defer os.RemoveAll(dir)
+	defer m.s.cfg.FS.RemoveAll(dir)
 
 	// apply snapshot of data
 	err = m.s.DataStorageByGroup(msg.Header.Shard.Group, msg.Header.Shard.ID).ApplySnapshot(dir)
@@ -412,7 +446,7 @@ func (m *defaultSnapshotManager) ReceiveSnapCount() uint64 {
 
 func (m *defaultSnapshotManager) cleanTmp(msg *bhraftpb.SnapshotMessage) error {
 	var err error
-	tmpFile := m.getTmpPathOfSnapKeyGZ(msg)
+	tmpFile := m.getTmpPathOfSnapKeyTar(msg)
 	fs := m.s.cfg.FS
 	if exist(fs, tmpFile) {
 		logger.Infof("shard %d delete exists snap tmp file, file=<%s>, header=<%s>",
@@ -430,7 +464,7 @@ func (m *defaultSnapshotManager) cleanTmp(msg *bhraftpb.SnapshotMessage) error {
 }
 
 func (m *defaultSnapshotManager) check(msg *bhraftpb.SnapshotMessage) error {
-	file := m.getTmpPathOfSnapKeyGZ(msg)
+	file := m.getTmpPathOfSnapKeyTar(msg)
 	fs := m.s.cfg.FS
 	if exist(fs, file) {
 		info, err := fs.Stat(file)
@@ -445,7 +479,7 @@ func (m *defaultSnapshotManager) check(msg *bhraftpb.SnapshotMessage) error {
 				file)
 		}
 
-		return fs.Rename(file, m.getPathOfSnapKeyGZ(msg))
+		return fs.Rename(file, m.getPathOfSnapKeyTar(msg))
 	}
 
 	return fmt.Errorf("missing snapshot file, path=%s", file)
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"net/http"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/transport"
 	"github.com/matrixorigin/matrixcube/util"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"go.etcd.io/etcd/raft/raftpb"
 )
 
@@ -83,17 +85,35 @@ type Store interface {
 	Prophet() prophet.Prophet
 	// CreateRPCCliendSideCodec returns the rpc codec at client side
 	CreateRPCCliendSideCodec() (codec.Encoder, codec.Decoder)
+	// TLS returns the tls loader to connect to the other stores, returns nil if the tls
+	// is not enabled
+	TLS() *tlsutil.Loader
 
 	// CreateResourcePool create resource pools, the resource pool will create shards,
 	// and try to maintain the number of shards in the pool not less than the `capacity`
 	// parameter. This is an idempotent operation.
 	CreateResourcePool(...metapb.ResourcePool) (ShardsPool, error)
+	// Backup starts to back up all the shards of the cluster to the path. The backup is
+	// coordinated by the backup job on the prophet leader, returns ErrBackupRunning if the
+	// previous backup is not finished.
+	Backup(path string) error
+	// BackupProgress returns the progress of the latest backup
+	BackupProgress() (bhmetapb.BackupProgress, error)
+	// SubscribeChanges subscribes the change events of the keys in [start, end) of the group
+	// on the local store. The subscription is resumed from the committed checkpoint of the
+	// subscription with the same name. The group must be in `CDCConfig.Groups`.
+	SubscribeChanges(name string, group uint64, start, end []byte) (ChangeSubscription, error)
+	// CheckRequestQuota takes a token from the QPS quotas of the tenant and the shard group
+	// of the request, returns the exceeded quota if the request should be rejected.
+	CheckRequestQuota(*raftcmdpb.Request) *errorpb.QuotaExceeded
 }
 
 const (
 	applyWorkerName      = "apply-%d-%d"
 	snapshotWorkerName   = "snapshot-%d"
 	splitCheckWorkerName = "split"
+	// consistencyCheckWorkerName computes the hashes of the shards out of the apply path
+	consistencyCheckWorkerName = "consistency-check"
 )
 
 type store struct {
@@ -105,6 +125,8 @@ type store struct {
 	pdStartedC chan struct{}
 
 	runner          *task.Runner
+	logStorage      storage.LogStorage
+	tls             *tlsutil.Loader
 	trans           transport.Transport
 	snapshotManager snapshot.SnapshotManager
 	rpc             *defaultRPC
@@ -115,6 +137,11 @@ type store struct {
 	replicas        sync.Map // shard id -> *peerReplica
 	delegates       sync.Map // shard id -> *applyDelegate
 	droppedVoteMsgs sync.Map // shard id -> raftpb.Message
+	backups         sync.Map // shard id -> struct{}, the shards are backing up
+	// prophetJobs the types of the created jobs in the last store heartbeat response
+	prophetJobs atomic.Value
+	// shard id -> struct{}, the shards are reporting the state or recovering by the unsafe recovery
+	unsafeRecoveries sync.Map
 
 	readHandlers  map[uint64]command.ReadCommandFunc
 	writeHandlers map[uint64]command.WriteCommandFunc
@@ -123,6 +150,15 @@ type store struct {
 	stopWG   sync.WaitGroup
 	state    uint32
 	stopOnce sync.Once
+	// timerTasks the id of the timer based tasks, timerTasksWG is done when the tasks stopped
+	timerTasks   uint64
+	timerTasksWG sync.WaitGroup
+
+	// the ready state of the store, served by the http listener
+	prophetConnected uint32
+	shardsStarted    uint32
+	transportStarted uint32
+	httpServer       *http.Server
 
 	localCB func(*raftcmdpb.RaftResponseHeader, *raftcmdpb.Response)
 	rpcCB   func(*raftcmdpb.RaftResponseHeader, *raftcmdpb.Response)
@@ -136,6 +172,20 @@ type store struct {
 
 	// shard pool processor
 	shardPool *dynamicShardsPool
+	// backup job processor
+	backupJob *backupJob
+	// unsafe recovery job processor
+	unsafeRecoveryJob *unsafeRecoveryJob
+	// request quota job processor
+	requestQuotaJob *requestQuotaJob
+	// request quotas of the tenants and the shard groups
+	quotas *quotaLimiter
+	// slow score of the disk reported by the store heartbeat
+	slowScore *slowScore
+	// snapshot bytes sent and received reported by the store heartbeat
+	snapStats snapshotStats
+	// change data capture
+	changes *changeFeed
 }
 
 // NewStore returns a raft store
@@ -150,6 +200,23 @@ func NewStore(cfg *config.Config) Store {
 		runner:        task.NewRunner(),
 		workReady:     newWorkReady(cfg.ShardGroups, cfg.Worker.RaftEventWorkers),
 		shardPool:     newDynamicShardsPool(&cfg.Prophet),
+		backupJob:     newBackupJob(cfg),
+		quotas:        newQuotaLimiter(),
+		slowScore:     newSlowScore(cfg.Replication.SlowStoreLatencyThreshold.Duration),
+	}
+	s.unsafeRecoveryJob = newUnsafeRecoveryJob(cfg)
+	s.requestQuotaJob = newRequestQuotaJob(cfg)
+
+	tls, err := tlsutil.NewLoader(cfg.TLS)
+	if err != nil {
+		logger.Fatalf("load tls certificates failed with %+v", err)
+	}
+	s.tls = tls
+
+	if s.cfg.Storage.LogStorage != nil {
+		s.logStorage = s.cfg.Storage.LogStorage
+	} else {
+		s.logStorage = newKVLogStorage(s.cfg.Storage.MetaStorage)
 	}
 
 	if s.cfg.Customize.CustomShardStateAwareFactory != nil {
@@ -161,7 +228,9 @@ func NewStore(cfg *config.Config) Store {
 	} else {
 		s.snapshotManager = newDefaultSnapshotManager(s)
 	}
+	s.snapshotManager = &statsSnapshotManager{SnapshotManager: s.snapshotManager, stats: &s.snapStats}
 
+	s.changes = newChangeFeed(s)
 	s.rpc = newRPC(s)
 	s.initWorkers()
 	return s
@@ -170,6 +239,9 @@ func NewStore(cfg *config.Config) Store {
 func (s *store) Start() {
 	logger.Infof("begin start raftstore")
 
+	// the health and the ready state can be checked while the store is starting
+	s.startHTTP()
+
 	s.startProphet()
 	logger.Infof("prophet started")
 
@@ -191,6 +263,10 @@ func (s *store) Start() {
 	s.startRouter()
 	logger.Infof("router started")
 
+	// the store is ready after the client rpc and the router are started
+	atomic.StoreUint32(&s.transportStarted, 1)
+	atomic.StoreUint32(&s.shardsStarted, 1)
+
 	s.doStoreHeartbeat(time.Now())
 }
 
@@ -206,10 +282,24 @@ func (s *store) Stop() {
 		})
 		s.stopWG.Wait()
 
+		// the timer based tasks call the runner, the runner waits for them until timeout if
+		// they are blocked by the stopping runner.
+		s.runner.StopCancelableTask(s.timerTasks)
+		s.timerTasksWG.Wait()
+
 		s.snapshotManager.Close()
 		s.runner.Stop()
 		s.trans.Stop()
 		s.rpc.Stop()
+		if err := s.logStorage.Close(); err != nil {
+			logger.Errorf("close log storage failed with %+v", err)
+		}
+
+		// the timer tasks are stopped, no heartbeat sets the flags again
+		atomic.StoreUint32(&s.prophetConnected, 0)
+		atomic.StoreUint32(&s.transportStarted, 0)
+		atomic.StoreUint32(&s.shardsStarted, 0)
+		s.stopHTTP()
 	})
 }
 
@@ -305,6 +395,10 @@ func (s *store) DataStorageByGroup(group, shardID uint64) storage.DataStorage {
 	return s.cfg.Storage.DataStorageFactory(group, shardID)
 }
 
+func (s *store) SubscribeChanges(name string, group uint64, start, end []byte) (ChangeSubscription, error) {
+	return s.changes.subscribe(name, group, start, end)
+}
+
 func (s *store) MaybeLeader(shard uint64) bool {
 	return nil != s.getPR(shard, true)
 }
@@ -339,6 +433,10 @@ func (s *store) CreateRPCCliendSideCodec() (codec.Encoder, codec.Decoder) {
 	return length.NewWithSize(v, v, 0, 0, 0, int(s.cfg.Raft.MaxEntryBytes)*2)
 }
 
+func (s *store) TLS() *tlsutil.Loader {
+	return s.tls
+}
+
 func (s *store) initWorkers() {
 	for g := uint64(0); g < s.cfg.ShardGroups; g++ {
 		s.applyWorkers = append(s.applyWorkers, make(map[string]int))
@@ -356,12 +454,13 @@ func (s *store) initWorkers() {
 	}
 
 	s.runner.AddNamedWorker(splitCheckWorkerName)
+	s.runner.AddNamedWorker(consistencyCheckWorkerName)
 }
 
 func (s *store) startProphet() {
 	logger.Infof("begin to start prophet")
 
-	s.cfg.Prophet.Adapter = newProphetAdapter()
+	s.cfg.Prophet.Adapter = NewProphetAdapter()
 	s.cfg.Prophet.Handler = s
 	s.cfg.Prophet.Adjust(nil, false)
 
@@ -370,31 +469,37 @@ func (s *store) startProphet() {
 	s.pd.Start()
 	<-s.pdStartedC
 	s.shardPool.setProphetClient(s.pd.GetClient())
+	s.backupJob.setProphetClient(s.pd.GetClient())
 }
 
 func (s *store) startTransport() {
 	if s.cfg.Customize.CustomTransportFactory != nil {
 		s.trans = s.cfg.Customize.CustomTransportFactory()
 	} else {
-		s.trans = transport.NewDefaultTransport(s.Meta().ID,
-			s.cfg.RaftAddr,
-			s.snapshotManager,
-			s.handle,
-			s.pd.GetStorage().GetContainer,
-			transport.WithMaxBodyBytes(int(s.cfg.Raft.MaxEntryBytes)*2),
-			transport.WithTimeout(3*time.Duration(s.cfg.Raft.HeartbeatTicks)*s.cfg.Raft.TickInterval.Duration, time.Minute),
-			transport.WithSendBatch(int64(s.cfg.Raft.SendRaftBatchSize)),
-			transport.WithWorkerCount(s.cfg.Worker.SendRaftMsgWorkerCount, s.cfg.Snapshot.MaxConcurrencySnapChunks),
-			transport.WithErrorHandler(func(msg *bhraftpb.RaftMessage, err error) {
-				if pr := s.getPR(msg.ShardID, true); pr != nil {
-					pr.addReport(msg.Message)
-				}
-			}))
+		s.trans = s.newDefaultTransport()
 	}
 
 	s.trans.Start()
 }
 
+func (s *store) newDefaultTransport() transport.Transport {
+	return transport.NewDefaultTransport(s.Meta().ID,
+		s.cfg.RaftAddr,
+		s.snapshotManager,
+		s.handle,
+		s.pd.GetStorage().GetContainer,
+		transport.WithMaxBodyBytes(int(s.cfg.Raft.MaxEntryBytes)*2),
+		transport.WithTimeout(3*time.Duration(s.cfg.Raft.HeartbeatTicks)*s.cfg.Raft.TickInterval.Duration, time.Minute),
+		transport.WithSendBatch(int64(s.cfg.Raft.SendRaftBatchSize)),
+		transport.WithWorkerCount(s.cfg.Worker.SendRaftMsgWorkerCount, s.cfg.Snapshot.MaxConcurrencySnapChunks),
+		transport.WithTLS(s.tls),
+		transport.WithErrorHandler(func(msg *bhraftpb.RaftMessage, err error) {
+			if pr := s.getPR(msg.ShardID, true); pr != nil {
+				pr.addReport(msg.Message)
+			}
+		}))
+}
+
 func (s *store) startRaftWorkers() {
 	for i := uint64(0); i < s.cfg.ShardGroups; i++ {
 		s.eventWorkers = append(s.eventWorkers, make(map[uint64]int))
@@ -453,7 +558,11 @@ func (s *store) startShards() {
 	totalCount := 0
 	tomebstoneCount := 0
 	applyingCount := 0
-	var tomebstoneShards []bhmetapb.Shard
+	var tomebstoneShards []bhraftpb.ShardLocalState
+
+	if err := s.migrateRaftLogs(); err != nil {
+		logger.Fatalf("migrate raft logs failed with %+v", err)
+	}
 
 	wb := util.NewWriteBatch()
 	err := s.MetadataStorage().Scan(metaMinKey, metaMaxKey, func(key, value []byte) (bool, error) {
@@ -476,7 +585,7 @@ func (s *store) startShards() {
 		}
 
 		if localState.State == bhraftpb.PeerState_Tombstone {
-			tomebstoneShards = append(tomebstoneShards, localState.Shard)
+			tomebstoneShards = append(tomebstoneShards, *localState)
 			tomebstoneCount++
 			logger.Infof("shard %d is tombstone in store",
 				shardID)
@@ -492,6 +601,9 @@ func (s *store) startShards() {
 			applyingCount++
 			logger.Infof("shard %d is applying in store", shardID)
 			pr.startApplyingSnapJob()
+		} else if localState.State == bhraftpb.PeerState_Merging {
+			logger.Infof("shard %d is merging in store", shardID)
+			pr.ps.mergeState = localState.Merge
 		}
 
 		pr.startRegistrationJob("startShards")
@@ -520,7 +632,9 @@ func (s *store) startShards() {
 }
 
 func (s *store) startTimerTasks() {
-	s.runner.RunCancelableTask(func(ctx context.Context) {
+	s.timerTasksWG.Add(1)
+	id, err := s.runner.RunCancelableTask(func(ctx context.Context) {
+		defer s.timerTasksWG.Done()
 		last := time.Now()
 
 		compactTicker := time.NewTicker(s.cfg.Raft.RaftLog.CompactDuration.Duration)
@@ -532,12 +646,27 @@ func (s *store) startTimerTasks() {
 		stateCheckTicker := time.NewTicker(s.cfg.Replication.ShardStateCheckDuration.Duration)
 		defer stateCheckTicker.Stop()
 
+		consistencyCheckTicker := time.NewTicker(s.cfg.Replication.ConsistencyCheckDuration.Duration)
+		defer consistencyCheckTicker.Stop()
+
 		shardLeaderheartbeatTicker := time.NewTicker(s.cfg.Replication.ShardHeartbeatDuration.Duration)
 		defer shardLeaderheartbeatTicker.Stop()
 
 		storeheartbeatTicker := time.NewTicker(s.cfg.Replication.StoreHeartbeatDuration.Duration)
 		defer storeheartbeatTicker.Stop()
 
+		changeLogGCTicker := time.NewTicker(s.cfg.CDC.GCDuration.Duration)
+		defer changeLogGCTicker.Stop()
+
+		backupTicker := time.NewTicker(s.cfg.Backup.CheckDuration.Duration)
+		defer backupTicker.Stop()
+
+		unsafeRecoveryTicker := time.NewTicker(s.cfg.Replication.UnsafeRecoveryCheckDuration.Duration)
+		defer unsafeRecoveryTicker.Stop()
+
+		quotaTicker := time.NewTicker(s.cfg.Quota.CheckDuration.Duration)
+		defer quotaTicker.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -551,14 +680,30 @@ func (s *store) startTimerTasks() {
 				}
 			case <-stateCheckTicker.C:
 				s.handleShardStateCheck()
+			case <-consistencyCheckTicker.C:
+				if !s.cfg.Replication.DisableConsistencyCheck {
+					s.handleConsistencyCheck()
+				}
 			case <-shardLeaderheartbeatTicker.C:
 				s.doShardHeartbeat()
 			case <-storeheartbeatTicker.C:
 				s.doStoreHeartbeat(last)
 				last = time.Now()
+			case <-changeLogGCTicker.C:
+				s.changes.gc()
+			case <-backupTicker.C:
+				s.handleBackup()
+			case <-unsafeRecoveryTicker.C:
+				s.handleUnsafeRecovery()
+			case <-quotaTicker.C:
+				s.handleRequestQuota()
 			}
 		}
 	})
+	if err != nil {
+		logger.Fatalf("start timer based tasks failed with %+v", err)
+	}
+	s.timerTasks = id
 }
 
 func (s *store) doCreate(shard bhmetapb.Shard) {
@@ -674,14 +819,39 @@ func (s *store) clearMeta(id uint64, wb *util.WriteBatch) error {
 	return nil
 }
 
-func (s *store) cleanup(shards []bhmetapb.Shard) {
-	for _, shard := range shards {
+func (s *store) cleanup(states []bhraftpb.ShardLocalState) {
+	for _, state := range states {
+		shard := state.Shard
+		// The raft logs may not be removed if the store crashed after the tombstone state saved
+		if err := s.logStorage.Remove(shard.ID); err != nil {
+			logger.Errorf("shard %d remove raft log failed with %+v",
+				shard.ID,
+				err)
+		}
+
+		// The shard was merged into other shard, the data is owned by the target shard now.
+		if state.Merge != nil && s.ownedByMergeTarget(state.Merge.Target) {
+			logger.Infof("shard %d data is owned by shard %d, only remove state",
+				shard.ID,
+				state.Merge.Target.ID)
+			s.removePeerState(shard)
+			continue
+		}
+
 		s.doClearData(shard)
 	}
 
 	logger.Infof("cleanup possible garbage data complete")
 }
 
+// ownedByMergeTarget returns true if the replica of the merge target shard is still on the
+// store, and it's the merged one or newer. Otherwise the target replica is removed or not
+// merged yet, and the data of the source shard is garbage.
+func (s *store) ownedByMergeTarget(target bhmetapb.Shard) bool {
+	pr := s.getPR(target.ID, false)
+	return pr != nil && pr.ps.shard.Epoch.Version >= target.Epoch.Version
+}
+
 func (s *store) addSnapJob(g uint64, task func() error, cb func(*task.Job)) error {
 	return s.addNamedJobWithCB("", fmt.Sprintf(snapshotWorkerName, g), task, cb)
 }
@@ -694,6 +864,10 @@ func (s *store) addSplitJob(task func() error) error {
 	return s.addNamedJob("", splitCheckWorkerName, task)
 }
 
+func (s *store) addConsistencyCheckJob(task func() error) error {
+	return s.addNamedJob("", consistencyCheckWorkerName, task)
+}
+
 func (s *store) addNamedJob(desc, worker string, task func() error) error {
 	return s.runner.RunJobWithNamedWorker(desc, worker, task)
 }
@@ -878,6 +1052,12 @@ func checkEpoch(shard bhmetapb.Shard, req *raftcmdpb.RaftCMDRequest) bool {
 		case raftcmdpb.AdminCmdType_TransferLeader:
 			checkVer = true
 			checkConfVer = true
+		case raftcmdpb.AdminCmdType_PrepareMerge:
+			checkVer = true
+			checkConfVer = true
+		case raftcmdpb.AdminCmdType_RollbackMerge:
+			checkVer = true
+			checkConfVer = true
 		}
 	} else {
 		// for redis command, we don't care conf version.
@@ -926,6 +1106,14 @@ func newAdminRaftCMDResponse(adminType raftcmdpb.AdminCmdType, rsp protoc.PB) *r
 		adminResp.CompactLog = rsp.(*raftcmdpb.CompactLogResponse)
 	case raftcmdpb.AdminCmdType_BatchSplit:
 		adminResp.Splits = rsp.(*raftcmdpb.BatchSplitResponse)
+	case raftcmdpb.AdminCmdType_PrepareMerge:
+		adminResp.PrepareMerge = rsp.(*raftcmdpb.PrepareMergeResponse)
+	case raftcmdpb.AdminCmdType_CommitMerge:
+		adminResp.CommitMerge = rsp.(*raftcmdpb.CommitMergeResponse)
+	case raftcmdpb.AdminCmdType_RollbackMerge:
+		adminResp.RollbackMerge = rsp.(*raftcmdpb.RollbackMergeResponse)
+	case raftcmdpb.AdminCmdType_VerifyHash:
+		adminResp.VerifyHash = rsp.(*raftcmdpb.VerifyHashResponse)
 	}
 
 	resp := pb.AcquireRaftCMDResponse()
@@ -998,6 +1186,34 @@ func (s *store) updatePeerState(shard bhmetapb.Shard, state bhraftpb.PeerState,
 	return s.MetadataStorage().Set(getShardLocaleStateKey(shard.ID), protoc.MustMarshal(shardState))
 }
 
+func (s *store) updateMergeState(shard bhmetapb.Shard, state bhraftpb.MergeState, wb *util.WriteBatch) error {
+	shardState := &bhraftpb.ShardLocalState{}
+	shardState.State = bhraftpb.PeerState_Merging
+	shardState.Shard = shard
+	shardState.Merge = &state
+
+	if wb != nil {
+		return wb.Set(getShardLocaleStateKey(shard.ID), protoc.MustMarshal(shardState))
+	}
+
+	return s.MetadataStorage().Set(getShardLocaleStateKey(shard.ID), protoc.MustMarshal(shardState))
+}
+
+// updateMergedState update the source shard state to Tombstone, and record the target shard
+// which owns the data of the source shard now.
+func (s *store) updateMergedState(source, target bhmetapb.Shard, wb *util.WriteBatch) error {
+	shardState := &bhraftpb.ShardLocalState{}
+	shardState.State = bhraftpb.PeerState_Tombstone
+	shardState.Shard = source
+	shardState.Merge = &bhraftpb.MergeState{Target: target}
+
+	if wb != nil {
+		return wb.Set(getShardLocaleStateKey(source.ID), protoc.MustMarshal(shardState))
+	}
+
+	return s.MetadataStorage().Set(getShardLocaleStateKey(source.ID), protoc.MustMarshal(shardState))
+}
+
 func (s *store) removePeerState(shard bhmetapb.Shard) error {
 	return s.MetadataStorage().Delete(getShardLocaleStateKey(shard.ID))
 }
@@ -0,0 +1,770 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	pconfig "github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/snapshot"
+	"github.com/matrixorigin/matrixcube/util"
+)
+
+var (
+	// ErrBackupRunning the backup is running, a new backup can be started after it finished
+	ErrBackupRunning = errors.New("backup is running")
+
+	errBackupEpochChanged = errors.New("shard epoch changed during the backup")
+)
+
+const (
+	backupMetaFile = "backup.meta"
+	// backupTaskLeaseChecks the backup task is reassigned if the assigned container
+	// doesn't fetch it in these check durations, e.g. the container is down.
+	backupTaskLeaseChecks = 6
+)
+
+func backupShardFile(shardID uint64) string {
+	return fmt.Sprintf("%d.gz", shardID)
+}
+
+func backupSnapshotDirName(bs bhmetapb.BackupShard) string {
+	return fmt.Sprintf("backup_%d_%d", bs.Shard.ID, bs.AppliedIndex)
+}
+
+func newBackupDestination(cfg *config.Config, path string) (snapshot.BackupDestination, error) {
+	if cfg.Customize.CustomBackupDestinationFactory != nil {
+		return cfg.Customize.CustomBackupDestinationFactory(path)
+	}
+
+	return snapshot.NewLocalBackupDestination(cfg.FS, path)
+}
+
+func (s *store) Backup(path string) error {
+	progress, err := s.BackupProgress()
+	if err == nil {
+		if progress.State == bhmetapb.BackupState_Pending ||
+			progress.State == bhmetapb.BackupState_Running {
+			return ErrBackupRunning
+		}
+
+		// remove the finished backup job, only one backup job can exist
+		err := s.pd.GetClient().RemoveJob(metapb.Job{Type: metapb.JobType_Backup})
+		if err != nil {
+			return err
+		}
+	}
+
+	// every shard is backed up after this barrier
+	barrier, err := s.pd.GetClient().GetTimestamp(1)
+	if err != nil {
+		return err
+	}
+
+	return s.pd.GetClient().CreateJob(metapb.Job{Type: metapb.JobType_Backup, Content: protoc.MustMarshal(&metapb.BackupJob{
+		Path:    path,
+		Barrier: barrier,
+	})})
+}
+
+func (s *store) BackupProgress() (bhmetapb.BackupProgress, error) {
+	progress := bhmetapb.BackupProgress{}
+	v, err := s.pd.GetClient().ExecuteJob(metapb.Job{Type: metapb.JobType_Backup}, protoc.MustMarshal(&bhmetapb.BackupCmd{
+		Type: bhmetapb.BackupCmdType_GetBackupProgress,
+	}))
+	if err != nil {
+		return progress, err
+	}
+
+	protoc.MustUnmarshal(&progress, v)
+	return progress, nil
+}
+
+// handleBackup fetches the shards which need to be backed up on the current store
+// from the backup job, and starts to backup them. The tasks are only fetched if the
+// prophet reports a backup job in the store heartbeat response.
+func (s *store) handleBackup() {
+	if !s.hasProphetJob(metapb.JobType_Backup) {
+		return
+	}
+
+	v, err := s.pd.GetClient().ExecuteJob(metapb.Job{Type: metapb.JobType_Backup}, protoc.MustMarshal(&bhmetapb.BackupCmd{
+		Type:        bhmetapb.BackupCmdType_FetchBackupTasks,
+		ContainerID: s.meta.meta.ID,
+	}))
+	if err != nil {
+		// no backup job
+		return
+	}
+
+	tasks := bhmetapb.BackupTasks{}
+	protoc.MustUnmarshal(&tasks, v)
+	for _, bs := range tasks.Shards {
+		if _, ok := s.backups.LoadOrStore(bs.Shard.ID, struct{}{}); ok {
+			// the replica is destroyed before the backup action is handled
+			if s.getPR(bs.Shard.ID, false) == nil {
+				s.completeBackupShard(bs, errShardNotFound)
+			}
+			continue
+		}
+
+		s.startBackupShardJob(tasks.Path, bs)
+	}
+}
+
+type backupTask struct {
+	path  string
+	shard bhmetapb.BackupShard
+}
+
+// startBackupShardJob backs up the shard by the event worker of the shard, see doBackup.
+func (s *store) startBackupShardJob(path string, bs bhmetapb.BackupShard) {
+	pr := s.getPR(bs.Shard.ID, false)
+	if pr == nil {
+		s.completeBackupShard(bs, errShardNotFound)
+		return
+	}
+
+	pr.addAction(action{
+		epoch:      bs.Shard.Epoch,
+		actionType: backupAction,
+		backup:     backupTask{path: path, shard: bs},
+	})
+}
+
+// doBackup takes a read index on the leader as the barrier of the shard. The read index
+// is taken after the TSO barrier of the backup job, so the backup of the shard contains
+// all the writes acknowledged before that barrier. The barrier doesn't provide a
+// consistent cut across the shards, the writes acknowledged after the barrier may be
+// included in the backups of some shards but not in others.
+func (pr *peerReplica) doBackup(task backupTask) {
+	if pr.ps.shard.Epoch.Version != task.shard.Shard.Epoch.Version {
+		pr.store.startBackupSnapshotJob(task, errBackupEpochChanged)
+		return
+	}
+
+	req := pb.AcquireRaftCMDRequest()
+	req.Header = pb.AcquireRaftRequestHeader()
+	req.Header.ShardID = pr.shardID
+	req.Header.Peer = pr.peer
+	req.Header.ID = uuid.NewV4().Bytes()
+	req.Header.Epoch = pr.ps.shard.Epoch
+	pr.execReadIndex(newCMD(req, func(resp *raftcmdpb.RaftCMDResponse) {
+		if resp.Header != nil && resp.Header.Error.Message != "" {
+			pr.store.startBackupSnapshotJob(task, errors.New(resp.Header.Error.Message))
+			return
+		}
+
+		if pr.ps.shard.Epoch.Version != task.shard.Shard.Epoch.Version {
+			pr.store.startBackupSnapshotJob(task, errBackupEpochChanged)
+			return
+		}
+
+		pr.startCreateBackupSnapshotJob(task)
+	}, read, 0))
+}
+
+// startCreateBackupSnapshotJob creates the range snapshot of the shard on the apply worker,
+// see doCreateBackupSnapshot.
+func (pr *peerReplica) startCreateBackupSnapshotJob(task backupTask) {
+	err := pr.store.addApplyJob(pr.applyWorker, "doCreateBackupSnapshot", func() error {
+		pr.doCreateBackupSnapshot(task)
+		return nil
+	}, nil)
+	if err != nil {
+		pr.store.startBackupSnapshotJob(task, err)
+	}
+}
+
+// doCreateBackupSnapshot creates the range snapshot of the shard between the applies of the
+// shard, so the data in the snapshot matches the applied index recorded in the backup. The
+// snapshot is compressed and written to the destination on the snapshot worker.
+func (pr *peerReplica) doCreateBackupSnapshot(task backupTask) {
+	value, ok := pr.store.delegates.Load(pr.shardID)
+	if !ok {
+		pr.store.startBackupSnapshotJob(task, errShardNotFound)
+		return
+	}
+
+	delegate := value.(*applyDelegate)
+	delegate.applyLock.Lock()
+	if delegate.shard.Epoch.Version != task.shard.Shard.Epoch.Version {
+		delegate.applyLock.Unlock()
+		pr.store.startBackupSnapshotJob(task, errBackupEpochChanged)
+		return
+	}
+
+	task.shard.Shard = delegate.shard
+	task.shard.AppliedIndex = delegate.applyState.AppliedIndex
+	dir := pr.store.backupSnapshotDir(task.shard)
+	err := pr.store.createBackupSnapshot(dir, task.shard)
+	delegate.applyLock.Unlock()
+
+	if err != nil {
+		pr.store.cfg.FS.RemoveAll(dir)
+	}
+	pr.store.startBackupSnapshotJob(task, err)
+}
+
+// startBackupSnapshotJob writes the created range snapshot of the shard to the destination
+// on the snapshot worker, or completes the backup of the shard with the error.
+func (s *store) startBackupSnapshotJob(task backupTask, backupErr error) {
+	bs := task.shard
+	err := s.addSnapJob(bs.Shard.Group, func() error {
+		if backupErr == nil {
+			backupErr = s.doWriteBackupSnapshot(task.path, bs, s.backupSnapshotDir(bs))
+		}
+		s.completeBackupShard(bs, backupErr)
+		return nil
+	}, nil)
+	if err != nil {
+		logger.Errorf("shard %d add backup job failed with %+v",
+			bs.Shard.ID,
+			err)
+		if backupErr == nil {
+			s.cfg.FS.RemoveAll(s.backupSnapshotDir(bs))
+		}
+		s.backups.Delete(bs.Shard.ID)
+	}
+}
+
+func (s *store) backupSnapshotDir(bs bhmetapb.BackupShard) string {
+	return s.cfg.FS.PathJoin(s.cfg.SnapshotDir(), backupSnapshotDirName(bs))
+}
+
+func (s *store) createBackupSnapshot(dir string, bs bhmetapb.BackupShard) error {
+	err := s.DataStorageByGroup(bs.Shard.Group, bs.Shard.ID).CreateSnapshot(dir,
+		encStartKey(&bs.Shard),
+		encEndKey(&bs.Shard))
+	if err != nil {
+		return err
+	}
+
+	if s.cfg.Customize.CustomSnapshotDataCreateFuncFactory != nil {
+		if fn := s.cfg.Customize.CustomSnapshotDataCreateFuncFactory(bs.Shard.Group); fn != nil {
+			return fn(dir, bs.Shard)
+		}
+	}
+
+	return nil
+}
+
+func (s *store) doWriteBackupSnapshot(path string, bs bhmetapb.BackupShard, dir string) error {
+	fs := s.cfg.FS
+	gzPath := fmt.Sprintf("%s.gz", dir)
+	defer fs.RemoveAll(dir)
+	defer fs.RemoveAll(gzPath)
+
+	if err := util.GZIP(fs, dir); err != nil {
+		return err
+	}
+
+	dest, err := newBackupDestination(s.cfg, path)
+	if err != nil {
+		return err
+	}
+
+	f, err := fs.Open(gzPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w, err := dest.Create(backupShardFile(bs.Shard.ID))
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(w, f); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *store) completeBackupShard(bs bhmetapb.BackupShard, err error) {
+	defer s.backups.Delete(bs.Shard.ID)
+
+	cmd := &bhmetapb.BackupCmd{
+		Type:        bhmetapb.BackupCmdType_CompleteBackupTask,
+		ContainerID: s.meta.meta.ID,
+		Shard:       bs,
+	}
+	if err != nil {
+		logger.Errorf("shard %d backup failed with %+v",
+			bs.Shard.ID,
+			err)
+		cmd.Error = err.Error()
+	}
+
+	_, err = s.pd.GetClient().ExecuteJob(metapb.Job{Type: metapb.JobType_Backup}, protoc.MustMarshal(cmd))
+	if err != nil {
+		logger.Errorf("shard %d complete backup failed with %+v, retry later",
+			bs.Shard.ID,
+			err)
+	}
+}
+
+// backupJob coordinates the backup on the prophet leader. Every shard is assigned to
+// the container of the shard leader, the stores fetch the shards assigned to them and
+// back up these shards. The assigned container renews the lease of the task by the fetch,
+// the task is reassigned if the lease expired. The metadata of the backup is written to
+// the destination after all the shards are backed up.
+type backupJob struct {
+	cfg *config.Config
+	pd  prophet.Client
+	pdC chan struct{}
+
+	mu struct {
+		sync.Mutex
+
+		state    int
+		job      metapb.Job
+		progress bhmetapb.BackupProgress
+		// leases shard id -> the last time the task is fetched by the assigned container
+		leases map[uint64]time.Time
+	}
+}
+
+func newBackupJob(cfg *config.Config) *backupJob {
+	j := &backupJob{cfg: cfg, pdC: make(chan struct{})}
+	cfg.Prophet.RegisterJobProcessor(metapb.JobType_Backup, j)
+	return j
+}
+
+func (j *backupJob) setProphetClient(pd prophet.Client) {
+	j.pd = pd
+	close(j.pdC)
+}
+
+func (j *backupJob) waitProphetClientSetted() {
+	if j.pd != nil {
+		return
+	}
+	<-j.pdC
+}
+
+func (j *backupJob) Start(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.isStartedLocked() {
+		return
+	}
+
+	// load or init the job data
+	value, err := store.GetJobData(job)
+	if err != nil {
+		return
+	}
+	j.mu.progress = bhmetapb.BackupProgress{}
+	j.mu.leases = make(map[uint64]time.Time)
+	if len(value) > 0 {
+		protoc.MustUnmarshal(&j.mu.progress, value)
+	} else {
+		jobContent := &metapb.BackupJob{}
+		protoc.MustUnmarshal(jobContent, job.Content)
+
+		j.mu.progress.Path = jobContent.Path
+		j.mu.progress.Barrier = jobContent.Barrier
+		j.mu.progress.State = bhmetapb.BackupState_Running
+		for g := uint64(0); g < j.cfg.ShardGroups; g++ {
+			aware.ForeachResources(g, func(res metadata.Resource) {
+				j.mu.progress.Shards = append(j.mu.progress.Shards, bhmetapb.BackupShard{
+					Shard:       res.(*resourceAdapter).meta,
+					ContainerID: backupContainer(aware, res.ID()),
+				})
+			})
+		}
+	}
+
+	j.mu.state = 1
+	j.mu.job = job
+	if err := j.saveLocked(store); err != nil {
+		return
+	}
+
+	logger.Infof("backup job started with %d shards to %s",
+		len(j.mu.progress.Shards),
+		j.mu.progress.Path)
+	j.maybeFinishLocked(store)
+}
+
+func (j *backupJob) Stop(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.mu.state = 0
+}
+
+func (j *backupJob) Remove(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.Stop(job, store, aware)
+	if err := store.RemoveJobData(job); err != nil {
+		logger.Errorf("remove backup job data failed with %+v", err)
+	}
+}
+
+func (j *backupJob) Execute(data []byte, store storage.JobStorage, aware pconfig.ResourcesAware) ([]byte, error) {
+	if len(data) <= 0 {
+		return nil, errors.New("error execute data")
+	}
+
+	cmd := &bhmetapb.BackupCmd{}
+	protoc.MustUnmarshal(cmd, data)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.isStartedLocked() {
+		return nil, fmt.Errorf("job not started")
+	}
+
+	switch cmd.Type {
+	case bhmetapb.BackupCmdType_FetchBackupTasks:
+		return j.doFetchLocked(cmd, store, aware), nil
+	case bhmetapb.BackupCmdType_CompleteBackupTask:
+		return nil, j.doCompleteLocked(cmd, store, aware)
+	case bhmetapb.BackupCmdType_GetBackupProgress:
+		return protoc.MustMarshal(&j.mu.progress), nil
+	default:
+		return nil, fmt.Errorf("invalid execute cmd %d", cmd.Type)
+	}
+}
+
+func (j *backupJob) doFetchLocked(cmd *bhmetapb.BackupCmd, store storage.JobStorage, aware pconfig.ResourcesAware) []byte {
+	tasks := &bhmetapb.BackupTasks{Path: j.mu.progress.Path}
+	if j.mu.progress.State != bhmetapb.BackupState_Running {
+		return protoc.MustMarshal(tasks)
+	}
+
+	now := time.Now()
+	changed := false
+	for idx := range j.mu.progress.Shards {
+		bs := &j.mu.progress.Shards[idx]
+		if bs.State == bhmetapb.BackupState_Succeeded {
+			continue
+		}
+
+		// the shard has no leader when the job started, or the assigned container
+		// doesn't fetch the task in time
+		if bs.ContainerID == 0 ||
+			(bs.ContainerID != cmd.ContainerID && j.leaseExpiredLocked(bs.Shard.ID, now)) {
+			if bs.ContainerID > 0 {
+				logger.Warningf("shard %d backup task on container %d lease expired, reassign it",
+					bs.Shard.ID,
+					bs.ContainerID)
+			}
+			j.reassignLocked(bs, aware, now)
+			changed = true
+		}
+
+		if bs.ContainerID == cmd.ContainerID {
+			if bs.State == bhmetapb.BackupState_Pending {
+				bs.State = bhmetapb.BackupState_Running
+				changed = true
+			}
+			j.mu.leases[bs.Shard.ID] = now
+			tasks.Shards = append(tasks.Shards, *bs)
+		}
+	}
+
+	if changed {
+		j.saveLocked(store)
+	}
+	return protoc.MustMarshal(tasks)
+}
+
+// leaseExpiredLocked returns true if the assigned container doesn't fetch the task in
+// the lease. The lease starts from now if the task has no lease, e.g. the prophet leader
+// is changed.
+func (j *backupJob) leaseExpiredLocked(shardID uint64, now time.Time) bool {
+	last, ok := j.mu.leases[shardID]
+	if !ok {
+		j.mu.leases[shardID] = now
+		return false
+	}
+
+	return now.Sub(last) > j.cfg.Backup.CheckDuration.Duration*backupTaskLeaseChecks
+}
+
+// reassignLocked assigns the task to the container of the current shard leader
+func (j *backupJob) reassignLocked(bs *bhmetapb.BackupShard, aware pconfig.ResourcesAware, now time.Time) {
+	bs.ContainerID = backupContainer(aware, bs.Shard.ID)
+	bs.State = bhmetapb.BackupState_Pending
+	j.mu.leases[bs.Shard.ID] = now
+}
+
+func (j *backupJob) doCompleteLocked(cmd *bhmetapb.BackupCmd, store storage.JobStorage, aware pconfig.ResourcesAware) error {
+	if j.mu.progress.State != bhmetapb.BackupState_Running {
+		return nil
+	}
+
+	var bs *bhmetapb.BackupShard
+	for idx := range j.mu.progress.Shards {
+		if j.mu.progress.Shards[idx].Shard.ID == cmd.Shard.Shard.ID {
+			bs = &j.mu.progress.Shards[idx]
+			break
+		}
+	}
+	if bs == nil ||
+		bs.State == bhmetapb.BackupState_Succeeded ||
+		bs.ContainerID != cmd.ContainerID {
+		return nil
+	}
+
+	if cmd.Error != "" {
+		// The replica is moved from the container or the leader is transferred, choose
+		// the new leader. The backup is failed if the range of the shard is changed by
+		// split or merge, the data of the backed up shards would be overlapped.
+		res := aware.GetResource(bs.Shard.ID)
+		if res != nil &&
+			res.Meta.(*resourceAdapter).meta.Epoch.Version == bs.Shard.Epoch.Version &&
+			(!hasPeerOnContainer(res.Meta.(*resourceAdapter).meta, cmd.ContainerID) ||
+				backupContainer(aware, bs.Shard.ID) != cmd.ContainerID) {
+			j.reassignLocked(bs, aware, time.Now())
+		} else {
+			j.mu.progress.State = bhmetapb.BackupState_Failed
+			j.mu.progress.Error = fmt.Sprintf("shard %d backup failed with %s", bs.Shard.ID, cmd.Error)
+		}
+		return j.saveLocked(store)
+	}
+
+	*bs = cmd.Shard
+	bs.ContainerID = cmd.ContainerID
+	bs.State = bhmetapb.BackupState_Succeeded
+	if err := j.saveLocked(store); err != nil {
+		return err
+	}
+
+	j.maybeFinishLocked(store)
+	return nil
+}
+
+// maybeFinishLocked writes the metadata of the backup if all the shards are backed up.
+func (j *backupJob) maybeFinishLocked(store storage.JobStorage) {
+	if j.mu.progress.State != bhmetapb.BackupState_Running {
+		return
+	}
+
+	meta := bhmetapb.BackupMeta{Barrier: j.mu.progress.Barrier}
+	for _, bs := range j.mu.progress.Shards {
+		if bs.State != bhmetapb.BackupState_Succeeded {
+			return
+		}
+		meta.Shards = append(meta.Shards, bs)
+	}
+
+	job := j.mu.job
+	path := j.mu.progress.Path
+	go func() {
+		err := j.writeMeta(path, meta)
+
+		j.mu.Lock()
+		defer j.mu.Unlock()
+
+		if !j.isStartedLocked() ||
+			j.mu.job.Type != job.Type ||
+			j.mu.progress.Path != path ||
+			j.mu.progress.State != bhmetapb.BackupState_Running {
+			return
+		}
+
+		if err != nil {
+			j.mu.progress.State = bhmetapb.BackupState_Failed
+			j.mu.progress.Error = fmt.Sprintf("write backup metadata failed with %s", err)
+		} else {
+			j.mu.progress.State = bhmetapb.BackupState_Succeeded
+		}
+		j.saveLocked(store)
+		logger.Infof("backup job to %s completed with state %s",
+			path,
+			j.mu.progress.State.String())
+	}()
+}
+
+func (j *backupJob) writeMeta(path string, meta bhmetapb.BackupMeta) error {
+	j.waitProphetClientSetted()
+	rules, err := j.pd.GetPlacementRules("")
+	if err != nil {
+		return err
+	}
+	meta.PlacementRules = rules
+
+	dest, err := newBackupDestination(j.cfg, path)
+	if err != nil {
+		return err
+	}
+
+	w, err := dest.Create(backupMetaFile)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(protoc.MustMarshal(&meta)); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (j *backupJob) isStartedLocked() bool {
+	return j.mu.state == 1
+}
+
+func (j *backupJob) saveLocked(store storage.JobStorage) error {
+	err := store.PutJobData(j.mu.job, protoc.MustMarshal(&j.mu.progress))
+	if err != nil {
+		logger.Errorf("put backup job data to storage failed with %+v", err)
+	}
+	return err
+}
+
+// backupContainer returns the container of the shard leader, returns 0 if the shard
+// has no leader.
+func backupContainer(aware pconfig.ResourcesAware, shardID uint64) uint64 {
+	res := aware.GetResource(shardID)
+	if res == nil || res.GetLeader() == nil {
+		return 0
+	}
+
+	return res.GetLeader().ContainerID
+}
+
+func hasPeerOnContainer(shard bhmetapb.Shard, containerID uint64) bool {
+	for _, p := range shard.Peers {
+		if p.ContainerID == containerID {
+			return true
+		}
+	}
+	return false
+}
+
+// mustRestoreShards creates the init shards with the ranges of the shards in the backup,
+// and restores the data of these shards from the snapshots in the backup.
+func (s *store) mustRestoreShards(path string) ([]bhmetapb.Shard, []rpcpb.PlacementRule) {
+	logger.Infof("begin to restore the cluster from the backup %s", path)
+	dest, err := newBackupDestination(s.cfg, path)
+	if err != nil {
+		logger.Fatalf("create backup destination %s failed with %+v", path, err)
+	}
+
+	r, err := dest.Open(backupMetaFile)
+	if err != nil {
+		logger.Fatalf("open backup metadata failed with %+v", err)
+	}
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		logger.Fatalf("read backup metadata failed with %+v", err)
+	}
+
+	meta := bhmetapb.BackupMeta{}
+	protoc.MustUnmarshal(&meta, data)
+
+	var shards []bhmetapb.Shard
+	for _, bs := range meta.Shards {
+		shard := bs.Shard
+		shard.Peers = nil
+		shard.Epoch = metapb.ResourceEpoch{}
+		shard.State = metapb.ResourceState_Running
+		s.doCreateInitShard(&shard)
+
+		if err := s.doRestoreShardData(dest, bs, shard); err != nil {
+			s.mustRemoveRestoredData(append(shards, shard)...)
+			logger.Fatalf("restore shard %d from backup shard %d failed with %+v",
+				shard.ID,
+				bs.Shard.ID,
+				err)
+		}
+
+		logger.Infof("shard %d restored from backup shard %d at index %d",
+			shard.ID,
+			bs.Shard.ID,
+			bs.AppliedIndex)
+		shards = append(shards, shard)
+	}
+
+	return shards, meta.PlacementRules
+}
+
+func (s *store) doRestoreShardData(dest snapshot.BackupDestination, bs bhmetapb.BackupShard, shard bhmetapb.Shard) error {
+	fs := s.cfg.FS
+	snapDir := s.cfg.SnapshotDir()
+	if err := fs.MkdirAll(snapDir, 0750); err != nil {
+		return err
+	}
+
+	dir := fs.PathJoin(snapDir, backupSnapshotDirName(bs))
+	gzPath := fmt.Sprintf("%s.gz", dir)
+	defer fs.RemoveAll(dir)
+	defer fs.RemoveAll(gzPath)
+
+	r, err := dest.Open(backupShardFile(bs.Shard.ID))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := fs.Create(gzPath)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := util.UnGZIP(fs, gzPath, snapDir); err != nil {
+		return err
+	}
+
+	if err := s.DataStorageByGroup(shard.Group, shard.ID).ApplySnapshot(dir); err != nil {
+		return err
+	}
+
+	if s.cfg.Customize.CustomSnapshotDataApplyFuncFactory != nil {
+		if fn := s.cfg.Customize.CustomSnapshotDataApplyFuncFactory(shard.Group); fn != nil {
+			return fn(dir, shard)
+		}
+	}
+
+	return nil
+}
+
+func (s *store) mustRemoveRestoredData(shards ...bhmetapb.Shard) {
+	for _, shard := range shards {
+		if err := s.removeShardData(shard, nil); err != nil {
+			logger.Fatalf("remove restored data of shard %d failed with %+v",
+				shard.ID,
+				err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/util"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/matrixorigin/matrixcube/vfs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	dir := fmt.Sprintf("%s/backup", util.GetTestDir())
+	recreateTestTempDir(vfs.Default, dir)
+
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Backup.CheckDuration.Duration = time.Millisecond * 100
+			cfg.Replication.StoreHeartbeatDuration.Duration = time.Millisecond * 100
+		}))
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	s := c.GetStore(0)
+	sendTestWrites(t, s, "key1", "key2")
+	assert.NoError(t, c.GetProphet().GetClient().PutPlacementRule(rpcpb.PlacementRule{GroupID: "g1", ID: "id1", Count: 1}))
+	assert.NoError(t, s.Backup(dir))
+	assert.Equal(t, ErrBackupRunning, s.Backup(dir))
+	progress := waitTestBackup(t, s)
+	assert.Equal(t, bhmetapb.BackupState_Succeeded, progress.State)
+	assert.Equal(t, 1, len(progress.Shards))
+	assert.True(t, progress.Shards[0].AppliedIndex > 0)
+	assert.True(t, progress.Barrier > 0)
+	c.Stop()
+
+	// bootstrap a new cluster from the backup
+	c = NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Backup.RestorePath = dir
+		}))
+	c.Start()
+	defer c.Stop()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	resps, err := sendTestReqs(c.GetStore(0), time.Second*10, nil, nil,
+		createTestReadReq("r1", "key1"),
+		createTestReadReq("r2", "key2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value", string(resps["r1"].Responses[0].Value))
+	assert.Equal(t, "value", string(resps["r2"].Responses[0].Value))
+
+	rules, err := c.GetProphet().GetClient().GetPlacementRules("g1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rules))
+}
+
+func TestBackupJobReassignExpiredTask(t *testing.T) {
+	cfg := &config.Config{ShardGroups: 1}
+	cfg.Backup.CheckDuration.Duration = time.Millisecond * 10
+	j := &backupJob{cfg: cfg}
+	store := storage.NewTestStorage()
+	shard := bhmetapb.Shard{ID: 1, Peers: []metapb.Peer{{ID: 2, ContainerID: 1}, {ID: 3, ContainerID: 2}}}
+	leader := shard.Peers[0]
+	aware := &testBackupResourcesAware{leader: &leader, shard: shard}
+	job := metapb.Job{Type: metapb.JobType_Backup, Content: protoc.MustMarshal(&metapb.BackupJob{Path: "path", Barrier: 1})}
+	j.Start(job, store, aware)
+
+	fetch := func(containerID uint64) bhmetapb.BackupTasks {
+		tasks := bhmetapb.BackupTasks{}
+		v, err := j.Execute(protoc.MustMarshal(&bhmetapb.BackupCmd{
+			Type:        bhmetapb.BackupCmdType_FetchBackupTasks,
+			ContainerID: containerID,
+		}), store, aware)
+		assert.NoError(t, err)
+		protoc.MustUnmarshal(&tasks, v)
+		return tasks
+	}
+
+	assert.Equal(t, 1, len(fetch(1).Shards))
+	assert.Equal(t, uint64(1), j.mu.progress.Barrier)
+
+	// container 1 is down, the leader is moved to container 2
+	aware.leader = &shard.Peers[1]
+	assert.Empty(t, fetch(2).Shards)
+	time.Sleep(cfg.Backup.CheckDuration.Duration * (backupTaskLeaseChecks + 1))
+	assert.Equal(t, 1, len(fetch(2).Shards))
+	assert.Empty(t, fetch(1).Shards)
+}
+
+type testBackupResourcesAware struct {
+	leader *metapb.Peer
+	shard  bhmetapb.Shard
+}
+
+func (a *testBackupResourcesAware) ForeachWaittingCreateResources(do func(res metadata.Resource)) {}
+
+func (a *testBackupResourcesAware) ForeachResources(group uint64, fn func(res metadata.Resource)) {
+	fn(NewResourceAdapterWithShard(a.shard))
+}
+
+func (a *testBackupResourcesAware) GetResource(resourceID uint64) *core.CachedResource {
+	return core.NewCachedResource(NewResourceAdapterWithShard(a.shard), a.leader)
+}
+
+func waitTestBackup(t *testing.T, s Store) bhmetapb.BackupProgress {
+	timeout := time.After(time.Second * 10)
+	for {
+		progress, err := s.BackupProgress()
+		assert.NoError(t, err)
+		if progress.State != bhmetapb.BackupState_Running {
+			return progress
+		}
+
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait backup timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
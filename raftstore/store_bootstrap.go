@@ -19,6 +19,7 @@ import (
 	"github.com/fagongzi/util/protoc"
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
 	"github.com/matrixorigin/matrixcube/util"
@@ -71,11 +72,17 @@ func (s *store) doBootstrapCluster() {
 	}
 	logger.Infof("the cluster already bootstrap: %+v", ok)
 
+	var rules []rpcpb.PlacementRule
 	if !ok {
 		logger.Infof("begin to bootstrap the cluster with init shards")
 		var initShards []bhmetapb.Shard
 		var resources []metadata.Resource
-		if s.cfg.Customize.CustomInitShardsFactory != nil {
+		if s.cfg.Backup.RestorePath != "" {
+			initShards, rules = s.mustRestoreShards(s.cfg.Backup.RestorePath)
+			for _, shard := range initShards {
+				resources = append(resources, NewResourceAdapterWithShard(shard))
+			}
+		} else if s.cfg.Customize.CustomInitShardsFactory != nil {
 			shards := s.cfg.Customize.CustomInitShardsFactory()
 			for _, shard := range shards {
 				s.doCreateInitShard(&shard)
@@ -98,6 +105,10 @@ func (s *store) doBootstrapCluster() {
 		if !ok {
 			logger.Info("the cluster is already bootstrapped")
 			s.removeInitShards(initShards...)
+			if s.cfg.Backup.RestorePath != "" {
+				s.mustRemoveRestoredData(initShards...)
+			}
+			rules = nil
 		}
 	}
 
@@ -105,6 +116,17 @@ func (s *store) doBootstrapCluster() {
 		logger.Fatalf("put container to prophet failed with %+v", err)
 	}
 
+	// the prophet only accepts the rules from the registered containers if the admin
+	// rpcs are disabled, so the rules are restored after the container is put.
+	for _, rule := range rules {
+		if err := s.pd.GetClient().PutPlacementRule(rule); err != nil {
+			logger.Fatalf("restore placement rule %s/%s failed with %+v",
+				rule.GroupID,
+				rule.ID,
+				err)
+		}
+	}
+
 	s.startHandleResourceHeartbeat()
 }
 
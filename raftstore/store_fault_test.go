@@ -0,0 +1,119 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/transport"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/matrixorigin/matrixcube/vfs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionLeader(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	c := NewTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		WithTestClusterFaults(transport.NewFaults()))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shard := c.GetShardByIndex(0)
+	leader := -1
+	for idx := range c.stores {
+		if c.awares[idx].isLeader(shard.ID) {
+			leader = idx
+		}
+	}
+	assert.True(t, leader >= 0)
+
+	var others []int
+	for idx := range c.stores {
+		if idx != leader {
+			others = append(others, idx)
+		}
+	}
+
+	// the majority elects a new leader after the old leader is partitioned
+	id := c.PartitionNodes([]int{leader}, others)
+	newLeader := -1
+	timeout := time.After(time.Second * 10)
+	for newLeader < 0 {
+		for _, idx := range others {
+			if c.awares[idx].isLeader(shard.ID) {
+				newLeader = idx
+			}
+		}
+
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait new leader timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+	c.HealPartition(id)
+
+	resps, err := sendTestReqs(c.stores[newLeader], time.Second*10, nil, nil, createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+}
+
+func TestSlowDisk(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	inj := vfs.NewFaultInjector()
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		WithTestClusterUseDisk(),
+		WithTestClusterFaultInjector(inj),
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.SlowStoreLatencyThreshold.Duration = time.Millisecond * 5
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	// the slow score rises with the slow raft log appending
+	inj.SetLatency(vfs.OpSync, time.Millisecond*10)
+	s := c.stores[0]
+	timeout := time.After(time.Second * 10)
+	for i := 0; ; i++ {
+		id := fmt.Sprintf("w%d", i)
+		resps, err := sendTestReqs(s, time.Second*10, nil, nil, createTestWriteReq(id, "key1", "value1"))
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", string(resps[id].Responses[0].Value))
+
+		s.slowScore.Lock()
+		score := s.slowScore.score
+		s.slowScore.Unlock()
+		if score > minSlowScore {
+			break
+		}
+
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait slow score timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
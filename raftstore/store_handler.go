@@ -54,10 +54,23 @@ func (s *store) handledCustomSplitCheck(group uint64) bool {
 	return s.cfg.Customize.CustomSplitCheckFuncFactory != nil && s.cfg.Customize.CustomSplitCheckFuncFactory(group) != nil
 }
 
+func (s *store) handleConsistencyCheck() {
+	s.foreachPR(func(pr *peerReplica) bool {
+		if pr.isLeader() {
+			pr.addAction(action{actionType: checkConsistencyAction})
+		}
+
+		return true
+	})
+}
+
 func (s *store) handleShardStateCheck() {
 	bm := roaring64.NewBitmap()
 	s.foreachPR(func(pr *peerReplica) bool {
 		bm.Add(pr.shardID)
+		if pr.ps.mergeState != nil {
+			pr.addAction(action{actionType: commitMergeAction})
+		}
 		return true
 	})
 
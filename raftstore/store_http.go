@@ -0,0 +1,122 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixcube/metric"
+)
+
+// readyState the state of the components which the store depends on
+type readyState struct {
+	ProphetConnected bool `json:"prophet-connected"`
+	ShardsStarted    bool `json:"shards-started"`
+	TransportStarted bool `json:"transport-started"`
+}
+
+func (rs readyState) ready() bool {
+	return rs.ProphetConnected && rs.ShardsStarted && rs.TransportStarted
+}
+
+// startHTTP starts the http listener which serves the metrics, the health and the
+// ready state of the store, and the pprof if enabled.
+func (s *store) startHTTP() {
+	if s.cfg.Metric.HTTPAddr == "" {
+		return
+	}
+
+	l, err := s.tls.Listen(s.cfg.Metric.HTTPAddr)
+	if err != nil {
+		logger.Fatalf("start http listener at %s failed with %+v",
+			s.cfg.Metric.HTTPAddr,
+			err)
+	}
+
+	if s.cfg.Metric.EnablePprof && !(s.cfg.TLS.Enabled() && s.cfg.TLS.ClientCertAuth) {
+		logger.Warningf("pprof is enabled without tls client-cert-auth, anyone who can reach %s can profile the store",
+			s.cfg.Metric.HTTPAddr)
+	}
+
+	logger.Infof("start listen at %s for http", s.cfg.Metric.HTTPAddr)
+	s.httpServer = &http.Server{Handler: s.newHTTPHandler()}
+	go func() {
+		if err := s.httpServer.Serve(l); err != nil && err != http.ErrServerClosed {
+			logger.Errorf("http listener at %s stopped with %+v",
+				s.cfg.Metric.HTTPAddr,
+				err)
+		}
+	}()
+}
+
+func (s *store) stopHTTP() {
+	if s.httpServer != nil {
+		s.httpServer.Close()
+	}
+}
+
+func (s *store) newHTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metric.Handler())
+	mux.HandleFunc("/health", s.handleHealth)
+	mux.HandleFunc("/ready", s.handleReady)
+
+	if s.cfg.Metric.EnablePprof {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+
+	return mux
+}
+
+// handleHealth returns 200 if the store is not stopped
+func (s *store) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadUint32(&s.state) != 0 {
+		http.Error(w, "stopped", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Write([]byte("ok"))
+}
+
+// handleReady returns 200 if the store can serve the requests, the body is the state of
+// the components which the store depends on.
+func (s *store) handleReady(w http.ResponseWriter, r *http.Request) {
+	rs := s.getReadyState()
+	data, err := json.Marshal(rs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !rs.ready() || atomic.LoadUint32(&s.state) != 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(data)
+}
+
+func (s *store) getReadyState() readyState {
+	return readyState{
+		ProphetConnected: atomic.LoadUint32(&s.prophetConnected) == 1,
+		ShardsStarted:    atomic.LoadUint32(&s.shardsStarted) == 1,
+		TransportStarted: atomic.LoadUint32(&s.transportStarted) == 1,
+	}
+}
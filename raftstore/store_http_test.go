@@ -0,0 +1,60 @@
+package raftstore
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c := NewSingleTestClusterStore(t,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Metric.EnablePprof = true
+		}))
+	c.Start()
+	defer c.Stop()
+	c.WaitShardByCount(t, 1, time.Second*10)
+
+	s := c.GetStore(0).(*store)
+	h := s.newHTTPHandler()
+
+	rsp := doTestHTTPRequest(h, "/health")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+
+	rsp = doTestHTTPRequest(h, "/ready")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.True(t, strings.Contains(rsp.Body.String(), `"prophet-connected":true`))
+
+	rsp = doTestHTTPRequest(h, "/metrics")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.True(t, strings.Contains(rsp.Body.String(), "matrixcube_raftstore"))
+
+	rsp = doTestHTTPRequest(h, "/debug/pprof/")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+
+	// the store is not ready if the prophet is disconnected
+	s.prophetConnected = 0
+	rsp = doTestHTTPRequest(h, "/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, rsp.Code)
+
+	s.cfg.Metric.EnablePprof = false
+	rsp = doTestHTTPRequest(s.newHTTPHandler(), "/debug/pprof/")
+	assert.Equal(t, http.StatusNotFound, rsp.Code)
+
+	// the ready state is reset after the store stopped
+	c.StopNode(0)
+	assert.Equal(t, readyState{}, s.getReadyState())
+}
+
+func doTestHTTPRequest(h http.Handler, path string) *httptest.ResponseRecorder {
+	rsp := httptest.NewRecorder()
+	h.ServeHTTP(rsp, httptest.NewRequest(http.MethodGet, path, nil))
+	return rsp
+}
@@ -0,0 +1,422 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	pconfig "github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/metric"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/errorpb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+)
+
+const (
+	readQPSQuota = iota
+	writeQPSQuota
+	readBytesQuota
+	writeBytesQuota
+)
+
+var quotaTypeNames = [...]string{"read-qps", "write-qps", "read-bytes", "write-bytes"}
+
+// SetRequestQuota sets the request quota of the tenant, or the request quota of the shard
+// group if the tenant is empty. The quotas are stored in the request quota job on the
+// prophet leader, every store fetches the quotas periodically and enforces them on the
+// requests it receives.
+func SetRequestQuota(client prophet.Client, quota bhmetapb.RequestQuota) error {
+	return executeRequestQuotaCmd(client, bhmetapb.RequestQuotaCmd{
+		Type:  bhmetapb.RequestQuotaCmdType_SetRequestQuota,
+		Quota: quota,
+	})
+}
+
+// RemoveRequestQuota removes the request quota of the tenant, or the request quota of the
+// shard group if the tenant is empty.
+func RemoveRequestQuota(client prophet.Client, tenant string, group uint64) error {
+	return executeRequestQuotaCmd(client, bhmetapb.RequestQuotaCmd{
+		Type:  bhmetapb.RequestQuotaCmdType_RemoveRequestQuota,
+		Quota: bhmetapb.RequestQuota{Tenant: tenant, Group: group},
+	})
+}
+
+// GetRequestQuotas returns all the request quotas
+func GetRequestQuotas(client prophet.Client) ([]bhmetapb.RequestQuota, error) {
+	v, err := client.ExecuteJob(metapb.Job{Type: metapb.JobType_RequestQuota}, protoc.MustMarshal(&bhmetapb.RequestQuotaCmd{
+		Type: bhmetapb.RequestQuotaCmdType_GetRequestQuotas,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := bhmetapb.RequestQuotas{}
+	protoc.MustUnmarshal(&quotas, v)
+	return quotas.Quotas, nil
+}
+
+func executeRequestQuotaCmd(client prophet.Client, cmd bhmetapb.RequestQuotaCmd) error {
+	// the request quota job is created by the first quota, and never removed
+	err := client.CreateJob(metapb.Job{Type: metapb.JobType_RequestQuota})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ExecuteJob(metapb.Job{Type: metapb.JobType_RequestQuota}, protoc.MustMarshal(&cmd))
+	return err
+}
+
+// handleRequestQuota fetches the request quotas from the request quota job, the previous
+// quotas are kept if the prophet is unavailable.
+func (s *store) handleRequestQuota() {
+	quotas, err := GetRequestQuotas(s.pd.GetClient())
+	if err != nil {
+		// no request quota job
+		return
+	}
+
+	s.quotas.update(quotas)
+}
+
+func (s *store) CheckRequestQuota(req *raftcmdpb.Request) *errorpb.QuotaExceeded {
+	return s.quotas.admit(req)
+}
+
+// quotaBucket is a token bucket which can be overdrawn. A request is admitted if there is
+// at least one token left, and the actual cost, e.g. the read bytes, is taken after the
+// request is executed. The bucket holds the tokens of at most one second.
+type quotaBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newQuotaBucket(rate uint64, now time.Time) *quotaBucket {
+	if rate == 0 {
+		return nil
+	}
+
+	return &quotaBucket{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   now,
+	}
+}
+
+func (b *quotaBucket) allow(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+		b.last = now
+	}
+	return b.tokens >= 1
+}
+
+func (b *quotaBucket) take(n uint64) {
+	if b != nil {
+		b.tokens -= float64(n)
+	}
+}
+
+type requestQuota struct {
+	name    string
+	limits  [4]uint64
+	buckets [4]*quotaBucket
+}
+
+func newRequestQuota(quota bhmetapb.RequestQuota, now time.Time) *requestQuota {
+	q := &requestQuota{name: requestQuotaName(quota), limits: requestQuotaLimits(quota)}
+	for tp, limit := range q.limits {
+		q.buckets[tp] = newQuotaBucket(limit, now)
+	}
+	return q
+}
+
+func (q *requestQuota) removeMetric() {
+	for _, tp := range quotaTypeNames {
+		metric.RemoveRequestQuotaMetric(q.name, tp)
+	}
+}
+
+func requestQuotaLimits(quota bhmetapb.RequestQuota) [4]uint64 {
+	return [4]uint64{quota.ReadQPS, quota.WriteQPS, quota.ReadBytes, quota.WriteBytes}
+}
+
+func requestQuotaName(quota bhmetapb.RequestQuota) string {
+	if quota.Tenant != "" {
+		return fmt.Sprintf("tenant-%s", quota.Tenant)
+	}
+	return fmt.Sprintf("group-%d", quota.Group)
+}
+
+// quotaLimiter limits the requests on the store by the request quotas of the tenants and
+// the shard groups. A request is limited by both the quota of its tenant and the quota
+// of its shard group. The QPS is limited when the request is dispatched by the proxy, and
+// the bytes are limited when the request is added to the proposal batch.
+type quotaLimiter struct {
+	sync.Mutex
+
+	tenants map[string]*requestQuota
+	groups  map[uint64]*requestQuota
+}
+
+func newQuotaLimiter() *quotaLimiter {
+	return &quotaLimiter{
+		tenants: make(map[string]*requestQuota),
+		groups:  make(map[uint64]*requestQuota),
+	}
+}
+
+// update replaces the quotas, the tokens of the unchanged quotas are kept.
+func (l *quotaLimiter) update(quotas []bhmetapb.RequestQuota) {
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	tenants := make(map[string]*requestQuota, len(quotas))
+	groups := make(map[uint64]*requestQuota, len(quotas))
+	for _, quota := range quotas {
+		var q *requestQuota
+		if quota.Tenant != "" {
+			q = l.tenants[quota.Tenant]
+		} else {
+			q = l.groups[quota.Group]
+		}
+		if q == nil || q.limits != requestQuotaLimits(quota) {
+			q = newRequestQuota(quota, now)
+			for tp, limit := range q.limits {
+				metric.SetRequestQuotaMetric(q.name, quotaTypeNames[tp], limit)
+			}
+		}
+
+		if quota.Tenant != "" {
+			tenants[quota.Tenant] = q
+		} else {
+			groups[quota.Group] = q
+		}
+	}
+
+	for tenant, q := range l.tenants {
+		if _, ok := tenants[tenant]; !ok {
+			q.removeMetric()
+		}
+	}
+	for group, q := range l.groups {
+		if _, ok := groups[group]; !ok {
+			q.removeMetric()
+		}
+	}
+
+	l.tenants = tenants
+	l.groups = groups
+}
+
+// admit takes a token from the QPS quotas of the request
+func (l *quotaLimiter) admit(req *raftcmdpb.Request) *errorpb.QuotaExceeded {
+	tp := readQPSQuota
+	if req.Type == raftcmdpb.CMDType_Write {
+		tp = writeQPSQuota
+	}
+
+	return l.check(req, tp, 1)
+}
+
+// admitBytes takes the size of the write request from the written bytes quotas, the read
+// request is admitted if there are read bytes left, the read bytes are taken by takeReadBytes
+// after the request is executed.
+func (l *quotaLimiter) admitBytes(req *raftcmdpb.Request) *errorpb.QuotaExceeded {
+	if req.Type == raftcmdpb.CMDType_Write {
+		return l.check(req, writeBytesQuota, uint64(req.Size()))
+	}
+
+	return l.check(req, readBytesQuota, 0)
+}
+
+func (l *quotaLimiter) takeReadBytes(req *raftcmdpb.Request, n uint64) {
+	l.Lock()
+	defer l.Unlock()
+
+	if q := l.getTenantQuota(req); q != nil {
+		q.buckets[readBytesQuota].take(n)
+	}
+	if q := l.groups[req.Group]; q != nil {
+		q.buckets[readBytesQuota].take(n)
+	}
+}
+
+func (l *quotaLimiter) check(req *raftcmdpb.Request, tp int, n uint64) *errorpb.QuotaExceeded {
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.tenants) == 0 && len(l.groups) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	tenant := l.getTenantQuota(req)
+	if tenant != nil && !tenant.buckets[tp].allow(now) {
+		metric.IncRequestQuotaRejectedCount(tenant.name, quotaTypeNames[tp])
+		return &errorpb.QuotaExceeded{Tenant: req.Tenant, Group: req.Group}
+	}
+
+	group := l.groups[req.Group]
+	if group != nil && !group.buckets[tp].allow(now) {
+		metric.IncRequestQuotaRejectedCount(group.name, quotaTypeNames[tp])
+		return &errorpb.QuotaExceeded{Group: req.Group}
+	}
+
+	if tenant != nil {
+		tenant.buckets[tp].take(n)
+	}
+	if group != nil {
+		group.buckets[tp].take(n)
+	}
+	return nil
+}
+
+func (l *quotaLimiter) getTenantQuota(req *raftcmdpb.Request) *requestQuota {
+	if req.Tenant == "" {
+		return nil
+	}
+	return l.tenants[req.Tenant]
+}
+
+// requestQuotaJob stores the request quotas on the prophet leader
+type requestQuotaJob struct {
+	cfg *config.Config
+
+	mu struct {
+		sync.Mutex
+
+		state  int
+		job    metapb.Job
+		quotas bhmetapb.RequestQuotas
+	}
+}
+
+func newRequestQuotaJob(cfg *config.Config) *requestQuotaJob {
+	j := &requestQuotaJob{cfg: cfg}
+	cfg.Prophet.RegisterJobProcessor(metapb.JobType_RequestQuota, j)
+	return j
+}
+
+func (j *requestQuotaJob) Start(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.isStartedLocked() {
+		return
+	}
+
+	value, err := store.GetJobData(job)
+	if err != nil {
+		return
+	}
+	j.mu.quotas = bhmetapb.RequestQuotas{}
+	if len(value) > 0 {
+		protoc.MustUnmarshal(&j.mu.quotas, value)
+	}
+
+	j.mu.state = 1
+	j.mu.job = job
+	logger.Infof("request quota job started with %d quotas",
+		len(j.mu.quotas.Quotas))
+}
+
+func (j *requestQuotaJob) Stop(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.mu.state = 0
+}
+
+func (j *requestQuotaJob) Remove(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.Stop(job, store, aware)
+	if err := store.RemoveJobData(job); err != nil {
+		logger.Errorf("remove request quota job data failed with %+v", err)
+	}
+}
+
+func (j *requestQuotaJob) Execute(data []byte, store storage.JobStorage, aware pconfig.ResourcesAware) ([]byte, error) {
+	if len(data) <= 0 {
+		return nil, errors.New("error execute data")
+	}
+
+	cmd := &bhmetapb.RequestQuotaCmd{}
+	protoc.MustUnmarshal(cmd, data)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.isStartedLocked() {
+		return nil, fmt.Errorf("job not started")
+	}
+
+	switch cmd.Type {
+	case bhmetapb.RequestQuotaCmdType_SetRequestQuota:
+		j.removeLocked(cmd.Quota)
+		j.mu.quotas.Quotas = append(j.mu.quotas.Quotas, cmd.Quota)
+		return nil, j.saveLocked(store)
+	case bhmetapb.RequestQuotaCmdType_RemoveRequestQuota:
+		if j.removeLocked(cmd.Quota) {
+			return nil, j.saveLocked(store)
+		}
+		return nil, nil
+	case bhmetapb.RequestQuotaCmdType_GetRequestQuotas:
+		return protoc.MustMarshal(&j.mu.quotas), nil
+	default:
+		return nil, fmt.Errorf("invalid execute cmd %d", cmd.Type)
+	}
+}
+
+// removeLocked removes the quota of the same tenant, or the same group if the tenant is empty
+func (j *requestQuotaJob) removeLocked(quota bhmetapb.RequestQuota) bool {
+	quotas := j.mu.quotas.Quotas[:0]
+	for _, q := range j.mu.quotas.Quotas {
+		if q.Tenant != quota.Tenant ||
+			(q.Tenant == "" && q.Group != quota.Group) {
+			quotas = append(quotas, q)
+		}
+	}
+
+	removed := len(quotas) != len(j.mu.quotas.Quotas)
+	j.mu.quotas.Quotas = quotas
+	return removed
+}
+
+func (j *requestQuotaJob) isStartedLocked() bool {
+	return j.mu.state == 1
+}
+
+func (j *requestQuotaJob) saveLocked(store storage.JobStorage) error {
+	err := store.PutJobData(j.mu.job, protoc.MustMarshal(&j.mu.quotas))
+	if err != nil {
+		logger.Errorf("put request quota job data to storage failed with %+v", err)
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaLimiter(t *testing.T) {
+	l := newQuotaLimiter()
+	write := &raftcmdpb.Request{Type: raftcmdpb.CMDType_Write, Tenant: "t1", Group: 1, Cmd: make([]byte, 32)}
+	read := &raftcmdpb.Request{Type: raftcmdpb.CMDType_Read, Tenant: "t2", Group: 1}
+	assert.Nil(t, l.admit(write))
+	assert.Nil(t, l.admitBytes(write))
+
+	l.update([]bhmetapb.RequestQuota{
+		{Tenant: "t1", WriteQPS: 2, WriteBytes: 10},
+		{Group: 1, ReadQPS: 1, ReadBytes: 10},
+	})
+
+	// limited by the tenant quota
+	assert.Nil(t, l.admit(write))
+	assert.Nil(t, l.admit(write))
+	assert.Equal(t, "t1", l.admit(write).Tenant)
+
+	// limited by the group quota
+	assert.Nil(t, l.admit(read))
+	err := l.admit(read)
+	assert.Empty(t, err.Tenant)
+	assert.Equal(t, uint64(1), err.Group)
+
+	// the bucket is overdrawn by the large write
+	assert.Nil(t, l.admitBytes(write))
+	assert.NotNil(t, l.admitBytes(write))
+
+	// the read bytes are taken after the read is executed
+	assert.Nil(t, l.admitBytes(read))
+	l.takeReadBytes(read, 100)
+	assert.NotNil(t, l.admitBytes(read))
+
+	// the tokens of the unchanged quota are kept
+	l.update([]bhmetapb.RequestQuota{
+		{Tenant: "t1", WriteQPS: 2, WriteBytes: 10},
+		{Group: 1, ReadQPS: 2, ReadBytes: 10},
+	})
+	assert.NotNil(t, l.admit(write))
+	assert.Nil(t, l.admit(read))
+
+	l.update(nil)
+	assert.Nil(t, l.admit(write))
+	assert.Nil(t, l.admitBytes(write))
+}
+
+func TestRequestQuota(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	c := NewSingleTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Quota.CheckDuration.Duration = time.Millisecond * 100
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	s := c.stores[0]
+	client := s.Prophet().GetClient()
+	assert.NoError(t, SetRequestQuota(client, bhmetapb.RequestQuota{Tenant: "t1", WriteBytes: 1}))
+	quotas, err := GetRequestQuotas(client)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(quotas))
+	assert.Equal(t, uint64(1), quotas[0].WriteBytes)
+
+	timeout := time.After(time.Second * 10)
+	for {
+		s.quotas.Lock()
+		n := len(s.quotas.tenants)
+		s.quotas.Unlock()
+		if n == 1 {
+			break
+		}
+
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait request quota timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+
+	// the first write overdraws the write bytes quota of the tenant
+	req := createTestWriteReq("w1", "key1", "value1")
+	req.Tenant = "t1"
+	resps, err := sendTestReqs(s, time.Second*10, nil, nil, req)
+	assert.NoError(t, err)
+	assert.Nil(t, resps["w1"].Header)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	req = createTestWriteReq("w2", "key2", "value2")
+	req.Tenant = "t1"
+	resps, err = sendTestReqs(s, time.Second*10, nil, nil, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", resps["w2"].Header.Error.QuotaExceeded.Tenant)
+
+	// the writes of the other tenants are not limited
+	resps, err = sendTestReqs(s, time.Second*10, nil, nil, createTestWriteReq("w3", "key3", "value3"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w3"].Responses[0].Value))
+
+	assert.NoError(t, RemoveRequestQuota(client, "t1", 0))
+	quotas, err = GetRequestQuotas(client)
+	assert.NoError(t, err)
+	assert.Empty(t, quotas)
+}
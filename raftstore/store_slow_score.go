@@ -0,0 +1,108 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	minSlowScore = 1
+	maxSlowScore = 100
+)
+
+// slowScore measures whether the disk of the store is slow by the latency of the raft
+// log append and apply. The score grows with the ratio of the timeouts in a heartbeat
+// interval, and is halved by an interval without timeouts. The score is kept if there
+// is no raft log appended or applied in the interval. The outstanding operations which
+// exceed the threshold are counted as the timeouts in every interval, so a hung disk is
+// reported even if no operation is finished.
+type slowScore struct {
+	sync.Mutex
+
+	threshold   time.Duration
+	total       uint64
+	timeouts    uint64
+	score       float64
+	seq         uint64
+	outstanding map[uint64]time.Time
+}
+
+func newSlowScore(threshold time.Duration) *slowScore {
+	return &slowScore{
+		threshold:   threshold,
+		score:       minSlowScore,
+		outstanding: make(map[uint64]time.Time),
+	}
+}
+
+// begin adds an outstanding operation started at the start, returns the id of the
+// operation which is passed to the record after the operation finished.
+func (s *slowScore) begin(start time.Time) uint64 {
+	s.Lock()
+	defer s.Unlock()
+
+	s.seq++
+	s.outstanding[s.seq] = start
+	return s.seq
+}
+
+func (s *slowScore) record(id uint64) {
+	s.Lock()
+	defer s.Unlock()
+
+	start, ok := s.outstanding[id]
+	if !ok {
+		return
+	}
+	delete(s.outstanding, id)
+
+	s.total++
+	if time.Since(start) >= s.threshold {
+		s.timeouts++
+	}
+}
+
+// tick updates the score by the timeouts since the last tick, and returns the new score
+func (s *slowScore) tick() uint64 {
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+	for _, start := range s.outstanding {
+		if now.Sub(start) >= s.threshold {
+			s.total++
+			s.timeouts++
+		}
+	}
+
+	if s.total > 0 {
+		if s.timeouts > 0 {
+			s.score *= 1 + float64(s.timeouts)/float64(s.total)
+			if s.score > maxSlowScore {
+				s.score = maxSlowScore
+			}
+		} else {
+			s.score /= 2
+			if s.score < minSlowScore {
+				s.score = minSlowScore
+			}
+		}
+	}
+
+	s.total = 0
+	s.timeouts = 0
+	return uint64(s.score)
+}
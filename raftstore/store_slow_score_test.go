@@ -0,0 +1,70 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowScore(t *testing.T) {
+	s := newSlowScore(time.Millisecond * 10)
+	assert.Equal(t, uint64(minSlowScore), s.tick())
+
+	// all timeouts double the score
+	slow := time.Now().Add(-time.Millisecond * 20)
+	for i := 0; i < 6; i++ {
+		s.record(s.begin(slow))
+		assert.Equal(t, uint64(1)<<(i+1), s.tick())
+	}
+	s.record(s.begin(slow))
+	assert.Equal(t, uint64(maxSlowScore), s.tick())
+
+	// the score is kept without any raft log
+	assert.Equal(t, uint64(maxSlowScore), s.tick())
+
+	// half timeouts
+	s.record(s.begin(slow))
+	s.record(s.begin(time.Now()))
+	assert.Equal(t, uint64(maxSlowScore), s.tick())
+
+	// recovered
+	s.record(s.begin(time.Now()))
+	assert.Equal(t, uint64(maxSlowScore/2), s.tick())
+	for i := 0; i < 10; i++ {
+		s.record(s.begin(time.Now()))
+		s.tick()
+	}
+	assert.Equal(t, uint64(minSlowScore), s.tick())
+}
+
+func TestSlowScoreWithHungOperation(t *testing.T) {
+	s := newSlowScore(time.Millisecond * 10)
+
+	// the hung operation is counted as a timeout in every tick until it finished
+	op := s.begin(time.Now().Add(-time.Millisecond * 20))
+	assert.Equal(t, uint64(2), s.tick())
+	assert.Equal(t, uint64(4), s.tick())
+	s.record(op)
+	assert.Equal(t, uint64(8), s.tick())
+	assert.Equal(t, uint64(8), s.tick())
+
+	// the outstanding operation within the threshold is not counted
+	op = s.begin(time.Now())
+	assert.Equal(t, uint64(8), s.tick())
+	s.record(op)
+	assert.Equal(t, uint64(4), s.tick())
+}
@@ -0,0 +1,102 @@
+package raftstore
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterWithTLS(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	dir, err := ioutil.TempDir("", "raftstore-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	tls := createTestCertificates(t, dir)
+
+	c := NewTestClusterStore(t,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.TLS = tls
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	for i := 0; i < 3; i++ {
+		assert.NotNil(t, c.GetStore(i).TLS())
+	}
+
+	shard := c.GetShardByIndex(0)
+	s := c.GetShardLeaderStore(shard.ID)
+	sendTestWrites(t, s, "key1")
+	resps, err := sendTestReqs(s, time.Second*10, nil, nil, createTestReadReq("r-key1", "key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value", string(resps["r-key1"].Responses[0].Value))
+}
+
+// createTestCertificates creates a CA and a certificate of 127.0.0.1 signed by the CA
+func createTestCertificates(t *testing.T, dir string) tlsutil.Config {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	caTpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTpl, caTpl, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	assert.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, ca, &key.PublicKey, caKey)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	cfg := tlsutil.Config{
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		CertFile:       filepath.Join(dir, "cert.pem"),
+		KeyFile:        filepath.Join(dir, "key.pem"),
+		ClientCertAuth: true,
+	}
+	for file, block := range map[string]*pem.Block{
+		cfg.CAFile:   {Type: "CERTIFICATE", Bytes: caDER},
+		cfg.CertFile: {Type: "CERTIFICATE", Bytes: der},
+		cfg.KeyFile:  {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	} {
+		assert.NoError(t, ioutil.WriteFile(file, pem.EncodeToMemory(block), 0600))
+	}
+	return cfg
+}
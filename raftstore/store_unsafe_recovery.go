@@ -0,0 +1,621 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	pconfig "github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"go.etcd.io/etcd/raft"
+)
+
+var (
+	// ErrUnsafeRecoveryRunning the unsafe recovery is running, a new unsafe recovery can be
+	// started after it completed
+	ErrUnsafeRecoveryRunning = errors.New("unsafe recovery is running")
+
+	errUnsafeRecoveryEpochChanged = errors.New("shard epoch changed during the unsafe recovery")
+	errUnsafeRecoveryMerging      = errors.New("shard is merging")
+)
+
+// StartUnsafeRecovery starts to recover the shards which lost the raft majority because
+// of the failed containers. The failed containers must be stopped permanently, they can
+// be tombstoned by `RemoveContainer` and `BuryContainer` after the recovery. For each
+// shard which has the quorum on the failed containers, the surviving replica with the
+// most up-to-date raft log, which is compared by the last term and the last index like
+// the raft election, is forced to be the only member of the shard, and the replica
+// checker rebuilds the other replicas. The writes committed after the last index of the
+// chosen replica may be lost, see `UnsafeRecoveryProgress`.
+func StartUnsafeRecovery(client prophet.Client, failedContainers []uint64) error {
+	if len(failedContainers) == 0 {
+		return errors.New("missing failed containers")
+	}
+
+	progress, err := GetUnsafeRecoveryProgress(client)
+	if err == nil {
+		if !progress.Completed {
+			return ErrUnsafeRecoveryRunning
+		}
+
+		// remove the completed job, only one unsafe recovery job can exist
+		err := client.RemoveJob(metapb.Job{Type: metapb.JobType_UnsafeRecovery})
+		if err != nil {
+			return err
+		}
+	}
+
+	return client.CreateJob(metapb.Job{Type: metapb.JobType_UnsafeRecovery, Content: protoc.MustMarshal(&metapb.UnsafeRecoveryJob{
+		FailedContainers: failedContainers,
+	})})
+}
+
+// GetUnsafeRecoveryProgress returns the progress of the latest unsafe recovery. For every
+// recovered shard, the writes to the shard which were committed after the last index of
+// the chosen replica may be lost.
+func GetUnsafeRecoveryProgress(client prophet.Client) (bhmetapb.UnsafeRecoveryProgress, error) {
+	progress := bhmetapb.UnsafeRecoveryProgress{}
+	v, err := client.ExecuteJob(metapb.Job{Type: metapb.JobType_UnsafeRecovery}, protoc.MustMarshal(&bhmetapb.UnsafeRecoveryCmd{
+		Type: bhmetapb.UnsafeRecoveryCmdType_GetUnsafeRecoveryProgress,
+	}))
+	if err != nil {
+		return progress, err
+	}
+
+	protoc.MustUnmarshal(&progress, v)
+	return progress, nil
+}
+
+// handleUnsafeRecovery fetches the shards which need to report the state or to be recovered
+// on the current store from the unsafe recovery job.
+func (s *store) handleUnsafeRecovery() {
+	v, err := s.pd.GetClient().ExecuteJob(metapb.Job{Type: metapb.JobType_UnsafeRecovery}, protoc.MustMarshal(&bhmetapb.UnsafeRecoveryCmd{
+		Type:   bhmetapb.UnsafeRecoveryCmdType_FetchUnsafeRecoveryTasks,
+		Report: bhmetapb.UnsafeRecoveryReport{ContainerID: s.meta.meta.ID},
+	}))
+	if err != nil {
+		// no unsafe recovery job
+		return
+	}
+
+	tasks := bhmetapb.UnsafeRecoveryTasks{}
+	protoc.MustUnmarshal(&tasks, v)
+	for _, us := range tasks.Shards {
+		switch us.State {
+		case bhmetapb.UnsafeRecoveryState_Collecting:
+			s.startUnsafeRecoveryReport(us)
+		case bhmetapb.UnsafeRecoveryState_Recovering:
+			s.startUnsafeRecovery(us)
+		case bhmetapb.UnsafeRecoveryState_Recovered:
+			s.startUnsafeRecoveryDestroy(us)
+		}
+	}
+}
+
+// startUnsafeRecoveryReport reports the raft log state and the applied index of the replica
+// on the event loop.
+func (s *store) startUnsafeRecoveryReport(us bhmetapb.UnsafeRecoveryShard) {
+	if _, ok := s.unsafeRecoveries.LoadOrStore(us.Shard.ID, struct{}{}); ok {
+		return
+	}
+
+	pr := s.getPR(us.Shard.ID, false)
+	if pr == nil {
+		s.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState, us.Shard.ID, bhmetapb.UnsafeRecoveryReport{}, errShardNotFound)
+		return
+	}
+
+	pr.addAction(action{actionType: unsafeRecoveryReportAction})
+}
+
+// startUnsafeRecovery forces the replica to be the only member of the shard on the event loop.
+func (s *store) startUnsafeRecovery(us bhmetapb.UnsafeRecoveryShard) {
+	if _, ok := s.unsafeRecoveries.LoadOrStore(us.Shard.ID, struct{}{}); ok {
+		return
+	}
+
+	pr := s.getPR(us.Shard.ID, false)
+	if pr == nil {
+		s.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask, us.Shard.ID, bhmetapb.UnsafeRecoveryReport{}, errShardNotFound)
+		return
+	}
+
+	pr.addAction(action{actionType: unsafeRecoveryAction, epoch: us.Shard.Epoch})
+}
+
+// startUnsafeRecoveryDestroy destroys the replica which is not chosen by the unsafe recovery
+// on the event loop, after the shard is recovered from the replica on the other store.
+func (s *store) startUnsafeRecoveryDestroy(us bhmetapb.UnsafeRecoveryShard) {
+	old := findPeer(&us.Shard, s.meta.meta.ID)
+	if old == nil {
+		return
+	}
+
+	pr := s.getPR(us.Shard.ID, false)
+	if pr == nil {
+		return
+	}
+
+	pr.addAction(action{actionType: unsafeRecoveryDestroyAction, peerID: old.ID})
+}
+
+func (s *store) reportUnsafeRecovery(cmdType bhmetapb.UnsafeRecoveryCmdType, shardID uint64, report bhmetapb.UnsafeRecoveryReport, err error) {
+	defer s.unsafeRecoveries.Delete(shardID)
+
+	report.ContainerID = s.meta.meta.ID
+	cmd := &bhmetapb.UnsafeRecoveryCmd{
+		Type:    cmdType,
+		ShardID: shardID,
+		Report:  report,
+	}
+	if err != nil {
+		logger.Errorf("shard %d unsafe recovery failed with %+v",
+			shardID,
+			err)
+		cmd.Report.Error = err.Error()
+	}
+
+	_, err = s.pd.GetClient().ExecuteJob(metapb.Job{Type: metapb.JobType_UnsafeRecovery}, protoc.MustMarshal(cmd))
+	if err != nil {
+		logger.Errorf("shard %d report unsafe recovery state failed with %+v, retry later",
+			shardID,
+			err)
+	}
+}
+
+// doUnsafeRecoveryReport reports the last term and the last index of the raft log on the
+// event loop, and the applied index on the apply worker.
+func (pr *peerReplica) doUnsafeRecoveryReport() {
+	lastIndex, err := pr.ps.LastIndex()
+	if err != nil {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, err)
+		return
+	}
+	lastTerm, err := pr.ps.Term(lastIndex)
+	if err != nil {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, err)
+		return
+	}
+
+	err = pr.store.addApplyJob(pr.applyWorker, "doUnsafeRecoveryReport", func() error {
+		value, ok := pr.store.delegates.Load(pr.shardID)
+		if !ok {
+			pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, errShardNotFound)
+			return nil
+		}
+
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState, pr.shardID, bhmetapb.UnsafeRecoveryReport{
+			AppliedIndex: value.(*applyDelegate).applyState.AppliedIndex,
+			LastTerm:     lastTerm,
+			LastIndex:    lastIndex,
+		}, nil)
+		return nil
+	}, nil)
+	if err != nil {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, err)
+	}
+}
+
+// doUnsafeRecovery removes all the other peers from the raft group and the shard, and
+// campaigns to be the leader of the single member raft group.
+func (pr *peerReplica) doUnsafeRecovery(epoch metapb.ResourceEpoch) {
+	shard := pr.ps.shard
+	if pr.ps.mergeState != nil {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, errUnsafeRecoveryMerging)
+		return
+	}
+	if shard.Epoch.Version != epoch.Version {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, errUnsafeRecoveryEpochChanged)
+		return
+	}
+
+	// already recovered, but the completion was not reported
+	if len(shard.Peers) == 1 && shard.Peers[0].ID == pr.peer.ID {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask, pr.shardID, bhmetapb.UnsafeRecoveryReport{AppliedIndex: pr.ps.getAppliedIndex()}, nil)
+		return
+	}
+
+	self := findPeer(&shard, pr.store.meta.meta.ID)
+	if self == nil {
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask, pr.shardID, bhmetapb.UnsafeRecoveryReport{}, errShardNotFound)
+		return
+	}
+
+	for _, p := range shard.Peers {
+		if p.ID != self.ID {
+			pr.peerHeartbeatsMap.Delete(p.ID)
+			pr.store.peers.Delete(p.ID)
+		}
+	}
+
+	newShard, err := pr.ps.forceSingleMember(*self)
+	if err != nil {
+		logger.Fatalf("shard %d force single member failed with %+v",
+			pr.shardID,
+			err)
+	}
+
+	// The conf changes are not in the raft log, so recreate the raft node with the conf
+	// state loaded from the persisted shard, the same as the raft node created after
+	// restart.
+	rn, err := raft.NewRawNode(getRaftConfig(pr.peer.ID, pr.ps.getAppliedIndex(), pr.ps, pr.store.cfg))
+	if err != nil {
+		logger.Fatalf("shard %d recreate raft node failed with %+v",
+			pr.shardID,
+			err)
+	}
+	pr.rn = rn
+
+	// The apply job is added before the campaign, so the logs committed by the new
+	// leader are applied with the new shard.
+	appliedIndex := pr.ps.getAppliedIndex()
+	err = pr.store.addApplyJob(pr.applyWorker, "doUnsafeRecovery", func() error {
+		if value, ok := pr.store.delegates.Load(pr.shardID); ok {
+			value.(*applyDelegate).shard = newShard
+		}
+		pr.store.reportUnsafeRecovery(bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask, pr.shardID, bhmetapb.UnsafeRecoveryReport{AppliedIndex: appliedIndex}, nil)
+		return nil
+	}, nil)
+	if err != nil {
+		logger.Fatalf("shard %d add unsafe recovery job failed with %+v",
+			pr.shardID,
+			err)
+	}
+
+	logger.Warningf("shard %d is recovered by the unsafe recovery at applied index %d, old peers %+v, new peers %+v",
+		pr.shardID,
+		appliedIndex,
+		shard.Peers,
+		newShard.Peers)
+
+	if err := pr.rn.Campaign(); err != nil {
+		logger.Errorf("shard %d campaign after unsafe recovery failed with %+v",
+			pr.shardID,
+			err)
+	}
+}
+
+// doUnsafeRecoveryDestroy destroys the replica which is not a member of the recovered
+// shard. The replica rebuilt on the same store by the replica checker has a new peer id,
+// it is kept.
+func (pr *peerReplica) doUnsafeRecoveryDestroy(peerID uint64) {
+	if pr.peer.ID != peerID {
+		return
+	}
+
+	logger.Infof("shard %d destroy the replica which is not chosen by the unsafe recovery",
+		pr.shardID)
+	pr.store.doDestroy(pr.shardID, false)
+}
+
+// unsafeRecoveryJob coordinates the unsafe recovery on the prophet leader. The shards
+// which have the quorum on the failed containers are collected when the job started.
+// Every surviving replica of these shards reports the last term and the last index of its
+// raft log and its applied index. The replica with the most up-to-date raft log is chosen
+// to recover the shard, the applied index is only used to break the tie. The other
+// surviving replicas are destroyed.
+type unsafeRecoveryJob struct {
+	cfg *config.Config
+
+	mu struct {
+		sync.Mutex
+
+		state    int
+		job      metapb.Job
+		progress bhmetapb.UnsafeRecoveryProgress
+	}
+}
+
+func newUnsafeRecoveryJob(cfg *config.Config) *unsafeRecoveryJob {
+	j := &unsafeRecoveryJob{cfg: cfg}
+	cfg.Prophet.RegisterJobProcessor(metapb.JobType_UnsafeRecovery, j)
+	return j
+}
+
+func (j *unsafeRecoveryJob) Start(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.isStartedLocked() {
+		return
+	}
+
+	// load or init the job data
+	value, err := store.GetJobData(job)
+	if err != nil {
+		return
+	}
+	j.mu.progress = bhmetapb.UnsafeRecoveryProgress{}
+	if len(value) > 0 {
+		protoc.MustUnmarshal(&j.mu.progress, value)
+	} else {
+		jobContent := &metapb.UnsafeRecoveryJob{}
+		protoc.MustUnmarshal(jobContent, job.Content)
+
+		j.mu.progress.FailedContainers = jobContent.FailedContainers
+		for g := uint64(0); g < j.cfg.ShardGroups; g++ {
+			aware.ForeachResources(g, func(res metadata.Resource) {
+				shard := res.(*resourceAdapter).meta
+				if us, ok := newUnsafeRecoveryShard(shard, jobContent.FailedContainers); ok {
+					j.mu.progress.Shards = append(j.mu.progress.Shards, us)
+				}
+			})
+		}
+	}
+
+	j.mu.state = 1
+	j.mu.job = job
+	j.maybeCompleteLocked()
+	if err := j.saveLocked(store); err != nil {
+		return
+	}
+
+	logger.Warningf("unsafe recovery job started with %d shards lost the majority on the failed containers %+v",
+		len(j.mu.progress.Shards),
+		j.mu.progress.FailedContainers)
+}
+
+func (j *unsafeRecoveryJob) Stop(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.mu.state = 0
+}
+
+func (j *unsafeRecoveryJob) Remove(job metapb.Job, store storage.JobStorage, aware pconfig.ResourcesAware) {
+	j.Stop(job, store, aware)
+	if err := store.RemoveJobData(job); err != nil {
+		logger.Errorf("remove unsafe recovery job data failed with %+v", err)
+	}
+}
+
+func (j *unsafeRecoveryJob) Execute(data []byte, store storage.JobStorage, aware pconfig.ResourcesAware) ([]byte, error) {
+	if len(data) <= 0 {
+		return nil, errors.New("error execute data")
+	}
+
+	cmd := &bhmetapb.UnsafeRecoveryCmd{}
+	protoc.MustUnmarshal(cmd, data)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.isStartedLocked() {
+		return nil, fmt.Errorf("job not started")
+	}
+
+	switch cmd.Type {
+	case bhmetapb.UnsafeRecoveryCmdType_FetchUnsafeRecoveryTasks:
+		return j.doFetchLocked(cmd), nil
+	case bhmetapb.UnsafeRecoveryCmdType_ReportUnsafeRecoveryState:
+		return nil, j.doReportLocked(cmd, store)
+	case bhmetapb.UnsafeRecoveryCmdType_CompleteUnsafeRecoveryTask:
+		return nil, j.doCompleteLocked(cmd, store)
+	case bhmetapb.UnsafeRecoveryCmdType_GetUnsafeRecoveryProgress:
+		return protoc.MustMarshal(&j.mu.progress), nil
+	default:
+		return nil, fmt.Errorf("invalid execute cmd %d", cmd.Type)
+	}
+}
+
+func (j *unsafeRecoveryJob) doFetchLocked(cmd *bhmetapb.UnsafeRecoveryCmd) []byte {
+	tasks := &bhmetapb.UnsafeRecoveryTasks{}
+	containerID := cmd.Report.ContainerID
+	for _, us := range j.mu.progress.Shards {
+		if !hasPeerOnContainer(us.Shard, containerID) ||
+			isFailedContainer(j.mu.progress.FailedContainers, containerID) {
+			continue
+		}
+
+		switch us.State {
+		case bhmetapb.UnsafeRecoveryState_Collecting:
+			if !hasUnsafeRecoveryReport(us, containerID) {
+				tasks.Shards = append(tasks.Shards, us)
+			}
+		case bhmetapb.UnsafeRecoveryState_Recovering:
+			if us.ChosenContainerID == containerID {
+				tasks.Shards = append(tasks.Shards, us)
+			}
+		case bhmetapb.UnsafeRecoveryState_Recovered:
+			// the surviving replicas which are not chosen are destroyed after the
+			// shard is recovered
+			if us.ChosenContainerID != containerID {
+				tasks.Shards = append(tasks.Shards, us)
+			}
+		}
+	}
+
+	return protoc.MustMarshal(tasks)
+}
+
+func (j *unsafeRecoveryJob) doReportLocked(cmd *bhmetapb.UnsafeRecoveryCmd, store storage.JobStorage) error {
+	us := j.getShardLocked(cmd.ShardID)
+	if us == nil ||
+		us.State != bhmetapb.UnsafeRecoveryState_Collecting ||
+		!hasPeerOnContainer(us.Shard, cmd.Report.ContainerID) ||
+		hasUnsafeRecoveryReport(*us, cmd.Report.ContainerID) {
+		return nil
+	}
+
+	us.Reports = append(us.Reports, cmd.Report)
+	if len(us.Reports) == len(us.Shard.Peers)-len(us.LostPeers) {
+		// choose the replica with the most up-to-date raft log, the witness has no data and
+		// can't be chosen
+		var chosen *bhmetapb.UnsafeRecoveryReport
+		witnesses := 0
+		for idx := range us.Reports {
+			report := &us.Reports[idx]
+			if p := findPeer(&us.Shard, report.ContainerID); p != nil && p.Witness {
+				witnesses++
+				continue
+			}
+
+			if report.Error == "" &&
+				(chosen == nil || isMoreUpToDate(*report, *chosen)) {
+				chosen = report
+			}
+		}
+
+		if chosen == nil {
+			us.State = bhmetapb.UnsafeRecoveryState_Unrecoverable
+			if witnesses == len(us.Reports) {
+				us.Error = "only the witness replicas survived, which have no data"
+			} else {
+				us.Error = "all the surviving replicas failed to report the state"
+			}
+		} else {
+			us.State = bhmetapb.UnsafeRecoveryState_Recovering
+			us.ChosenContainerID = chosen.ContainerID
+			logger.Warningf("shard %d will be recovered from the replica on container %d at last term %d, last index %d, applied index %d",
+				us.Shard.ID,
+				chosen.ContainerID,
+				chosen.LastTerm,
+				chosen.LastIndex,
+				chosen.AppliedIndex)
+		}
+	}
+
+	j.maybeCompleteLocked()
+	return j.saveLocked(store)
+}
+
+func (j *unsafeRecoveryJob) doCompleteLocked(cmd *bhmetapb.UnsafeRecoveryCmd, store storage.JobStorage) error {
+	us := j.getShardLocked(cmd.ShardID)
+	if us == nil ||
+		us.State != bhmetapb.UnsafeRecoveryState_Recovering ||
+		us.ChosenContainerID != cmd.Report.ContainerID {
+		return nil
+	}
+
+	if cmd.Report.Error != "" {
+		us.State = bhmetapb.UnsafeRecoveryState_Unrecoverable
+		us.Error = fmt.Sprintf("recover on container %d failed with %s",
+			cmd.Report.ContainerID,
+			cmd.Report.Error)
+	} else {
+		us.State = bhmetapb.UnsafeRecoveryState_Recovered
+	}
+	logger.Warningf("shard %d unsafe recovery completed with state %s, lost peers %+v",
+		us.Shard.ID,
+		us.State.String(),
+		us.LostPeers)
+
+	j.maybeCompleteLocked()
+	return j.saveLocked(store)
+}
+
+func (j *unsafeRecoveryJob) maybeCompleteLocked() {
+	for _, us := range j.mu.progress.Shards {
+		if us.State != bhmetapb.UnsafeRecoveryState_Recovered &&
+			us.State != bhmetapb.UnsafeRecoveryState_Unrecoverable {
+			return
+		}
+	}
+
+	if !j.mu.progress.Completed {
+		j.mu.progress.Completed = true
+		logger.Warningf("unsafe recovery job on the failed containers %+v completed",
+			j.mu.progress.FailedContainers)
+	}
+}
+
+func (j *unsafeRecoveryJob) getShardLocked(id uint64) *bhmetapb.UnsafeRecoveryShard {
+	for idx := range j.mu.progress.Shards {
+		if j.mu.progress.Shards[idx].Shard.ID == id {
+			return &j.mu.progress.Shards[idx]
+		}
+	}
+	return nil
+}
+
+func (j *unsafeRecoveryJob) isStartedLocked() bool {
+	return j.mu.state == 1
+}
+
+func (j *unsafeRecoveryJob) saveLocked(store storage.JobStorage) error {
+	err := store.PutJobData(j.mu.job, protoc.MustMarshal(&j.mu.progress))
+	if err != nil {
+		logger.Errorf("put unsafe recovery job data to storage failed with %+v", err)
+	}
+	return err
+}
+
+// newUnsafeRecoveryShard returns true if the majority of the voters of the shard are on
+// the failed containers.
+func newUnsafeRecoveryShard(shard bhmetapb.Shard, failedContainers []uint64) (bhmetapb.UnsafeRecoveryShard, bool) {
+	us := bhmetapb.UnsafeRecoveryShard{Shard: shard}
+	voters, aliveVoters := 0, 0
+	for _, p := range shard.Peers {
+		failed := isFailedContainer(failedContainers, p.ContainerID)
+		if failed {
+			us.LostPeers = append(us.LostPeers, p)
+		}
+
+		if p.Role == metapb.PeerRole_Voter {
+			voters++
+			if !failed {
+				aliveVoters++
+			}
+		}
+	}
+
+	if aliveVoters >= voters/2+1 {
+		return us, false
+	}
+
+	if len(us.LostPeers) == len(shard.Peers) {
+		us.State = bhmetapb.UnsafeRecoveryState_Unrecoverable
+		us.Error = "all the replicas are lost"
+	}
+	return us, true
+}
+
+func isFailedContainer(failedContainers []uint64, containerID uint64) bool {
+	for _, id := range failedContainers {
+		if id == containerID {
+			return true
+		}
+	}
+	return false
+}
+
+// isMoreUpToDate returns true if the raft log of the replica is more up-to-date than the
+// other one, the same as the raft election. The replica with the higher applied index is
+// chosen if the raft logs are the same.
+func isMoreUpToDate(report, other bhmetapb.UnsafeRecoveryReport) bool {
+	if report.LastTerm != other.LastTerm {
+		return report.LastTerm > other.LastTerm
+	}
+	if report.LastIndex != other.LastIndex {
+		return report.LastIndex > other.LastIndex
+	}
+	return report.AppliedIndex > other.AppliedIndex
+}
+
+func hasUnsafeRecoveryReport(us bhmetapb.UnsafeRecoveryShard, containerID uint64) bool {
+	for _, report := range us.Reports {
+		if report.ContainerID == containerID {
+			return true
+		}
+	}
+	return false
+}
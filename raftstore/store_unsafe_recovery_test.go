@@ -0,0 +1,277 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUnsafeRecoveryShard(t *testing.T) {
+	shard := bhmetapb.Shard{ID: 1, Peers: []metapb.Peer{
+		{ID: 1, ContainerID: 1},
+		{ID: 2, ContainerID: 2},
+		{ID: 3, ContainerID: 3},
+		{ID: 4, ContainerID: 4, Role: metapb.PeerRole_Learner},
+	}}
+
+	_, ok := newUnsafeRecoveryShard(shard, []uint64{1})
+	assert.False(t, ok)
+
+	us, ok := newUnsafeRecoveryShard(shard, []uint64{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, bhmetapb.UnsafeRecoveryState_Collecting, us.State)
+	assert.Equal(t, 2, len(us.LostPeers))
+
+	us, ok = newUnsafeRecoveryShard(shard, []uint64{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.Equal(t, bhmetapb.UnsafeRecoveryState_Unrecoverable, us.State)
+}
+
+func TestUnsafeRecoveryFetchTasks(t *testing.T) {
+	shard := bhmetapb.Shard{ID: 1, Peers: []metapb.Peer{
+		{ID: 1, ContainerID: 1},
+		{ID: 2, ContainerID: 2},
+		{ID: 3, ContainerID: 3},
+	}}
+
+	j := &unsafeRecoveryJob{}
+	j.mu.state = 1
+	j.mu.progress.FailedContainers = []uint64{3}
+	j.mu.progress.Shards = []bhmetapb.UnsafeRecoveryShard{{
+		Shard:             shard,
+		State:             bhmetapb.UnsafeRecoveryState_Recovering,
+		ChosenContainerID: 1,
+	}}
+
+	fetch := func(containerID uint64) []bhmetapb.UnsafeRecoveryShard {
+		tasks := bhmetapb.UnsafeRecoveryTasks{}
+		protoc.MustUnmarshal(&tasks, j.doFetchLocked(&bhmetapb.UnsafeRecoveryCmd{
+			Report: bhmetapb.UnsafeRecoveryReport{ContainerID: containerID},
+		}))
+		return tasks.Shards
+	}
+
+	// the replica which is not chosen is kept until the shard is recovered
+	assert.Equal(t, 1, len(fetch(1)))
+	assert.Equal(t, 0, len(fetch(2)))
+	assert.Equal(t, 0, len(fetch(3)))
+
+	j.mu.progress.Shards[0].State = bhmetapb.UnsafeRecoveryState_Recovered
+	assert.Equal(t, 0, len(fetch(1)))
+	assert.Equal(t, 1, len(fetch(2)))
+	assert.Equal(t, 0, len(fetch(3)))
+}
+
+func TestUnsafeRecoveryReportChooseUpToDateLog(t *testing.T) {
+	shard := bhmetapb.Shard{ID: 1, Peers: []metapb.Peer{
+		{ID: 1, ContainerID: 1},
+		{ID: 2, ContainerID: 2},
+		{ID: 3, ContainerID: 3},
+		{ID: 4, ContainerID: 4},
+		{ID: 5, ContainerID: 5},
+		{ID: 6, ContainerID: 6},
+		{ID: 7, ContainerID: 7},
+	}}
+	report := func(j *unsafeRecoveryJob, containerID, lastTerm, lastIndex, appliedIndex uint64) {
+		assert.NoError(t, j.doReportLocked(&bhmetapb.UnsafeRecoveryCmd{
+			ShardID: 1,
+			Report: bhmetapb.UnsafeRecoveryReport{
+				ContainerID:  containerID,
+				LastTerm:     lastTerm,
+				LastIndex:    lastIndex,
+				AppliedIndex: appliedIndex,
+			},
+		}, storage.NewTestStorage()))
+	}
+	chosen := func(reports ...[4]uint64) uint64 {
+		j := &unsafeRecoveryJob{}
+		us, ok := newUnsafeRecoveryShard(shard, []uint64{4, 5, 6, 7})
+		assert.True(t, ok)
+		j.mu.progress.Shards = []bhmetapb.UnsafeRecoveryShard{us}
+		for _, r := range reports {
+			report(j, r[0], r[1], r[2], r[3])
+		}
+		assert.Equal(t, bhmetapb.UnsafeRecoveryState_Recovering, j.mu.progress.Shards[0].State)
+		return j.mu.progress.Shards[0].ChosenContainerID
+	}
+
+	// the higher last term wins even if the applied index is lower
+	assert.Equal(t, uint64(2), chosen([4]uint64{1, 2, 20, 15}, [4]uint64{2, 3, 10, 5}, [4]uint64{3, 2, 30, 10}))
+	// the higher last index wins with the same last term
+	assert.Equal(t, uint64(3), chosen([4]uint64{1, 3, 20, 15}, [4]uint64{2, 3, 10, 10}, [4]uint64{3, 3, 30, 10}))
+	// the applied index breaks the tie
+	assert.Equal(t, uint64(1), chosen([4]uint64{1, 3, 30, 15}, [4]uint64{2, 3, 30, 10}, [4]uint64{3, 3, 30, 10}))
+}
+
+func TestUnsafeRecoveryReportWithWitness(t *testing.T) {
+	shard := bhmetapb.Shard{ID: 1, Peers: []metapb.Peer{
+		{ID: 1, ContainerID: 1},
+		{ID: 2, ContainerID: 2},
+		{ID: 3, ContainerID: 3, Witness: true},
+		{ID: 4, ContainerID: 4},
+		{ID: 5, ContainerID: 5},
+	}}
+	report := func(j *unsafeRecoveryJob, containerID, appliedIndex uint64) {
+		assert.NoError(t, j.doReportLocked(&bhmetapb.UnsafeRecoveryCmd{
+			ShardID: 1,
+			Report: bhmetapb.UnsafeRecoveryReport{
+				ContainerID:  containerID,
+				LastTerm:     1,
+				LastIndex:    appliedIndex,
+				AppliedIndex: appliedIndex,
+			},
+		}, storage.NewTestStorage()))
+	}
+
+	// the witness is skipped even if it reports the most up-to-date raft log
+	j := &unsafeRecoveryJob{}
+	us, ok := newUnsafeRecoveryShard(shard, []uint64{1, 4, 5})
+	assert.True(t, ok)
+	j.mu.progress.Shards = []bhmetapb.UnsafeRecoveryShard{us}
+	report(j, 2, 10)
+	report(j, 3, 20)
+	assert.Equal(t, bhmetapb.UnsafeRecoveryState_Recovering, j.mu.progress.Shards[0].State)
+	assert.Equal(t, uint64(2), j.mu.progress.Shards[0].ChosenContainerID)
+
+	// the shard is unrecoverable if only the witness survived
+	j = &unsafeRecoveryJob{}
+	us, ok = newUnsafeRecoveryShard(shard, []uint64{1, 2, 4, 5})
+	assert.True(t, ok)
+	j.mu.progress.Shards = []bhmetapb.UnsafeRecoveryShard{us}
+	report(j, 3, 20)
+	assert.Equal(t, bhmetapb.UnsafeRecoveryState_Unrecoverable, j.mu.progress.Shards[0].State)
+	assert.NotEmpty(t, j.mu.progress.Shards[0].Error)
+}
+
+func TestUnsafeRecovery(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// 5 prophet nodes, so the prophet is available after 2 nodes stopped
+	c := NewTestClusterStore(t,
+		WithTestClusterNodeCount(5),
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.UnsafeRecoveryCheckDuration.Duration = time.Millisecond * 100
+			cfg.Prophet.EnableHTTPAdmin = true
+		}))
+	c.Start()
+	defer c.Stop()
+
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	shard := waitTestShardPeers(t, c, 3, time.Second*30)
+	sendTestWrites(t, c.GetShardLeaderStore(shard.ID), "key1")
+
+	// stop the nodes of 2 replicas, keep the node 0 running if possible to avoid
+	// the prophet leader election
+	var failed []uint64
+	survivor := -1
+	for i := 4; i >= 0; i-- {
+		if findPeer(&shard, c.GetStore(i).Meta().ID) == nil {
+			continue
+		}
+
+		if len(failed) < 2 {
+			failed = append(failed, c.GetStore(i).Meta().ID)
+			c.StopNode(i)
+		} else {
+			survivor = i
+		}
+	}
+
+	// the failed containers are still up in the prophet until the max down time,
+	// mark them offline to keep the replica checker from adding the lost replicas
+	// back to them
+	s := c.GetStore(survivor)
+	for _, id := range failed {
+		assert.NoError(t, s.Prophet().GetClient().UpdateContainerState(id, metapb.ContainerState_Offline, true))
+	}
+	assert.NoError(t, StartUnsafeRecovery(s.Prophet().GetClient(), failed))
+	assert.Equal(t, ErrUnsafeRecoveryRunning, StartUnsafeRecovery(s.Prophet().GetClient(), failed))
+
+	timeout := time.After(time.Minute)
+	for {
+		progress, err := GetUnsafeRecoveryProgress(s.Prophet().GetClient())
+		if err == nil && progress.Completed {
+			assert.Equal(t, 1, len(progress.Shards))
+			assert.Equal(t, bhmetapb.UnsafeRecoveryState_Recovered, progress.Shards[0].State)
+			assert.Equal(t, s.Meta().ID, progress.Shards[0].ChosenContainerID)
+			assert.Equal(t, 2, len(progress.Shards[0].LostPeers))
+			break
+		}
+
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait unsafe recovery timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+
+	// wait until the replica checker adds the lost replicas back
+	timeout = time.After(time.Minute)
+	for !hasTestShardVoters(c, shard.ID, 3) {
+		select {
+		case <-timeout:
+			assert.FailNow(t, "wait replicas added back timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+
+	resps, err := sendTestReqs(s, time.Second*10, nil, nil, createTestReadReq("r1", "key1"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "value", string(resps["r1"].Responses[0].Value))
+}
+
+// hasTestShardVoters returns true if the leader of the shard has the number of
+// voters and no learner
+func hasTestShardVoters(c *TestRaftCluster, shardID uint64, voters int) bool {
+	ok := false
+	for _, s := range c.stores {
+		s.foreachPR(func(pr *peerReplica) bool {
+			if pr.shardID == shardID && pr.isLeader() && len(pr.ps.shard.Peers) == voters {
+				ok = true
+				for _, p := range pr.ps.shard.Peers {
+					if p.Role != metapb.PeerRole_Voter {
+						ok = false
+					}
+				}
+			}
+			return true
+		})
+	}
+	return ok
+}
+
+// waitTestShardPeers waits until the leader of a shard has the number of peers
+func waitTestShardPeers(t *testing.T, c *TestRaftCluster, peers int, timeout time.Duration) bhmetapb.Shard {
+	timeoutC := time.After(timeout)
+	for {
+		var shard bhmetapb.Shard
+		for _, s := range c.stores {
+			s.foreachPR(func(pr *peerReplica) bool {
+				if pr.isLeader() && len(pr.ps.shard.Peers) == peers {
+					shard = pr.ps.shard
+				}
+				return true
+			})
+		}
+		if shard.ID > 0 {
+			return shard
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNow(t, "wait shard peers timeout")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
@@ -35,6 +35,8 @@ import (
 	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/storage/mem"
 	"github.com/matrixorigin/matrixcube/storage/pebble"
+	"github.com/matrixorigin/matrixcube/storage/wal"
+	"github.com/matrixorigin/matrixcube/transport"
 	"github.com/matrixorigin/matrixcube/util"
 	"github.com/matrixorigin/matrixcube/vfs"
 	"github.com/stretchr/testify/assert"
@@ -83,7 +85,10 @@ type testClusterOptions struct {
 	nodeStartFunc      func(node int, store Store)
 	logLevel           string
 	useDisk            bool
+	useWAL             bool
 	dataOpts, metaOpts *cpebble.Options
+	faults             *transport.Faults
+	faultInjector      *vfs.FaultInjector
 
 	writeHandlers map[uint64]command.WriteCommandFunc
 	readHandlers  map[uint64]command.ReadCommandFunc
@@ -134,6 +139,13 @@ func WithTestClusterUseDisk() TestClusterOption {
 	}
 }
 
+// WithTestClusterUseWAL use the wal.Storage to store the raft logs
+func WithTestClusterUseWAL() TestClusterOption {
+	return func(opts *testClusterOptions) {
+		opts.useWAL = true
+	}
+}
+
 // WithTestClusterDisableSchedule disable pd schedule
 func WithTestClusterDisableSchedule() TestClusterOption {
 	return func(opts *testClusterOptions) {
@@ -204,6 +216,23 @@ func WithAppendTestClusterAdjustConfigFunc(value func(node int, cfg *config.Conf
 	}
 }
 
+// WithTestClusterFaults injects the faults of the rules into the raft messages sent by
+// all the stores, the rules can be changed at runtime to partition the network, drop,
+// delay or duplicate the raft messages.
+func WithTestClusterFaults(faults *transport.Faults) TestClusterOption {
+	return func(opts *testClusterOptions) {
+		opts.faults = faults
+	}
+}
+
+// WithTestClusterFaultInjector injects the latency and the errors of the injector into the
+// FS of all the stores.
+func WithTestClusterFaultInjector(inj *vfs.FaultInjector) TestClusterOption {
+	return func(opts *testClusterOptions) {
+		opts.faultInjector = inj
+	}
+}
+
 func recreateTestTempDir(fs vfs.FS, tmpDir string) {
 	fs.RemoveAll(tmpDir)
 	fs.MkdirAll(tmpDir, 0755)
@@ -349,6 +378,21 @@ func (ts *testShardAware) Splited(shard bhmetapb.Shard) {
 	}
 }
 
+func (ts *testShardAware) Merged(shard bhmetapb.Shard) {
+	ts.Lock()
+	defer ts.Unlock()
+
+	for idx := range ts.shards {
+		if ts.shards[idx].ID == shard.ID {
+			ts.shards[idx] = shard
+		}
+	}
+
+	if ts.wrapper != nil {
+		ts.wrapper.Merged(shard)
+	}
+}
+
 func (ts *testShardAware) Destory(shard bhmetapb.Shard) {
 	ts.Lock()
 	defer ts.Unlock()
@@ -415,6 +459,7 @@ type TestRaftCluster struct {
 	awares           []*testShardAware
 	dataStorages     []storage.DataStorage
 	metadataStorages []storage.MetadataStorage
+	logStorages      []storage.LogStorage
 }
 
 // NewSingleTestClusterStore create test cluster with 1 node
@@ -437,6 +482,7 @@ func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 	c.awares = nil
 	c.dataStorages = nil
 	c.metadataStorages = nil
+	c.logStorages = nil
 
 	for _, opt := range opts {
 		opt(c.opts)
@@ -454,6 +500,9 @@ func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 	for i := 0; i < c.opts.nodes; i++ {
 		cfg := &config.Config{}
 		cfg.FS = vfs.GetTestFS()
+		if c.opts.faultInjector != nil {
+			cfg.FS = vfs.NewFaultFS(cfg.FS, c.opts.faultInjector)
+		}
 		cfg.DataPath = fmt.Sprintf("%s/node-%d", c.opts.tmpDir, i)
 		if c.opts.recreate {
 			recreateTestTempDir(cfg.FS, cfg.DataPath)
@@ -505,6 +554,12 @@ func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 			cfg.Storage.MetaStorage = metaStorage
 			c.metadataStorages = append(c.metadataStorages, metaStorage)
 		}
+		if cfg.Storage.LogStorage == nil && c.opts.useWAL {
+			s, err := wal.NewStorage(cfg.FS, cfg.FS.PathJoin(cfg.DataPath, "wal"), 0)
+			assert.NoError(c.t, err)
+			cfg.Storage.LogStorage = s
+			c.logStorages = append(c.logStorages, s)
+		}
 		if cfg.Storage.DataStorageFactory == nil {
 			var dataStorage storage.DataStorage
 			dataStorage = mem.NewStorage(cfg.FS)
@@ -528,6 +583,12 @@ func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 		cfg.Customize.TestShardStateAware = ts
 
 		var s *store
+		if c.opts.faults != nil && cfg.Customize.CustomTransportFactory == nil {
+			faults := c.opts.faults
+			cfg.Customize.CustomTransportFactory = func() transport.Transport {
+				return transport.NewFaultTransport(s.newDefaultTransport(), faults)
+			}
+		}
 		if c.opts.storeFactory != nil {
 			s = c.opts.storeFactory(i, cfg).(*store)
 		} else {
@@ -625,6 +686,10 @@ func (c *TestRaftCluster) Stop() {
 		s.Close()
 	}
 
+	for _, s := range c.logStorages {
+		s.Close()
+	}
+
 	for _, s := range c.metadataStorages {
 		s.Close()
 	}
@@ -638,6 +703,30 @@ func (c *TestRaftCluster) Stop() {
 	}
 }
 
+// PartitionNodes drops all the raft messages between the left nodes and the right nodes
+// by the faults of WithTestClusterFaults, returns the id of the fault rule to heal the
+// partition.
+func (c *TestRaftCluster) PartitionNodes(left, right []int) uint64 {
+	if c.opts.faults == nil {
+		panic("faults not set")
+	}
+
+	return c.opts.faults.Partition(c.nodeStoreIDs(left), c.nodeStoreIDs(right))
+}
+
+// HealPartition removes the fault rule created by PartitionNodes
+func (c *TestRaftCluster) HealPartition(id uint64) {
+	c.opts.faults.Remove(id)
+}
+
+func (c *TestRaftCluster) nodeStoreIDs(nodes []int) []uint64 {
+	var ids []uint64
+	for _, node := range nodes {
+		ids = append(ids, c.stores[node].Meta().ID)
+	}
+	return ids
+}
+
 // GetPRCount returns peer replica count of node
 func (c *TestRaftCluster) GetPRCount(nodeIndex int) int {
 	cnt := 0
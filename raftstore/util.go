@@ -13,6 +13,8 @@
 package raftstore
 
 import (
+	"bytes"
+
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 )
@@ -37,6 +39,12 @@ func findPeer(shard *bhmetapb.Shard, storeID uint64) *metapb.Peer {
 	return nil
 }
 
+// shardContains returns true if the key range of the shard covers the other one
+func shardContains(shard, other bhmetapb.Shard) bool {
+	return bytes.Compare(shard.Start, other.Start) <= 0 &&
+		(len(shard.End) == 0 || (len(other.End) > 0 && bytes.Compare(other.End, shard.End) <= 0))
+}
+
 func removePeer(shard *bhmetapb.Shard, storeID uint64) *metapb.Peer {
 	var removed *metapb.Peer
 	var newPeers []metapb.Peer
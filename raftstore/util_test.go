@@ -29,6 +29,16 @@ func TestIsEpochStale(t *testing.T) {
 	assert.False(t, isEpochStale(metapb.ResourceEpoch{Version: 2}, metapb.ResourceEpoch{Version: 1}))
 }
 
+func TestShardContains(t *testing.T) {
+	assert.True(t, shardContains(bhmetapb.Shard{}, bhmetapb.Shard{Start: []byte("a"), End: []byte("b")}))
+	assert.True(t, shardContains(bhmetapb.Shard{End: []byte("c")}, bhmetapb.Shard{Start: []byte("a"), End: []byte("c")}))
+	assert.True(t, shardContains(bhmetapb.Shard{Start: []byte("a")}, bhmetapb.Shard{Start: []byte("b")}))
+
+	assert.False(t, shardContains(bhmetapb.Shard{End: []byte("b")}, bhmetapb.Shard{Start: []byte("a"), End: []byte("c")}))
+	assert.False(t, shardContains(bhmetapb.Shard{End: []byte("b")}, bhmetapb.Shard{Start: []byte("a")}))
+	assert.False(t, shardContains(bhmetapb.Shard{Start: []byte("b")}, bhmetapb.Shard{Start: []byte("a"), End: []byte("c")}))
+}
+
 func TestFindPeer(t *testing.T) {
 	shard := &bhmetapb.Shard{
 		Peers: []metapb.Peer{
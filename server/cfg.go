@@ -14,6 +14,8 @@
 package server
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixcube/raftstore"
 )
 
@@ -23,4 +25,10 @@ type Cfg struct {
 	Store          raftstore.Store
 	Handler        Handler
 	ExternalServer bool
+	// EnableTxn enable the distributed transactions, the txn commands are registered to the
+	// store, and the application can use NewTxn to create transactions.
+	EnableTxn bool
+	// TxnLockTTL the ttl of the txn locks, the lock can be rolled back by other transactions
+	// after expired. Default is 3s.
+	TxnLockTTL time.Duration
 }
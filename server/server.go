@@ -24,10 +24,13 @@ import (
 	"github.com/fagongzi/log"
 	"github.com/fagongzi/util/hack"
 	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/components/prophet"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/errorpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/proxy"
+	"github.com/matrixorigin/matrixcube/txn"
 	"github.com/matrixorigin/matrixcube/util"
 )
 
@@ -38,6 +41,7 @@ var (
 // Application a tcp application server
 type Application struct {
 	cfg         Cfg
+	oracle      *prophet.TimestampOracle
 	server      goetty.NetApplication
 	shardsProxy proxy.ShardsProxy
 	libaryCB    sync.Map // id -> application cb
@@ -73,7 +77,14 @@ func NewApplicationWithDispatcher(cfg Cfg, dispatcher func(req *raftcmdpb.Reques
 
 // Start start the application server
 func (s *Application) Start() error {
+	if s.cfg.EnableTxn {
+		txn.RegisterCommands(s.cfg.Store)
+	}
 	s.cfg.Store.Start()
+	if s.cfg.EnableTxn {
+		s.oracle = prophet.NewTimestampOracle(s.cfg.Store.Prophet().GetClient(), 0)
+	}
+
 	sp, err := proxy.NewShardsProxyWithStore(s.cfg.Store, s.done, s.doneError)
 	if err != nil {
 		return err
@@ -91,6 +102,9 @@ func (s *Application) Start() error {
 
 // Stop stop redis server
 func (s *Application) Stop() {
+	if s.oracle != nil {
+		s.oracle.Close()
+	}
 	if s.cfg.ExternalServer {
 		return
 	}
@@ -109,6 +123,19 @@ func (s *Application) Exec(cmd interface{}, timeout time.Duration) ([]byte, erro
 
 // ExecWithGroup exec the request command
 func (s *Application) ExecWithGroup(cmd interface{}, group uint64, timeout time.Duration) ([]byte, error) {
+	return syncExec(func(cb func(interface{}, []byte, error)) {
+		s.AsyncExecWithGroupAndTimeout(cmd, group, cb, timeout, nil)
+	})
+}
+
+// execRequest exec the request which is already built, and wait for the response
+func (s *Application) execRequest(req *raftcmdpb.Request, timeout time.Duration) ([]byte, error) {
+	return syncExec(func(cb func(interface{}, []byte, error)) {
+		s.doExec(req, nil, cb, timeout, nil)
+	})
+}
+
+func syncExec(fn func(cb func(interface{}, []byte, error))) ([]byte, error) {
 	completeC := make(chan interface{}, 1)
 	closed := uint32(0)
 	cb := func(cmd interface{}, resp []byte, err error) {
@@ -122,7 +149,7 @@ func (s *Application) ExecWithGroup(cmd interface{}, group uint64, timeout time.
 		}
 	}
 
-	s.AsyncExecWithGroupAndTimeout(cmd, group, cb, timeout, nil)
+	fn(cb)
 	value := <-completeC
 	switch v := value.(type) {
 	case error:
@@ -156,6 +183,12 @@ func (s *Application) AsyncExecWithGroupAndTimeout(cmd interface{}, group uint64
 		return
 	}
 
+	s.doExec(req, cmd, cb, timeout, arg)
+}
+
+// doExec dispatch the request, the cmd is the application's command which the request is
+// built from, it's nil if the request is built by matrixcube itself, e.g. the txn commands.
+func (s *Application) doExec(req *raftcmdpb.Request, cmd interface{}, cb func(interface{}, []byte, error), timeout time.Duration, arg interface{}) {
 	s.libaryCB.Store(hack.SliceToString(req.ID), ctx{
 		arg: arg,
 		cb:  cb,
@@ -164,7 +197,8 @@ func (s *Application) AsyncExecWithGroupAndTimeout(cmd interface{}, group uint64
 		util.DefaultTimeoutWheel().Schedule(timeout, s.execTimeout, req.ID)
 	}
 
-	if s.dispatcher != nil {
+	var err error
+	if s.dispatcher != nil && cmd != nil {
 		err = s.dispatcher(req, cmd, s.shardsProxy)
 	} else {
 		err = s.shardsProxy.Dispatch(req)
@@ -232,9 +266,7 @@ func (s *Application) onMessage(conn goetty.IOSession, cmd interface{}, seq uint
 	}
 
 	if err != nil {
-		resp := &raftcmdpb.Response{}
-		resp.Error.Message = err.Error()
-		conn.WriteAndFlush(resp)
+		conn.WriteAndFlush(errorResp(req, err))
 	}
 	return nil
 }
@@ -294,10 +326,22 @@ func (s *Application) doneError(resp *raftcmdpb.Request, err error) {
 	}
 
 	if conn, _ := s.server.GetSession(uint64(resp.SID)); conn != nil {
-		resp := &raftcmdpb.Response{}
-		resp.Error.Message = err.Error()
-		conn.WriteAndFlush(resp)
+		conn.WriteAndFlush(errorResp(resp, err))
+	}
+}
+
+// errorResp returns the error response of the request, the client can back off by the
+// QuotaExceeded error if the request is rejected by the request quota.
+func errorResp(req *raftcmdpb.Request, err error) *raftcmdpb.Response {
+	resp := &raftcmdpb.Response{}
+	resp.Error.Message = err.Error()
+	if err == proxy.ErrQuotaExceeded {
+		resp.Error.QuotaExceeded = &errorpb.QuotaExceeded{
+			Tenant: req.Tenant,
+			Group:  req.Group,
+		}
 	}
+	return resp
 }
 
 func (s *Application) continueBroadcast(arg interface{}) {
@@ -126,9 +126,10 @@ func createDiskDataStorageCluster(t *testing.T, opts ...raftstore.TestClusterOpt
 		store.RegisterWriteFunc(1, h.set)
 		store.RegisterReadFunc(2, h.get)
 		return NewApplication(Cfg{
-			Addr:    fmt.Sprintf("127.0.0.1:808%d", i),
-			Store:   store,
-			Handler: h,
+			Addr:      fmt.Sprintf("127.0.0.1:808%d", i),
+			Store:     store,
+			Handler:   h,
+			EnableTxn: true,
 		})
 	}, opts...)
 
@@ -0,0 +1,348 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/txn"
+)
+
+var (
+	// ErrTxnNotEnabled the txn is not enabled in the Cfg
+	ErrTxnNotEnabled = errors.New("txn is not enabled")
+	// ErrTxnClosed the txn is already committed or rolled back
+	ErrTxnClosed = errors.New("txn is closed")
+	// ErrWriteConflict the key is written by other transaction after the txn started
+	ErrWriteConflict = errors.New("txn write conflict")
+	// ErrTxnAborted the txn is rolled back, e.g. the lock is expired and rolled back by others
+	ErrTxnAborted = errors.New("txn aborted")
+	// ErrTxnLockTimeout the lock of other transaction is not resolved before timeout
+	ErrTxnLockTimeout = errors.New("txn wait lock timeout")
+)
+
+var (
+	defaultTxnLockTTL = time.Second * 3
+	minTxnBackoff     = time.Millisecond * 10
+	maxTxnBackoff     = time.Millisecond * 500
+)
+
+// Txn is a distributed transaction which can update multi keys in different shards atomically.
+// The reads of the transaction see a snapshot at the start timestamp, the writes are buffered
+// in the Txn until Commit. Txn is not thread safe.
+type Txn struct {
+	app       *Application
+	group     uint64
+	timeout   time.Duration
+	startTS   uint64
+	closed    bool
+	keys      [][]byte
+	mutations map[string]txnpb.Mutation
+}
+
+// NewTxn create a transaction on the shards of the group, the timeout is used for every request
+// of the transaction.
+func (s *Application) NewTxn(group uint64, timeout time.Duration) (*Txn, error) {
+	if !s.cfg.EnableTxn {
+		return nil, ErrTxnNotEnabled
+	}
+
+	startTS, err := s.oracle.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Txn{
+		app:       s,
+		group:     group,
+		timeout:   timeout,
+		startTS:   startTS,
+		mutations: make(map[string]txnpb.Mutation),
+	}, nil
+}
+
+// StartTS returns the start timestamp of the txn
+func (t *Txn) StartTS() uint64 {
+	return t.startTS
+}
+
+// Get returns the value of the key, nil if the key is not exists. The locks of other
+// transactions which block the read will be resolved.
+func (t *Txn) Get(key []byte) ([]byte, error) {
+	if t.closed {
+		return nil, ErrTxnClosed
+	}
+
+	if m, ok := t.mutations[string(key)]; ok {
+		if m.Op == txnpb.Delete {
+			return nil, nil
+		}
+		return m.Value, nil
+	}
+
+	resp, err := t.execWithResolveLock(key, txn.GetType, &txnpb.GetRequest{StartTS: t.startTS})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Get.Found {
+		return nil, nil
+	}
+	return resp.Get.Value, nil
+}
+
+// Set set the value of the key in the txn
+func (t *Txn) Set(key, value []byte) error {
+	return t.addMutation(txnpb.Mutation{Op: txnpb.Put, Key: key, Value: value})
+}
+
+// Delete delete the key in the txn
+func (t *Txn) Delete(key []byte) error {
+	return t.addMutation(txnpb.Mutation{Op: txnpb.Delete, Key: key})
+}
+
+// Commit commit the txn. All the keys are prewritten with locks, then the primary key is
+// committed, the txn is committed once the primary key committed, the locks of the other
+// keys are committed after that, or they will be resolved by the readers.
+func (t *Txn) Commit() error {
+	if t.closed {
+		return ErrTxnClosed
+	}
+	t.closed = true
+
+	if len(t.keys) == 0 {
+		return nil
+	}
+
+	ttl := t.app.cfg.TxnLockTTL
+	if ttl == 0 {
+		ttl = defaultTxnLockTTL
+	}
+
+	primary := t.keys[0]
+	lockTime := time.Now().UnixNano() / int64(time.Millisecond)
+	err := t.forEachKey(t.keys, func(key []byte) error {
+		_, err := t.execWithResolveLock(key, txn.PrewriteType, &txnpb.PrewriteRequest{
+			Mutation: t.mutations[string(key)],
+			Primary:  primary,
+			StartTS:  t.startTS,
+			TTL:      uint64(ttl / time.Millisecond),
+			LockTime: lockTime,
+		})
+		return err
+	})
+	if err != nil {
+		t.rollbackKeys(t.keys)
+		return err
+	}
+
+	commitTS, err := t.app.oracle.Get()
+	if err != nil {
+		t.rollbackKeys(t.keys)
+		return err
+	}
+
+	req := &txnpb.CommitRequest{StartTS: t.startTS, CommitTS: commitTS}
+	if _, err := t.execCheckError(primary, txn.CommitType, req); err != nil {
+		if errors.Is(err, ErrTxnAborted) {
+			t.rollbackKeys(t.keys[1:])
+		}
+		return err
+	}
+
+	t.forEachKey(t.keys[1:], func(key []byte) error {
+		if _, err := t.execCheckError(key, txn.CommitType, req); err != nil {
+			logger.Warningf("txn %d commit secondary key %+v failed with %+v, the lock will be resolved later",
+				t.startTS,
+				key,
+				err)
+		}
+		return nil
+	})
+	return nil
+}
+
+// Rollback rollback the txn, the writes of the txn are discarded
+func (t *Txn) Rollback() error {
+	if t.closed {
+		return ErrTxnClosed
+	}
+
+	t.closed = true
+	return nil
+}
+
+func (t *Txn) addMutation(m txnpb.Mutation) error {
+	if t.closed {
+		return ErrTxnClosed
+	}
+
+	if _, ok := t.mutations[string(m.Key)]; !ok {
+		t.keys = append(t.keys, m.Key)
+	}
+	t.mutations[string(m.Key)] = m
+	return nil
+}
+
+func (t *Txn) rollbackKeys(keys [][]byte) {
+	t.forEachKey(keys, func(key []byte) error {
+		_, err := t.execCheckError(key, txn.RollbackType, &txnpb.RollbackRequest{StartTS: t.startTS})
+		if err != nil {
+			logger.Warningf("txn %d rollback key %+v failed with %+v, the lock will be resolved later",
+				t.startTS,
+				key,
+				err)
+		}
+		return nil
+	})
+}
+
+// forEachKey call the fn with the keys concurrently, returns the first error
+func (t *Txn) forEachKey(keys [][]byte, fn func([]byte) error) error {
+	var err error
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key []byte) {
+			defer wg.Done()
+			if e := fn(key); e != nil {
+				lock.Lock()
+				if err == nil {
+					err = e
+				}
+				lock.Unlock()
+			}
+		}(key)
+	}
+	wg.Wait()
+	return err
+}
+
+// execWithResolveLock exec the txn command, and resolve the lock of the other transaction which
+// blocks the command, then retry the command until timeout.
+func (t *Txn) execWithResolveLock(key []byte, cmdType uint64, cmd protoc.PB) (*txnpb.Response, error) {
+	deadline := time.Now().Add(t.timeout)
+	backoff := minTxnBackoff
+	for {
+		resp, err := t.exec(key, cmdType, cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Error.KeyLocked == nil {
+			return resp, toTxnError(resp.Error)
+		}
+
+		resolved, err := t.resolveLock(key, resp.Error.KeyLocked.Lock)
+		if err != nil {
+			return nil, err
+		}
+		if !resolved {
+			if time.Now().Add(backoff).After(deadline) {
+				return nil, ErrTxnLockTimeout
+			}
+
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > maxTxnBackoff {
+				backoff = maxTxnBackoff
+			}
+		}
+	}
+}
+
+// resolveLock resolve the lock by the status of the transaction which is checked by the primary key,
+// returns false if the lock is alive.
+func (t *Txn) resolveLock(key []byte, lock txnpb.Lock) (bool, error) {
+	resp, err := t.execCheckError(lock.Primary, txn.CheckTxnStatusType, &txnpb.CheckTxnStatusRequest{
+		StartTS:     lock.StartTS,
+		CurrentTime: time.Now().UnixNano() / int64(time.Millisecond),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	status := resp.CheckTxnStatus
+	if status.Lock != nil {
+		return false, nil
+	}
+
+	// the primary key is already committed or rolled back by CheckTxnStatus
+	if bytes.Equal(key, lock.Primary) {
+		return true, nil
+	}
+
+	if status.CommitTS > 0 {
+		_, err = t.execCheckError(key, txn.CommitType, &txnpb.CommitRequest{StartTS: lock.StartTS, CommitTS: status.CommitTS})
+	} else {
+		_, err = t.execCheckError(key, txn.RollbackType, &txnpb.RollbackRequest{StartTS: lock.StartTS})
+	}
+	// the lock maybe already resolved by others
+	if err != nil && !errors.Is(err, ErrTxnAborted) {
+		return false, err
+	}
+	return true, nil
+}
+
+func (t *Txn) execCheckError(key []byte, cmdType uint64, cmd protoc.PB) (*txnpb.Response, error) {
+	resp, err := t.exec(key, cmdType, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return resp, toTxnError(resp.Error)
+}
+
+func (t *Txn) exec(key []byte, cmdType uint64, cmd protoc.PB) (*txnpb.Response, error) {
+	req := pb.AcquireRequest()
+	req.ID = uuid.NewV4().Bytes()
+	req.Group = t.group
+	req.Key = key
+	req.CustemType = cmdType
+	req.Type = raftcmdpb.CMDType_Write
+	if cmdType == txn.GetType {
+		req.Type = raftcmdpb.CMDType_Read
+	}
+	req.Cmd = protoc.MustMarshal(cmd)
+	req.StopAt = time.Now().Add(t.timeout).Unix()
+
+	value, err := t.app.execRequest(req, t.timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &txnpb.Response{}
+	protoc.MustUnmarshal(resp, value)
+	return resp, nil
+}
+
+func toTxnError(err txnpb.TxnError) error {
+	switch {
+	case err.WriteConflict != nil:
+		return fmt.Errorf("%w: %s", ErrWriteConflict, err.Message)
+	case err.TxnAborted != nil:
+		return fmt.Errorf("%w: %s", ErrTxnAborted, err.Message)
+	case err.Message != "":
+		return errors.New(err.Message)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/matrixorigin/matrixcube/txn"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	c, closer := createDiskDataStorageCluster(t, raftstore.WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+		cfg.Customize.CustomInitShardsFactory = func() []bhmetapb.Shard {
+			return []bhmetapb.Shard{{End: []byte("b")}, {Start: []byte("b")}}
+		}
+	}))
+	defer closer()
+	c.RaftCluster.WaitShardByCount(t, 2, time.Second*10)
+
+	app := c.Applications[0]
+	timeout := time.Second * 10
+
+	// keys in different shards
+	txn1, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	assert.NoError(t, txn1.Set([]byte("a"), []byte("1")))
+	assert.NoError(t, txn1.Set([]byte("c"), []byte("1")))
+	value, err := txn1.Get([]byte("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+	assert.NoError(t, txn1.Commit())
+	assert.Equal(t, ErrTxnClosed, txn1.Commit())
+
+	txn2, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	value, err = txn2.Get([]byte("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+	value, err = txn2.Get([]byte("c"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+	value, err = txn2.Get([]byte("d"))
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	// write conflict
+	txn3, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	assert.NoError(t, txn2.Set([]byte("a"), []byte("2")))
+	assert.NoError(t, txn2.Set([]byte("c"), []byte("2")))
+	assert.NoError(t, txn3.Set([]byte("c"), []byte("3")))
+	assert.NoError(t, txn2.Commit())
+	assert.True(t, errors.Is(txn3.Commit(), ErrWriteConflict))
+
+	// the txn is crashed after the primary key committed
+	txn4, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	prewriteTestTxn(t, txn4, []byte("a"), []byte("4"), []byte("c"), []byte("4"))
+	commitTS, err := app.oracle.Get()
+	assert.NoError(t, err)
+	_, err = txn4.execCheckError([]byte("a"), txn.CommitType, &txnpb.CommitRequest{StartTS: txn4.startTS, CommitTS: commitTS})
+	assert.NoError(t, err)
+
+	txn5, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	value, err = txn5.Get([]byte("c"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("4"), value)
+
+	// the txn is crashed before the primary key committed
+	app.cfg.TxnLockTTL = time.Millisecond * 100
+	txn6, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	prewriteTestTxn(t, txn6, []byte("a"), []byte("6"), []byte("c"), []byte("6"))
+
+	txn7, err := app.NewTxn(0, timeout)
+	assert.NoError(t, err)
+	value, err = txn7.Get([]byte("c"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("4"), value)
+	value, err = txn7.Get([]byte("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("4"), value)
+	assert.NoError(t, txn7.Rollback())
+}
+
+func prewriteTestTxn(t *testing.T, tx *Txn, primary, primaryValue, secondary, secondaryValue []byte) {
+	ttl := tx.app.cfg.TxnLockTTL
+	if ttl == 0 {
+		ttl = defaultTxnLockTTL
+	}
+
+	for _, m := range []txnpb.Mutation{{Key: primary, Value: primaryValue}, {Key: secondary, Value: secondaryValue}} {
+		_, err := tx.execCheckError(m.Key, txn.PrewriteType, &txnpb.PrewriteRequest{
+			Mutation: m,
+			Primary:  primary,
+			StartTS:  tx.startTS,
+			TTL:      uint64(ttl / time.Millisecond),
+			LockTime: time.Now().UnixNano() / int64(time.Millisecond),
+		})
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixcube/vfs"
+)
+
+// BackupDestination is the destination which the backup files are written to and
+// read from. The files of a backup are the shard snapshots and the backup metadata.
+type BackupDestination interface {
+	// Create creates the file with the name, the file is visible after the writer
+	// is closed.
+	Create(name string) (io.WriteCloser, error)
+	// Open opens the file with the name
+	Open(name string) (io.ReadCloser, error)
+}
+
+type localBackupDestination struct {
+	fs  vfs.FS
+	dir string
+}
+
+// NewLocalBackupDestination returns a BackupDestination which writes the files to
+// the local directory. Each store writes the snapshots of its shards to its own
+// directory, so the directory should be a shared file system if the stores are not
+// on the same machine.
+func NewLocalBackupDestination(fs vfs.FS, dir string) (BackupDestination, error) {
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &localBackupDestination{fs: fs, dir: dir}, nil
+}
+
+func (d *localBackupDestination) Create(name string) (io.WriteCloser, error) {
+	file := d.fs.PathJoin(d.dir, name)
+	tmp := fmt.Sprintf("%s.tmp", file)
+	f, err := d.fs.Create(tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localBackupFile{File: f, fs: d.fs, tmp: tmp, file: file}, nil
+}
+
+func (d *localBackupDestination) Open(name string) (io.ReadCloser, error) {
+	return d.fs.Open(d.fs.PathJoin(d.dir, name))
+}
+
+// localBackupFile renames the tmp file to the target file after closed, so a
+// partially written file is never visible.
+type localBackupFile struct {
+	vfs.File
+
+	fs   vfs.FS
+	tmp  string
+	file string
+}
+
+func (f *localBackupFile) Close() error {
+	if err := f.File.Sync(); err != nil {
+		f.File.Close()
+		return err
+	}
+
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+
+	return f.fs.Rename(f.tmp, f.file)
+}
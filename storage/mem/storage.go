@@ -16,6 +16,7 @@ package mem
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"sync/atomic"
 	"time"
@@ -70,6 +71,12 @@ func (s *Storage) SetWithTTL(key []byte, value []byte, ttl int32) error {
 	return nil
 }
 
+func (s *Storage) setWithExpireAt(key []byte, value []byte, expireAt int64) {
+	atomic.AddUint64(&s.stats.WrittenKeys, 1)
+	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(value)+len(key)))
+	s.kv.Put(key, encodeValue(value, expireAt))
+}
+
 // BatchSet batch set
 func (s *Storage) BatchSet(pairs ...[]byte) error {
 	if len(pairs)%2 != 0 {
@@ -164,6 +171,30 @@ func (s *Storage) Free(pooled []byte) {
 
 }
 
+// Checksum returns the checksum of all key-value pairs in [start, end) which are not expired
+// at now
+func (s *Storage) Checksum(start, end []byte, now int64) (func() (uint32, error), error) {
+	// the memory storage has no snapshot, the view is a copy of the key-value pairs, and
+	// the empty values are included as the pebble storage does.
+	var pairs [][]byte
+	s.kv.Scan(start, end, func(key, value []byte) (bool, error) {
+		if isExpiredAt(value, now) {
+			return true, nil
+		}
+
+		pairs = append(pairs, key, value[8:])
+		return true, nil
+	})
+
+	return func() (uint32, error) {
+		sum := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+		for _, v := range pairs {
+			sum.Write(v)
+		}
+		return sum.Sum32(), nil
+	}, nil
+}
+
 // SplitCheck Find a key from [start, end), so that the sum of bytes of the value of [start, key) <=size,
 // returns the current bytes in [start,end), and the founded key
 func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uint64, [][]byte, error) {
@@ -220,7 +251,7 @@ func (s *Storage) Write(wb *util.WriteBatch, sync bool) error {
 		case util.OpDelete:
 			s.Delete(wb.Keys[idx])
 		case util.OpSet:
-			s.SetWithTTL(wb.Keys[idx], wb.Values[idx], wb.TTLs[idx])
+			s.setWithExpireAt(wb.Keys[idx], wb.Values[idx], wb.ExpireAt(idx))
 		}
 	}
 	return nil
@@ -231,6 +262,12 @@ func (s *Storage) RemoveShardData(shard bhmetapb.Shard, encodedStartKey, encoded
 	return s.RangeDelete(encodedStartKey, encodedEndKey)
 }
 
+// MergeShardData merge the data of the source shard into the target shard. All shards share
+// the same key space, so nothing need to be moved.
+func (s *Storage) MergeShardData(source, target bhmetapb.Shard) error {
+	return nil
+}
+
 // CreateSnapshot create a snapshot file under the giving path
 func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 	err := s.fs.MkdirAll(path, 0755)
@@ -368,15 +405,22 @@ func encodeValue(value []byte, expireAt int64) []byte {
 }
 
 func decodeValue(value []byte) []byte {
-	if len(value) == 0 {
+	if len(value) == 0 || isExpired(value) {
 		return nil
 	}
 
-	expireAt := buf.Byte2Int64(value)
-	now := time.Now().Unix()
-	if expireAt != 0 && expireAt < now {
-		return nil
+	return value[8:]
+}
+
+func isExpired(value []byte) bool {
+	return isExpiredAt(value, time.Now().Unix())
+}
+
+func isExpiredAt(value []byte, now int64) bool {
+	if len(value) < 8 {
+		return false
 	}
 
-	return value[8:]
+	expireAt := buf.Byte2Int64(value)
+	return expireAt != 0 && expireAt < now
 }
@@ -17,22 +17,84 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/sstable"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/log"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/storage/stats"
 	"github.com/matrixorigin/matrixcube/util"
 	"github.com/matrixorigin/matrixcube/vfs"
 )
 
-// Storage returns a kv storage based on badger
+var (
+	logger = log.NewLoggerWithPrefix("[pebble]")
+
+	// ttlIndexPrefix the prefix of the ttl index keys, which are formatted as the prefix, the
+	// expire time and the key. The range is above all the user keys, and it's excluded from
+	// the iterations, so the keys >= the prefix are reserved.
+	ttlIndexPrefix = []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 't', 't', 'l'}
+	// migrationKey the key of the last migrated key when migrating the data written by the
+	// previous versions. It's in the reserved range, and never reached by the gc.
+	migrationKey = ttlIndexKey(math.MaxInt64, []byte("migration"))
+)
+
+const (
+	// defaultGCInterval interval to remove the expired key-value pairs
+	defaultGCInterval = time.Minute
+	// gcBatchSize max number of the expired keys removed in a batch
+	gcBatchSize = 256
+	// gcGracePeriod the expired keys are removed after the grace period, so the checksum of
+	// the replicas computed at the same time in the period are not affected by the gc.
+	gcGracePeriod = time.Minute * 10
+
+	// snapshotRangeFile the file of the snapshot range
+	snapshotRangeFile = "db.range"
+	// snapshotSSTFile the sst file of the key-value pairs in the snapshot
+	snapshotSSTFile = "db.sst"
+	// snapshotTTLFile the sst file of the ttl index keys of the key-value pairs in the
+	// snapshot, it's only created if any key has the ttl
+	snapshotTTLFile = "db.ttl"
+	// snapshotDataFile the snapshot file of the previous versions, which contains the
+	// range and all the key-value pairs
+	snapshotDataFile = "db.data"
+
+	// formatFile the file of the format version of the storage
+	formatFile = "CUBE-FORMAT"
+	// formatVersion the current format version, every value is stored with the expire time
+	// prefix, and the keys with ttl are indexed by the expire time.
+	formatVersion = 1
+	// expireAtSize the size of the expire time prefix of the values
+	expireAtSize = 8
+	// migrationBatchSize max number of the key-value pairs migrated in a batch
+	migrationBatchSize = 1024
+)
+
+// Storage returns a kv storage based on badger. Every value is stored with a 8 bytes
+// expire time prefix, 0 means never expired. The keys with ttl are also indexed by the
+// expire time, so the background gc only scans the expired keys.
 type Storage struct {
 	db    *pebble.DB
+	opts  *pebble.Options
 	fs    vfs.FS
 	stats stats.Stats
 
+	// mu is used to avoid the background gc removes the key which is written
+	// again after we found it's expired.
+	mu       sync.RWMutex
+	stopC    chan struct{}
+	stopWG   sync.WaitGroup
+	stopOnce sync.Once
+
 	// SyncCount number of `Sync` method called
 	SyncCount uint64
 }
@@ -55,10 +117,143 @@ func NewStorage(dir string, opts *pebble.Options) (*Storage, error) {
 		panic("fs not set for pebble")
 	}
 
-	return &Storage{
-		db: db,
-		fs: fs,
-	}, nil
+	s := &Storage{
+		db:    db,
+		opts:  opts.Clone().EnsureDefaults(),
+		fs:    fs,
+		stopC: make(chan struct{}),
+	}
+	if err := s.checkFormat(dir); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	s.startGC(defaultGCInterval)
+	return s, nil
+}
+
+// checkFormat checks the format version of the storage, the version is written if the
+// storage is new. The storage which has data but no format version is written by the
+// previous versions without the expire time prefix, it's migrated to the current format.
+func (s *Storage) checkFormat(dir string) error {
+	path := s.fs.PathJoin(dir, formatFile)
+	if _, err := s.fs.Stat(path); err == nil {
+		f, err := s.fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		data, err := readBytes(f)
+		if err != nil {
+			return err
+		}
+		version, err := strconv.Atoi(string(data))
+		if err != nil {
+			return fmt.Errorf("invalid format version %q of storage %s", data, dir)
+		}
+		if version != formatVersion {
+			return fmt.Errorf("unsupported format version %d of storage %s, expect %d",
+				version,
+				dir,
+				formatVersion)
+		}
+		return nil
+	}
+
+	iter := s.db.NewIter(&pebble.IterOptions{})
+	hasData := iter.First()
+	err := iter.Close()
+	if err != nil {
+		return err
+	}
+	if hasData {
+		if err := s.migrateLegacyFormat(dir); err != nil {
+			return err
+		}
+	}
+
+	f, err := s.fs.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = writeBytes(f, []byte(strconv.Itoa(formatVersion)))
+	if err != nil {
+		return err
+	}
+	err = f.Sync()
+	if err != nil {
+		return err
+	}
+
+	// the migration is completed after the format version is written
+	if hasData {
+		return s.db.Delete(migrationKey, pebble.Sync)
+	}
+	return nil
+}
+
+// migrateLegacyFormat rewrites the values written by the previous versions with the never
+// expired prefix. The last migrated key is written with the values in the same batch, so
+// the migration is resumed after restart and no value is prefixed twice.
+func (s *Storage) migrateLegacyFormat(dir string) error {
+	var last []byte
+	value, closer, err := s.db.Get(migrationKey)
+	if err == nil {
+		last = clone(value)
+		closer.Close()
+	} else if err != pebble.ErrNotFound {
+		return err
+	}
+
+	logger.Infof("storage %s has data without the format version, migrate from key %+v",
+		dir,
+		last)
+	n := 0
+	for {
+		b := s.db.NewBatch()
+		iter := s.db.NewIter(&pebble.IterOptions{LowerBound: last, UpperBound: ttlIndexPrefix})
+		count := 0
+		for iter.First(); iter.Valid() && count < migrationBatchSize; iter.Next() {
+			if last != nil && bytes.Equal(iter.Key(), last) {
+				continue
+			}
+
+			last = clone(iter.Key())
+			if err := b.Set(last, encodeValue(iter.Value(), 0), nil); err != nil {
+				iter.Close()
+				b.Close()
+				return err
+			}
+			count++
+		}
+		if err := iter.Close(); err != nil {
+			b.Close()
+			return err
+		}
+		if count == 0 {
+			b.Close()
+			break
+		}
+
+		n += count
+		if err := b.Set(migrationKey, last, nil); err != nil {
+			b.Close()
+			return err
+		}
+		err := s.db.Apply(b, pebble.Sync)
+		b.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Infof("storage %s migrated %d key-value pairs to format version %d",
+		dir,
+		n,
+		formatVersion)
+	return nil
 }
 
 func (s *Storage) Stats() stats.Stats {
@@ -67,14 +262,28 @@ func (s *Storage) Stats() stats.Stats {
 
 // Set put the key, value pair to the storage
 func (s *Storage) Set(key []byte, value []byte) error {
-	atomic.AddUint64(&s.stats.WrittenKeys, 1)
-	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(value)+len(key)))
-	return s.db.Set(key, value, pebble.NoSync)
+	return s.SetWithTTL(key, value, 0)
 }
 
 // SetWithTTL put the key, value pair to the storage with a ttl in seconds
 func (s *Storage) SetWithTTL(key []byte, value []byte, ttl int32) error {
-	return fmt.Errorf("pebble storage not support set key-value with TTL")
+	atomic.AddUint64(&s.stats.WrittenKeys, 1)
+	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(value)+len(key)))
+
+	expireAt := int64(0)
+	if ttl > 0 {
+		expireAt = time.Now().Add(time.Second * time.Duration(ttl)).Unix()
+	}
+
+	b := s.db.NewBatch()
+	defer b.Close()
+	if err := setValue(b, key, value, expireAt); err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.db.Apply(b, pebble.NoSync)
 }
 
 // BatchSet batch set
@@ -88,10 +297,14 @@ func (s *Storage) BatchSet(pairs ...[]byte) error {
 
 	atomic.AddUint64(&s.stats.WrittenKeys, uint64(len(pairs)/2))
 	for i := 0; i < len(pairs)/2; i++ {
-		b.Set(pairs[2*i], pairs[2*i+1], nil)
+		if err := setValue(b, pairs[2*i], pairs[2*i+1], 0); err != nil {
+			return err
+		}
 		atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(pairs[2*i])+len(pairs[2*i+1])))
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.db.Apply(b, pebble.NoSync)
 }
 
@@ -107,6 +320,10 @@ func (s *Storage) Get(key []byte) ([]byte, error) {
 	}
 
 	defer closer.Close()
+	value, err = decodeValue(value)
+	if err != nil {
+		return nil, err
+	}
 	if len(value) == 0 {
 		return nil, nil
 	}
@@ -161,14 +378,14 @@ func (s *Storage) BatchDelete(keys ...[]byte) error {
 func (s *Storage) RangeDelete(start, end []byte) error {
 	atomic.AddUint64(&s.stats.WrittenKeys, 2)
 	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(start)+len(end)))
-	return s.db.DeleteRange(start, end, pebble.NoSync)
+	return s.db.DeleteRange(start, upperBound(end), pebble.NoSync)
 }
 
 // Scan scans the key-value pairs in [start, end), and perform with a handler function, if the function
 // returns false, the scan will be terminated, if the `pooledKey` is true, raftstore will call `Free` when
 // scan completed.
 func (s *Storage) Scan(start, end []byte, handler func(key, value []byte) (bool, error), pooledKey bool) error {
-	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: upperBound(end)})
 	defer iter.Close()
 
 	iter.First()
@@ -178,13 +395,22 @@ func (s *Storage) Scan(start, end []byte, handler func(key, value []byte) (bool,
 			return err
 		}
 
-		ok, err := handler(clone(iter.Key()), clone(iter.Value()))
+		if isExpired(iter.Value()) {
+			iter.Next()
+			continue
+		}
+
+		value, err := decodeValue(iter.Value())
+		if err != nil {
+			return err
+		}
+		ok, err := handler(clone(iter.Key()), clone(value))
 		if err != nil {
 			return err
 		}
 
 		atomic.AddUint64(&s.stats.ReadKeys, 1)
-		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(iter.Value())))
+		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(value)))
 
 		if !ok {
 			break
@@ -201,7 +427,7 @@ func (s *Storage) Scan(start, end []byte, handler func(key, value []byte) (bool,
 // if the `pooledKey` is true, raftstore will call `Free` when
 // scan completed.
 func (s *Storage) PrefixScan(prefix []byte, handler func(key, value []byte) (bool, error), pooledKey bool) error {
-	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: prefix})
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: prefix, UpperBound: upperBound(nil)})
 	defer iter.Close()
 	iter.First()
 	for iter.Valid() {
@@ -212,12 +438,20 @@ func (s *Storage) PrefixScan(prefix []byte, handler func(key, value []byte) (boo
 		if ok := bytes.HasPrefix(iter.Key(), prefix); !ok {
 			break
 		}
-		ok, err := handler(clone(iter.Key()), clone(iter.Value()))
+		if isExpired(iter.Value()) {
+			iter.Next()
+			continue
+		}
+		value, err := decodeValue(iter.Value())
+		if err != nil {
+			return err
+		}
+		ok, err := handler(clone(iter.Key()), clone(value))
 		if err != nil {
 			return err
 		}
 		atomic.AddUint64(&s.stats.ReadKeys, 1)
-		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(iter.Value())))
+		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(value)))
 		if !ok {
 			break
 		}
@@ -236,6 +470,53 @@ func (s *Storage) RemoveShardData(shard bhmetapb.Shard, encodedStartKey, encoded
 	return s.RangeDelete(encodedStartKey, encodedEndKey)
 }
 
+// MergeShardData merge the data of the source shard into the target shard. All shards share
+// the same key space, so nothing need to be moved.
+func (s *Storage) MergeShardData(source, target bhmetapb.Shard) error {
+	return nil
+}
+
+// Checksum returns the checksum of all key-value pairs in [start, end) which are not expired
+// at now
+func (s *Storage) Checksum(start, end []byte, now int64) (func() (uint32, error), error) {
+	snap := s.db.NewSnapshot()
+	return func() (uint32, error) {
+		defer snap.Close()
+
+		var n uint64
+		sum := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+
+		iter := snap.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: upperBound(end)})
+		defer iter.Close()
+
+		for iter.First(); iter.Valid(); iter.Next() {
+			if isExpiredAt(iter.Value(), now) {
+				continue
+			}
+
+			if len(iter.Value()) < expireAtSize {
+				return 0, fmt.Errorf("invalid value of %d bytes, missing the expire time prefix",
+					len(iter.Value()))
+			}
+			value := iter.Value()[expireAtSize:]
+			sum.Write(iter.Key())
+			sum.Write(value)
+			n += uint64(len(iter.Key()) + len(value))
+			atomic.AddUint64(&s.stats.ReadKeys, 1)
+		}
+
+		// the iteration stops at an I/O error, the partial checksum must not be used
+		if err := iter.Error(); err != nil {
+			return 0, err
+		}
+
+		if n > 0 {
+			atomic.AddUint64(&s.stats.ReadBytes, n)
+		}
+		return sum.Sum32(), nil
+	}, nil
+}
+
 // SplitCheck Find a key from [start, end), so that the sum of bytes of the value of [start, key) <=size,
 // returns the current bytes in [start,end), and the founded key
 func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uint64, [][]byte, error) {
@@ -245,7 +526,7 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 	appendSplitKey := false
 	var splitKeys [][]byte
 
-	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: upperBound(end)})
 	defer iter.Close()
 
 	iter.First()
@@ -259,13 +540,22 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 			break
 		}
 
+		if isExpired(iter.Value()) {
+			iter.Next()
+			continue
+		}
+
+		value, err := decodeValue(iter.Value())
+		if err != nil {
+			return 0, 0, nil, err
+		}
 		if appendSplitKey {
 			splitKeys = append(splitKeys, clone(iter.Key()))
 			appendSplitKey = false
 			sum = 0
 		}
 
-		n := uint64(len(iter.Key()) + len(iter.Value()))
+		n := uint64(len(iter.Key()) + len(value))
 		sum += n
 		total += n
 		keys++
@@ -287,21 +577,32 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 func (s *Storage) Seek(target []byte) ([]byte, []byte, error) {
 	var key, value []byte
 
-	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: target})
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: target, UpperBound: upperBound(nil)})
 	defer iter.Close()
 
 	iter.First()
-	if iter.Valid() {
+	for iter.Valid() {
 		err := iter.Error()
 		if err != nil {
 			return nil, nil, err
 		}
 
+		if isExpired(iter.Value()) {
+			iter.Next()
+			continue
+		}
+
+		v, err := decodeValue(iter.Value())
+		if err != nil {
+			return nil, nil, err
+		}
+
 		key = clone(iter.Key())
-		value = clone(iter.Value())
+		value = clone(v)
 
 		atomic.AddUint64(&s.stats.ReadKeys, 1)
-		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(iter.Value())))
+		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(key)+len(value)))
+		break
 	}
 
 	return key, value, nil
@@ -324,12 +625,8 @@ func (s *Storage) Write(wb *util.WriteBatch, sync bool) error {
 			atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(wb.Keys[idx])))
 			err = b.Delete(wb.Keys[idx], nil)
 		case util.OpSet:
-			if wb.TTLs[idx] > 0 {
-				return fmt.Errorf("pebble storage not support set key-value with TTL")
-			}
-
 			atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(wb.Keys[idx])+len(wb.Values[idx])))
-			err = b.Set(wb.Keys[idx], wb.Values[idx], nil)
+			err = setValue(b, wb.Keys[idx], wb.Values[idx], wb.ExpireAt(idx))
 		}
 
 		if err != nil {
@@ -342,6 +639,8 @@ func (s *Storage) Write(wb *util.WriteBatch, sync bool) error {
 		opts = pebble.Sync
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.db.Apply(b, opts)
 }
 
@@ -351,14 +650,17 @@ func (s *Storage) Sync() error {
 	return s.db.Flush()
 }
 
-// CreateSnapshot create a snapshot file under the giving path
+// CreateSnapshot create a snapshot under the giving path. The key-value pairs in the range
+// are written into a sst file, and the ttl index keys are written into another sst file,
+// so the sst file of the key-value pairs only spans the range. Both files are ingested
+// into the pebble when applying.
 func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 	err := s.fs.MkdirAll(path, 0755)
 	if err != nil {
 		return err
 	}
-	file := s.fs.PathJoin(path, "db.data")
-	f, err := s.fs.Create(file)
+
+	f, err := s.fs.Create(s.fs.PathJoin(path, snapshotRangeFile))
 	if err != nil {
 		return err
 	}
@@ -367,22 +669,33 @@ func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 	if err != nil {
 		return err
 	}
-
 	err = writeBytes(f, end)
 	if err != nil {
 		return err
 	}
+	err = f.Sync()
+	if err != nil {
+		return err
+	}
+
+	sf, err := s.fs.Create(s.fs.PathJoin(path, snapshotSSTFile))
+	if err != nil {
+		return err
+	}
+	w := sstable.NewWriter(sf, s.opts.MakeWriterOptions(0))
 
 	snap := s.db.NewSnapshot()
 	defer snap.Close()
 
-	iter := snap.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	iter := snap.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: upperBound(end)})
 	defer iter.Close()
 
+	var indexes [][]byte
 	iter.First()
 	for iter.Valid() {
 		err := iter.Error()
 		if err != nil {
+			w.Close()
 			return err
 		}
 
@@ -390,52 +703,149 @@ func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 			break
 		}
 
-		err = writeBytes(f, iter.Key())
-		if err != nil {
-			return err
+		// the expire time is kept in the snapshot
+		if isExpired(iter.Value()) {
+			iter.Next()
+			continue
+		}
+		if expireAt := getExpireAt(iter.Value()); expireAt > 0 {
+			indexes = append(indexes, ttlIndexKey(expireAt, iter.Key()))
 		}
 
-		err = writeBytes(f, iter.Value())
+		err = w.Set(iter.Key(), iter.Value())
 		if err != nil {
+			w.Close()
 			return err
 		}
 
 		n := uint64(len(iter.Key()) + len(iter.Value()))
 		atomic.AddUint64(&s.stats.ReadKeys, 1)
 		atomic.AddUint64(&s.stats.ReadBytes, n)
-		atomic.AddUint64(&s.stats.WrittenKeys, 1)
-		atomic.AddUint64(&s.stats.WrittenBytes, n)
 		iter.Next()
 	}
 
-	return nil
+	// the sst file is synced and closed by the writer
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return s.createTTLSnapshot(path, indexes)
 }
 
-// ApplySnapshot apply a snapshort file from giving path
+// createTTLSnapshot writes the ttl index keys of the snapshot into a separate sst file.
+func (s *Storage) createTTLSnapshot(path string, indexes [][]byte) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	f, err := s.fs.Create(s.fs.PathJoin(path, snapshotTTLFile))
+	if err != nil {
+		return err
+	}
+	w := sstable.NewWriter(f, s.opts.MakeWriterOptions(0))
+
+	sort.Slice(indexes, func(i, j int) bool { return bytes.Compare(indexes[i], indexes[j]) < 0 })
+	for _, index := range indexes {
+		if err := w.Set(index, nil); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// ApplySnapshot apply a snapshort file from giving path. The range of the snapshot and
+// the ttl index keys of the replaced key-value pairs are cleared, and then the sst files
+// are ingested. The snapshots created by the previous versions are applied by rewriting
+// the key-value pairs.
 func (s *Storage) ApplySnapshot(path string) error {
-	f, err := s.fs.Open(s.fs.PathJoin(path, "db.data"))
+	if _, err := s.fs.Stat(s.fs.PathJoin(path, snapshotDataFile)); err == nil {
+		return s.applyDataSnapshot(path)
+	}
+
+	f, err := s.fs.Open(s.fs.PathJoin(path, snapshotRangeFile))
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
-	start, err := readBytes(f)
+	start, end, err := readSnapshotRange(f)
 	if err != nil {
 		return err
 	}
-	if len(start) == 0 {
-		return fmt.Errorf("error format, missing start field")
+
+	sstFile := s.fs.PathJoin(path, snapshotSSTFile)
+	info, err := s.fs.Stat(sstFile)
+	if err != nil {
+		return err
 	}
+	files := []string{sstFile}
+	written := uint64(info.Size())
 
-	end, err := readBytes(f)
+	ttlFile := s.fs.PathJoin(path, snapshotTTLFile)
+	if info, err := s.fs.Stat(ttlFile); err == nil {
+		files = append(files, ttlFile)
+		written += uint64(info.Size())
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	err = s.clearRange(start, end)
 	if err != nil {
 		return err
 	}
-	if len(end) == 0 {
-		return fmt.Errorf("error format, missing end field")
+
+	// the values in the snapshot is already encoded with expire time
+	atomic.AddUint64(&s.stats.WrittenBytes, written)
+	// the ingested key-value pairs are newer than the range deletion
+	return s.db.Ingest(files)
+}
+
+// clearRange deletes the key-value pairs in the range and their ttl index keys.
+func (s *Storage) clearRange(start, end []byte) error {
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: upperBound(end)})
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		if bytes.Compare(iter.Key(), end) >= 0 {
+			break
+		}
+		if expireAt := getExpireAt(iter.Value()); expireAt > 0 {
+			if err := b.Delete(ttlIndexKey(expireAt, iter.Key()), nil); err != nil {
+				return err
+			}
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if err := b.DeleteRange(start, end, nil); err != nil {
+		return err
+	}
+	return s.db.Apply(b, pebble.NoSync)
+}
+
+// applyDataSnapshot applies the snapshot which all the key-value pairs are written in
+// the db.data file. The values in the file are written by the previous versions without
+// the expire time prefix, they are encoded as the values never expire.
+func (s *Storage) applyDataSnapshot(path string) error {
+	f, err := s.fs.Open(s.fs.PathJoin(path, snapshotDataFile))
+	if err != nil {
+		return err
 	}
+	defer f.Close()
 
-	err = s.db.DeleteRange(start, end, pebble.NoSync)
+	start, end, err := readSnapshotRange(f)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	err = s.clearRange(start, end)
 	if err != nil {
 		return err
 	}
@@ -462,7 +872,7 @@ func (s *Storage) ApplySnapshot(path string) error {
 		atomic.AddUint64(&s.stats.ReadBytes, n)
 		atomic.AddUint64(&s.stats.WrittenKeys, 1)
 		atomic.AddUint64(&s.stats.WrittenBytes, n)
-		err = s.db.Set(key, value, pebble.NoSync)
+		err = s.db.Set(key, encodeValue(value, 0), pebble.NoSync)
 		if err != nil {
 			return err
 		}
@@ -473,9 +883,167 @@ func (s *Storage) ApplySnapshot(path string) error {
 
 // Close close the storage
 func (s *Storage) Close() error {
+	s.stopOnce.Do(func() {
+		close(s.stopC)
+	})
+	s.stopWG.Wait()
 	return s.db.Close()
 }
 
+func (s *Storage) startGC(interval time.Duration) {
+	s.stopWG.Add(1)
+	go func() {
+		defer s.stopWG.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stopC:
+				return
+			case <-ticker.C:
+				if err := s.gc(time.Now().Add(-gcGracePeriod).Unix()); err != nil {
+					logger.Errorf("remove expired key-value pairs failed with %+v", err)
+				}
+			}
+		}
+	}()
+}
+
+// gc removes the key-value pairs expired before the giving time, only the ttl index keys
+// of the expired keys are scanned.
+func (s *Storage) gc(before int64) error {
+	iter := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: ttlIndexPrefix,
+		UpperBound: ttlIndexKey(before, nil),
+	})
+	defer iter.Close()
+
+	var indexes [][]byte
+	for iter.First(); iter.Valid(); iter.Next() {
+		indexes = append(indexes, clone(iter.Key()))
+		if len(indexes) >= gcBatchSize {
+			if err := s.removeExpired(indexes); err != nil {
+				return err
+			}
+			indexes = indexes[:0]
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return s.removeExpired(indexes)
+}
+
+// removeExpired removes the ttl index keys and the indexed keys which are not written
+// again with another expire time.
+func (s *Storage) removeExpired(indexes [][]byte) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	for _, index := range indexes {
+		b.Delete(index, nil)
+
+		expireAt, key := decodeTTLIndexKey(index)
+		value, closer, err := s.db.Get(key)
+		if err == pebble.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// maybe written again after we found it's expired
+		indexed := getExpireAt(value) == expireAt
+		closer.Close()
+		if indexed {
+			b.Delete(key, nil)
+		}
+	}
+
+	return s.db.Apply(b, pebble.NoSync)
+}
+
+// setValue sets the key-value pair with the expire time prefix in the batch, and indexes
+// the key by the expire time if it's set.
+func setValue(b *pebble.Batch, key, value []byte, expireAt int64) error {
+	if err := b.Set(key, encodeValue(value, expireAt), nil); err != nil {
+		return err
+	}
+	if expireAt > 0 {
+		return b.Set(ttlIndexKey(expireAt, key), nil, nil)
+	}
+	return nil
+}
+
+func encodeValue(value []byte, expireAt int64) []byte {
+	data := make([]byte, len(value)+expireAtSize)
+	buf.Int64ToBytesTo(expireAt, data)
+	copy(data[expireAtSize:], value)
+	return data
+}
+
+// decodeValue returns the value without the expire time prefix, nil if it's expired
+func decodeValue(value []byte) ([]byte, error) {
+	if len(value) < expireAtSize {
+		return nil, fmt.Errorf("invalid value of %d bytes, missing the expire time prefix",
+			len(value))
+	}
+	if isExpired(value) {
+		return nil, nil
+	}
+
+	return value[expireAtSize:], nil
+}
+
+func getExpireAt(value []byte) int64 {
+	if len(value) < expireAtSize {
+		return 0
+	}
+
+	return buf.Byte2Int64(value)
+}
+
+func isExpired(value []byte) bool {
+	return isExpiredAt(value, time.Now().Unix())
+}
+
+func isExpiredAt(value []byte, now int64) bool {
+	expireAt := getExpireAt(value)
+	return expireAt != 0 && expireAt < now
+}
+
+func ttlIndexKey(expireAt int64, key []byte) []byte {
+	n := len(ttlIndexPrefix)
+	index := make([]byte, n+expireAtSize+len(key))
+	copy(index, ttlIndexPrefix)
+	binary.BigEndian.PutUint64(index[n:], uint64(expireAt))
+	copy(index[n+expireAtSize:], key)
+	return index
+}
+
+func decodeTTLIndexKey(index []byte) (int64, []byte) {
+	n := len(ttlIndexPrefix)
+	return int64(binary.BigEndian.Uint64(index[n:])), index[n+expireAtSize:]
+}
+
+// upperBound returns the upper bound of the iteration which excludes the ttl index keys
+func upperBound(end []byte) []byte {
+	if len(end) == 0 || bytes.Compare(end, ttlIndexPrefix) > 0 {
+		return ttlIndexPrefix
+	}
+	return end
+}
+
 func clone(value []byte) []byte {
 	v := make([]byte, len(value))
 	copy(v, value)
@@ -497,6 +1065,25 @@ func writeBytes(f vfs.File, data []byte) error {
 	return nil
 }
 
+func readSnapshotRange(f vfs.File) ([]byte, []byte, error) {
+	start, err := readBytes(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(start) == 0 {
+		return nil, nil, fmt.Errorf("error format, missing start field")
+	}
+
+	end, err := readBytes(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(end) == 0 {
+		return nil, nil, fmt.Errorf("error format, missing end field")
+	}
+	return start, end, nil
+}
+
 func readBytes(f vfs.File) ([]byte, error) {
 	size := make([]byte, 4)
 	n, err := f.Read(size)
@@ -1,11 +1,14 @@
 package pebble
 
 import (
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/sstable"
 	"github.com/matrixorigin/matrixcube/util"
 	"github.com/matrixorigin/matrixcube/vfs"
 	"github.com/stretchr/testify/assert"
@@ -45,6 +48,264 @@ func TestSync(t *testing.T) {
 	assert.Equal(t, v, d)
 }
 
+func TestGC(t *testing.T) {
+	recreateTestTempDir(tmpDir)
+	opts := pebble.Options{FS: vfs.NewPebbleFS(vfs.Default)}
+
+	s, err := NewStorage(filepath.Join(tmpDir, "gc"), &opts)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	assert.NoError(t, s.SetWithTTL([]byte("k1"), []byte("v1"), 1))
+	assert.NoError(t, s.Set([]byte("k2"), []byte("v2")))
+	// written again with a longer ttl, the first index is stale
+	assert.NoError(t, s.SetWithTTL([]byte("k3"), []byte("v3"), 1))
+	assert.NoError(t, s.SetWithTTL([]byte("k3"), []byte("v3"), 100))
+	time.Sleep(time.Second * 2)
+
+	assert.NoError(t, s.gc(time.Now().Unix()))
+	_, _, err = s.db.Get([]byte("k1"))
+	assert.Equal(t, pebble.ErrNotFound, err)
+	v, err := s.Get([]byte("k2"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v2"), v)
+	v, err = s.Get([]byte("k3"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v3"), v)
+
+	// only the index of k3 is left, and it's invisible to the iterations
+	var indexes int
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: ttlIndexPrefix})
+	for iter.First(); iter.Valid(); iter.Next() {
+		indexes++
+	}
+	assert.NoError(t, iter.Close())
+	assert.Equal(t, 1, indexes)
+	key, _, err := s.Seek([]byte("k4"))
+	assert.NoError(t, err)
+	assert.Empty(t, key)
+	assert.NoError(t, s.Scan([]byte("k"), nil, func(key, value []byte) (bool, error) {
+		assert.Equal(t, byte('k'), key[0])
+		return true, nil
+	}, false))
+}
+
+func TestFormat(t *testing.T) {
+	recreateTestTempDir(tmpDir)
+	opts := pebble.Options{FS: vfs.NewPebbleFS(vfs.Default)}
+
+	path := filepath.Join(tmpDir, "format")
+	s, err := NewStorage(path, &opts)
+	assert.NoError(t, err)
+	// the value written without the expire time prefix is an error instead of a panic
+	assert.NoError(t, s.db.Set([]byte("k1"), []byte("v1"), pebble.NoSync))
+	_, err = s.Get([]byte("k1"))
+	assert.Error(t, err)
+	assert.Error(t, s.Scan([]byte("k"), nil, func(key, value []byte) (bool, error) {
+		return true, nil
+	}, false))
+	assert.NoError(t, s.Close())
+
+	// reopen with the format version
+	s, err = NewStorage(path, &opts)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Close())
+
+	// the data written by the previous versions has no format version, it's migrated
+	assert.NoError(t, os.Remove(filepath.Join(path, formatFile)))
+	s, err = NewStorage(path, &opts)
+	assert.NoError(t, err)
+	v, err := s.Get([]byte("k1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v1"), v)
+	_, _, err = s.db.Get(migrationKey)
+	assert.Equal(t, pebble.ErrNotFound, err)
+	assert.NoError(t, s.Close())
+
+	// migrated once
+	s, err = NewStorage(path, &opts)
+	assert.NoError(t, err)
+	v, err = s.Get([]byte("k1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v1"), v)
+	assert.NoError(t, s.Close())
+}
+
+func TestMigrateLegacyFormat(t *testing.T) {
+	recreateTestTempDir(tmpDir)
+	opts := pebble.Options{FS: vfs.NewPebbleFS(vfs.Default)}
+
+	// the data written by the previous versions, and the migration is interrupted after k2
+	path := filepath.Join(tmpDir, "legacy")
+	db, err := pebble.Open(path, &opts)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Set([]byte("k1"), encodeValue([]byte("v1"), 0), pebble.Sync))
+	assert.NoError(t, db.Set([]byte("k2"), encodeValue(nil, 0), pebble.Sync))
+	assert.NoError(t, db.Set([]byte("k3"), []byte("v3"), pebble.Sync))
+	assert.NoError(t, db.Set([]byte("k4"), []byte("v"), pebble.Sync))
+	assert.NoError(t, db.Set(migrationKey, []byte("k2"), pebble.Sync))
+	assert.NoError(t, db.Close())
+
+	s, err := NewStorage(path, &opts)
+	assert.NoError(t, err)
+	defer s.Close()
+	for key, value := range map[string]string{"k1": "v1", "k2": "", "k3": "v3", "k4": "v"} {
+		v, err := s.Get([]byte(key))
+		assert.NoError(t, err)
+		assert.Equal(t, value, string(v))
+	}
+}
+
+func TestScanEmptyValue(t *testing.T) {
+	recreateTestTempDir(tmpDir)
+	opts := pebble.Options{FS: vfs.NewPebbleFS(vfs.Default)}
+
+	s, err := NewStorage(filepath.Join(tmpDir, "empty"), &opts)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	assert.NoError(t, s.Set([]byte("k1"), nil))
+	assert.NoError(t, s.SetWithTTL([]byte("k2"), []byte("v2"), 1))
+	time.Sleep(time.Second * 2)
+
+	var keys []string
+	assert.NoError(t, s.Scan([]byte("k"), []byte("l"), func(key, value []byte) (bool, error) {
+		keys = append(keys, string(key))
+		return true, nil
+	}, false))
+	assert.Equal(t, []string{"k1"}, keys)
+
+	key, _, err := s.Seek([]byte("k"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("k1"), key)
+}
+
+func TestSnapshotWithTTL(t *testing.T) {
+	recreateTestTempDir(tmpDir)
+	opts := pebble.Options{FS: vfs.NewPebbleFS(vfs.Default)}
+
+	s1, err := NewStorage(filepath.Join(tmpDir, "s1"), &opts)
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := NewStorage(filepath.Join(tmpDir, "s2"), &opts)
+	assert.NoError(t, err)
+	defer s2.Close()
+
+	assert.NoError(t, s1.SetWithTTL([]byte("k1"), []byte("v1"), 100))
+	assert.NoError(t, s1.Set([]byte("k2"), []byte("v2")))
+	// the ttl index keys of the replaced key-value pairs are removed, and the others are kept
+	assert.NoError(t, s2.SetWithTTL([]byte("k2"), []byte("old"), 200))
+	assert.NoError(t, s2.SetWithTTL([]byte("k3"), []byte("v3"), 300))
+
+	path := filepath.Join(tmpDir, "snap")
+	assert.NoError(t, s1.CreateSnapshot(path, []byte("k1"), []byte("k3")))
+
+	// the sst file of the key-value pairs only spans the range
+	f, err := opts.FS.Open(filepath.Join(path, snapshotSSTFile))
+	assert.NoError(t, err)
+	r, err := sstable.NewReader(f, sstable.ReaderOptions{Comparer: pebble.DefaultComparer})
+	assert.NoError(t, err)
+	iter, err := r.NewIter(nil, nil)
+	assert.NoError(t, err)
+	first, _ := iter.First()
+	assert.Equal(t, "k1", string(first.UserKey))
+	last, _ := iter.Last()
+	assert.Equal(t, "k2", string(last.UserKey))
+	assert.NoError(t, iter.Close())
+	assert.NoError(t, r.Close())
+
+	assert.NoError(t, s2.ApplySnapshot(path))
+
+	var indexes []string
+	iter2 := s2.db.NewIter(&pebble.IterOptions{LowerBound: ttlIndexPrefix})
+	for iter2.First(); iter2.Valid(); iter2.Next() {
+		_, key := decodeTTLIndexKey(iter2.Key())
+		indexes = append(indexes, string(key))
+	}
+	assert.NoError(t, iter2.Close())
+	assert.Equal(t, []string{"k1", "k3"}, indexes)
+
+	for _, key := range [][]byte{[]byte("k1"), []byte("k2")} {
+		v1, c1, err := s1.db.Get(key)
+		assert.NoError(t, err)
+		v2, c2, err := s2.db.Get(key)
+		assert.NoError(t, err)
+		assert.Equal(t, getExpireAt(v1), getExpireAt(v2))
+		d1, err := decodeValue(v1)
+		assert.NoError(t, err)
+		d2, err := decodeValue(v2)
+		assert.NoError(t, err)
+		assert.Equal(t, d1, d2)
+		c1.Close()
+		c2.Close()
+	}
+}
+
+func TestApplyDataSnapshot(t *testing.T) {
+	recreateTestTempDir(tmpDir)
+	fs := vfs.Default
+	opts := pebble.Options{FS: vfs.NewPebbleFS(fs)}
+
+	// the snapshot created by the previous versions, the values have no expire time prefix
+	db, err := pebble.Open(filepath.Join(tmpDir, "legacy"), &opts)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Set([]byte("k1"), []byte("v1"), pebble.Sync))
+	assert.NoError(t, db.Set([]byte("k2"), []byte("value-longer-than-8-bytes"), pebble.Sync))
+	path := filepath.Join(tmpDir, "snap")
+	assert.NoError(t, createLegacySnapshot(fs, db, path, []byte("k1"), []byte("k3")))
+	assert.NoError(t, db.Close())
+
+	s, err := NewStorage(filepath.Join(tmpDir, "s"), &opts)
+	assert.NoError(t, err)
+	defer s.Close()
+	assert.NoError(t, s.Set([]byte("k1"), []byte("old")))
+	assert.NoError(t, s.Set([]byte("k2"), []byte("old")))
+	assert.NoError(t, s.Set([]byte("k3"), []byte("v3")))
+
+	assert.NoError(t, s.ApplySnapshot(path))
+	for key, value := range map[string]string{"k1": "v1", "k2": "value-longer-than-8-bytes", "k3": "v3"} {
+		v, err := s.Get([]byte(key))
+		assert.NoError(t, err)
+		assert.Equal(t, value, string(v))
+	}
+	assert.NoError(t, s.gc(math.MaxInt64))
+	v, err := s.Get([]byte("k2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value-longer-than-8-bytes", string(v))
+}
+
+// createLegacySnapshot creates the snapshot in the same way as the previous versions
+func createLegacySnapshot(fs vfs.FS, db *pebble.DB, path string, start, end []byte) error {
+	if err := fs.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+	f, err := fs.Create(fs.PathJoin(path, snapshotDataFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := writeBytes(f, start); err != nil {
+		return err
+	}
+	if err := writeBytes(f, end); err != nil {
+		return err
+	}
+
+	snap := db.NewSnapshot()
+	defer snap.Close()
+	iter := snap.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := writeBytes(f, iter.Key()); err != nil {
+			return err
+		}
+		if err := writeBytes(f, iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
 func recreateTestTempDir(tmpDir string) {
 	os.RemoveAll(tmpDir)
 	os.MkdirAll(tmpDir, 0755)
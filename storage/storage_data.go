@@ -26,6 +26,16 @@ type DataStorage interface {
 	Sync() error
 	// RemoveShardData remove shard data
 	RemoveShardData(shard bhmetapb.Shard, encodedStartKey, encodedEndKey []byte) error
+	// MergeShardData merge the data of the source shard into the target shard. The source shard
+	// will be removed after merged, the target shard's range is already extended to cover the source.
+	MergeShardData(source, target bhmetapb.Shard) error
+	// Checksum takes a consistent view of [start, end), and returns a function to compute the
+	// checksum of all key-value pairs in the view, the replicas of a shard use it to check whether
+	// their data are consistent. The view is taken before Checksum returns, so the function can be
+	// called later out of the apply path, and it must be called once to release the view. The
+	// key-value pairs expired at now, in unix seconds, are excluded, the replicas use the same now
+	// to get the same checksum.
+	Checksum(start, end []byte, now int64) (func() (uint32, error), error)
 	// SplitCheck Find a key from [start, end), so that the sum of bytes of the value of [start, key) <=size,
 	// returns the current bytes in [start,end), and the founded key
 	SplitCheck(start []byte, end []byte, size uint64) (currentSize uint64, currentKeys uint64, splitKeys [][]byte, err error)
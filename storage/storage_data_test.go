@@ -178,6 +178,70 @@ func TestSplitCheck(t *testing.T) {
 	}
 }
 
+func TestChecksum(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+	sums := make(map[string]uint32)
+	for name, factory := range dataDactories {
+		t.Run(name, func(t *testing.T) {
+			s := factory(fs, t)
+			defer s.Close()
+			kv := s.(KVStorage)
+
+			checksum := func() uint32 {
+				compute, err := s.Checksum([]byte("k1"), []byte("k4"), time.Now().Unix())
+				assert.NoError(t, err)
+				sum, err := compute()
+				assert.NoError(t, err)
+				return sum
+			}
+
+			empty := checksum()
+
+			assert.NoError(t, kv.Set([]byte("k1"), []byte("v1")))
+			assert.NoError(t, kv.Set([]byte("k2"), []byte("v2")))
+			assert.NoError(t, kv.Set([]byte("k3"), nil))
+			assert.NoError(t, kv.Set([]byte("k4"), []byte("v4")))
+
+			sum := checksum()
+			assert.NotEqual(t, empty, sum)
+			sums[name] = sum
+
+			// keys out of the range has no effect
+			assert.NoError(t, kv.Set([]byte("k4"), []byte("v5")))
+			assert.Equal(t, sum, checksum())
+
+			// the checksum is computed on the view taken by Checksum
+			compute, err := s.Checksum([]byte("k1"), []byte("k4"), time.Now().Unix())
+			assert.NoError(t, err)
+			assert.NoError(t, kv.Set([]byte("k2"), []byte("v3")))
+			value, err := compute()
+			assert.NoError(t, err)
+			assert.Equal(t, sum, value)
+			assert.NotEqual(t, sum, checksum())
+
+			// the expiration is checked at the giving time, not the current time
+			assert.NoError(t, kv.Set([]byte("k2"), []byte("v2")))
+			assert.NoError(t, kv.SetWithTTL([]byte("k3"), nil, 10))
+			now := time.Now().Unix()
+			compute, err = s.Checksum([]byte("k1"), []byte("k4"), now)
+			assert.NoError(t, err)
+			value, err = compute()
+			assert.NoError(t, err)
+			assert.Equal(t, sum, value)
+			compute, err = s.Checksum([]byte("k1"), []byte("k4"), now+20)
+			assert.NoError(t, err)
+			value, err = compute()
+			assert.NoError(t, err)
+			assert.NotEqual(t, sum, value)
+		})
+	}
+
+	// all the storages have the same checksum with the same data, including the empty values
+	assert.Equal(t, sums["memory"], sums["pebble"])
+}
+
 func TestCreateAndApply(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 	fs := vfs.GetTestFS()
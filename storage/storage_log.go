@@ -0,0 +1,46 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/matrixorigin/matrixcube/util"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+// LogStorage the storage to save the raft logs of the shards. The raft state of the shards
+// is saved in the MetadataStorage after the entries appended, so the entries after the last
+// index of the raft state are never read. The methods returns `raft.ErrUnavailable` if the
+// requested entries are missing.
+type LogStorage interface {
+	CloseableStorage
+	// Append appends the entries of the shard, the entries in (the index of the last appended
+	// entry, prevLastIndex] are the uncommitted entries replaced by the new leader, they must
+	// be removed. The implementation can add the entries to the write batch which is written
+	// to the MetadataStorage with the raft state, otherwise the entries must be durable after
+	// Append returns if sync is true.
+	Append(shardID uint64, prevLastIndex uint64, entries []raftpb.Entry, wb *util.WriteBatch, sync bool) error
+	// Entries returns the entries in [low, high) of the shard. The total size of the returned
+	// entries is limited by maxSize, but at least one entry is returned.
+	Entries(shardID uint64, low, high, maxSize uint64) ([]raftpb.Entry, error)
+	// Term returns the term of the entry at the index
+	Term(shardID uint64, index uint64) (uint64, error)
+	// FirstIndex returns the index of the first entry of the shard, returns 0 if the shard has
+	// no entries.
+	FirstIndex(shardID uint64) (uint64, error)
+	// Compact removes the entries of the shard whose index is less than the index
+	Compact(shardID uint64, index uint64) error
+	// Remove removes all the entries of the shard, it's called after the shard is destroyed
+	// and the tombstone state is saved in the MetadataStorage.
+	Remove(shardID uint64) error
+}
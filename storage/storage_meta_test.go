@@ -182,10 +182,6 @@ func TestSetAndGetWithTTL(t *testing.T) {
 	defer vfs.ReportLeakedFD(fs, t)
 	for name, factory := range factories {
 		t.Run(name, func(t *testing.T) {
-			if name == "pebble" {
-				return
-			}
-
 			s := factory(fs, t)
 			defer s.Close()
 			key1 := []byte("k1")
@@ -219,10 +215,6 @@ func TestWritebatchWithTTL(t *testing.T) {
 	defer vfs.ReportLeakedFD(fs, t)
 	for name, factory := range factories {
 		t.Run(name, func(t *testing.T) {
-			if name == "pebble" {
-				return
-			}
-
 			s := factory(fs, t)
 			defer s.Close()
 			key1 := []byte("k1")
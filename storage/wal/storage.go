@@ -0,0 +1,728 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fagongzi/log"
+	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/matrixorigin/matrixcube/util"
+	"github.com/matrixorigin/matrixcube/vfs"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[wal]")
+
+	errClosed = errors.New("wal is closed")
+)
+
+const (
+	// DefaultSegmentSize the default size of the segment file
+	DefaultSegmentSize uint64 = 64 * 1024 * 1024
+
+	segmentSuffix = ".wal"
+	// crc32 and the length of the payload
+	recordHeaderSize = 8
+	// record type and the shard id
+	payloadHeaderSize = 9
+
+	recordEntries    = byte(0)
+	recordCompact    = byte(1)
+	recordRemove     = byte(2)
+	recordCheckpoint = byte(3)
+)
+
+var _ storage.LogStorage = (*Storage)(nil)
+
+// position the position of an entry in the segment files
+type position struct {
+	segment uint64
+	offset  int64
+	length  uint32
+	term    uint64
+}
+
+// shardLog the positions of the entries in [first, first + len(positions)) of a shard
+type shardLog struct {
+	first     uint64
+	positions []position
+}
+
+type segment struct {
+	id   uint64
+	file vfs.File
+	// writer the writer of the active segment, or the rotated segment which is
+	// being synced
+	writer vfs.File
+	// refs number of the live entries in the segment
+	refs int
+}
+
+// Storage is a segmented write-ahead log which implements the `storage.LogStorage`. The
+// entries of all the shards are appended to the active segment file, the concurrent
+// appends share the fsync of the active segment. A new segment is created after the
+// active segment exceeds the segment size, and a segment file is removed after all the
+// entries in it are compacted or removed.
+//
+// Each segment starts with a checkpoint record, which records the first index of every
+// shard at the time the segment is created. So the entries in the older segments which
+// are compacted or removed by the records in a removed segment are dropped at replay.
+type Storage struct {
+	fs          vfs.FS
+	dir         string
+	segmentSize uint64
+
+	mu struct {
+		sync.RWMutex
+
+		closed     bool
+		shards     map[uint64]*shardLog
+		segments   map[uint64]*segment
+		activeID   uint64
+		active     vfs.File
+		activeSize uint64
+		// syncing the writer which is being synced without the lock
+		syncing vfs.File
+		// written the total bytes written, it's used to check whether the appended
+		// entries is synced
+		written uint64
+	}
+
+	syncMu sync.Mutex
+	synced uint64
+}
+
+// NewStorage returns a segmented write-ahead log in the dir. The existing segments in the
+// dir are replayed, and a new segment is created as the active segment.
+func NewStorage(fs vfs.FS, dir string, segmentSize uint64) (*Storage, error) {
+	if segmentSize == 0 {
+		segmentSize = DefaultSegmentSize
+	}
+
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &Storage{fs: fs, dir: dir, segmentSize: segmentSize}
+	s.mu.shards = make(map[uint64]*shardLog)
+	s.mu.segments = make(map[uint64]*segment)
+	if err := s.replay(); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.rotateLocked(); err != nil {
+		s.closeLocked()
+		return nil, err
+	}
+	s.reclaimLocked()
+	return s, nil
+}
+
+// Append appends the entries of the shard to the active segment. The entries with the index
+// not less than the first appended entry are replaced, so the prevLastIndex and the write
+// batch are not used.
+func (s *Storage) Append(shardID uint64, prevLastIndex uint64, entries []raftpb.Entry, wb *util.WriteBatch, sync bool) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	data, offsets := encodeEntries(shardID, entries)
+
+	s.mu.Lock()
+	if s.mu.closed {
+		s.mu.Unlock()
+		return errClosed
+	}
+
+	if s.mu.activeSize > 0 && s.mu.activeSize+uint64(len(data)) > s.segmentSize {
+		if err := s.rotateLocked(); err != nil {
+			s.mu.Unlock()
+			return err
+		}
+	}
+
+	base := int64(s.mu.activeSize)
+	if err := s.writeLocked(data); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+
+	positions := make([]position, len(entries))
+	for i, e := range entries {
+		positions[i] = position{
+			segment: s.mu.activeID,
+			offset:  base + offsets[i],
+			length:  uint32(e.Size()),
+			term:    e.Term,
+		}
+	}
+	s.appendLocked(shardID, entries[0].Index, positions)
+	s.reclaimLocked()
+	seq := s.mu.written
+	s.mu.Unlock()
+
+	if sync {
+		return s.sync(seq)
+	}
+	return nil
+}
+
+// Entries returns the entries in [low, high) of the shard
+func (s *Storage) Entries(shardID uint64, low, high, maxSize uint64) ([]raftpb.Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sl, ok := s.mu.shards[shardID]
+	if !ok || low < sl.first || high > sl.first+uint64(len(sl.positions)) {
+		return nil, raft.ErrUnavailable
+	}
+
+	var ents []raftpb.Entry
+	var size uint64
+	for _, pos := range sl.positions[low-sl.first : high-sl.first] {
+		size += uint64(pos.length)
+		if size > maxSize && len(ents) > 0 {
+			break
+		}
+
+		e, err := s.readLocked(pos)
+		if err != nil {
+			return nil, err
+		}
+		ents = append(ents, e)
+	}
+	return ents, nil
+}
+
+// Term returns the term of the entry at the index
+func (s *Storage) Term(shardID uint64, index uint64) (uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sl, ok := s.mu.shards[shardID]
+	if !ok || index < sl.first || index >= sl.first+uint64(len(sl.positions)) {
+		return 0, raft.ErrUnavailable
+	}
+	return sl.positions[index-sl.first].term, nil
+}
+
+// FirstIndex returns the index of the first entry of the shard
+func (s *Storage) FirstIndex(shardID uint64) (uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if sl, ok := s.mu.shards[shardID]; ok {
+		return sl.first, nil
+	}
+	return 0, nil
+}
+
+// Compact removes the entries of the shard whose index is less than the index, the segments
+// without live entries are removed.
+func (s *Storage) Compact(shardID uint64, index uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mu.closed {
+		return errClosed
+	}
+
+	sl, ok := s.mu.shards[shardID]
+	if !ok || index <= sl.first {
+		return nil
+	}
+
+	if err := s.writeLocked(encodeRecord(recordCompact, shardID, uint64Bytes(index))); err != nil {
+		return err
+	}
+	s.compactLocked(shardID, index)
+	s.reclaimLocked()
+	return nil
+}
+
+// Remove removes all the entries of the shard
+func (s *Storage) Remove(shardID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mu.closed {
+		return errClosed
+	}
+
+	if _, ok := s.mu.shards[shardID]; !ok {
+		return nil
+	}
+
+	if err := s.writeLocked(encodeRecord(recordRemove, shardID, nil)); err != nil {
+		return err
+	}
+	s.removeLocked(shardID)
+	s.reclaimLocked()
+	return nil
+}
+
+// Close syncs the active segment and closes all the segment files
+func (s *Storage) Close() error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mu.closed {
+		return nil
+	}
+	return s.closeLocked()
+}
+
+func (s *Storage) closeLocked() error {
+	s.mu.closed = true
+
+	var err error
+	if s.mu.active != nil {
+		err = s.mu.active.Sync()
+	}
+	for _, seg := range s.mu.segments {
+		if seg.writer != nil {
+			seg.writer.Close()
+		}
+		seg.file.Close()
+	}
+	return err
+}
+
+// sync syncs the active segment if the bytes before seq are not synced. The appends which
+// wait for the same fsync are synced together.
+func (s *Storage) sync(seq uint64) error {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if atomic.LoadUint64(&s.synced) >= seq {
+		return nil
+	}
+
+	s.mu.Lock()
+	if s.mu.closed {
+		s.mu.Unlock()
+		return errClosed
+	}
+	id := s.mu.activeID
+	f := s.mu.active
+	target := s.mu.written
+	s.mu.syncing = f
+	s.mu.Unlock()
+
+	err := f.Sync()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.syncing = nil
+	// the segment is rotated during the fsync
+	if id != s.mu.activeID {
+		if seg, ok := s.mu.segments[id]; ok {
+			seg.writer = nil
+		}
+		f.Close()
+		s.reclaimLocked()
+	}
+
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint64(&s.synced, target)
+	return nil
+}
+
+func (s *Storage) writeLocked(data []byte) error {
+	// the vfs.File is allowed to modify the written data
+	if _, err := s.mu.active.Write(append([]byte(nil), data...)); err != nil {
+		return err
+	}
+
+	s.mu.activeSize += uint64(len(data))
+	s.mu.written += uint64(len(data))
+	return nil
+}
+
+func (s *Storage) readLocked(pos position) (raftpb.Entry, error) {
+	e := raftpb.Entry{}
+	seg, ok := s.mu.segments[pos.segment]
+	if !ok {
+		return e, fmt.Errorf("missing wal segment %d", pos.segment)
+	}
+
+	data := make([]byte, pos.length)
+	if _, err := seg.file.ReadAt(data, pos.offset); err != nil {
+		return e, err
+	}
+
+	err := e.Unmarshal(data)
+	return e, err
+}
+
+// rotateLocked syncs the active segment, and creates a new segment which starts with the
+// checkpoint record.
+func (s *Storage) rotateLocked() error {
+	if s.mu.active != nil {
+		if err := s.mu.active.Sync(); err != nil {
+			return err
+		}
+		// the writer is closed by the fsync which is in progress
+		if s.mu.active != s.mu.syncing {
+			s.mu.segments[s.mu.activeID].writer = nil
+			s.mu.active.Close()
+		}
+		s.mu.active = nil
+	}
+
+	id := s.mu.activeID + 1
+	name := s.segmentFile(id)
+	w, err := s.fs.Create(name)
+	if err != nil {
+		return err
+	}
+	r, err := s.fs.Open(name)
+	if err != nil {
+		w.Close()
+		return err
+	}
+
+	s.mu.activeID = id
+	s.mu.active = w
+	s.mu.activeSize = 0
+	s.mu.segments[id] = &segment{id: id, file: r, writer: w}
+	if err := s.writeLocked(s.encodeCheckpointLocked()); err != nil {
+		return err
+	}
+	return s.syncDirLocked()
+}
+
+func (s *Storage) syncDirLocked() error {
+	d, err := s.fs.OpenDir(s.dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// reclaimLocked removes the segments without live entries and writer. The records which
+// released the entries may not be synced, so the active segment is synced before the
+// removal, otherwise the released entries are still live after a crash.
+func (s *Storage) reclaimLocked() {
+	var ids []uint64
+	for id, seg := range s.mu.segments {
+		if seg.writer == nil && seg.refs <= 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	if s.mu.active != nil {
+		if err := s.mu.active.Sync(); err != nil {
+			logger.Errorf("sync wal segment %d before reclaim failed with %+v", s.mu.activeID, err)
+			return
+		}
+	}
+
+	for _, id := range ids {
+		seg := s.mu.segments[id]
+		seg.file.Close()
+		delete(s.mu.segments, id)
+		if err := s.fs.Remove(s.segmentFile(id)); err != nil {
+			logger.Errorf("remove wal segment %d failed with %+v", id, err)
+			continue
+		}
+		logger.Debugf("wal segment %d reclaimed", id)
+	}
+}
+
+func (s *Storage) appendLocked(shardID uint64, first uint64, positions []position) {
+	sl, ok := s.mu.shards[shardID]
+	if !ok {
+		sl = &shardLog{first: first}
+		s.mu.shards[shardID] = sl
+	}
+
+	// replace the entries after the first appended entry, or all the entries if there
+	// is a gap.
+	if first < sl.first || first > sl.first+uint64(len(sl.positions)) {
+		s.releaseLocked(sl.positions)
+		sl.first = first
+		sl.positions = nil
+	} else {
+		n := first - sl.first
+		s.releaseLocked(sl.positions[n:])
+		sl.positions = sl.positions[:n]
+	}
+
+	for _, pos := range positions {
+		if seg, ok := s.mu.segments[pos.segment]; ok {
+			seg.refs++
+		}
+	}
+	sl.positions = append(sl.positions, positions...)
+}
+
+func (s *Storage) compactLocked(shardID uint64, index uint64) {
+	sl, ok := s.mu.shards[shardID]
+	if !ok || index <= sl.first {
+		return
+	}
+
+	n := index - sl.first
+	if n >= uint64(len(sl.positions)) {
+		s.removeLocked(shardID)
+		return
+	}
+
+	s.releaseLocked(sl.positions[:n])
+	sl.positions = append([]position(nil), sl.positions[n:]...)
+	sl.first = index
+}
+
+func (s *Storage) removeLocked(shardID uint64) {
+	if sl, ok := s.mu.shards[shardID]; ok {
+		s.releaseLocked(sl.positions)
+		delete(s.mu.shards, shardID)
+	}
+}
+
+func (s *Storage) releaseLocked(positions []position) {
+	for _, pos := range positions {
+		if seg, ok := s.mu.segments[pos.segment]; ok {
+			seg.refs--
+		}
+	}
+}
+
+func (s *Storage) segmentFile(id uint64) string {
+	return s.fs.PathJoin(s.dir, fmt.Sprintf("%020d%s", id, segmentSuffix))
+}
+
+// replay rebuilds the positions of the entries from the existing segments
+func (s *Storage) replay() error {
+	names, err := s.fs.List(s.dir)
+	if err != nil {
+		return err
+	}
+
+	var ids []uint64
+	for _, name := range names {
+		if !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		if err := s.replaySegmentLocked(id); err != nil {
+			return err
+		}
+		s.mu.activeID = id
+	}
+
+	logger.Infof("wal replayed %d segments with %d shards",
+		len(ids),
+		len(s.mu.shards))
+	return nil
+}
+
+func (s *Storage) replaySegmentLocked(id uint64) error {
+	f, err := s.fs.Open(s.segmentFile(id))
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.mu.segments[id] = &segment{id: id, file: f}
+
+	offset := 0
+	for offset < len(data) {
+		payload, ok := decodeRecord(data[offset:])
+		if !ok {
+			// the tail of the segment is not completely written
+			logger.Warningf("wal segment %d has invalid record at %d, skip the remaining %d bytes",
+				id,
+				offset,
+				len(data)-offset)
+			break
+		}
+
+		base := int64(offset + recordHeaderSize)
+		if err := s.replayRecordLocked(id, base, payload); err != nil {
+			return fmt.Errorf("replay wal segment %d at %d failed with %+v", id, offset, err)
+		}
+		offset += recordHeaderSize + len(payload)
+	}
+	return nil
+}
+
+func (s *Storage) replayRecordLocked(id uint64, base int64, payload []byte) error {
+	if len(payload) < payloadHeaderSize {
+		return errors.New("invalid record")
+	}
+
+	shardID := binary.BigEndian.Uint64(payload[1:])
+	body := payload[payloadHeaderSize:]
+	switch payload[0] {
+	case recordEntries:
+		var positions []position
+		var first uint64
+		offset := 4
+		for i := uint32(0); i < binary.BigEndian.Uint32(body); i++ {
+			n := int(binary.BigEndian.Uint32(body[offset:]))
+			offset += 4
+			e := raftpb.Entry{}
+			if err := e.Unmarshal(body[offset : offset+n]); err != nil {
+				return err
+			}
+			if i == 0 {
+				first = e.Index
+			}
+
+			positions = append(positions, position{
+				segment: id,
+				offset:  base + payloadHeaderSize + int64(offset),
+				length:  uint32(n),
+				term:    e.Term,
+			})
+			offset += n
+		}
+		if len(positions) > 0 {
+			s.appendLocked(shardID, first, positions)
+		}
+	case recordCompact:
+		s.compactLocked(shardID, binary.BigEndian.Uint64(body))
+	case recordRemove:
+		s.removeLocked(shardID)
+	case recordCheckpoint:
+		firsts := make(map[uint64]uint64)
+		for i := uint32(0); i < binary.BigEndian.Uint32(body); i++ {
+			offset := 4 + int(i)*16
+			firsts[binary.BigEndian.Uint64(body[offset:])] = binary.BigEndian.Uint64(body[offset+8:])
+		}
+
+		// the shards without entries at the time the segment created are removed
+		for shardID := range s.mu.shards {
+			if first, ok := firsts[shardID]; ok {
+				s.compactLocked(shardID, first)
+			} else {
+				s.removeLocked(shardID)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid record type %d", payload[0])
+	}
+	return nil
+}
+
+func (s *Storage) encodeCheckpointLocked() []byte {
+	body := make([]byte, 4, 4+16*len(s.mu.shards))
+	binary.BigEndian.PutUint32(body, uint32(len(s.mu.shards)))
+	for shardID, sl := range s.mu.shards {
+		body = append(body, uint64Bytes(shardID)...)
+		body = append(body, uint64Bytes(sl.first)...)
+	}
+	return encodeRecord(recordCheckpoint, 0, body)
+}
+
+// encodeEntries returns the entries record, and the offsets of the entries in the record
+func encodeEntries(shardID uint64, entries []raftpb.Entry) ([]byte, []int64) {
+	size := 4
+	for _, e := range entries {
+		size += 4 + e.Size()
+	}
+
+	body := make([]byte, size)
+	offsets := make([]int64, len(entries))
+	binary.BigEndian.PutUint32(body, uint32(len(entries)))
+	offset := 4
+	for i, e := range entries {
+		n := e.Size()
+		binary.BigEndian.PutUint32(body[offset:], uint32(n))
+		offset += 4
+		offsets[i] = int64(recordHeaderSize + payloadHeaderSize + offset)
+		e.MarshalTo(body[offset : offset+n])
+		offset += n
+	}
+	return encodeRecord(recordEntries, shardID, body), offsets
+}
+
+// encodeRecord returns the record, the format is crc32(4 bytes) + payload length(4 bytes)
+// + payload, and the payload is record type(1 byte) + shard id(8 bytes) + body.
+func encodeRecord(recordType byte, shardID uint64, body []byte) []byte {
+	data := make([]byte, recordHeaderSize+payloadHeaderSize+len(body))
+	payload := data[recordHeaderSize:]
+	payload[0] = recordType
+	binary.BigEndian.PutUint64(payload[1:], shardID)
+	copy(payload[payloadHeaderSize:], body)
+
+	binary.BigEndian.PutUint32(data, crc32.ChecksumIEEE(payload))
+	binary.BigEndian.PutUint32(data[4:], uint32(len(payload)))
+	return data
+}
+
+// decodeRecord returns the payload of the record, returns false if the record is
+// incomplete or corrupted.
+func decodeRecord(data []byte) ([]byte, bool) {
+	if len(data) < recordHeaderSize {
+		return nil, false
+	}
+
+	n := int(binary.BigEndian.Uint32(data[4:]))
+	if n < payloadHeaderSize || len(data) < recordHeaderSize+n {
+		return nil, false
+	}
+
+	payload := data[recordHeaderSize : recordHeaderSize+n]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(data) {
+		return nil, false
+	}
+	return payload, true
+}
+
+func uint64Bytes(v uint64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, v)
+	return data
+}
@@ -0,0 +1,226 @@
+package wal
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/util"
+	"github.com/matrixorigin/matrixcube/util/leaktest"
+	"github.com/matrixorigin/matrixcube/vfs"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+func createTestWAL(t *testing.T, fs vfs.FS, segmentSize uint64) (*Storage, string) {
+	dir := filepath.Join(util.GetTestDir(), "wal", fmt.Sprintf("%d", time.Now().UnixNano()))
+	fs.RemoveAll(dir)
+	s, err := NewStorage(fs, dir, segmentSize)
+	assert.NoError(t, err)
+	return s, dir
+}
+
+func newTestEntries(low, high, term uint64) []raftpb.Entry {
+	var ents []raftpb.Entry
+	for i := low; i < high; i++ {
+		ents = append(ents, raftpb.Entry{Index: i, Term: term, Data: []byte(fmt.Sprintf("data-%d", i))})
+	}
+	return ents
+}
+
+func TestAppendAndEntries(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+
+	s, _ := createTestWAL(t, fs, 0)
+	defer s.Close()
+
+	assert.NoError(t, s.Append(1, 0, newTestEntries(1, 11, 1), nil, true))
+	assert.NoError(t, s.Append(2, 0, newTestEntries(5, 8, 2), nil, true))
+
+	ents, err := s.Entries(1, 1, 11, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 11, 1), ents)
+
+	ents, err = s.Entries(1, 3, 11, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ents))
+	assert.Equal(t, uint64(3), ents[0].Index)
+
+	_, err = s.Entries(1, 1, 12, 1024)
+	assert.Equal(t, raft.ErrUnavailable, err)
+	_, err = s.Entries(2, 4, 6, 1024)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	term, err := s.Term(2, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), term)
+
+	first, err := s.FirstIndex(2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), first)
+
+	first, err = s.FirstIndex(3)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), first)
+
+	// the new leader replaces the uncommitted entries
+	assert.NoError(t, s.Append(1, 10, newTestEntries(6, 8, 3), nil, true))
+	_, err = s.Entries(1, 1, 9, 1024)
+	assert.Equal(t, raft.ErrUnavailable, err)
+	ents, err = s.Entries(1, 5, 8, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), ents[0].Term)
+	assert.Equal(t, newTestEntries(6, 8, 3), ents[1:])
+}
+
+func TestCompactAndRemove(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+
+	s, _ := createTestWAL(t, fs, 0)
+	defer s.Close()
+
+	assert.NoError(t, s.Append(1, 0, newTestEntries(1, 11, 1), nil, true))
+	assert.NoError(t, s.Compact(1, 5))
+	first, err := s.FirstIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), first)
+	_, err = s.Term(1, 4)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	assert.NoError(t, s.Remove(1))
+	first, err = s.FirstIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), first)
+}
+
+func TestReplay(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+
+	s, dir := createTestWAL(t, fs, 1024)
+	for i := uint64(1); i < 100; i++ {
+		assert.NoError(t, s.Append(1, 0, newTestEntries(i, i+1, 1), nil, true))
+		assert.NoError(t, s.Append(2, 0, newTestEntries(i, i+1, 1), nil, true))
+	}
+	assert.NoError(t, s.Append(3, 0, newTestEntries(1, 10, 1), nil, true))
+	assert.NoError(t, s.Compact(1, 50))
+	assert.NoError(t, s.Append(2, 0, newTestEntries(80, 90, 2), nil, true))
+	assert.NoError(t, s.Remove(3))
+	assert.NoError(t, s.Close())
+
+	s, err := NewStorage(fs, dir, 1024)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	ents, err := s.Entries(1, 50, 100, 1024*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(50, 100, 1), ents)
+	_, err = s.Term(1, 49)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	ents, err = s.Entries(2, 1, 90, 1024*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 80, 1), ents[:79])
+	assert.Equal(t, newTestEntries(80, 90, 2), ents[79:])
+	_, err = s.Term(2, 90)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	first, err := s.FirstIndex(3)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), first)
+}
+
+func TestReplayWithTornRecord(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+
+	s, dir := createTestWAL(t, fs, 0)
+	assert.NoError(t, s.Append(1, 0, newTestEntries(1, 3, 1), nil, true))
+	name := s.segmentFile(s.mu.activeID)
+	assert.NoError(t, s.Close())
+
+	// append a half written record
+	f, err := fs.Open(name)
+	assert.NoError(t, err)
+	stat, err := f.Stat()
+	assert.NoError(t, err)
+	data := make([]byte, stat.Size())
+	_, err = f.ReadAt(data, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	record, _ := encodeEntries(1, newTestEntries(3, 4, 1))
+	f, err = fs.Create(name)
+	assert.NoError(t, err)
+	_, err = f.Write(append(data, record[:len(record)-1]...))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Sync())
+	assert.NoError(t, f.Close())
+
+	s, err = NewStorage(fs, dir, 0)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	ents, err := s.Entries(1, 1, 3, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 3, 1), ents)
+	_, err = s.Term(1, 3)
+	assert.Equal(t, raft.ErrUnavailable, err)
+}
+
+func TestSegmentReclaim(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+
+	s, dir := createTestWAL(t, fs, 512)
+	defer s.Close()
+
+	for i := uint64(1); i < 100; i++ {
+		assert.NoError(t, s.Append(1, 0, newTestEntries(i, i+1, 1), nil, false))
+	}
+	names, err := fs.List(dir)
+	assert.NoError(t, err)
+	assert.True(t, len(names) > 1)
+
+	assert.NoError(t, s.Compact(1, 100))
+	names, err = fs.List(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(names))
+}
+
+func TestConcurrentAppend(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	fs := vfs.GetTestFS()
+	defer vfs.ReportLeakedFD(fs, t)
+
+	s, _ := createTestWAL(t, fs, 4096)
+	defer s.Close()
+
+	var wg sync.WaitGroup
+	for shardID := uint64(1); shardID <= 10; shardID++ {
+		wg.Add(1)
+		go func(shardID uint64) {
+			defer wg.Done()
+			for i := uint64(1); i <= 100; i++ {
+				assert.NoError(t, s.Append(shardID, 0, newTestEntries(i, i+1, 1), nil, true))
+			}
+		}(shardID)
+	}
+	wg.Wait()
+
+	for shardID := uint64(1); shardID <= 10; shardID++ {
+		ents, err := s.Entries(shardID, 1, 101, 1024*1024)
+		assert.NoError(t, err)
+		assert.Equal(t, newTestEntries(1, 101, 1), ents)
+	}
+}
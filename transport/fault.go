@@ -0,0 +1,234 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+// FaultAction is the fault injected into the matched raft messages
+type FaultAction int
+
+const (
+	// FaultDrop drops the message
+	FaultDrop FaultAction = iota
+	// FaultDelay sends the message after the delay of the rule
+	FaultDelay
+	// FaultDuplicate sends the message twice
+	FaultDuplicate
+)
+
+// FaultRule matches the raft messages sent from the `From` stores to the `To` stores, and
+// injects the fault into the matched messages.
+type FaultRule struct {
+	// From the stores which send the messages, empty means all the stores
+	From []uint64
+	// To the stores which receive the messages, empty means all the stores
+	To []uint64
+	// Bidirectional the rule also matches the messages sent from the `To` stores to the
+	// `From` stores
+	Bidirectional bool
+	// MsgTypes the raft message types, empty means all the types
+	MsgTypes []raftpb.MessageType
+	// Action the fault injected into the matched messages
+	Action FaultAction
+	// Delay the delay of the FaultDelay action
+	Delay time.Duration
+	// Probability the probability to inject the fault into a matched message, 0 means 1
+	Probability float64
+}
+
+func (r FaultRule) match(msg *bhraftpb.RaftMessage) bool {
+	from, to := msg.From.ContainerID, msg.To.ContainerID
+	// the messages to the local store are not sent by the network
+	if from == to {
+		return false
+	}
+
+	if len(r.MsgTypes) > 0 && !containsMsgType(r.MsgTypes, msg.Message.Type) {
+		return false
+	}
+
+	if matchStores(r.From, from) && matchStores(r.To, to) {
+		return true
+	}
+	return r.Bidirectional && matchStores(r.From, to) && matchStores(r.To, from)
+}
+
+func matchStores(stores []uint64, id uint64) bool {
+	if len(stores) == 0 {
+		return true
+	}
+
+	for _, s := range stores {
+		if s == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMsgType(types []raftpb.MessageType, tp raftpb.MessageType) bool {
+	for _, t := range types {
+		if t == tp {
+			return true
+		}
+	}
+	return false
+}
+
+// Faults is the set of the fault rules, it is shared by the fault transports of all the
+// stores in a cluster, so the rules can be changed at runtime in one place.
+type Faults struct {
+	sync.Mutex
+
+	id    uint64
+	ids   []uint64
+	rules []FaultRule
+	rnd   *rand.Rand
+}
+
+// NewFaults returns an empty fault rules set
+func NewFaults() *Faults {
+	return &Faults{
+		rnd: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Add adds the rule, and returns the id to remove the rule
+func (f *Faults) Add(rule FaultRule) uint64 {
+	f.Lock()
+	defer f.Unlock()
+
+	f.id++
+	f.ids = append(f.ids, f.id)
+	f.rules = append(f.rules, rule)
+	return f.id
+}
+
+// Partition drops all the messages between the left stores and the right stores
+func (f *Faults) Partition(left, right []uint64) uint64 {
+	return f.Add(FaultRule{
+		From:          left,
+		To:            right,
+		Bidirectional: true,
+		Action:        FaultDrop,
+	})
+}
+
+// Remove removes the rule
+func (f *Faults) Remove(id uint64) {
+	f.Lock()
+	defer f.Unlock()
+
+	for i, v := range f.ids {
+		if v == id {
+			f.ids = append(f.ids[:i], f.ids[i+1:]...)
+			f.rules = append(f.rules[:i], f.rules[i+1:]...)
+			return
+		}
+	}
+}
+
+// Clear removes all the rules
+func (f *Faults) Clear() {
+	f.Lock()
+	defer f.Unlock()
+
+	f.ids = nil
+	f.rules = nil
+}
+
+// apply returns the faults injected into the message. The message is dropped if any
+// matched rule drops it, and is delayed by the max delay of the matched rules.
+func (f *Faults) apply(msg *bhraftpb.RaftMessage) (drop bool, delay time.Duration, duplicate bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, r := range f.rules {
+		if !r.match(msg) ||
+			(r.Probability > 0 && f.rnd.Float64() >= r.Probability) {
+			continue
+		}
+
+		switch r.Action {
+		case FaultDrop:
+			drop = true
+		case FaultDelay:
+			if r.Delay > delay {
+				delay = r.Delay
+			}
+		case FaultDuplicate:
+			duplicate = true
+		}
+	}
+	return
+}
+
+type faultTransport struct {
+	Transport
+
+	faults  *Faults
+	stopped uint32
+}
+
+// NewFaultTransport returns a transport which injects the faults of the rules into the
+// raft messages sent by the transport, it is used to test the raftstore with the
+// unreliable network.
+func NewFaultTransport(trans Transport, faults *Faults) Transport {
+	return &faultTransport{
+		Transport: trans,
+		faults:    faults,
+	}
+}
+
+func (t *faultTransport) Stop() {
+	atomic.StoreUint32(&t.stopped, 1)
+	t.Transport.Stop()
+}
+
+func (t *faultTransport) Send(msg *bhraftpb.RaftMessage) {
+	drop, delay, duplicate := t.faults.apply(msg)
+	if drop {
+		return
+	}
+
+	if duplicate {
+		// the transport releases the message after sent
+		dup := &bhraftpb.RaftMessage{}
+		protoc.MustUnmarshal(dup, protoc.MustMarshal(msg))
+		t.send(dup, delay)
+	}
+	t.send(msg, delay)
+}
+
+func (t *faultTransport) send(msg *bhraftpb.RaftMessage, delay time.Duration) {
+	if delay == 0 {
+		t.Transport.Send(msg)
+		return
+	}
+
+	time.AfterFunc(delay, func() {
+		if atomic.LoadUint32(&t.stopped) == 0 {
+			t.Transport.Send(msg)
+		}
+	})
+}
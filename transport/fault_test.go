@@ -0,0 +1,100 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+type testTransport struct {
+	sync.Mutex
+	sent []*bhraftpb.RaftMessage
+}
+
+func (t *testTransport) Start()                       {}
+func (t *testTransport) Stop()                        {}
+func (t *testTransport) SendingSnapshotCount() uint64 { return 0 }
+
+func (t *testTransport) Send(msg *bhraftpb.RaftMessage) {
+	t.Lock()
+	defer t.Unlock()
+	t.sent = append(t.sent, msg)
+}
+
+func (t *testTransport) count() int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.sent)
+}
+
+func newTestRaftMessage(from, to uint64, tp raftpb.MessageType) *bhraftpb.RaftMessage {
+	return &bhraftpb.RaftMessage{
+		From:    metapb.Peer{ContainerID: from},
+		To:      metapb.Peer{ContainerID: to},
+		Message: raftpb.Message{Type: tp},
+	}
+}
+
+func TestFaultRuleMatch(t *testing.T) {
+	r := FaultRule{From: []uint64{1}, To: []uint64{2}}
+	assert.True(t, r.match(newTestRaftMessage(1, 2, raftpb.MsgApp)))
+	assert.False(t, r.match(newTestRaftMessage(2, 1, raftpb.MsgApp)))
+	assert.False(t, r.match(newTestRaftMessage(1, 3, raftpb.MsgApp)))
+
+	r.Bidirectional = true
+	assert.True(t, r.match(newTestRaftMessage(2, 1, raftpb.MsgApp)))
+
+	r.MsgTypes = []raftpb.MessageType{raftpb.MsgHeartbeat}
+	assert.False(t, r.match(newTestRaftMessage(1, 2, raftpb.MsgApp)))
+	assert.True(t, r.match(newTestRaftMessage(1, 2, raftpb.MsgHeartbeat)))
+
+	// empty stores match all the stores except the local store
+	r = FaultRule{}
+	assert.True(t, r.match(newTestRaftMessage(1, 2, raftpb.MsgApp)))
+	assert.False(t, r.match(newTestRaftMessage(1, 1, raftpb.MsgApp)))
+}
+
+func TestFaultTransport(t *testing.T) {
+	faults := NewFaults()
+	trans := &testTransport{}
+	ft := NewFaultTransport(trans, faults)
+
+	id := faults.Partition([]uint64{1}, []uint64{2, 3})
+	ft.Send(newTestRaftMessage(1, 2, raftpb.MsgApp))
+	ft.Send(newTestRaftMessage(3, 1, raftpb.MsgApp))
+	ft.Send(newTestRaftMessage(2, 3, raftpb.MsgApp))
+	assert.Equal(t, 1, trans.count())
+
+	faults.Remove(id)
+	ft.Send(newTestRaftMessage(1, 2, raftpb.MsgApp))
+	assert.Equal(t, 2, trans.count())
+
+	faults.Add(FaultRule{Action: FaultDuplicate})
+	ft.Send(newTestRaftMessage(1, 2, raftpb.MsgApp))
+	assert.Equal(t, 4, trans.count())
+
+	faults.Clear()
+	faults.Add(FaultRule{Action: FaultDelay, Delay: time.Millisecond * 50})
+	ft.Send(newTestRaftMessage(1, 2, raftpb.MsgApp))
+	assert.Equal(t, 4, trans.count())
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, 5, trans.count())
+}
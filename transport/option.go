@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 )
 
 // Option transport option
@@ -30,6 +31,7 @@ type options struct {
 	raftWorkerCount  uint64
 	snapWorkerCount  uint64
 	errorHandlerFunc func(*bhraftpb.RaftMessage, error)
+	tls              *tlsutil.Loader
 }
 
 // WithTimeout set read and write timeout for rpc
@@ -68,3 +70,11 @@ func WithErrorHandler(value func(*bhraftpb.RaftMessage, error)) Option {
 		opts.errorHandlerFunc = value
 	}
 }
+
+// WithTLS set the tls loader, the raft messages and the snapshots are sent by tls
+// if the loader is not nil
+func WithTLS(value *tlsutil.Loader) Option {
+	return func(opts *options) {
+		opts.tls = value
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
 	"github.com/matrixorigin/matrixcube/snapshot"
+	"github.com/matrixorigin/matrixcube/util/tlsutil"
 	"go.etcd.io/etcd/raft/raftpb"
 )
 
@@ -90,7 +91,11 @@ func NewDefaultTransport(
 	baseEncoder := newRaftEncoder()
 	baseDecoder := newRaftDecoder()
 	t.encoder, t.decoder = length.NewWithSize(baseEncoder, baseDecoder, 0, 0, 0, t.opts.maxBodySize)
-	app, err := goetty.NewTCPApplication(addr, t.onMessage,
+	listener, err := t.opts.tls.Listen(addr)
+	if err != nil {
+		logger.Fatalf("create transport failed with %+v", err)
+	}
+	app, err := goetty.NewApplication(listener, t.onMessage,
 		goetty.WithAppSessionOptions(goetty.WithCodec(t.encoder, t.decoder),
 			goetty.WithTimeout(t.opts.readTimeout, t.opts.writeTimeout),
 			goetty.WithLogger(logger),
@@ -403,6 +408,15 @@ func (t *defaultTransport) checkConnect(id uint64, conn goetty.IOSession) bool {
 }
 
 func (t *defaultTransport) createConn() (goetty.IOSession, error) {
+	if t.opts.tls != nil {
+		return tlsutil.NewIOSession(t.opts.tls, tlsutil.SessionOptions{
+			Encoder:      t.encoder,
+			Decoder:      t.decoder,
+			ReadTimeout:  t.opts.readTimeout,
+			WriteTimeout: t.opts.writeTimeout,
+		}), nil
+	}
+
 	return goetty.NewIOSession(goetty.WithCodec(t.encoder, t.decoder),
 		goetty.WithTimeout(t.opts.readTimeout, t.opts.writeTimeout)), nil
 }
@@ -0,0 +1,173 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/storage"
+)
+
+const (
+	// recordsAttr the attr of the apply context to keep the records which are modified but
+	// not written to the storage in the current raft log.
+	recordsAttr = "txn.records"
+)
+
+func prewriteCmd(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	cmd := txnpb.PrewriteRequest{}
+	return execWrite(req, ctx, &cmd, func(r *txnpb.Record, resp *txnpb.Response) bool {
+		changed, err := prewrite(r, req.Key, cmd)
+		setError(resp, err)
+		return changed
+	})
+}
+
+func commitCmd(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	cmd := txnpb.CommitRequest{}
+	return execWrite(req, ctx, &cmd, func(r *txnpb.Record, resp *txnpb.Response) bool {
+		changed, err := commit(r, req.Key, cmd)
+		setError(resp, err)
+		return changed
+	})
+}
+
+func rollbackCmd(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	cmd := txnpb.RollbackRequest{}
+	return execWrite(req, ctx, &cmd, func(r *txnpb.Record, resp *txnpb.Response) bool {
+		changed, err := rollback(r, req.Key, cmd.StartTS)
+		setError(resp, err)
+		return changed
+	})
+}
+
+func checkTxnStatusCmd(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	cmd := txnpb.CheckTxnStatusRequest{}
+	return execWrite(req, ctx, &cmd, func(r *txnpb.Record, resp *txnpb.Response) bool {
+		changed, status := checkTxnStatus(r, req.Key, cmd)
+		resp.CheckTxnStatus = status
+		return changed
+	})
+}
+
+func getCmd(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (*raftcmdpb.Response, uint64) {
+	resp := pb.AcquireResponse()
+	txnResp := &txnpb.Response{}
+
+	cmd := txnpb.GetRequest{}
+	if err := cmd.Unmarshal(req.Cmd); err != nil {
+		txnResp.Error.Message = err.Error()
+		resp.Value = protoc.MustMarshal(txnResp)
+		return resp, 0
+	}
+
+	r, _, err := loadRecord(req.Key, ctx)
+	if err != nil {
+		txnResp.Error.Message = err.Error()
+		resp.Value = protoc.MustMarshal(txnResp)
+		return resp, 0
+	}
+
+	value, txnErr := get(r, req.Key, cmd.StartTS)
+	setError(txnResp, txnErr)
+	txnResp.Get = value
+	resp.Value = protoc.MustMarshal(txnResp)
+	return resp, uint64(len(value.Value))
+}
+
+func execWrite(req *raftcmdpb.Request, ctx command.Context, cmd protoc.PB,
+	fn func(*txnpb.Record, *txnpb.Response) bool) (uint64, int64, *raftcmdpb.Response) {
+	resp := pb.AcquireResponse()
+	txnResp := &txnpb.Response{}
+
+	if err := cmd.Unmarshal(req.Cmd); err != nil {
+		txnResp.Error.Message = err.Error()
+		resp.Value = protoc.MustMarshal(txnResp)
+		return 0, 0, resp
+	}
+
+	r, size, err := loadRecord(req.Key, ctx)
+	if err != nil {
+		txnResp.Error.Message = err.Error()
+		resp.Value = protoc.MustMarshal(txnResp)
+		return 0, 0, resp
+	}
+
+	writtenBytes := uint64(0)
+	diffBytes := int64(0)
+	if fn(r, txnResp) {
+		value := protoc.MustMarshal(r)
+		if err := ctx.WriteBatch().Set(req.Key, value); err != nil {
+			txnResp = &txnpb.Response{}
+			txnResp.Error.Message = err.Error()
+			resp.Value = protoc.MustMarshal(txnResp)
+			return 0, 0, resp
+		}
+
+		records(ctx)[string(req.Key)] = r
+		writtenBytes = uint64(len(req.Key) + len(value))
+		diffBytes = int64(len(value) - size)
+		if size == 0 {
+			diffBytes += int64(len(req.Key))
+		}
+	}
+
+	resp.Value = protoc.MustMarshal(txnResp)
+	return writtenBytes, diffBytes, resp
+}
+
+// loadRecord returns the record of the key and the size of the record in the storage. The
+// records which are modified by the previous commands in the same raft log are not written
+// into the storage, so they are read from the apply context first.
+func loadRecord(key []byte, ctx command.Context) (*txnpb.Record, int, error) {
+	if v, ok := ctx.Attrs()[recordsAttr]; ok {
+		if r, ok := v.(map[string]*txnpb.Record)[string(key)]; ok {
+			return r, r.Size(), nil
+		}
+	}
+
+	value, err := ctx.DataStorage().(storage.KVStorage).Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r := &txnpb.Record{}
+	if len(value) > 0 {
+		if err := r.Unmarshal(value); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, len(value), nil
+}
+
+func records(ctx command.Context) map[string]*txnpb.Record {
+	attrs := ctx.Attrs()
+	if v, ok := attrs[recordsAttr]; ok {
+		return v.(map[string]*txnpb.Record)
+	}
+
+	v := make(map[string]*txnpb.Record)
+	attrs[recordsAttr] = v
+	return v
+}
+
+func setError(resp *txnpb.Response, err *txnpb.TxnError) {
+	if err != nil {
+		resp.Error = *err
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+)
+
+var (
+	// maxVersions max number of versions of a key, the oldest versions are removed by gc
+	maxVersions = 32
+)
+
+func newKeyLockedError(key []byte, lock txnpb.Lock) *txnpb.TxnError {
+	return &txnpb.TxnError{
+		Message:   fmt.Sprintf("key %+v is locked by txn %d", key, lock.StartTS),
+		KeyLocked: &txnpb.KeyLocked{Key: key, Lock: lock},
+	}
+}
+
+func newWriteConflictError(key []byte, startTS, conflictTS uint64) *txnpb.TxnError {
+	return &txnpb.TxnError{
+		Message:       fmt.Sprintf("txn %d write conflict with %d on key %+v", startTS, conflictTS, key),
+		WriteConflict: &txnpb.WriteConflict{Key: key, StartTS: startTS, ConflictTS: conflictTS},
+	}
+}
+
+func newTxnAbortedError(key []byte, startTS uint64) *txnpb.TxnError {
+	return &txnpb.TxnError{
+		Message:    fmt.Sprintf("txn %d is aborted on key %+v", startTS, key),
+		TxnAborted: &txnpb.TxnAborted{Key: key, StartTS: startTS},
+	}
+}
+
+// findVersion returns the version written by the txn
+func findVersion(r *txnpb.Record, startTS uint64) (txnpb.Version, bool) {
+	for _, v := range r.Versions {
+		if v.StartTS == startTS {
+			return v, true
+		}
+	}
+	return txnpb.Version{}, false
+}
+
+// addVersion add the version and keep the versions order by commitTS desc, the oldest
+// versions will be removed if there are too many versions. The gcTS is the watermark of
+// the removed versions, the removed rollbacks are folded into it too, so the prewrites
+// of the removed versions are rejected, see prewrite.
+func addVersion(r *txnpb.Record, v txnpb.Version) {
+	r.Versions = append(r.Versions, v)
+	sort.SliceStable(r.Versions, func(i, j int) bool {
+		return r.Versions[i].CommitTS > r.Versions[j].CommitTS
+	})
+
+	for len(r.Versions) > maxVersions {
+		removed := r.Versions[len(r.Versions)-1]
+		if removed.CommitTS > r.GcTS {
+			r.GcTS = removed.CommitTS
+		}
+		r.Versions = r.Versions[:len(r.Versions)-1]
+	}
+}
+
+// prewrite lock the key by the txn, returns true if the record is changed
+func prewrite(r *txnpb.Record, key []byte, req txnpb.PrewriteRequest) (bool, *txnpb.TxnError) {
+	if r.Lock != nil {
+		if r.Lock.StartTS == req.StartTS {
+			return false, nil
+		}
+		return false, newKeyLockedError(key, *r.Lock)
+	}
+
+	for _, v := range r.Versions {
+		if v.StartTS == req.StartTS {
+			if v.Op == txnpb.Rollback {
+				return false, newTxnAbortedError(key, req.StartTS)
+			}
+			// already committed, the prewrite is retried
+			return false, nil
+		}
+
+		if v.Op != txnpb.Rollback && v.CommitTS >= req.StartTS {
+			return false, newWriteConflictError(key, req.StartTS, v.CommitTS)
+		}
+	}
+
+	// the versions which may conflict with the txn, or the rollback of the txn are
+	// removed by gc
+	if req.StartTS <= r.GcTS {
+		return false, newWriteConflictError(key, req.StartTS, r.GcTS)
+	}
+
+	r.Lock = &txnpb.Lock{
+		Primary:  req.Primary,
+		StartTS:  req.StartTS,
+		TTL:      req.TTL,
+		LockTime: req.LockTime,
+		Op:       req.Mutation.Op,
+		Value:    req.Mutation.Value,
+	}
+	return true, nil
+}
+
+// commit commit the lock of the txn, returns true if the record is changed
+func commit(r *txnpb.Record, key []byte, req txnpb.CommitRequest) (bool, *txnpb.TxnError) {
+	if r.Lock != nil && r.Lock.StartTS == req.StartTS {
+		addVersion(r, txnpb.Version{
+			StartTS:  req.StartTS,
+			CommitTS: req.CommitTS,
+			Op:       r.Lock.Op,
+			Value:    r.Lock.Value,
+		})
+		r.Lock = nil
+		return true, nil
+	}
+
+	if v, ok := findVersion(r, req.StartTS); ok && v.Op != txnpb.Rollback {
+		return false, nil
+	}
+	return false, newTxnAbortedError(key, req.StartTS)
+}
+
+// rollback rollback the lock of the txn, a rollback version is written to prevent the
+// prewrite which is arrived after the rollback. Returns true if the record is changed.
+func rollback(r *txnpb.Record, key []byte, startTS uint64) (bool, *txnpb.TxnError) {
+	if r.Lock != nil && r.Lock.StartTS == startTS {
+		r.Lock = nil
+	} else if v, ok := findVersion(r, startTS); ok {
+		if v.Op == txnpb.Rollback {
+			return false, nil
+		}
+		return false, &txnpb.TxnError{
+			Message: fmt.Sprintf("txn %d is already committed on key %+v", startTS, key),
+		}
+	}
+
+	addVersion(r, txnpb.Version{
+		StartTS:  startTS,
+		CommitTS: startTS,
+		Op:       txnpb.Rollback,
+	})
+	return true, nil
+}
+
+// checkTxnStatus check the txn status by the primary key, the expired lock will be rolled
+// back. Returns true if the record is changed.
+func checkTxnStatus(r *txnpb.Record, key []byte, req txnpb.CheckTxnStatusRequest) (bool, txnpb.CheckTxnStatusResponse) {
+	if r.Lock != nil && r.Lock.StartTS == req.StartTS {
+		if req.CurrentTime < r.Lock.LockTime+int64(r.Lock.TTL) {
+			lock := *r.Lock
+			return false, txnpb.CheckTxnStatusResponse{Lock: &lock}
+		}
+
+		logger.Infof("txn %d lock of primary key %+v is expired, rollback",
+			req.StartTS,
+			key)
+	}
+
+	if v, ok := findVersion(r, req.StartTS); ok && v.Op != txnpb.Rollback {
+		return false, txnpb.CheckTxnStatusResponse{CommitTS: v.CommitTS}
+	}
+
+	changed, _ := rollback(r, key, req.StartTS)
+	return changed, txnpb.CheckTxnStatusResponse{RolledBack: true}
+}
+
+// get returns the value of the key which is visible at the startTS
+func get(r *txnpb.Record, key []byte, startTS uint64) (txnpb.GetResponse, *txnpb.TxnError) {
+	if r.Lock != nil && r.Lock.StartTS <= startTS {
+		return txnpb.GetResponse{}, newKeyLockedError(key, *r.Lock)
+	}
+
+	for _, v := range r.Versions {
+		if v.Op == txnpb.Rollback || v.CommitTS > startTS {
+			continue
+		}
+
+		if v.Op == txnpb.Delete {
+			return txnpb.GetResponse{}, nil
+		}
+		return txnpb.GetResponse{Value: v.Value, Found: true}, nil
+	}
+
+	if r.GcTS > 0 {
+		return txnpb.GetResponse{}, &txnpb.TxnError{
+			Message: fmt.Sprintf("versions of key %+v before %d are removed by gc", key, r.GcTS),
+		}
+	}
+	return txnpb.GetResponse{}, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrewriteAndCommit(t *testing.T) {
+	key := []byte("k1")
+	r := &txnpb.Record{}
+
+	changed, err := prewrite(r, key, txnpb.PrewriteRequest{
+		Mutation: txnpb.Mutation{Op: txnpb.Put, Key: key, Value: []byte("v1")},
+		Primary:  key,
+		StartTS:  10,
+	})
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.NotNil(t, r.Lock)
+
+	// retry prewrite
+	changed, err = prewrite(r, key, txnpb.PrewriteRequest{StartTS: 10})
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	// locked by other txn
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{StartTS: 11})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.KeyLocked)
+	_, err = get(r, key, 12)
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.KeyLocked)
+	value, err := get(r, key, 9)
+	assert.Nil(t, err)
+	assert.False(t, value.Found)
+
+	changed, err = commit(r, key, txnpb.CommitRequest{StartTS: 10, CommitTS: 20})
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, r.Lock)
+
+	// retry commit
+	changed, err = commit(r, key, txnpb.CommitRequest{StartTS: 10, CommitTS: 20})
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	value, err = get(r, key, 19)
+	assert.Nil(t, err)
+	assert.False(t, value.Found)
+	value, err = get(r, key, 20)
+	assert.Nil(t, err)
+	assert.True(t, value.Found)
+	assert.Equal(t, []byte("v1"), value.Value)
+
+	// write conflict
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{StartTS: 15})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.WriteConflict)
+
+	// delete
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{
+		Mutation: txnpb.Mutation{Op: txnpb.Delete, Key: key},
+		Primary:  key,
+		StartTS:  30,
+	})
+	assert.Nil(t, err)
+	_, err = commit(r, key, txnpb.CommitRequest{StartTS: 30, CommitTS: 40})
+	assert.Nil(t, err)
+	value, err = get(r, key, 41)
+	assert.Nil(t, err)
+	assert.False(t, value.Found)
+	value, err = get(r, key, 39)
+	assert.Nil(t, err)
+	assert.True(t, value.Found)
+}
+
+func TestRollback(t *testing.T) {
+	key := []byte("k1")
+	r := &txnpb.Record{}
+
+	_, err := prewrite(r, key, txnpb.PrewriteRequest{Primary: key, StartTS: 10})
+	assert.Nil(t, err)
+	changed, err := rollback(r, key, 10)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, r.Lock)
+
+	// retry rollback
+	changed, err = rollback(r, key, 10)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	// prewrite after rollback
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{Primary: key, StartTS: 10})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.TxnAborted)
+	_, err = commit(r, key, txnpb.CommitRequest{StartTS: 10, CommitTS: 20})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.TxnAborted)
+
+	// rollback is not a write conflict
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{Primary: key, StartTS: 5})
+	assert.Nil(t, err)
+	_, err = commit(r, key, txnpb.CommitRequest{StartTS: 5, CommitTS: 30})
+	assert.Nil(t, err)
+
+	// rollback the committed txn
+	_, err = rollback(r, key, 5)
+	assert.NotNil(t, err)
+}
+
+func TestCheckTxnStatus(t *testing.T) {
+	key := []byte("k1")
+	r := &txnpb.Record{}
+
+	_, err := prewrite(r, key, txnpb.PrewriteRequest{Primary: key, StartTS: 10, TTL: 100, LockTime: 1000})
+	assert.Nil(t, err)
+	changed, status := checkTxnStatus(r, key, txnpb.CheckTxnStatusRequest{StartTS: 10, CurrentTime: 1050})
+	assert.False(t, changed)
+	assert.NotNil(t, status.Lock)
+
+	changed, status = checkTxnStatus(r, key, txnpb.CheckTxnStatusRequest{StartTS: 10, CurrentTime: 1100})
+	assert.True(t, changed)
+	assert.True(t, status.RolledBack)
+	assert.Nil(t, r.Lock)
+
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{Primary: key, StartTS: 20, TTL: 100, LockTime: 1000})
+	assert.Nil(t, err)
+	_, err = commit(r, key, txnpb.CommitRequest{StartTS: 20, CommitTS: 30})
+	assert.Nil(t, err)
+	changed, status = checkTxnStatus(r, key, txnpb.CheckTxnStatusRequest{StartTS: 20, CurrentTime: 2000})
+	assert.False(t, changed)
+	assert.Equal(t, uint64(30), status.CommitTS)
+
+	// the txn is not prewritten on the primary key
+	changed, status = checkTxnStatus(r, key, txnpb.CheckTxnStatusRequest{StartTS: 40, CurrentTime: 2000})
+	assert.True(t, changed)
+	assert.True(t, status.RolledBack)
+}
+
+func TestVersionsGC(t *testing.T) {
+	defer func(old int) {
+		maxVersions = old
+	}(maxVersions)
+	maxVersions = 2
+
+	key := []byte("k1")
+	r := &txnpb.Record{}
+	for i := uint64(1); i <= 3; i++ {
+		_, err := prewrite(r, key, txnpb.PrewriteRequest{
+			Mutation: txnpb.Mutation{Op: txnpb.Put, Key: key, Value: key},
+			Primary:  key,
+			StartTS:  i * 10,
+		})
+		assert.Nil(t, err)
+		_, err = commit(r, key, txnpb.CommitRequest{StartTS: i * 10, CommitTS: i*10 + 1})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 2, len(r.Versions))
+	assert.Equal(t, uint64(11), r.GcTS)
+	_, err := get(r, key, 15)
+	assert.NotNil(t, err)
+	value, err := get(r, key, 25)
+	assert.Nil(t, err)
+	assert.True(t, value.Found)
+}
+
+func TestPrewriteAfterVersionsGC(t *testing.T) {
+	defer func(old int) {
+		maxVersions = old
+	}(maxVersions)
+	maxVersions = 2
+
+	key := []byte("k1")
+	r := &txnpb.Record{}
+	for i := uint64(1); i <= 3; i++ {
+		_, err := prewrite(r, key, txnpb.PrewriteRequest{
+			Mutation: txnpb.Mutation{Op: txnpb.Put, Key: key, Value: key},
+			Primary:  key,
+			StartTS:  i * 10,
+		})
+		assert.Nil(t, err)
+		_, err = commit(r, key, txnpb.CommitRequest{StartTS: i * 10, CommitTS: i*10 + 1})
+		assert.Nil(t, err)
+	}
+
+	// the removed version committed at 11 conflicts with the txn
+	_, err := prewrite(r, key, txnpb.PrewriteRequest{StartTS: 5})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.WriteConflict)
+	assert.Nil(t, r.Lock)
+}
+
+func TestPrewriteAfterRollbackGC(t *testing.T) {
+	defer func(old int) {
+		maxVersions = old
+	}(maxVersions)
+	maxVersions = 2
+
+	key := []byte("k1")
+	r := &txnpb.Record{}
+	for i := uint64(1); i <= 3; i++ {
+		_, err := rollback(r, key, i*10)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, len(r.Versions))
+	assert.Equal(t, uint64(10), r.GcTS)
+
+	// the rollback of the txn is removed, but the txn can't be prewritten again
+	_, err := prewrite(r, key, txnpb.PrewriteRequest{StartTS: 10})
+	assert.NotNil(t, err)
+	assert.Nil(t, r.Lock)
+	_, err = commit(r, key, txnpb.CommitRequest{StartTS: 10, CommitTS: 40})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.TxnAborted)
+
+	// the rollbacks kept are still checked
+	_, err = prewrite(r, key, txnpb.PrewriteRequest{StartTS: 20})
+	assert.NotNil(t, err)
+	assert.NotNil(t, err.TxnAborted)
+}
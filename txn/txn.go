@@ -0,0 +1,56 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txn implements the percolator-style distributed transactions on top of raftstore.
+//
+// All the transaction data of a key, the lock and the versions, are stored in a single
+// record which is the value of the key in the DataStorage, so the split and merge of the
+// shards never separate them. A transaction prewrites all the mutations with locks, then
+// commits the primary key with a commit timestamp, once the primary key committed, the
+// transaction is committed, the locks of the secondary keys are committed asynchronously
+// or resolved by the readers. The timestamps of the transactions are allocated by the timestamp
+// oracle of the prophet.
+package txn
+
+import (
+	"github.com/fagongzi/log"
+	"github.com/matrixorigin/matrixcube/raftstore"
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[matrixcube-txn]")
+)
+
+// The custom types of the transaction commands, the application's custom types must not
+// conflict with them.
+const (
+	// PrewriteType prewrite command, write
+	PrewriteType uint64 = (1 << 63) + iota
+	// CommitType commit command, write
+	CommitType
+	// RollbackType rollback command, write
+	RollbackType
+	// CheckTxnStatusType check the transaction status by the primary key, write
+	CheckTxnStatusType
+	// GetType get command, read
+	GetType
+)
+
+// RegisterCommands register the transaction commands to the store
+func RegisterCommands(store raftstore.Store) {
+	store.RegisterWriteFunc(PrewriteType, prewriteCmd)
+	store.RegisterWriteFunc(CommitType, commitCmd)
+	store.RegisterWriteFunc(RollbackType, rollbackCmd)
+	store.RegisterWriteFunc(CheckTxnStatusType, checkTxnStatusCmd)
+	store.RegisterReadFunc(GetType, getCmd)
+}
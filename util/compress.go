@@ -15,6 +15,8 @@ package util
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"compress/gzip"
 	"fmt"
 	"io"
@@ -26,6 +28,8 @@ import (
 
 var (
 	logger = log.NewLoggerWithPrefix("[util]")
+
+	gzipMagic = []byte{0x1f, 0x8b}
 )
 
 // GZIP compress a path to a gzip file
@@ -51,6 +55,46 @@ func UnGZIP(fs vfs.FS, file string, dest string) error {
 	return deCompress(fs, file, dest)
 }
 
+// Tar archives a path to a tar file without compression
+func Tar(fs vfs.FS, path string) error {
+	file, err := fs.Open(path)
+	if err != nil {
+		return err
+	}
+
+	d, err := fs.Create(fmt.Sprintf("%s.tar", path))
+	if err != nil {
+		file.Close()
+		return err
+	}
+	defer d.Close()
+	tw := tar.NewWriter(d)
+	defer tw.Close()
+
+	return compress(fs, file, path, "", tw)
+}
+
+// UnTar extracts a tar file, the file created by GZIP is also supported
+func UnTar(fs vfs.FS, file string, dest string) error {
+	srcFile, err := fs.Open(file)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	r := bufio.NewReader(srcFile)
+	magic, err := r.Peek(len(gzipMagic))
+	if err == nil && bytes.Equal(magic, gzipMagic) {
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		return extract(fs, tar.NewReader(gr), dest)
+	}
+	return extract(fs, tar.NewReader(r), dest)
+}
+
 // both file and filePath are provided here as we can no longer assume that the
 // full path of the specified file is still accessible from the file object. we
 // do need the full path info to list the directory when file is a directory.
@@ -106,7 +150,10 @@ func deCompress(fs vfs.FS, tarFile, dest string) error {
 		return err
 	}
 	defer gr.Close()
-	tr := tar.NewReader(gr)
+	return extract(fs, tar.NewReader(gr), dest)
+}
+
+func extract(fs vfs.FS, tr *tar.Reader, dest string) error {
 	for {
 		hdr, err := tr.Next()
 		if err != nil {
@@ -121,7 +168,11 @@ func deCompress(fs vfs.FS, tarFile, dest string) error {
 		if err != nil {
 			return err
 		}
-		io.Copy(file, tr)
+		_, err = io.Copy(file, tr)
+		file.Close()
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,54 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/matrixorigin/matrixcube/vfs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTarAndUnTar(t *testing.T) {
+	fs := vfs.GetTestFS()
+	root := fs.PathJoin(GetTestDir(), "compress")
+	fs.RemoveAll(root)
+	defer fs.RemoveAll(root)
+
+	path := fs.PathJoin(root, "snap")
+	assert.NoError(t, fs.MkdirAll(path, 0755))
+	f, err := fs.Create(fs.PathJoin(path, "data"))
+	assert.NoError(t, err)
+	_, err = f.Write([]byte("hello"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	assert.NoError(t, Tar(fs, path))
+	assert.NoError(t, GZIP(fs, path))
+
+	// the gzip file created by the previous versions is supported
+	for i, file := range []string{path + ".tar", path + ".gz"} {
+		dest := fs.PathJoin(root, "dest", string(rune('a'+i)))
+		assert.NoError(t, fs.MkdirAll(dest, 0755))
+		assert.NoError(t, UnTar(fs, file, dest))
+
+		f, err := fs.Open(fs.PathJoin(dest, "snap", "data"))
+		assert.NoError(t, err)
+		data, err := ioutil.ReadAll(f)
+		assert.NoError(t, err)
+		assert.NoError(t, f.Close())
+		assert.Equal(t, "hello", string(data))
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec"
+)
+
+// SessionOptions the options of the client session
+type SessionOptions struct {
+	Encoder      codec.Encoder
+	Decoder      codec.Decoder
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+// NewIOSession returns a client goetty.IOSession which connects to the server by tls. The
+// goetty.IOSession created by goetty always dials by tcp, so this session is used if the
+// tls is enabled. The messages are flushed in Write, and the Write is thread safe.
+func NewIOSession(l *Loader, opts SessionOptions) goetty.IOSession {
+	return &session{
+		loader: l,
+		opts:   opts,
+		in:     buf.NewByteBuf(goetty.DefaultReadBuf),
+		out:    buf.NewByteBuf(goetty.DefaultWriteBuf),
+	}
+}
+
+type session struct {
+	loader *Loader
+	opts   SessionOptions
+	attrs  sync.Map
+	in     *buf.ByteBuf
+
+	mu struct {
+		sync.RWMutex
+		conn       net.Conn
+		remoteAddr string
+	}
+	// outMu protects the out buffer and the writes to the conn
+	outMu sync.Mutex
+	out   *buf.ByteBuf
+}
+
+func (s *session) ID() uint64 {
+	return 0
+}
+
+func (s *session) Connect(addr string, timeout time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mu.conn != nil {
+		return true, nil
+	}
+
+	conn, err := s.loader.Dial(addr, timeout)
+	if err != nil {
+		return false, err
+	}
+
+	s.in.Clear()
+	s.outMu.Lock()
+	s.out.Clear()
+	s.outMu.Unlock()
+	s.mu.conn = conn
+	s.mu.remoteAddr = conn.RemoteAddr().String()
+	return true, nil
+}
+
+func (s *session) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mu.conn == nil {
+		return nil
+	}
+
+	err := s.mu.conn.Close()
+	s.mu.conn = nil
+	return err
+}
+
+func (s *session) Connected() bool {
+	return s.conn() != nil
+}
+
+func (s *session) Read() (interface{}, error) {
+	conn := s.conn()
+	if conn == nil {
+		return nil, goetty.ErrIllegalState
+	}
+
+	for {
+		if s.in.Readable() > 0 {
+			complete, msg, err := s.opts.Decoder.Decode(s.in)
+			if err != nil {
+				s.in.Clear()
+				return nil, err
+			}
+
+			if complete {
+				if s.in.Readable() == 0 {
+					s.in.Clear()
+				}
+				return msg, nil
+			}
+		} else {
+			s.in.Clear()
+		}
+
+		if s.opts.ReadTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(s.opts.ReadTimeout))
+		} else {
+			conn.SetReadDeadline(time.Time{})
+		}
+
+		n, err := s.in.ReadFrom(conn)
+		if err != nil {
+			s.in.Clear()
+			return nil, err
+		}
+
+		if n == 0 {
+			s.in.Clear()
+			return nil, io.EOF
+		}
+	}
+}
+
+func (s *session) Write(msg interface{}) error {
+	return s.WriteAndFlush(msg)
+}
+
+func (s *session) WriteAndFlush(msg interface{}) error {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+
+	if err := s.opts.Encoder.Encode(msg, s.out); err != nil {
+		return err
+	}
+	return s.flushLocked()
+}
+
+func (s *session) Flush() error {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+
+	return s.flushLocked()
+}
+
+func (s *session) flushLocked() error {
+	defer s.out.Clear()
+
+	conn := s.conn()
+	if conn == nil {
+		return goetty.ErrIllegalState
+	}
+
+	if s.opts.WriteTimeout > 0 {
+		conn.SetWriteDeadline(time.Now().Add(s.opts.WriteTimeout))
+	} else {
+		conn.SetWriteDeadline(time.Time{})
+	}
+
+	_, err := conn.Write(s.out.RawBuf()[s.out.GetReaderIndex():s.out.GetWriteIndex()])
+	return err
+}
+
+func (s *session) InBuf() *buf.ByteBuf {
+	return s.in
+}
+
+func (s *session) OutBuf() *buf.ByteBuf {
+	return s.out
+}
+
+func (s *session) SetAttr(key string, value interface{}) {
+	s.attrs.Store(key, value)
+}
+
+func (s *session) GetAttr(key string) interface{} {
+	if v, ok := s.attrs.Load(key); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *session) RemoteAddr() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.mu.remoteAddr
+}
+
+func (s *session) RemoteIP() string {
+	return strings.Split(s.RemoteAddr(), ":")[0]
+}
+
+func (s *session) conn() net.Conn {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.mu.conn
+}
@@ -0,0 +1,293 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[tls]")
+
+	// reloadCheckInterval the interval to check whether the certificate files are changed
+	reloadCheckInterval = time.Second
+)
+
+// Config the tls config, the tls is enabled if the CertFile and the KeyFile are set.
+// The same certificate is used as the server certificate and the client certificate.
+type Config struct {
+	// CAFile the CA certificate to verify the certificate of the other side
+	CAFile string `toml:"ca-file"`
+	// CertFile the certificate
+	CertFile string `toml:"cert-file"`
+	// KeyFile the private key of the certificate
+	KeyFile string `toml:"key-file"`
+	// ClientCertAuth require and verify the client certificates by the CA
+	ClientCertAuth bool `toml:"client-cert-auth"`
+}
+
+// Enabled returns true if the tls is enabled
+func (c Config) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// Validate validates the config
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		if c.CertFile != "" || c.KeyFile != "" || c.CAFile != "" || c.ClientCertAuth {
+			return errors.New("tls cert-file and key-file must be set together")
+		}
+		return nil
+	}
+
+	if c.ClientCertAuth && c.CAFile == "" {
+		return errors.New("tls ca-file must be set if client-cert-auth enabled")
+	}
+	return nil
+}
+
+// Loader loads the certificates of the config, and reloads them if the files are
+// changed. So the certificates can be rotated without restart. The tls configs
+// returned by the Loader always use the latest certificates.
+type Loader struct {
+	cfg Config
+
+	mu struct {
+		sync.Mutex
+		cert     *tls.Certificate
+		pool     *x509.CertPool
+		modTimes []time.Time
+		checked  time.Time
+	}
+}
+
+// NewLoader returns a Loader of the config, returns nil if the tls is not enabled.
+func NewLoader(cfg Config) (*Loader, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	l := &Loader{cfg: cfg}
+	if err := l.load(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ServerConfig returns the tls config of the listeners, returns nil if the loader is nil
+func (l *Loader) ServerConfig() *tls.Config {
+	if l == nil {
+		return nil
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := l.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+			}
+			if l.cfg.ClientCertAuth {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns the tls config to connect to the server, returns nil if the loader is nil
+func (l *Loader) ClientConfig() *tls.Config {
+	if l == nil {
+		return nil
+	}
+
+	return l.clientConfig("")
+}
+
+// clientConfig returns the tls config to connect to the server. The server certificate is
+// verified by the VerifyConnection with the current CA of the loader instead of the RootCAs,
+// so the long-lived config uses the rotated CA. The server name in the SNI is verified, the
+// serverName is verified if the SNI is not sent, e.g. the server is dialed by the ip address.
+func (l *Loader) clientConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			name := serverName
+			if cs.ServerName != "" {
+				name = cs.ServerName
+			}
+
+			_, pool := l.current()
+			return verifyServerCertificate(cs.PeerCertificates, name, pool)
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := l.current()
+			return cert, nil
+		},
+	}
+}
+
+// Listen returns a tls listener if the loader is not nil, otherwise returns a tcp listener
+func (l *Loader) Listen(addr string) (net.Listener, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return listener, nil
+	}
+	return tls.NewListener(listener, l.ServerConfig()), nil
+}
+
+// Dial connects to the address using tls if the loader is not nil
+func (l *Loader) Dial(addr string, timeout time.Duration) (net.Conn, error) {
+	if l == nil {
+		return net.DialTimeout("tcp", addr, timeout)
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	return tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", addr, l.clientConfig(host))
+}
+
+// verifyServerCertificate verifies the server certificate chain as the default verification
+// of the tls client, the system roots are used if roots is nil. The name of the server is not
+// verified if the serverName is empty.
+func verifyServerCertificate(certs []*x509.Certificate, serverName string, roots *x509.CertPool) error {
+	if len(certs) == 0 {
+		return errors.New("tls: server has no certificate")
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+func (l *Loader) current() (*tls.Certificate, *x509.CertPool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.mu.checked) >= reloadCheckInterval {
+		l.mu.checked = now
+		if l.changedLocked() {
+			if err := l.loadLocked(); err != nil {
+				logger.Errorf("reload tls certificates failed with %+v, use the previous certificates",
+					err)
+			} else {
+				logger.Infof("tls certificates reloaded")
+			}
+		}
+	}
+
+	return l.mu.cert, l.mu.pool
+}
+
+func (l *Loader) load() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.mu.checked = time.Now()
+	return l.loadLocked()
+}
+
+func (l *Loader) loadLocked() error {
+	modTimes, err := l.modTimes()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(l.cfg.CertFile, l.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	var pool *x509.CertPool
+	if l.cfg.CAFile != "" {
+		data, err := ioutil.ReadFile(l.cfg.CAFile)
+		if err != nil {
+			return err
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificate found in the ca file %s", l.cfg.CAFile)
+		}
+	}
+
+	l.mu.cert = &cert
+	l.mu.pool = pool
+	l.mu.modTimes = modTimes
+	return nil
+}
+
+func (l *Loader) changedLocked() bool {
+	modTimes, err := l.modTimes()
+	if err != nil {
+		logger.Errorf("check tls certificates failed with %+v", err)
+		return false
+	}
+
+	for i := range modTimes {
+		if !modTimes[i].Equal(l.mu.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Loader) modTimes() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, file := range []string{l.cfg.CertFile, l.cfg.KeyFile, l.cfg.CAFile} {
+		if file == "" {
+			modTimes = append(modTimes, time.Time{})
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
@@ -0,0 +1,233 @@
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec/length"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCodec struct{}
+
+func (c *testCodec) Decode(in *buf.ByteBuf) (bool, interface{}, error) {
+	data := append([]byte(nil), in.GetMarkedRemindData()...)
+	in.MarkedBytesReaded()
+	return true, data, nil
+}
+
+func (c *testCodec) Encode(data interface{}, out *buf.ByteBuf) error {
+	_, err := out.Write(data.([]byte))
+	return err
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	writeTestPEM(t, filepath.Join(dir, "ca.pem"), "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, dir string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	writeTestPEM(t, filepath.Join(dir, "cert.pem"), "CERTIFICATE", der)
+	writeTestPEM(t, filepath.Join(dir, "key.pem"), "EC PRIVATE KEY", keyDER)
+}
+
+func writeTestPEM(t *testing.T, file, typ string, der []byte) {
+	assert.NoError(t, ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600))
+}
+
+func newTestTLSDir(t *testing.T) (string, Config, *testCA) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	assert.NoError(t, err)
+
+	ca := newTestCA(t, dir)
+	ca.issue(t, dir, 2)
+	return dir, Config{
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		CertFile:       filepath.Join(dir, "cert.pem"),
+		KeyFile:        filepath.Join(dir, "key.pem"),
+		ClientCertAuth: true,
+	}, ca
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, Config{}.Validate())
+	assert.Error(t, Config{CertFile: "cert.pem"}.Validate())
+	assert.Error(t, Config{CertFile: "cert.pem", KeyFile: "key.pem", ClientCertAuth: true}.Validate())
+	assert.NoError(t, Config{CertFile: "cert.pem", KeyFile: "key.pem"}.Validate())
+
+	l, err := NewLoader(Config{})
+	assert.NoError(t, err)
+	assert.Nil(t, l)
+}
+
+func TestSession(t *testing.T) {
+	dir, cfg, _ := newTestTLSDir(t)
+	defer os.RemoveAll(dir)
+
+	l, err := NewLoader(cfg)
+	assert.NoError(t, err)
+	listener, err := l.Listen("127.0.0.1:0")
+	assert.NoError(t, err)
+
+	encoder, decoder := length.New(&testCodec{}, &testCodec{})
+	app, err := goetty.NewApplication(listener, func(conn goetty.IOSession, msg interface{}, _ uint64) error {
+		return conn.WriteAndFlush(msg)
+	}, goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder)))
+	assert.NoError(t, err)
+	assert.NoError(t, app.Start())
+	defer app.Stop()
+
+	conn := NewIOSession(l, SessionOptions{Encoder: encoder, Decoder: decoder, ReadTimeout: time.Second * 5})
+	ok, err := conn.Connect(listener.Addr().String(), time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	defer conn.Close()
+
+	assert.NoError(t, conn.Write([]byte("hello")))
+	msg, err := conn.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hello"), msg)
+
+	// the client without certificate is rejected
+	conn = NewIOSession(nil, SessionOptions{Encoder: encoder, Decoder: decoder, ReadTimeout: time.Second})
+	_, err = conn.Connect(listener.Addr().String(), time.Second)
+	assert.NoError(t, err)
+	assert.NoError(t, conn.Write([]byte("hello")))
+	_, err = conn.Read()
+	assert.Error(t, err)
+	conn.Close()
+}
+
+func TestReload(t *testing.T) {
+	old := reloadCheckInterval
+	reloadCheckInterval = 0
+	defer func() {
+		reloadCheckInterval = old
+	}()
+
+	dir, cfg, ca := newTestTLSDir(t)
+	defer os.RemoveAll(dir)
+
+	l, err := NewLoader(cfg)
+	assert.NoError(t, err)
+	cert, _ := l.current()
+	serial := parseTestSerial(t, cert.Certificate[0])
+	assert.Equal(t, int64(2), serial)
+
+	// the previous certificate is used if the new files are invalid
+	time.Sleep(time.Millisecond * 10)
+	assert.NoError(t, ioutil.WriteFile(cfg.KeyFile, []byte("invalid"), 0600))
+	cert, _ = l.current()
+	assert.Equal(t, int64(2), parseTestSerial(t, cert.Certificate[0]))
+
+	time.Sleep(time.Millisecond * 10)
+	ca.issue(t, dir, 3)
+	cert, _ = l.current()
+	assert.Equal(t, int64(3), parseTestSerial(t, cert.Certificate[0]))
+}
+
+func TestClientConfigWithRotatedCA(t *testing.T) {
+	old := reloadCheckInterval
+	reloadCheckInterval = 0
+	defer func() {
+		reloadCheckInterval = old
+	}()
+
+	dir, cfg, _ := newTestTLSDir(t)
+	defer os.RemoveAll(dir)
+
+	l, err := NewLoader(cfg)
+	assert.NoError(t, err)
+	listener, err := l.Listen("127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	// the client config is created before the CA rotated
+	clientCfg := l.ClientConfig()
+	clientCfg.ServerName = "127.0.0.1"
+	dial := func() error {
+		conn, err := tls.Dial("tcp", listener.Addr().String(), clientCfg)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+	assert.NoError(t, dial())
+
+	time.Sleep(time.Millisecond * 10)
+	newTestCA(t, dir).issue(t, dir, 3)
+	assert.NoError(t, dial())
+
+	// the server name is verified too
+	clientCfg.ServerName = "localhost"
+	assert.Error(t, dial())
+}
+
+func parseTestSerial(t *testing.T, der []byte) int64 {
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert.SerialNumber.Int64()
+}
@@ -13,6 +13,10 @@
 
 package util
 
+import (
+	"time"
+)
+
 var (
 	// OpSet op set
 	OpSet int32 = 0
@@ -31,6 +35,10 @@ type WriteBatch struct {
 	Keys   [][]byte
 	Values [][]byte
 	TTLs   []int32
+	// Now the unix time in seconds which the TTLs are based on, the current time is used if
+	// it's 0. The replicas set it to the propose time of the log, so the same write has the
+	// same expire time on all the replicas.
+	Now int64
 }
 
 // Delete remove the key
@@ -70,4 +78,20 @@ func (wb *WriteBatch) Reset() {
 	wb.Keys = wb.Keys[:0]
 	wb.Values = wb.Values[:0]
 	wb.TTLs = wb.TTLs[:0]
+	wb.Now = 0
+}
+
+// ExpireAt returns the expire time in unix seconds of the write at the index, 0 means it
+// never expires
+func (wb *WriteBatch) ExpireAt(idx int) int64 {
+	ttl := wb.TTLs[idx]
+	if ttl <= 0 {
+		return 0
+	}
+
+	now := wb.Now
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+	return now + int64(ttl)
 }
@@ -0,0 +1,33 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteBatchExpireAt(t *testing.T) {
+	wb := NewWriteBatch()
+	wb.Set([]byte("k1"), []byte("v1"))
+	wb.SetWithTTL([]byte("k2"), []byte("v2"), 10)
+	wb.Now = 100
+
+	assert.Equal(t, int64(0), wb.ExpireAt(0))
+	assert.Equal(t, int64(110), wb.ExpireAt(1))
+
+	wb.Reset()
+	assert.Equal(t, int64(0), wb.Now)
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vfs
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	pvfs "github.com/lni/vfs"
+)
+
+// Op is the type of the operation performed on the FS
+type Op = pvfs.Op
+
+const (
+	// OpRead describes read operations
+	OpRead = pvfs.OpRead
+	// OpWrite describes write operations
+	OpWrite = pvfs.OpWrite
+	// OpSync describes the fsync operation
+	OpSync = pvfs.OpSync
+)
+
+// ErrInjected is the error injected by the FaultInjector
+var ErrInjected = pvfs.ErrInjected
+
+// FaultInjector injects the latency and the errors into the operations of the FS returned
+// by NewFaultFS. The latency and the error rate can be changed at runtime, it is used to
+// test with the slow or broken disks.
+type FaultInjector struct {
+	sync.Mutex
+
+	latency   map[Op]time.Duration
+	errorRate map[Op]float64
+	rnd       *rand.Rand
+}
+
+// NewFaultInjector returns a FaultInjector which injects nothing
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		latency:   make(map[Op]time.Duration),
+		errorRate: make(map[Op]float64),
+		rnd:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// SetLatency sets the latency of the operations
+func (inj *FaultInjector) SetLatency(op Op, latency time.Duration) {
+	inj.Lock()
+	defer inj.Unlock()
+
+	inj.latency[op] = latency
+}
+
+// SetErrorRate sets the probability to fail the operations with ErrInjected, the rate
+// should be within the range [0.0,1.0].
+func (inj *FaultInjector) SetErrorRate(op Op, rate float64) {
+	inj.Lock()
+	defer inj.Unlock()
+
+	inj.errorRate[op] = rate
+}
+
+// Reset removes all the injected latency and errors
+func (inj *FaultInjector) Reset() {
+	inj.Lock()
+	defer inj.Unlock()
+
+	inj.latency = make(map[Op]time.Duration)
+	inj.errorRate = make(map[Op]float64)
+}
+
+// MaybeError implements the lni/vfs Injector interface
+func (inj *FaultInjector) MaybeError(op Op) error {
+	inj.Lock()
+	latency := inj.latency[op]
+	rate := inj.errorRate[op]
+	failed := rate > 0 && inj.rnd.Float64() < rate
+	inj.Unlock()
+
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+	if failed {
+		return ErrInjected
+	}
+	return nil
+}
+
+// NewFaultFS returns a FS which injects the faults of the injector into the operations
+// of the fs.
+func NewFaultFS(fs FS, inj *FaultInjector) FS {
+	return pvfs.Wrap(fs, inj)
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFaultFS(t *testing.T) {
+	inj := NewFaultInjector()
+	fs := NewFaultFS(NewMemFS(), inj)
+	defer ReportLeakedFD(fs, t)
+
+	f, err := fs.Create("test")
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	inj.SetErrorRate(OpWrite, 1)
+	_, err = fs.Create("test2")
+	assert.Equal(t, ErrInjected, err)
+	_, err = fs.Stat("test")
+	assert.NoError(t, err)
+
+	inj.Reset()
+	inj.SetLatency(OpRead, time.Millisecond*50)
+	start := time.Now()
+	_, err = fs.Stat("test")
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) >= time.Millisecond*50)
+}
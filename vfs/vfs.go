@@ -189,5 +189,8 @@ func Clean(dir string) string {
 
 // ReportLeakedFD reports leaked file fds.
 func ReportLeakedFD(fs FS, t *testing.T) {
+	if efs, ok := fs.(*pvfs.ErrorFS); ok {
+		fs = efs.Unwrap()
+	}
 	pvfs.ReportLeakedFD(fs, t)
 }